@@ -2,18 +2,32 @@ package actioncontroller
 
 import (
 	"fmt"
+	"github.com/chadius/terosgamerules/entity/battlegrid"
+	"github.com/chadius/terosgamerules/entity/faction"
+	"github.com/chadius/terosgamerules/entity/objective"
 	"github.com/chadius/terosgamerules/entity/powerusagescenario"
+	"github.com/chadius/terosgamerules/entity/squaddieinterface"
+	"github.com/chadius/terosgamerules/entity/trigger"
+	"github.com/chadius/terosgamerules/usecase/battleoutcome"
+	"github.com/chadius/terosgamerules/usecase/battletrigger"
+	"github.com/chadius/terosgamerules/usecase/battleturn"
+	"github.com/chadius/terosgamerules/usecase/experience"
+	"github.com/chadius/terosgamerules/usecase/itemequip"
+	"github.com/chadius/terosgamerules/usecase/itemuse"
+	"github.com/chadius/terosgamerules/usecase/levelup"
 	"github.com/chadius/terosgamerules/usecase/powerattackforecast"
 	"github.com/chadius/terosgamerules/usecase/powercantarget"
 	"github.com/chadius/terosgamerules/usecase/powercommit"
+	"github.com/chadius/terosgamerules/usecase/powerequip"
+	"github.com/chadius/terosgamerules/usecase/reaction"
 	"github.com/chadius/terosgamerules/usecase/repositories"
 	"github.com/chadius/terosgamerules/usecase/squaddiestats"
 	"github.com/chadius/terosgamerules/utility"
-	"math/rand"
 	"strings"
 )
 
-// WhiteRoomController assumes all Squaddies are within range and can attack each other.
+// WhiteRoomController assumes all Squaddies are within range and can attack each other,
+//   unless the battle has a grid that both squaddies stand on.
 type WhiteRoomController struct{}
 
 // SetupAction creates a record of the next action.
@@ -35,6 +49,9 @@ func (controller *WhiteRoomController) GenerateForecast(action *powerusagescenar
 			&repositories.RepositoryCollection{
 				SquaddieRepo: repos.SquaddieRepo,
 				PowerRepo:    repos.PowerRepo,
+				ItemRepo:     repos.ItemRepo,
+				FactionRepo:  repos.FactionRepo,
+				Grid:         repos.Grid,
 			},
 		).
 		OffenseStrategy(&squaddiestats.CalculateSquaddieOffenseStats{}).
@@ -45,19 +62,298 @@ func (controller *WhiteRoomController) GenerateForecast(action *powerusagescenar
 }
 
 // GenerateResult uses the forecast to create results.
+//   If useRandomSeed is true, the dice are seeded with randomSeed so the same seed always rolls the same results.
+//   Raises an error if the power's summon cannot join the battle.
 func (controller *WhiteRoomController) GenerateResult(
 	forecast *powerattackforecast.Forecast,
 	repos *repositories.RepositoryCollection,
 	useRandomSeed bool,
-	randomSeed int64) *powercommit.Result {
+	randomSeed int64) (*powercommit.Result, error) {
 
-	powerResult := powercommit.NewResult(forecast, &utility.RandomDieRoller{}, nil)
+	var dieRoller utility.SixSideGenerator = &utility.RandomDieRoller{}
 	if useRandomSeed == true {
-		rand.Seed(randomSeed)
+		dieRoller = utility.NewSeededDieRoller(randomSeed)
 	}
+	powerResult := powercommit.NewResult(forecast, dieRoller, nil)
 
-	powerResult.Commit()
-	return powerResult
+	err := powerResult.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return powerResult, nil
+}
+
+// AwardExperience gives experience to the squaddies who used powers in the result.
+func (controller *WhiteRoomController) AwardExperience(result *powercommit.Result, repos *repositories.RepositoryCollection) []*experience.Award {
+	awardStrategy := experience.AwardByRelativeLevel{}
+	return awardStrategy.AwardExperience(result, repos)
+}
+
+// ResolveLevelUps applies every pending level up for the squaddies that earned experience.
+//   bigLevelIDBySquaddieID names the big level each squaddie chooses, if it qualifies for one.
+//   randomSeed picks the small levels, so the same seed always gives the same level ups.
+//   Squaddies keep their pending level ups if they cannot be applied.
+func (controller *WhiteRoomController) ResolveLevelUps(awards []*experience.Award, bigLevelIDBySquaddieID map[string]string, randomSeed int64, repos *repositories.RepositoryCollection) []*experience.LevelUp {
+	levelUps := []*experience.LevelUp{}
+	if repos.LevelRepo == nil || repos.ClassRepo == nil {
+		return levelUps
+	}
+
+	resolveStrategy := experience.NewResolveLevelUpInCurrentClass(
+		levelup.NewSelectLevelUpBasedOnSquaddieBigLevelsOnEvenLevels(utility.NewSeededIntGenerator(randomSeed)),
+	)
+	for _, award := range awards {
+		squaddieToLevelUp := repos.SquaddieRepo.GetOriginalSquaddieByID(award.SquaddieID)
+		for squaddieToLevelUp.LevelUpsPending() > 0 {
+			levelUp, err := resolveStrategy.ResolvePendingLevelUp(squaddieToLevelUp, bigLevelIDBySquaddieID[award.SquaddieID], repos)
+			if err != nil {
+				break
+			}
+			levelUps = append(levelUps, levelUp)
+		}
+	}
+	return levelUps
+}
+
+// LevelUpSquaddie gives the squaddie a level in their current class, using a pending level up if they have one.
+//   bigLevelID names the big level the squaddie chooses, if it qualifies for one.
+//   randomSeed picks the small level, so the same seed always gives the same level up.
+func (controller *WhiteRoomController) LevelUpSquaddie(squaddieID, bigLevelID string, randomSeed int64, repos *repositories.RepositoryCollection) (*experience.LevelUp, error) {
+	squaddieToLevelUp, err := controller.getSquaddie(squaddieID, repos)
+	if err != nil {
+		return nil, err
+	}
+	resolveStrategy := experience.NewResolveLevelUpInCurrentClass(
+		levelup.NewSelectLevelUpBasedOnSquaddieBigLevelsOnEvenLevels(utility.NewSeededIntGenerator(randomSeed)),
+	)
+	if squaddieToLevelUp.LevelUpsPending() > 0 {
+		return resolveStrategy.ResolvePendingLevelUp(squaddieToLevelUp, bigLevelID, repos)
+	}
+	return resolveStrategy.ApplyLevelUp(squaddieToLevelUp, bigLevelID, repos)
+}
+
+// ChangeSquaddieClass switches the squaddie's current class.
+//   Raises an error if the squaddie does not qualify for the class.
+func (controller *WhiteRoomController) ChangeSquaddieClass(squaddieID, classID string, repos *repositories.RepositoryCollection) error {
+	squaddieToChange, err := controller.getSquaddie(squaddieID, repos)
+	if err != nil {
+		return err
+	}
+	if repos.LevelRepo == nil || repos.ClassRepo == nil {
+		newError := fmt.Errorf(`squaddie "%s" cannot change class without level and class repositories`, squaddieToChange.Name())
+		utility.Log(newError.Error(), 0, utility.Error)
+		return newError
+	}
+
+	classToUse, err := repos.ClassRepo.GetClassByID(classID)
+	if err != nil {
+		return err
+	}
+
+	classChecker := levelup.PromotionChecker{}
+	if classChecker.SquaddieCanSwitchToClass(squaddieToChange, classID, repos) == false {
+		newError := fmt.Errorf(`squaddie "%s" cannot switch to class "%s"`, squaddieToChange.Name(), classToUse.Name())
+		utility.Log(newError.Error(), 0, utility.Error)
+		return newError
+	}
+
+	squaddieToChange.AddClass(classToUse.GetReference())
+	return squaddieToChange.SetClass(classID)
+}
+
+// EquipSquaddiePower makes the squaddie equip the power.
+//   Raises an error if the squaddie cannot equip it.
+func (controller *WhiteRoomController) EquipSquaddiePower(squaddieID, powerID string, repos *repositories.RepositoryCollection) error {
+	squaddieToEquip, err := controller.getSquaddie(squaddieID, repos)
+	if err != nil {
+		return err
+	}
+	equipCheck := powerequip.CheckRepositories{}
+	if equipCheck.SquaddieEquipPower(squaddieToEquip, powerID, repos) == false {
+		newError := fmt.Errorf(`squaddie "%s" cannot equip power "%s"`, squaddieToEquip.Name(), powerID)
+		utility.Log(newError.Error(), 0, utility.Error)
+		return newError
+	}
+	return nil
+}
+
+// EquipSquaddieItem makes the squaddie equip an item from its inventory.
+//   Raises an error if the squaddie does not exist or cannot equip it.
+func (controller *WhiteRoomController) EquipSquaddieItem(squaddieID, itemID string, repos *repositories.RepositoryCollection) error {
+	squaddieToEquip, err := controller.getSquaddie(squaddieID, repos)
+	if err != nil {
+		return err
+	}
+	equipCheck := itemequip.CheckRepositories{}
+	return equipCheck.SquaddieEquipItem(squaddieToEquip, itemID, repos)
+}
+
+// UnequipSquaddieItem makes the squaddie unequip the item in the slot and returns the unequipped item's ID.
+//   Raises an error if the squaddie does not exist or the slot is empty.
+func (controller *WhiteRoomController) UnequipSquaddieItem(squaddieID, slot string, repos *repositories.RepositoryCollection) (string, error) {
+	squaddieToUnequip, err := controller.getSquaddie(squaddieID, repos)
+	if err != nil {
+		return "", err
+	}
+	itemID := squaddieToUnequip.GetEquippedItemID(slot)
+	equipCheck := itemequip.CheckRepositories{}
+	err = equipCheck.SquaddieUnequipItem(squaddieToUnequip, slot, repos)
+	if err != nil {
+		return "", err
+	}
+	return itemID, nil
+}
+
+// SetupItemUse creates a record of the squaddie using the consumable item's power.
+//   Raises an error if the squaddie cannot use the item.
+func (controller *WhiteRoomController) SetupItemUse(userID, itemID string, targetIDs []string, repos *repositories.RepositoryCollection) (*powerusagescenario.Setup, error) {
+	user := repos.SquaddieRepo.GetOriginalSquaddieByID(userID)
+	useCheck := itemuse.CheckInventories{}
+	powerID, err := useCheck.GetConsumablePowerID(user, itemID, repos)
+	if err != nil {
+		return nil, err
+	}
+	return controller.SetupAction(userID, targetIDs, powerID), nil
+}
+
+// ConsumeItem uses up one copy of the item from the squaddie or its team.
+func (controller *WhiteRoomController) ConsumeItem(userID, itemID string, repos *repositories.RepositoryCollection) error {
+	user := repos.SquaddieRepo.GetOriginalSquaddieByID(userID)
+	useCheck := itemuse.CheckInventories{}
+	return useCheck.ConsumeItem(user, itemID, repos)
+}
+
+// EndSquaddieTurn ends the squaddie's turn, using up a turn of any override to its affiliation
+//   and of its summon's duration.
+//   Raises an error if the squaddie does not exist.
+func (controller *WhiteRoomController) EndSquaddieTurn(squaddieID string, repos *repositories.RepositoryCollection) (*battleturn.TurnEnd, error) {
+	squaddieToUpdate, err := controller.getSquaddie(squaddieID, repos)
+	if err != nil {
+		return nil, err
+	}
+	return battleturn.EndSquaddieTurn(squaddieToUpdate), nil
+}
+
+// StartNewTurn ends the turn of every squaddie who has not ended it yet and starts a new one,
+//   resetting their reactions, applying their lingering effects, regenerating their mana and
+//   counting down their power cooldowns.
+func (controller *WhiteRoomController) StartNewTurn(squaddieIDs []string, repos *repositories.RepositoryCollection) *battleturn.TurnStart {
+	return battleturn.StartNewTurn(squaddieIDs, repos)
+}
+
+// GuardSquaddie makes the guardian take the attacks aimed at the guarded squaddie until the next turn starts.
+//   Raises an error if the guardian cannot guard the squaddie.
+func (controller *WhiteRoomController) GuardSquaddie(guardianID, guardedID string, repos *repositories.RepositoryCollection) error {
+	return reaction.StartGuarding(guardianID, guardedID, repos)
+}
+
+// StartOverwatch makes the squaddie attack with the power when a foe moves into its range, until the next turn starts.
+//   If powerID is empty, the squaddie uses its equipped power.
+//   Raises an error if the squaddie cannot go on overwatch.
+func (controller *WhiteRoomController) StartOverwatch(squaddieID, powerID string, repos *repositories.RepositoryCollection) error {
+	return reaction.StartOverwatch(squaddieID, powerID, repos)
+}
+
+// MoveSquaddie moves the squaddie to the destination on the battle grid.
+//   Returns the IDs of the squaddies whose overwatch the move triggered.
+//   Raises an error if the battle has no grid, if the squaddie is missing, dead or not on the grid,
+//   or if the destination is taken or too far away.
+func (controller *WhiteRoomController) MoveSquaddie(squaddieID string, destination battlegrid.Coordinate, repos *repositories.RepositoryCollection) ([]string, error) {
+	mover, err := controller.getSquaddie(squaddieID, repos)
+	if err != nil {
+		return nil, err
+	}
+
+	if repos.Grid == nil {
+		newError := fmt.Errorf(`squaddie "%s" cannot move, the battle has no grid`, mover.Name())
+		utility.Log(newError.Error(), 0, utility.Error)
+		return nil, newError
+	}
+
+	origin, onGrid := repos.Grid.SquaddieCoordinate(squaddieID)
+	if !onGrid {
+		newError := fmt.Errorf(`squaddie "%s" cannot move, it is not on the grid`, mover.Name())
+		utility.Log(newError.Error(), 0, utility.Error)
+		return nil, newError
+	}
+
+	if mover.IsDead() {
+		newError := fmt.Errorf(`squaddie "%s" cannot move, it is dead`, mover.Name())
+		utility.Log(newError.Error(), 0, utility.Error)
+		return nil, newError
+	}
+
+	if origin.DistanceTo(destination) > mover.MovementDistance() {
+		newError := fmt.Errorf(`squaddie "%s" cannot move %d steps, it moves up to %d`, mover.Name(), origin.DistanceTo(destination), mover.MovementDistance())
+		utility.Log(newError.Error(), 0, utility.Error)
+		return nil, newError
+	}
+
+	err = repos.Grid.PlaceSquaddie(squaddieID, destination)
+	if err != nil {
+		return nil, err
+	}
+	return reaction.FindOverwatchers(squaddieID, origin, repos), nil
+}
+
+// TriggerOverwatch takes the overwatcher off overwatch and creates a record of it attacking the mover.
+func (controller *WhiteRoomController) TriggerOverwatch(overwatcherID, moverID string, repos *repositories.RepositoryCollection) *powerusagescenario.Setup {
+	overwatcher := repos.SquaddieRepo.GetOriginalSquaddieByID(overwatcherID)
+	powerID := overwatcher.OverwatchPowerID()
+	overwatcher.EndOverwatch()
+	return controller.SetupAction(overwatcherID, []string{moverID}, powerID)
+}
+
+// getSquaddie returns the squaddie with the given ID.
+//   Raises an error if the squaddie does not exist.
+func (controller *WhiteRoomController) getSquaddie(squaddieID string, repos *repositories.RepositoryCollection) (squaddieinterface.Interface, error) {
+	squaddieFound := repos.SquaddieRepo.GetOriginalSquaddieByID(squaddieID)
+	if squaddieFound == nil {
+		newError := fmt.Errorf(`squaddie "%s" does not exist`, squaddieID)
+		utility.Log(newError.Error(), 0, utility.Error)
+		return nil, newError
+	}
+	return squaddieFound, nil
+}
+
+// ChangeFactionRelationship changes how both factions treat each other.
+//   Raises an error if the relationship is unknown.
+func (controller *WhiteRoomController) ChangeFactionRelationship(factionID, otherFactionID, relationship string, repos *repositories.RepositoryCollection) error {
+	if repos.FactionRepo == nil {
+		repos.FactionRepo = faction.NewRepository()
+	}
+	return repos.FactionRepo.SetRelationship(factionID, otherFactionID, relationship)
+}
+
+// CheckBattleObjectives makes sure the objectives are valid and name squaddies that exist.
+func (controller *WhiteRoomController) CheckBattleObjectives(objectives []*objective.Objective, repos *repositories.RepositoryCollection) error {
+	outcomeCheck := battleoutcome.CheckRepositories{}
+	return outcomeCheck.CheckObjectives(objectives, repos)
+}
+
+// CheckBattleOutcome returns the outcome of the battle fought by the squaddies, or nil if the battle continues.
+//   The objectives are written for the squaddies with the player affiliation or faction.
+func (controller *WhiteRoomController) CheckBattleOutcome(objectives []*objective.Objective, playerAffiliation string, squaddieIDs []string, turnsSurvived int, repos *repositories.RepositoryCollection) *objective.Outcome {
+	outcomeCheck := battleoutcome.NewCheckRepositories(playerAffiliation)
+	return outcomeCheck.CheckOutcome(objectives, squaddieIDs, turnsSurvived, repos)
+}
+
+// CheckBattleTriggers makes sure the triggers are valid and name squaddies, templates and powers that exist.
+func (controller *WhiteRoomController) CheckBattleTriggers(triggers []*trigger.Trigger, repos *repositories.RepositoryCollection) error {
+	triggerCheck := battletrigger.CheckRepositories{}
+	return triggerCheck.CheckTriggers(triggers, repos)
+}
+
+// GetTriggersToFire returns the triggers that have not fired yet whose conditions are met, in the order they were declared.
+func (controller *WhiteRoomController) GetTriggersToFire(triggers []*trigger.Trigger, firedTriggerIDs map[string]bool, progress *trigger.BattleProgress, repos *repositories.RepositoryCollection) []*trigger.Trigger {
+	triggerCheck := battletrigger.CheckRepositories{}
+	return triggerCheck.GetTriggersToFire(triggers, firedTriggerIDs, progress, repos)
+}
+
+// ApplyTriggerEffects applies the trigger's effects and returns the IDs of the squaddies it added.
+func (controller *WhiteRoomController) ApplyTriggerEffects(triggerToFire *trigger.Trigger, repos *repositories.RepositoryCollection) ([]string, error) {
+	triggerCheck := battletrigger.CheckRepositories{}
+	return triggerCheck.ApplyEffects(triggerToFire, repos)
 }
 
 //InvalidAttackDescription gives more detail on why an attack is invalid.
@@ -68,76 +364,167 @@ type InvalidAttackDescription struct {
 
 // CheckForValidAction makes sure the action is valid. Otherwise, it returns an error.
 func (controller *WhiteRoomController) CheckForValidAction(action *powerusagescenario.Setup, repos *repositories.RepositoryCollection) []InvalidAttackDescription {
-	descriptions := []InvalidAttackDescription{}
 	targetingStrategy := powercantarget.ValidTargetChecker{}
 
-	for _, targetID := range action.Targets {
+	_, reasonForInvalidAction := targetingStrategy.IsValidAction(
+		action.UserID,
+		action.PowerID,
+		action.Targets,
+		repos,
+	)
+	if reasonForInvalidAction != powercantarget.TargetIsValid {
+		return controller.describeInvalidAction(reasonForInvalidAction, action, "", repos)
+	}
 
+	descriptions := []InvalidAttackDescription{}
+	for _, targetID := range action.Targets {
 		_, reasonForInvalidTarget := targetingStrategy.IsValidTarget(
 			action.UserID,
 			action.PowerID,
 			targetID,
 			repos,
 		)
+		descriptions = append(descriptions, controller.describeInvalidAction(reasonForInvalidTarget, action, targetID, repos)...)
+	}
+	return descriptions
+}
+
+// describeInvalidAction explains why the action is invalid.
+//   targetID is empty if the reason applies to the action as a whole.
+//   Returns no descriptions if the reason is TargetIsValid.
+func (controller *WhiteRoomController) describeInvalidAction(reasonForInvalidTarget powercantarget.InvalidTargetReason, action *powerusagescenario.Setup, targetID string, repos *repositories.RepositoryCollection) []InvalidAttackDescription {
+	user := repos.SquaddieRepo.GetOriginalSquaddieByID(action.UserID)
+	powerUsed := repos.PowerRepo.GetPowerByID(action.PowerID)
+	target := repos.SquaddieRepo.GetOriginalSquaddieByID(targetID)
 
-		user := repos.SquaddieRepo.GetSquaddieByID(action.UserID)
-		powerUsed := repos.PowerRepo.GetPowerByID(action.PowerID)
-		target := repos.SquaddieRepo.GetSquaddieByID(targetID)
-
-		if reasonForInvalidTarget == powercantarget.UserIsDead {
-			descriptions = append(
-				descriptions,
-				InvalidAttackDescription{
-					reasonForInvalidTarget,
-					[]string{
-						"User is dead, cannot use power",
-						fmt.Sprintf("  %s[%s] is dead", user.Name(), user.ID()),
-					},
+	switch reasonForInvalidTarget {
+	case powercantarget.PowerDoesNotExist:
+		return []InvalidAttackDescription{
+			{
+				reasonForInvalidTarget,
+				[]string{
+					"Power does not exist",
+					fmt.Sprintf("  %s[%s] tried to use unknown power %s", user.Name(), user.ID(), action.PowerID),
 				},
-			)
-			continue
+			},
 		}
-
-		if reasonForInvalidTarget == powercantarget.TargetIsDead {
-			descriptions = append(
-				descriptions,
-				InvalidAttackDescription{
-					reasonForInvalidTarget,
-					[]string{
-						"Target is dead, cannot use power",
-						fmt.Sprintf("  %s[%s] is dead", target.Name(), target.ID()),
-					},
+	case powercantarget.UserIsDead:
+		return []InvalidAttackDescription{
+			{
+				reasonForInvalidTarget,
+				[]string{
+					"User is dead, cannot use power",
+					fmt.Sprintf("  %s[%s] is dead", user.Name(), user.ID()),
 				},
-			)
-			continue
+			},
+		}
+	case powercantarget.UserCannotAffordPower:
+		return []InvalidAttackDescription{
+			{
+				reasonForInvalidTarget,
+				[]string{
+					"User cannot afford power",
+					fmt.Sprintf("  %s[%s] has %d mana", user.Name(), user.ID(), user.CurrentMana()),
+					fmt.Sprintf("    %s[%s] costs %d mana", powerUsed.Name(), powerUsed.ID(), powerUsed.ManaCost()),
+				},
+			},
+		}
+	case powercantarget.PowerIsOnCooldown:
+		return []InvalidAttackDescription{
+			{
+				reasonForInvalidTarget,
+				[]string{
+					"Power is on cooldown",
+					fmt.Sprintf("  %s[%s] can use %s[%s] again in %d turns", user.Name(), user.ID(), powerUsed.Name(), powerUsed.ID(), user.RemainingPowerCooldown(powerUsed.ID())),
+				},
+			},
+		}
+	case powercantarget.PowerHasNoChargesLeft:
+		return []InvalidAttackDescription{
+			{
+				reasonForInvalidTarget,
+				[]string{
+					"Power has no charges left",
+					fmt.Sprintf("  %s[%s] already used %s[%s] %d times this battle", user.Name(), user.ID(), powerUsed.Name(), powerUsed.ID(), user.PowerChargesUsed(powerUsed.ID())),
+				},
+			},
+		}
+	case powercantarget.SummonTemplateNotFound:
+		return []InvalidAttackDescription{
+			{
+				reasonForInvalidTarget,
+				[]string{
+					"Summon template is missing",
+					fmt.Sprintf("  %s[%s] summons unknown squaddie %s", powerUsed.Name(), powerUsed.ID(), powerUsed.SummonTemplateID()),
+				},
+			},
+		}
+	case powercantarget.SummonLimitReached:
+		return []InvalidAttackDescription{
+			{
+				reasonForInvalidTarget,
+				[]string{
+					"Summon limit reached",
+					fmt.Sprintf("  %s[%s] already has %d summons in battle", user.Name(), user.ID(), powercantarget.CountActiveSummons(action.UserID, repos)),
+					fmt.Sprintf("    %s[%s] allows %d", powerUsed.Name(), powerUsed.ID(), powerUsed.SummonLimit()),
+				},
+			},
+		}
+	case powercantarget.PowerNeedsATarget:
+		return []InvalidAttackDescription{
+			{
+				reasonForInvalidTarget,
+				[]string{
+					"Power needs a target",
+					fmt.Sprintf("  %s[%s] used %s[%s] without choosing a target", user.Name(), user.ID(), powerUsed.Name(), powerUsed.ID()),
+				},
+			},
+		}
+	case powercantarget.TargetIsOutOfRange:
+		distance, _ := repos.Grid.DistanceBetween(action.UserID, targetID)
+		return []InvalidAttackDescription{
+			{
+				reasonForInvalidTarget,
+				[]string{
+					"Target is out of range",
+					fmt.Sprintf("  %s[%s] is %d steps away from %s[%s]", target.Name(), target.ID(), distance, user.Name(), user.ID()),
+					fmt.Sprintf("    %s[%s] has a range of %d", powerUsed.Name(), powerUsed.ID(), powerUsed.Range()),
+				},
+			},
+		}
+	case powercantarget.TargetIsDead:
+		return []InvalidAttackDescription{
+			{
+				reasonForInvalidTarget,
+				[]string{
+					"Target is dead, cannot use power",
+					fmt.Sprintf("  %s[%s] is dead", target.Name(), target.ID()),
+				},
+			},
+		}
+	case powercantarget.PowerCannotTargetAffiliation:
+		affiliationRelationsTargeted := []string{}
+		if powerUsed.CanPowerTargetSelf() {
+			affiliationRelationsTargeted = append(affiliationRelationsTargeted, "self")
+		}
+		if powerUsed.CanPowerTargetFriend() {
+			affiliationRelationsTargeted = append(affiliationRelationsTargeted, "friend")
+		}
+		if powerUsed.CanPowerTargetFoe() {
+			affiliationRelationsTargeted = append(affiliationRelationsTargeted, "foe")
 		}
 
-		if reasonForInvalidTarget == powercantarget.PowerCannotTargetAffiliation {
-			affiliationRelationsTargeted := []string{}
-			if powerUsed.CanPowerTargetSelf() {
-				affiliationRelationsTargeted = append(affiliationRelationsTargeted, "self")
-			}
-			if powerUsed.CanPowerTargetFriend() {
-				affiliationRelationsTargeted = append(affiliationRelationsTargeted, "friend")
-			}
-			if powerUsed.CanPowerTargetFoe() {
-				affiliationRelationsTargeted = append(affiliationRelationsTargeted, "foe")
-			}
-
-			descriptions = append(
-				descriptions,
-				InvalidAttackDescription{
-					reasonForInvalidTarget,
-					[]string{
-						"Target is not compatible with affiliation",
-						fmt.Sprintf("  %s[%s] is a %s", user.Name(), user.ID(), user.AffiliationLogic().Name()),
-						fmt.Sprintf("    uses %s[%s] that targets %s", powerUsed.Name(), powerUsed.ID(), strings.Join(affiliationRelationsTargeted, ",")),
-						fmt.Sprintf("  %s[%s] is a %s", target.Name(), target.ID(), target.AffiliationLogic().Name()),
-					},
+		return []InvalidAttackDescription{
+			{
+				reasonForInvalidTarget,
+				[]string{
+					"Target is not compatible with affiliation",
+					fmt.Sprintf("  %s[%s] is a %s", user.Name(), user.ID(), user.AffiliationLogic().Name()),
+					fmt.Sprintf("    uses %s[%s] that targets %s", powerUsed.Name(), powerUsed.ID(), strings.Join(affiliationRelationsTargeted, ",")),
+					fmt.Sprintf("  %s[%s] is a %s", target.Name(), target.ID(), target.AffiliationLogic().Name()),
 				},
-			)
-			continue
+			},
 		}
 	}
-	return descriptions
+	return []InvalidAttackDescription{}
 }
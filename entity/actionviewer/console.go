@@ -3,10 +3,21 @@ package actionviewer
 import (
 	"fmt"
 	"github.com/chadius/terosgamerules/entity/damagedistribution"
+	"github.com/chadius/terosgamerules/entity/faction"
+	"github.com/chadius/terosgamerules/entity/levelupbenefit"
+	"github.com/chadius/terosgamerules/entity/objective"
+	"github.com/chadius/terosgamerules/entity/powerreference"
+	"github.com/chadius/terosgamerules/entity/powerusagescenario"
+	"github.com/chadius/terosgamerules/entity/squaddieinterface"
+	"github.com/chadius/terosgamerules/entity/trigger"
+	"github.com/chadius/terosgamerules/usecase/experience"
 	"github.com/chadius/terosgamerules/usecase/powerattackforecast"
+	"github.com/chadius/terosgamerules/usecase/powercantarget"
 	"github.com/chadius/terosgamerules/usecase/powercommit"
+	"github.com/chadius/terosgamerules/usecase/reaction"
 	"github.com/chadius/terosgamerules/usecase/repositories"
 	"io"
+	"strings"
 )
 
 // ConsoleActionViewerVerbosity represents options you can use to show how verbose you want the output.
@@ -51,18 +62,110 @@ func (viewer *ConsoleActionViewer) PrintForecast(powerForecast powerattackforeca
 // PrepareForecast creates messages to show the attack preview.
 func (viewer *ConsoleActionViewer) PrepareForecast(powerForecast powerattackforecast.ForecastInterface, repositories *repositories.RepositoryCollection) {
 	for resultIndex, forecast := range powerForecast.ForecastedResultPerTarget() {
+		if forecast.Reaction() != nil {
+			viewer.createMessagesForReaction(forecast.Reaction(), repositories)
+		}
+
+		if forecast.CounterAttack() != nil && forecast.CounterAttackStrikesFirst() {
+			viewer.createMessagesForAttackOrCounterAttack(forecast, repositories, resultIndex, true)
+		}
+
 		if forecast.Attack() != nil {
 			viewer.createMessagesForAttackOrCounterAttack(forecast, repositories, resultIndex, false)
 		}
 
-		if forecast.CounterAttack() != nil {
+		if forecast.CounterAttack() != nil && !forecast.CounterAttackStrikesFirst() {
 			viewer.createMessagesForAttackOrCounterAttack(forecast, repositories, resultIndex, true)
 		}
 
+		if forecast.Riposte() != nil {
+			viewer.createMessagesForAttackForecast(forecast.RiposteSetup(), forecast.Riposte(), repositories, "ripostes", false)
+		}
+
 		if forecast.HealingForecast() != nil {
 			viewer.createMessagesForHealing(repositories, forecast, resultIndex)
 		}
 	}
+
+	viewer.createMessagesForSummon(powerForecast, repositories)
+	viewer.createMessagesForPowerUsage(powerForecast, repositories)
+}
+
+// createMessagesForReaction shows the squaddie that reacted to the power before it was used.
+func (viewer *ConsoleActionViewer) createMessagesForReaction(reactionForecast *powerattackforecast.ReactionForecast, repositories *repositories.RepositoryCollection) {
+	reactingSquaddie := repositories.SquaddieRepo.GetOriginalSquaddieByID(reactionForecast.SquaddieID)
+	protectedSquaddie := repositories.SquaddieRepo.GetOriginalSquaddieByID(reactionForecast.ProtectedSquaddieID)
+
+	if reactionForecast.Kind == reaction.Guard {
+		viewer.Messages = append(viewer.Messages, fmt.Sprintf("%s guards %s", reactingSquaddie.Name(), protectedSquaddie.Name()))
+	}
+}
+
+// createMessagesForSummon shows the squaddie the power summons and how many summons the user already has, if the power limits them.
+func (viewer *ConsoleActionViewer) createMessagesForSummon(powerForecast powerattackforecast.ForecastInterface, repositories *repositories.RepositoryCollection) {
+	if len(powerForecast.ForecastedResultPerTarget()) == 0 || powerForecast.ForecastedResultPerTarget()[0].Setup() == nil {
+		return
+	}
+	setup := powerForecast.ForecastedResultPerTarget()[0].Setup()
+	powerToUse := repositories.PowerRepo.GetPowerByID(setup.PowerID)
+	if !powerToUse.CanSummon() {
+		return
+	}
+	user := repositories.SquaddieRepo.GetSquaddieByID(setup.UserID)
+	template := repositories.SquaddieRepo.GetOriginalSquaddieByID(powerToUse.SummonTemplateID())
+
+	userAndPowerMessage := fmt.Sprintf("%s (%s)", user.Name(), powerToUse.Name())
+	viewer.Messages = append(viewer.Messages, fmt.Sprintf(
+		"%s summons %s%s",
+		userAndPowerMessage,
+		template.Name(),
+		getSummonDurationMessageSnippet(powerToUse.SummonTurns()),
+	))
+
+	if powerToUse.SummonLimit() > 0 {
+		viewer.Messages = append(viewer.Messages, fmt.Sprintf(
+			"%s summons: %d/%d in battle",
+			userAndPowerMessage,
+			powercantarget.CountActiveSummons(setup.UserID, repositories),
+			powerToUse.SummonLimit(),
+		))
+	}
+}
+
+func getSummonDurationMessageSnippet(turns int) string {
+	if turns <= 0 {
+		return ""
+	}
+	return fmt.Sprintf(" for %s", describeTurns(turns))
+}
+
+// createMessagesForPowerUsage shows the cooldown and charges left on the power, if it has any limits.
+func (viewer *ConsoleActionViewer) createMessagesForPowerUsage(powerForecast powerattackforecast.ForecastInterface, repositories *repositories.RepositoryCollection) {
+	if len(powerForecast.ForecastedResultPerTarget()) == 0 || powerForecast.ForecastedResultPerTarget()[0].Setup() == nil {
+		return
+	}
+	setup := powerForecast.ForecastedResultPerTarget()[0].Setup()
+	user := repositories.SquaddieRepo.GetSquaddieByID(setup.UserID)
+	powerToUse := repositories.PowerRepo.GetPowerByID(setup.PowerID)
+
+	userAndPowerMessage := fmt.Sprintf("%s (%s)", user.Name(), powerToUse.Name())
+	if powerToUse.Cooldown() > 0 {
+		viewer.Messages = append(viewer.Messages, fmt.Sprintf(
+			"%s cooldown: %d turns remaining, %d after use",
+			userAndPowerMessage,
+			user.RemainingPowerCooldown(powerToUse.ID()),
+			powerToUse.Cooldown(),
+		))
+	}
+
+	if powerToUse.ChargesPerBattle() > 0 {
+		viewer.Messages = append(viewer.Messages, fmt.Sprintf(
+			"%s charges: %d/%d remaining",
+			userAndPowerMessage,
+			powerToUse.ChargesPerBattle()-user.PowerChargesUsed(powerToUse.ID()),
+			powerToUse.ChargesPerBattle(),
+		))
+	}
 }
 
 func (viewer *ConsoleActionViewer) createMessagesForHealing(repositories *repositories.RepositoryCollection, forecast powerattackforecast.CalculationInterface, resultIndex int) {
@@ -70,12 +173,15 @@ func (viewer *ConsoleActionViewer) createMessagesForHealing(repositories *reposi
 	target := repositories.SquaddieRepo.GetSquaddieByID(forecast.Setup().Targets[0])
 	powerToUse := repositories.PowerRepo.GetPowerByID(forecast.Setup().PowerID)
 
-	damageHealedDescription := fmt.Sprintf(", for %d healing", forecast.HealingForecast().RawHitPointsRestored)
-	if forecast.HealingForecast().RawHitPointsRestored == 0 {
-		damageHealedDescription = " for NO HEALING"
-	}
+	healingForecast := forecast.HealingForecast()
+	damageHealedDescription := getHealingMessageSnippet(
+		healingForecast.RawHitPointsRestored,
+		healingForecast.RawBarrierRestored,
+		healingForecast.HitPointsHealedPerTurn,
+		healingForecast.HealOverTimeTurns,
+	)
 
-	effectMessage := fmt.Sprintf("%s", damageHealedDescription)
+	effectMessage := fmt.Sprintf("%s%s", damageHealedDescription, getCleanseMessageSnippet(healingForecast.HarmfulEffectsRemoved))
 
 	attackerAndPowerMessage := fmt.Sprintf("%s (%s)", healer.Name(), powerToUse.Name())
 	if resultIndex > 0 {
@@ -93,13 +199,21 @@ func (viewer *ConsoleActionViewer) createMessagesForHealing(repositories *reposi
 }
 
 func (viewer *ConsoleActionViewer) createMessagesForAttackOrCounterAttack(calculation powerattackforecast.CalculationInterface, repositories *repositories.RepositoryCollection, resultIndex int, isACounterAttack bool) {
-	attackForecast := calculation.Attack()
-	attackSetup := calculation.Setup()
-	if isACounterAttack {
-		attackForecast = calculation.CounterAttack()
-		attackSetup = calculation.CounterAttackSetup()
+	if !isACounterAttack {
+		viewer.createMessagesForAttackForecast(calculation.Setup(), calculation.Attack(), repositories, "vs", resultIndex > 0)
+		return
+	}
+
+	versusMessage := "counters"
+	if calculation.CounterAttackStrikesFirst() {
+		versusMessage = "strikes first against"
 	}
+	viewer.createMessagesForAttackForecast(calculation.CounterAttackSetup(), calculation.CounterAttack(), repositories, versusMessage, false)
+}
 
+// createMessagesForAttackForecast describes a single attack. versusMessage describes how the attacker engages the target.
+//   If isAlsoTargeted is true, the attacker and power are not repeated.
+func (viewer *ConsoleActionViewer) createMessagesForAttackForecast(attackSetup *powerusagescenario.Setup, attackForecast *powerattackforecast.AttackForecast, repositories *repositories.RepositoryCollection, versusMessage string, isAlsoTargeted bool) {
 	attackerHitBonus := attackForecast.VersusContext.ToHit()
 	chanceOutOf36 := getChanceToHitMessageSnippet(attackerHitBonus.ToHitBonus, true)
 	effectMessage := getDamageDistributionMessageSnippet(attackForecast.VersusContext.NormalDamage())
@@ -109,15 +223,10 @@ func (viewer *ConsoleActionViewer) createMessagesForAttackOrCounterAttack(calcul
 	powerToUse := repositories.PowerRepo.GetPowerByID(attackSetup.PowerID)
 
 	attackerAndPowerMessage := fmt.Sprintf("%s (%s)", attacker.Name(), powerToUse.Name())
-	if resultIndex > 0 && !isACounterAttack {
+	if isAlsoTargeted {
 		attackerAndPowerMessage = "- also"
 	}
 
-	versusMessage := "vs"
-	if isACounterAttack {
-		versusMessage = "counters"
-	}
-
 	attackMessage := fmt.Sprintf(
 		"%s %s %s: %+d %s%s",
 		attackerAndPowerMessage,
@@ -141,6 +250,37 @@ func (viewer *ConsoleActionViewer) createMessagesForAttackOrCounterAttack(calcul
 			viewer.Messages = append(viewer.Messages, criticalHitAttackMessage)
 		}
 	}
+
+	if attackForecast.AttackerContext.HitsPerUse() > 1 {
+		multiHitMessage := fmt.Sprintf(
+			" %d strikes: %.1f expected damage per strike, %.1f total",
+			attackForecast.AttackerContext.HitsPerUse(),
+			attackForecast.ExpectedDamagePerStrike(),
+			attackForecast.ExpectedTotalDamage(),
+		)
+		viewer.Messages = append(viewer.Messages, multiHitMessage)
+	}
+
+	attackerEffectMessage := getAttackerEffectForecastMessageSnippet(attackForecast.AttackerEffect)
+	if attackerEffectMessage != "" {
+		viewer.Messages = append(viewer.Messages, fmt.Sprintf(" %s: %s", attacker.Name(), attackerEffectMessage))
+	}
+}
+
+func getAttackerEffectForecastMessageSnippet(attackerEffect powerattackforecast.AttackerEffectForecast) string {
+	effects := []string{}
+	if attackerEffect.HitPointsStolen > 0 {
+		effects = append(effects, fmt.Sprintf("steals %d HP", attackerEffect.HitPointsStolen))
+	}
+	if attackerEffect.BarrierSiphoned > 0 {
+		effects = append(effects, fmt.Sprintf("siphons %d barrier", attackerEffect.BarrierSiphoned))
+	}
+	if attackerEffect.IsFatalToAttacker {
+		effects = append(effects, "recoil is FATAL")
+	} else if attackerEffect.RecoilDamage > 0 {
+		effects = append(effects, fmt.Sprintf("takes %d recoil damage", attackerEffect.RecoilDamage))
+	}
+	return strings.Join(effects, ", ")
 }
 
 func getDamageDistributionMessageSnippet(damageDistribution *damagedistribution.DamageDistribution) string {
@@ -164,26 +304,7 @@ func getDamageDistributionMessageSnippet(damageDistribution *damagedistribution.
 }
 
 func getChanceToHitMessageSnippet(toHitBonus int, includeParenthesis bool) string {
-	toHitLookup := map[int]int{
-		-5: 1,
-		-4: 3,
-		-3: 6,
-		-2: 10,
-		-1: 15,
-		0:  21,
-		1:  26,
-		2:  30,
-		3:  33,
-		4:  35,
-	}
-	chanceOutOf36 := 0
-	if toHitBonus > 4 {
-		chanceOutOf36 = 36
-	} else if toHitBonus < -5 {
-		chanceOutOf36 = 0
-	} else {
-		chanceOutOf36 = toHitLookup[toHitBonus]
-	}
+	chanceOutOf36 := damagedistribution.ChanceToHitOutOf36(toHitBonus)
 	if includeParenthesis {
 		return fmt.Sprintf("(%d/36)", chanceOutOf36)
 	}
@@ -198,6 +319,25 @@ func (viewer *ConsoleActionViewer) PrintResult(powerResult powercommit.ResultStr
 
 // PrepareResult creates messages to show the attack result.
 func (viewer *ConsoleActionViewer) PrepareResult(powerResult powercommit.ResultStrategy, repositories *repositories.RepositoryCollection, verbosity *ConsoleActionViewerVerbosity) {
+	viewer.addResultMessages(powerResult, repositories, verbosity)
+	viewer.Messages = append(viewer.Messages, "---")
+}
+
+// PrepareResultWithExperience creates messages to show the attack result,
+//   followed by the experience each squaddie earned and the stats they gained from level ups.
+func (viewer *ConsoleActionViewer) PrepareResultWithExperience(
+	powerResult powercommit.ResultStrategy,
+	awards []*experience.Award,
+	levelUps []*experience.LevelUp,
+	repositories *repositories.RepositoryCollection,
+	verbosity *ConsoleActionViewerVerbosity,
+) {
+	viewer.addResultMessages(powerResult, repositories, verbosity)
+	viewer.addExperienceMessages(awards, levelUps, repositories)
+	viewer.Messages = append(viewer.Messages, "---")
+}
+
+func (viewer *ConsoleActionViewer) addResultMessages(powerResult powercommit.ResultStrategy, repositories *repositories.RepositoryCollection, verbosity *ConsoleActionViewerVerbosity) {
 	messagesPerPowerUsage := viewer.collatePowerResultPerTargetsByResult(powerResult)
 	viewer.addUserAffectTargetMessagesByResult(messagesPerPowerUsage, repositories, verbosity)
 	if verbosity != nil && verbosity.ShowRolls == true {
@@ -207,9 +347,400 @@ func (viewer *ConsoleActionViewer) PrepareResult(powerResult powercommit.ResultS
 		viewer.addTargetStatusMessagesByResult(messagesPerPowerUsage, repositories)
 	}
 	viewer.printResultMessagesInOrder(messagesPerPowerUsage)
+	viewer.Messages = append(viewer.Messages, createSummonResultMessages(powerResult, repositories)...)
+}
+
+func createSummonResultMessages(powerResult powercommit.ResultStrategy, repositories *repositories.RepositoryCollection) []string {
+	messages := []string{}
+	for _, summonID := range powerResult.SummonedSquaddieIDs() {
+		summon := repositories.SquaddieRepo.GetOriginalSquaddieByID(summonID)
+		summoner := repositories.SquaddieRepo.GetOriginalSquaddieByID(summon.SummonerID())
+		messages = append(messages, fmt.Sprintf(
+			"%s summons %s%s",
+			summoner.Name(),
+			summon.Name(),
+			getSummonDurationMessageSnippet(summon.SummonTurnsRemaining()),
+		))
+	}
+	return messages
+}
+
+func (viewer *ConsoleActionViewer) addExperienceMessages(awards []*experience.Award, levelUps []*experience.LevelUp, repositories *repositories.RepositoryCollection) {
+	for _, award := range awards {
+		squaddieWithExperience := repositories.SquaddieRepo.GetOriginalSquaddieByID(award.SquaddieID)
+		viewer.Messages = append(viewer.Messages, fmt.Sprintf("   %s gains %d XP", squaddieWithExperience.Name(), award.ExperienceGained))
+		if award.LevelUpsQueued > 0 {
+			viewer.Messages = append(viewer.Messages, fmt.Sprintf("   %s is ready to level up", squaddieWithExperience.Name()))
+		}
+	}
+
+	for _, levelUp := range levelUps {
+		viewer.Messages = append(viewer.Messages, "   "+createLevelUpMessage(levelUp, repositories))
+	}
+}
+
+// PrepareLevelUp creates messages to show the stats the squaddie gained from a level up.
+func (viewer *ConsoleActionViewer) PrepareLevelUp(levelUp *experience.LevelUp, repositories *repositories.RepositoryCollection) {
+	viewer.Messages = append(viewer.Messages, createLevelUpMessage(levelUp, repositories))
+	viewer.Messages = append(viewer.Messages, "---")
+}
+
+// PrepareClassChange creates messages to show the squaddie switched classes.
+func (viewer *ConsoleActionViewer) PrepareClassChange(squaddieID string, repositories *repositories.RepositoryCollection) {
+	squaddieThatChanged := repositories.SquaddieRepo.GetOriginalSquaddieByID(squaddieID)
+	className := squaddieThatChanged.CurrentClassID()
+	if classUsed, err := repositories.ClassRepo.GetClassByID(squaddieThatChanged.CurrentClassID()); err == nil {
+		className = classUsed.Name()
+	}
+
+	viewer.Messages = append(viewer.Messages, fmt.Sprintf("%s switches class to %s", squaddieThatChanged.Name(), className))
+	viewer.Messages = append(viewer.Messages, "---")
+}
+
+// PrepareEquipPower creates messages to show the power the squaddie equipped.
+func (viewer *ConsoleActionViewer) PrepareEquipPower(squaddieID string, repositories *repositories.RepositoryCollection) {
+	squaddieThatEquipped := repositories.SquaddieRepo.GetOriginalSquaddieByID(squaddieID)
+	powerEquipped := repositories.PowerRepo.GetPowerByID(squaddieThatEquipped.GetEquippedPowerID())
+
+	viewer.Messages = append(viewer.Messages, fmt.Sprintf("%s equips %s", squaddieThatEquipped.Name(), powerEquipped.Name()))
+	viewer.Messages = append(viewer.Messages, "---")
+}
+
+// PrepareEquipItem creates messages to show the item the squaddie equipped.
+func (viewer *ConsoleActionViewer) PrepareEquipItem(squaddieID, itemID string, repositories *repositories.RepositoryCollection) {
+	viewer.prepareItemMessage(squaddieID, itemID, "equips", repositories)
+}
+
+// PrepareUnequipItem creates messages to show the item the squaddie unequipped.
+func (viewer *ConsoleActionViewer) PrepareUnequipItem(squaddieID, itemID string, repositories *repositories.RepositoryCollection) {
+	viewer.prepareItemMessage(squaddieID, itemID, "unequips", repositories)
+}
+
+func (viewer *ConsoleActionViewer) prepareItemMessage(squaddieID, itemID, verb string, repositories *repositories.RepositoryCollection) {
+	squaddieWithItem := repositories.SquaddieRepo.GetOriginalSquaddieByID(squaddieID)
+	itemMessage := fmt.Sprintf("%s %s %s", squaddieWithItem.Name(), verb, getItemName(itemID, repositories))
+	if squaddieWithItem.GetEquippedPowerID() != "" {
+		powerEquipped := repositories.PowerRepo.GetPowerByID(squaddieWithItem.GetEquippedPowerID())
+		itemMessage = fmt.Sprintf("%s, wielding %s", itemMessage, powerEquipped.Name())
+	}
+	viewer.Messages = append(viewer.Messages, itemMessage)
+	viewer.Messages = append(viewer.Messages, "---")
+}
+
+// PrepareEndTurn creates messages to show the squaddie returning to its side.
+//   Nothing is shown if the squaddie's affiliation did not change.
+func (viewer *ConsoleActionViewer) PrepareEndTurn(squaddieID string, affiliationRestored bool, repositories *repositories.RepositoryCollection) {
+	if !affiliationRestored {
+		return
+	}
+	squaddieRestored := repositories.SquaddieRepo.GetOriginalSquaddieByID(squaddieID)
+	viewer.Messages = append(viewer.Messages, fmt.Sprintf("%s returns to the %s side", squaddieRestored.Name(), squaddieRestored.AffiliationLogic().Name()))
+	viewer.Messages = append(viewer.Messages, "---")
+}
+
+// PrepareGuard creates messages to show the squaddie starting to guard an ally.
+func (viewer *ConsoleActionViewer) PrepareGuard(guardianID, guardedID string, repositories *repositories.RepositoryCollection) {
+	guardian := repositories.SquaddieRepo.GetOriginalSquaddieByID(guardianID)
+	guarded := repositories.SquaddieRepo.GetOriginalSquaddieByID(guardedID)
+	viewer.Messages = append(viewer.Messages, fmt.Sprintf("%s starts guarding %s", guardian.Name(), guarded.Name()))
+	viewer.Messages = append(viewer.Messages, "---")
+}
+
+// PrepareOverwatch creates messages to show a squaddie going on overwatch.
+func (viewer *ConsoleActionViewer) PrepareOverwatch(squaddieID string, repositories *repositories.RepositoryCollection) {
+	overwatcher := repositories.SquaddieRepo.GetOriginalSquaddieByID(squaddieID)
+	overwatchPower := repositories.PowerRepo.GetPowerByID(overwatcher.OverwatchPowerID())
+	viewer.Messages = append(viewer.Messages, fmt.Sprintf("%s goes on overwatch with %s", overwatcher.Name(), overwatchPower.Name()))
+	viewer.Messages = append(viewer.Messages, "---")
+}
+
+// PrepareMove creates messages to show where a squaddie moved and who reacted to it.
+func (viewer *ConsoleActionViewer) PrepareMove(squaddieID string, overwatcherIDs []string, repositories *repositories.RepositoryCollection) {
+	mover := repositories.SquaddieRepo.GetOriginalSquaddieByID(squaddieID)
+	destination, _ := repositories.Grid.SquaddieCoordinate(squaddieID)
+	viewer.Messages = append(viewer.Messages, fmt.Sprintf("%s moves to (%d, %d)", mover.Name(), destination.Row, destination.Column))
+	for _, overwatcherID := range overwatcherIDs {
+		overwatcher := repositories.SquaddieRepo.GetOriginalSquaddieByID(overwatcherID)
+		viewer.Messages = append(viewer.Messages, fmt.Sprintf("%s reacts to %s moving into range", overwatcher.Name(), mover.Name()))
+	}
+	viewer.Messages = append(viewer.Messages, "---")
+}
+
+// PrepareSummonVanished creates messages to show a summon leaving the battle because its time ran out.
+func (viewer *ConsoleActionViewer) PrepareSummonVanished(squaddieID string, repositories *repositories.RepositoryCollection) {
+	summon := repositories.SquaddieRepo.GetOriginalSquaddieByID(squaddieID)
+	viewer.Messages = append(viewer.Messages, fmt.Sprintf("%s vanishes", summon.Name()))
+	viewer.Messages = append(viewer.Messages, "---")
+}
+
+// PrepareRelationshipChange creates messages to show how two factions now treat each other.
+func (viewer *ConsoleActionViewer) PrepareRelationshipChange(factionID, otherFactionID, relationship string, repositories *repositories.RepositoryCollection) {
+	viewer.Messages = append(viewer.Messages, fmt.Sprintf(
+		"%s and %s are now %s",
+		getFactionName(factionID, repositories),
+		getFactionName(otherFactionID, repositories),
+		describeRelationship(relationship),
+	))
+	viewer.Messages = append(viewer.Messages, "---")
+}
+
+// PrepareNextTurn creates messages to show the battle moving to its next turn.
+func (viewer *ConsoleActionViewer) PrepareNextTurn(turn int) {
+	viewer.Messages = append(viewer.Messages, fmt.Sprintf("Turn %d begins", turn))
+	viewer.Messages = append(viewer.Messages, "---")
+}
+
+// PrepareLingeringEffects creates messages to show the hit points squaddies gained or lost from their lingering effects.
+//   Squaddies are shown in the given order. Nothing is shown if no hit points changed.
+func (viewer *ConsoleActionViewer) PrepareLingeringEffects(squaddieIDs []string, hitPointChangeBySquaddieID map[string]int, repositories *repositories.RepositoryCollection) {
+	if len(hitPointChangeBySquaddieID) == 0 {
+		return
+	}
+
+	for _, squaddieID := range squaddieIDs {
+		hitPointChange, changed := hitPointChangeBySquaddieID[squaddieID]
+		if !changed {
+			continue
+		}
+
+		affectedSquaddie := repositories.SquaddieRepo.GetOriginalSquaddieByID(squaddieID)
+		if hitPointChange > 0 {
+			viewer.Messages = append(viewer.Messages, fmt.Sprintf("%s heals %d HP from lingering effects", affectedSquaddie.Name(), hitPointChange))
+		} else {
+			viewer.Messages = append(viewer.Messages, fmt.Sprintf("%s takes %d damage from lingering effects", affectedSquaddie.Name(), -hitPointChange))
+		}
+		viewer.Messages = append(viewer.Messages, fmt.Sprintf("   %s: %d/%d HP", affectedSquaddie.Name(), affectedSquaddie.CurrentHitPoints(), affectedSquaddie.MaxHitPoints()))
+	}
+	viewer.Messages = append(viewer.Messages, "---")
+}
+
+// PrepareBattleOutcome creates messages to show how the battle ended.
+//   A nil outcome means no objective decided the battle.
+func (viewer *ConsoleActionViewer) PrepareBattleOutcome(outcome *objective.Outcome, repositories *repositories.RepositoryCollection) {
+	if outcome == nil {
+		viewer.Messages = append(viewer.Messages, "The battle is undecided")
+		return
+	}
+
+	result := "Defeat"
+	if outcome.IsVictory() {
+		result = "Victory"
+	}
+	viewer.Messages = append(viewer.Messages, fmt.Sprintf("%s: %s", result, describeOutcome(outcome, repositories)))
+}
+
+func describeOutcome(outcome *objective.Outcome, repositories *repositories.RepositoryCollection) string {
+	if outcome.Objective == nil {
+		return "every player squaddie has fallen"
+	}
+
+	switch outcome.Objective.Kind {
+	case objective.RoutFoes:
+		return "every foe has fallen"
+	case objective.SurviveTurns:
+		return fmt.Sprintf("survived %s", describeTurns(outcome.Objective.Turns))
+	case objective.ReachTile:
+		return fmt.Sprintf(
+			"%s reached (%d, %d)",
+			repositories.SquaddieRepo.GetOriginalSquaddieByID(outcome.Objective.SquaddieID).Name(),
+			outcome.Objective.Tile.Row,
+			outcome.Objective.Tile.Column,
+		)
+	}
+	return fmt.Sprintf("%s has fallen", repositories.SquaddieRepo.GetOriginalSquaddieByID(outcome.Objective.SquaddieID).Name())
+}
+
+// PrepareTrigger creates messages to show the effects of a trigger that fired.
+func (viewer *ConsoleActionViewer) PrepareTrigger(triggerFired *trigger.Trigger, repositories *repositories.RepositoryCollection) {
+	for _, effect := range triggerFired.Effects {
+		viewer.Messages = append(viewer.Messages, createTriggerEffectMessages(effect, repositories)...)
+	}
+	viewer.Messages = append(viewer.Messages, "---")
+}
+
+func createTriggerEffectMessages(effect *trigger.Effect, repositories *repositories.RepositoryCollection) []string {
+	switch effect.Kind {
+	case trigger.AddSquaddies:
+		messages := []string{}
+		for _, squaddieID := range effect.SquaddieIDs {
+			messages = append(messages, fmt.Sprintf("%s joins the battle", repositories.SquaddieRepo.GetOriginalSquaddieByID(squaddieID).Name()))
+		}
+		return messages
+	case trigger.GainPowers:
+		squaddieChanged := repositories.SquaddieRepo.GetOriginalSquaddieByID(effect.SquaddieID)
+		return []string{fmt.Sprintf("%s %s", squaddieChanged.Name(), strings.Join(getPowerChangesMessageSnippets("learns", effect.Powers, repositories), ", "))}
+	case trigger.LosePowers:
+		squaddieChanged := repositories.SquaddieRepo.GetOriginalSquaddieByID(effect.SquaddieID)
+		return []string{fmt.Sprintf("%s %s", squaddieChanged.Name(), strings.Join(getPowerChangesMessageSnippets("forgets", effect.Powers, repositories), ", "))}
+	case trigger.ChangeAffiliation:
+		squaddieChanged := repositories.SquaddieRepo.GetOriginalSquaddieByID(effect.SquaddieID)
+		return []string{fmt.Sprintf("%s switches to %s", squaddieChanged.Name(), getFactionName(squaddieChanged.BaseAffiliationLogic().Name(), repositories))}
+	case trigger.ShowMessage:
+		return []string{effect.Message}
+	}
+	return []string{}
+}
+
+func getFactionName(factionID string, repositories *repositories.RepositoryCollection) string {
+	if repositories.FactionRepo == nil {
+		return factionID
+	}
+	return repositories.FactionRepo.GetFactionName(factionID)
+}
+
+func describeRelationship(relationship string) string {
+	switch relationship {
+	case faction.Friend:
+		return "friends"
+	case faction.Foe:
+		return "foes"
+	}
+	return relationship
+}
+
+func getItemName(itemID string, repositories *repositories.RepositoryCollection) string {
+	if repositories.ItemRepo == nil {
+		return itemID
+	}
+	itemUsed, err := repositories.ItemRepo.GetItemByID(itemID)
+	if err != nil {
+		return itemID
+	}
+	return itemUsed.Name()
+}
+
+// PrepareItemUse creates messages to show the squaddie consuming an item on its targets.
+//   Counterattacks caused by the item are described the same way as counterattacks against powers.
+func (viewer *ConsoleActionViewer) PrepareItemUse(itemID string, itemResult powercommit.ResultStrategy, repositories *repositories.RepositoryCollection, verbosity *ConsoleActionViewerVerbosity) {
+	viewer.Messages = append(viewer.Messages, createSummonResultMessages(itemResult, repositories)...)
+	for _, result := range itemResult.ResultPerTarget() {
+		if result.Attack() != nil && result.Attack().IsCounterAttack() {
+			viewer.Messages = append(viewer.Messages, viewer.createMessageForResultPerTarget(result, repositories, false, verbosity))
+		} else {
+			viewer.Messages = append(viewer.Messages, createItemUseMessage(itemID, result, repositories))
+		}
+
+		if verbosity != nil && verbosity.ShowTargetStatus == true {
+			viewer.Messages = append(viewer.Messages, viewer.createTargetStatusMessage(result, repositories))
+		}
+	}
 	viewer.Messages = append(viewer.Messages, "---")
 }
 
+func createItemUseMessage(itemID string, result *powercommit.ResultPerTarget, repositories *repositories.RepositoryCollection) string {
+	user := repositories.SquaddieRepo.GetOriginalSquaddieByID(result.UserID())
+	target := repositories.SquaddieRepo.GetOriginalSquaddieByID(result.TargetID())
+	itemUseMessage := fmt.Sprintf("%s uses %s on %s", user.Name(), getItemName(itemID, repositories), target.Name())
+
+	if result.Attack() != nil {
+		return itemUseMessage + getItemAttackMessageSnippet(result.Attack(), target)
+	}
+	if result.Healing() != nil {
+		return itemUseMessage + getItemHealingMessageSnippet(result.Healing())
+	}
+	return itemUseMessage
+}
+
+func getItemAttackMessageSnippet(attackResult *powercommit.AttackResult, target squaddieinterface.Interface) string {
+	if attackResult.HitTarget() == false && attackResult.CriticallyHitTarget() == false {
+		return ", missing"
+	}
+	if target.IsDead() {
+		return ", felling"
+	}
+
+	barrierBurnDescription := ""
+	if attackResult.Damage().TotalRawBarrierBurnt > 0 {
+		barrierBurnDescription = fmt.Sprintf(" + %d barrier burn", attackResult.Damage().TotalRawBarrierBurnt)
+	}
+	return fmt.Sprintf(", for %d damage%s", attackResult.Damage().ActualDamageTaken, barrierBurnDescription)
+}
+
+func getItemHealingMessageSnippet(healResult *powercommit.HealResult) string {
+	restorations := []string{}
+	if healResult.HitPointsRestored() > 0 {
+		restorations = append(restorations, fmt.Sprintf("%d HP", healResult.HitPointsRestored()))
+	}
+	if healResult.BarrierRestored() > 0 {
+		restorations = append(restorations, fmt.Sprintf("%d barrier", healResult.BarrierRestored()))
+	}
+	if healResult.ManaRestored() > 0 {
+		restorations = append(restorations, fmt.Sprintf("%d mana", healResult.ManaRestored()))
+	}
+	if healResult.HitPointsHealedPerTurn() > 0 {
+		restorations = append(restorations, fmt.Sprintf("%d HP per turn for %d turns", healResult.HitPointsHealedPerTurn(), healResult.HealOverTimeTurns()))
+	}
+
+	healingMessage := ""
+	if len(restorations) > 0 {
+		healingMessage = ", restoring " + strings.Join(restorations, " + ")
+	}
+	healingMessage += getCleanseMessageSnippet(healResult.EffectsRemoved())
+	if healingMessage == "" {
+		return ", with no effect"
+	}
+	return healingMessage
+}
+
+func createLevelUpMessage(levelUp *experience.LevelUp, repositories *repositories.RepositoryCollection) string {
+	squaddieThatLevelledUp := repositories.SquaddieRepo.GetOriginalSquaddieByID(levelUp.SquaddieID)
+	gains := []string{}
+	for _, benefit := range levelUp.BenefitsApplied {
+		gains = append(gains, getLevelUpBenefitGainsMessageSnippets(benefit, repositories)...)
+	}
+
+	levelUpMessage := fmt.Sprintf("%s levels up", squaddieThatLevelledUp.Name())
+	if len(gains) > 0 {
+		levelUpMessage = fmt.Sprintf("%s: %s", levelUpMessage, strings.Join(gains, ", "))
+	}
+	return levelUpMessage
+}
+
+func getLevelUpBenefitGainsMessageSnippets(benefit *levelupbenefit.LevelUpBenefit, repositories *repositories.RepositoryCollection) []string {
+	gains := []string{}
+	statChanges := []struct {
+		name   string
+		amount int
+	}{
+		{"Max HP", benefit.MaxHitPoints()},
+		{"Dodge", benefit.Dodge()},
+		{"Deflect", benefit.Deflect()},
+		{"Max Barrier", benefit.MaxBarrier()},
+		{"Armor", benefit.Armor()},
+		{"Aim", benefit.Aim()},
+		{"Strength", benefit.Strength()},
+		{"Mind", benefit.Mind()},
+		{"Movement", benefit.MovementDistance()},
+	}
+	for _, statChange := range statChanges {
+		if statChange.amount != 0 {
+			gains = append(gains, fmt.Sprintf("%+d %s", statChange.amount, statChange.name))
+		}
+	}
+
+	if benefit.CanHitAndRun() {
+		gains = append(gains, "can hit and run")
+	}
+
+	gains = append(gains, getPowerChangesMessageSnippets("learns", benefit.PowersGained(), repositories)...)
+	gains = append(gains, getPowerChangesMessageSnippets("forgets", benefit.PowersLost(), repositories)...)
+	return gains
+}
+
+func getPowerChangesMessageSnippets(verb string, powerReferences []*powerreference.Reference, repositories *repositories.RepositoryCollection) []string {
+	snippets := []string{}
+	for _, reference := range powerReferences {
+		powerName := reference.Name
+		if repositories.PowerRepo != nil {
+			if powerInRepo := repositories.PowerRepo.GetPowerByID(reference.PowerID); powerInRepo != nil {
+				powerName = powerInRepo.Name()
+			}
+		}
+		snippets = append(snippets, fmt.Sprintf("%s %s", verb, powerName))
+	}
+	return snippets
+}
+
 func (viewer *ConsoleActionViewer) printResultMessagesInOrder(messagesPerPowerUsage []*messagesByPowerUsage) {
 	for _, perGroupMessages := range messagesPerPowerUsage {
 		for _, resultMessage := range perGroupMessages.userAffectsTargetMessages {
@@ -230,7 +761,7 @@ func (viewer *ConsoleActionViewer) addRollMessagesByResult(messagesPerPowerUsage
 	for _, perGroupMessages := range messagesPerPowerUsage {
 		rollMessages := []string{}
 		for _, result := range perGroupMessages.powerResults {
-			rollMessages = append(rollMessages, viewer.createRollMessage(result, repositories))
+			rollMessages = append(rollMessages, viewer.createRollMessages(result, repositories)...)
 		}
 
 		for _, message := range rollMessages {
@@ -272,7 +803,8 @@ func (viewer *ConsoleActionViewer) collatePowerResultPerTargetsByResult(powerRes
 	previousUserID := ""
 	perTargetResultsFromTheSameResult := []*powercommit.ResultPerTarget{}
 	for _, result := range powerResult.ResultPerTarget() {
-		if result.UserID() != previousUserID {
+		isCounterAttack := result.Attack() != nil && result.Attack().IsCounterAttack()
+		if result.UserID() != previousUserID || isCounterAttack {
 			if previousUserID != "" {
 				messagesPerPowerUsage = append(messagesPerPowerUsage, &messagesByPowerUsage{powerResults: perTargetResultsFromTheSameResult})
 				perTargetResultsFromTheSameResult = nil
@@ -285,25 +817,47 @@ func (viewer *ConsoleActionViewer) collatePowerResultPerTargetsByResult(powerRes
 	return messagesPerPowerUsage
 }
 
-func (viewer *ConsoleActionViewer) createRollMessage(result *powercommit.ResultPerTarget, repositories *repositories.RepositoryCollection) string {
+func (viewer *ConsoleActionViewer) createRollMessages(result *powercommit.ResultPerTarget, repositories *repositories.RepositoryCollection) []string {
 	squaddieRepo := repositories.SquaddieRepo
 	user := squaddieRepo.GetOriginalSquaddieByID(result.UserID())
 	target := squaddieRepo.GetOriginalSquaddieByID(result.TargetID())
 
-	if result.Attack() != nil {
-		return fmt.Sprintf(
-			"   %s rolls %d + %d = %d, %s rolls %d + %d = %d",
+	if result.Attack() == nil {
+		return []string{"   Auto-hit"}
+	}
+
+	if len(result.Attack().Strikes()) <= 1 {
+		return []string{
+			fmt.Sprintf(
+				"   %s rolls %d + %d = %d, %s rolls %d + %d = %d",
+				user.Name(),
+				result.Attack().AttackRoll(),
+				result.Attack().AttackerToHitBonus(),
+				result.Attack().AttackerTotal(),
+				target.Name(),
+				result.Attack().DefendRoll(),
+				result.Attack().DefenderToHitPenalty(),
+				result.Attack().DefenderTotal(),
+			),
+		}
+	}
+
+	rollMessages := []string{}
+	for strikeIndex, strike := range result.Attack().Strikes() {
+		rollMessages = append(rollMessages, fmt.Sprintf(
+			"   strike %d: %s rolls %d + %d = %d, %s rolls %d + %d = %d",
+			strikeIndex+1,
 			user.Name(),
-			result.Attack().AttackRoll(),
+			strike.AttackRoll(),
 			result.Attack().AttackerToHitBonus(),
-			result.Attack().AttackerTotal(),
+			strike.AttackerTotal(),
 			target.Name(),
-			result.Attack().DefendRoll(),
+			strike.DefendRoll(),
 			result.Attack().DefenderToHitPenalty(),
-			result.Attack().DefenderTotal(),
-		)
+			strike.DefenderTotal(),
+		))
 	}
-	return "   Auto-hit"
+	return rollMessages
 }
 
 func (viewer *ConsoleActionViewer) createTargetStatusMessage(result *powercommit.ResultPerTarget, repositories *repositories.RepositoryCollection) string {
@@ -315,11 +869,17 @@ func (viewer *ConsoleActionViewer) createTargetStatusMessage(result *powercommit
 		barrierMessage = fmt.Sprintf(", %d barrier", target.CurrentBarrier())
 	}
 
-	targetStatusMessage := fmt.Sprintf("   %s: %d/%d HP%s",
+	affiliationMessage := ""
+	if target.HasAffiliationOverride() {
+		affiliationMessage = fmt.Sprintf(", %s for %s", target.AffiliationLogic().Name(), describeTurns(target.AffiliationOverrideTurnsRemaining()))
+	}
+
+	targetStatusMessage := fmt.Sprintf("   %s: %d/%d HP%s%s",
 		target.Name(),
 		target.CurrentHitPoints(),
 		target.MaxHitPoints(),
 		barrierMessage,
+		affiliationMessage,
 	)
 
 	return targetStatusMessage
@@ -375,9 +935,56 @@ func (viewer *ConsoleActionViewer) makeMessageForResultPerTargetAttackEffect(res
 		}
 	}
 
+	effectMessage += getAttackerEffectResultMessageSnippet(result.Attack())
+	effectMessage += getAffiliationEffectResultMessageSnippet(result.Attack())
+	effectMessage += getDamageOverTimeResultMessageSnippet(result.Attack())
+
+	strikesMessage := ""
+	if len(result.Attack().Strikes()) > 1 {
+		strikesMessage = fmt.Sprintf(" (%d of %d strikes hit)", result.Attack().StrikesThatHit(), len(result.Attack().Strikes()))
+	}
+
 	userPrefix := viewer.getMessagePrefix(result, repositories, userCausedThePreviousResult, verbosity)
 
-	return fmt.Sprintf("%s %s%s %s%s", userPrefix, criticalHit, hitMessage, target.Name(), effectMessage)
+	return fmt.Sprintf("%s %s%s %s%s%s", userPrefix, criticalHit, hitMessage, target.Name(), effectMessage, strikesMessage)
+}
+
+func getAttackerEffectResultMessageSnippet(attackResult *powercommit.AttackResult) string {
+	attackerEffectMessage := ""
+	if attackResult.HitPointsStolen() > 0 {
+		attackerEffectMessage += fmt.Sprintf(", stealing %d HP", attackResult.HitPointsStolen())
+	}
+	if attackResult.BarrierSiphoned() > 0 {
+		attackerEffectMessage += fmt.Sprintf(", siphoning %d barrier", attackResult.BarrierSiphoned())
+	}
+	if attackResult.RecoilDamageTaken() > 0 {
+		attackerEffectMessage += fmt.Sprintf(", taking %d recoil damage", attackResult.RecoilDamageTaken())
+	}
+	return attackerEffectMessage
+}
+
+func getAffiliationEffectResultMessageSnippet(attackResult *powercommit.AttackResult) string {
+	if attackResult.TurnsConfused() > 0 {
+		return fmt.Sprintf(", confusing for %s", describeTurns(attackResult.TurnsConfused()))
+	}
+	if attackResult.TurnsCharmed() > 0 {
+		return fmt.Sprintf(", charming for %s", describeTurns(attackResult.TurnsCharmed()))
+	}
+	return ""
+}
+
+func getDamageOverTimeResultMessageSnippet(attackResult *powercommit.AttackResult) string {
+	if attackResult.DamageOverTimeTurns() == 0 {
+		return ""
+	}
+	return fmt.Sprintf(", then %d damage per turn for %s", attackResult.DamagePerTurn(), describeTurns(attackResult.DamageOverTimeTurns()))
+}
+
+func describeTurns(turns int) string {
+	if turns == 1 {
+		return "1 turn"
+	}
+	return fmt.Sprintf("%d turns", turns)
 }
 
 func (viewer *ConsoleActionViewer) makeMessageForResultPerTargetHealingEffect(result *powercommit.ResultPerTarget, repositories *repositories.RepositoryCollection, userCausedThePreviousResult bool, verbosity *ConsoleActionViewerVerbosity) string {
@@ -385,12 +992,56 @@ func (viewer *ConsoleActionViewer) makeMessageForResultPerTargetHealingEffect(re
 	target := squaddieRepo.GetOriginalSquaddieByID(result.TargetID())
 
 	userPrefix := viewer.getMessagePrefix(result, repositories, userCausedThePreviousResult, verbosity)
-	hitPointsRestored := fmt.Sprintf(", for %d healing", result.Healing().HitPointsRestored())
-	if result.Healing().HitPointsRestored() == 0 {
-		hitPointsRestored = " for NO HEALING"
+	healResult := result.Healing()
+	onlyRestoresMana := healResult.HitPointsRestored() == 0 &&
+		healResult.BarrierRestored() == 0 &&
+		healResult.HitPointsHealedPerTurn() == 0 &&
+		healResult.EffectsRemoved() == 0
+	if onlyRestoresMana && healResult.ManaRestored() > 0 {
+		return fmt.Sprintf("%s restores %s, for %d mana", userPrefix, target.Name(), healResult.ManaRestored())
 	}
 
-	return fmt.Sprintf("%s heals %s%s", userPrefix, target.Name(), hitPointsRestored)
+	hitPointsRestored := getHealingMessageSnippet(
+		healResult.HitPointsRestored(),
+		healResult.BarrierRestored(),
+		healResult.HitPointsHealedPerTurn(),
+		healResult.HealOverTimeTurns(),
+	)
+
+	manaRestored := ""
+	if healResult.ManaRestored() > 0 {
+		manaRestored = fmt.Sprintf(" + %d mana", healResult.ManaRestored())
+	}
+
+	return fmt.Sprintf("%s heals %s%s%s%s", userPrefix, target.Name(), hitPointsRestored, manaRestored, getCleanseMessageSnippet(healResult.EffectsRemoved()))
+}
+
+func getHealingMessageSnippet(hitPointsRestored, barrierRestored, hitPointsHealedPerTurn, healOverTimeTurns int) string {
+	healingDescriptions := []string{}
+	if hitPointsRestored > 0 {
+		healingDescriptions = append(healingDescriptions, fmt.Sprintf("%d healing", hitPointsRestored))
+	}
+	if barrierRestored > 0 {
+		healingDescriptions = append(healingDescriptions, fmt.Sprintf("%d barrier", barrierRestored))
+	}
+	if hitPointsHealedPerTurn > 0 {
+		healingDescriptions = append(healingDescriptions, fmt.Sprintf("%d healing per turn for %d turns", hitPointsHealedPerTurn, healOverTimeTurns))
+	}
+
+	if len(healingDescriptions) == 0 {
+		return " for NO HEALING"
+	}
+	return ", for " + strings.Join(healingDescriptions, " + ")
+}
+
+func getCleanseMessageSnippet(effectsRemoved int) string {
+	if effectsRemoved == 0 {
+		return ""
+	}
+	if effectsRemoved == 1 {
+		return ", cleansing 1 effect"
+	}
+	return fmt.Sprintf(", cleansing %d effects", effectsRemoved)
 }
 
 func (viewer *ConsoleActionViewer) getMessagePrefix(result *powercommit.ResultPerTarget, repositories *repositories.RepositoryCollection, userCausedThePreviousResult bool, verbosity *ConsoleActionViewerVerbosity) string {
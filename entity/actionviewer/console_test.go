@@ -2,18 +2,27 @@ package actionviewer_test
 
 import (
 	"github.com/chadius/terosgamerules/entity/actionviewer"
+	"github.com/chadius/terosgamerules/entity/affiliation"
 	"github.com/chadius/terosgamerules/entity/damagedistribution"
+	"github.com/chadius/terosgamerules/entity/faction"
+	"github.com/chadius/terosgamerules/entity/item"
+	"github.com/chadius/terosgamerules/entity/levelupbenefit"
+	"github.com/chadius/terosgamerules/entity/objective"
 	"github.com/chadius/terosgamerules/entity/power"
 	"github.com/chadius/terosgamerules/entity/powerinterface"
+	"github.com/chadius/terosgamerules/entity/powerreference"
 	"github.com/chadius/terosgamerules/entity/powerrepository"
 	"github.com/chadius/terosgamerules/entity/powerusagescenario"
 	"github.com/chadius/terosgamerules/entity/squaddie"
 	"github.com/chadius/terosgamerules/entity/squaddieinterface"
+	"github.com/chadius/terosgamerules/entity/trigger"
+	"github.com/chadius/terosgamerules/usecase/experience"
 	"github.com/chadius/terosgamerules/usecase/powerattackforecast"
 	"github.com/chadius/terosgamerules/usecase/powerattackforecast/powerattackforecastfakes"
 	"github.com/chadius/terosgamerules/usecase/powercommit"
 	"github.com/chadius/terosgamerules/usecase/powercommit/powercommitfakes"
 	"github.com/chadius/terosgamerules/usecase/repositories"
+	"github.com/chadius/terosgamerules/usecase/squaddiestats"
 	"github.com/chadius/terosgamerules/utility/testutility"
 	. "gopkg.in/check.v1"
 	"strings"
@@ -149,6 +158,83 @@ func (suite *ConsoleShowsFatalAttacksSuite) TestIndicateIfItIsAKillingBlow(check
 	checker.Assert(output.String(), Equals, "Teros (Blot) hits Bandit, felling\n---\n")
 }
 
+func (suite *ConsoleShowsFatalAttacksSuite) TestShowForecastCooldownAndCharges(checker *C) {
+	ultimate := power.NewPowerBuilder().Blot().WithName("Ultimate").Cooldown(3).ChargesPerBattle(2).Build()
+	suite.powerRepo.AddPower(ultimate)
+	suite.teros.MarkPowerUsed(ultimate.ID(), ultimate.Cooldown())
+	suite.teros.ReducePowerCooldowns()
+
+	ultimateCalculation := &powerattackforecastfakes.FakeCalculationInterface{}
+	ultimateCalculation.SetupReturns(&powerusagescenario.Setup{
+		UserID:          suite.teros.ID(),
+		PowerID:         ultimate.ID(),
+		Targets:         []string{suite.bandit.ID()},
+		IsCounterAttack: false,
+	})
+	forecastUltimate := &powerattackforecastfakes.FakeForecastInterface{}
+	forecastUltimate.ForecastedResultPerTargetReturns([]powerattackforecast.CalculationInterface{
+		ultimateCalculation,
+	})
+
+	var forecastOutput strings.Builder
+	suite.viewer.PrintForecast(forecastUltimate, suite.repos, &forecastOutput)
+
+	checker.Assert(forecastOutput.String(), Equals,
+		"Teros (Ultimate) cooldown: 2 turns remaining, 3 after use\n"+
+			"Teros (Ultimate) charges: 1/2 remaining\n",
+	)
+}
+
+func (suite *ConsoleShowsFatalAttacksSuite) TestShowForecastDamagePerStrike(checker *C) {
+	flurry := power.NewPowerBuilder().WithName("Flurry").TargetsFoe().CanBeEquipped().DealsDamage(1).HitsPerUse(2).Build()
+	suite.powerRepo.AddPower(flurry)
+
+	forecastFlurry := powerattackforecast.NewForecastBuilder().
+		Setup(&powerusagescenario.Setup{
+			UserID:          suite.teros.ID(),
+			PowerID:         flurry.ID(),
+			Targets:         []string{suite.bandit2.ID()},
+			IsCounterAttack: false,
+		}).
+		Repositories(suite.repos).
+		OffenseStrategy(&squaddiestats.CalculateSquaddieOffenseStats{}).
+		Build()
+	forecastFlurry.CalculateForecast()
+
+	var forecastOutput strings.Builder
+	suite.viewer.PrintForecast(forecastFlurry, suite.repos, &forecastOutput)
+
+	checker.Assert(forecastOutput.String(), Equals,
+		"Teros (Flurry) vs Bandit2: +0 (21/36), for 1 damage\n"+
+			" 2 strikes: 0.6 expected damage per strike, 1.2 total\n",
+	)
+}
+
+func (suite *ConsoleShowsFatalAttacksSuite) TestShowForecastEffectsOnAttacker(checker *C) {
+	recklessSwing := power.NewPowerBuilder().WithName("Reckless Swing").TargetsFoe().DealsDamage(1).RecoilDamage(1).Build()
+	suite.powerRepo.AddPower(recklessSwing)
+
+	forecastRecklessSwing := powerattackforecast.NewForecastBuilder().
+		Setup(&powerusagescenario.Setup{
+			UserID:          suite.teros.ID(),
+			PowerID:         recklessSwing.ID(),
+			Targets:         []string{suite.bandit2.ID()},
+			IsCounterAttack: false,
+		}).
+		Repositories(suite.repos).
+		OffenseStrategy(&squaddiestats.CalculateSquaddieOffenseStats{}).
+		Build()
+	forecastRecklessSwing.CalculateForecast()
+
+	var forecastOutput strings.Builder
+	suite.viewer.PrintForecast(forecastRecklessSwing, suite.repos, &forecastOutput)
+
+	checker.Assert(forecastOutput.String(), Equals,
+		"Teros (Reckless Swing) vs Bandit2: +0 (21/36), for 1 damage\n"+
+			" Teros: takes 1 recoil damage\n",
+	)
+}
+
 type ConsoleShowsHealingAttempts struct {
 	teros squaddieinterface.Interface
 	lini  squaddieinterface.Interface
@@ -203,6 +289,114 @@ func (suite *ConsoleShowsHealingAttempts) TestShowPowerHealingEffects(checker *C
 	checker.Assert(output.String(), Equals, "Lini (healing Staff) heals Teros, for 4 healing\n---\n")
 }
 
+func (suite *ConsoleShowsHealingAttempts) TestShowPowerSupportEffects(checker *C) {
+	resultLiniWardsTeros := &powercommitfakes.FakeResultStrategy{}
+	resultLiniWardsTeros.ResultPerTargetReturns([]*powercommit.ResultPerTarget{
+		powercommit.NewResultPerTargetBuilder().
+			User(suite.lini).
+			Power(suite.healingStaff).
+			Target(suite.teros).
+			HealResult(
+				powercommit.NewHealResultBuilder().BarrierRestored(2).HealsOverTime(1, 3).EffectsRemoved(1).Build(),
+			).
+			Build(),
+	})
+
+	var output strings.Builder
+	suite.viewer.PrintResult(resultLiniWardsTeros, suite.repos, nil, &output)
+
+	checker.Assert(output.String(), Equals, "Lini (healing Staff) heals Teros, for 2 barrier + 1 healing per turn for 3 turns, cleansing 1 effect\n---\n")
+}
+
+type ConsoleShowsItemUse struct {
+	teros  squaddieinterface.Interface
+	lini   squaddieinterface.Interface
+	bandit squaddieinterface.Interface
+
+	potionPower powerinterface.Interface
+	bombPower   powerinterface.Interface
+
+	viewer *actionviewer.ConsoleActionViewer
+	repos  *repositories.RepositoryCollection
+}
+
+var _ = Suite(&ConsoleShowsItemUse{})
+
+func (suite *ConsoleShowsItemUse) SetUpTest(checker *C) {
+	suite.repos = &repositories.RepositoryCollection{
+		SquaddieRepo: squaddie.NewSquaddieRepository(),
+		PowerRepo:    powerrepository.NewPowerRepository(),
+		ItemRepo:     item.NewRepository(),
+	}
+	suite.viewer = &actionviewer.ConsoleActionViewer{}
+
+	suite.teros = squaddie.NewSquaddieBuilder().Teros().Build()
+	suite.lini = squaddie.NewSquaddieBuilder().Lini().Build()
+	suite.bandit = squaddie.NewSquaddieBuilder().Bandit().HitPoints(5).Build()
+
+	suite.potionPower = power.NewPowerBuilder().HealingStaff().WithName("Drink Potion").WithID("powerPotion").Build()
+	suite.bombPower = power.NewPowerBuilder().WithName("Explode").WithID("powerBomb").TargetsFoe().DealsDamage(3).Build()
+
+	testutility.AddSquaddieWithInnatePowersToRepos(suite.teros, nil, suite.repos, false)
+	testutility.AddSquaddieWithInnatePowersToRepos(suite.lini, nil, suite.repos, false)
+	testutility.AddSquaddieWithInnatePowersToRepos(suite.bandit, nil, suite.repos, false)
+	suite.repos.PowerRepo.AddSlicePowerSource([]powerinterface.Interface{suite.potionPower, suite.bombPower})
+	suite.repos.ItemRepo.AddListOfItems([]*item.Item{
+		item.NewConsumableItem("itemPotion", "Potion", suite.potionPower.GetReference()),
+		item.NewConsumableItem("itemBomb", "Bomb", suite.bombPower.GetReference()),
+	})
+}
+
+func (suite *ConsoleShowsItemUse) TestShowHealingItemUse(checker *C) {
+	resultLiniDrinksPotion := &powercommitfakes.FakeResultStrategy{}
+	resultLiniDrinksPotion.ResultPerTargetReturns([]*powercommit.ResultPerTarget{
+		powercommit.NewResultPerTargetBuilder().
+			User(suite.lini).
+			Power(suite.potionPower).
+			Target(suite.teros).
+			HealResult(
+				powercommit.NewHealResultBuilder().HitPointsRestored(8).BarrierRestored(1).Build(),
+			).
+			Build(),
+	})
+
+	suite.viewer.PrepareItemUse("itemPotion", resultLiniDrinksPotion, suite.repos, nil)
+	var output strings.Builder
+	suite.viewer.PrintMessages(&output)
+
+	checker.Assert(output.String(), Equals, "Lini uses Potion on Teros, restoring 8 HP + 1 barrier\n---\n")
+}
+
+func (suite *ConsoleShowsItemUse) TestShowAttackingItemUse(checker *C) {
+	resultTerosThrowsBomb := &powercommitfakes.FakeResultStrategy{}
+	resultTerosThrowsBomb.ResultPerTargetReturns([]*powercommit.ResultPerTarget{
+		powercommit.NewResultPerTargetBuilder().
+			User(suite.teros).
+			Power(suite.bombPower).
+			Target(suite.bandit).
+			AttackResult(
+				powercommit.NewAttackResultBuilder().HitTarget().DamageDistribution(&damagedistribution.DamageDistribution{
+					ActualDamageTaken: 3,
+				}).Build(),
+			).
+			Build(),
+		powercommit.NewResultPerTargetBuilder().
+			User(suite.teros).
+			Power(suite.bombPower).
+			Target(suite.lini).
+			AttackResult(
+				powercommit.NewAttackResultBuilder().Build(),
+			).
+			Build(),
+	})
+
+	suite.viewer.PrepareItemUse("itemBomb", resultTerosThrowsBomb, suite.repos, nil)
+	var output strings.Builder
+	suite.viewer.PrintMessages(&output)
+
+	checker.Assert(output.String(), Equals, "Teros uses Bomb on Bandit, for 3 damage\nTeros uses Bomb on Lini, missing\n---\n")
+}
+
 type ConsoleShowsCounterAttackSuite struct {
 	teros   squaddieinterface.Interface
 	bandit  squaddieinterface.Interface
@@ -433,6 +627,67 @@ func (suite *ConsoleShowsCounterAttackSuite) TestShowCounterattackResults(checke
 	checker.Assert(output.String(), Equals, "Teros (Blot) hits Bandit, for 0 damage\nBandit (axe) counters Teros, for 2 damage\n---\n")
 }
 
+func (suite *ConsoleShowsCounterAttackSuite) TestShowForecastFirstStrikeAndRiposte(checker *C) {
+	duelist := squaddie.NewSquaddieBuilder().Teros().WithName("Duelist").Build()
+	pikeman := squaddie.NewSquaddieBuilder().Bandit().WithName("Pikeman").Build()
+	rapier := power.NewPowerBuilder().WithName("Rapier").TargetsFoe().CanBeEquipped().DealsDamage(1).Riposte().Build()
+	pike := power.NewPowerBuilder().WithName("Pike").TargetsFoe().CanBeEquipped().DealsDamage(1).FirstStrike().Build()
+	testutility.AddSquaddieWithInnatePowersToRepos(duelist, rapier, suite.repos, true)
+	testutility.AddSquaddieWithInnatePowersToRepos(pikeman, pike, suite.repos, true)
+
+	forecastRapierOnPikeman := powerattackforecast.NewForecastBuilder().
+		Setup(&powerusagescenario.Setup{
+			UserID:          duelist.ID(),
+			PowerID:         rapier.ID(),
+			Targets:         []string{pikeman.ID()},
+			IsCounterAttack: false,
+		}).
+		Repositories(suite.repos).
+		OffenseStrategy(&squaddiestats.CalculateSquaddieOffenseStats{}).
+		Build()
+	forecastRapierOnPikeman.CalculateForecast()
+
+	var forecastOutput strings.Builder
+	suite.viewer.PrintForecast(forecastRapierOnPikeman, suite.repos, &forecastOutput)
+
+	checker.Assert(forecastOutput.String(), Equals,
+		"Pikeman (Pike) strikes first against Duelist: -2 (10/36), for 1 damage\n"+
+			"Duelist (Rapier) vs Pikeman: +0 (21/36), for 1 damage\n"+
+			"Duelist (Rapier) ripostes Pikeman: -2 (10/36), for 1 damage\n",
+	)
+}
+
+func (suite *ConsoleShowsCounterAttackSuite) TestShowForecastGuard(checker *C) {
+	knight := squaddie.NewSquaddieBuilder().Teros().WithName("Knight").Build()
+	squire := squaddie.NewSquaddieBuilder().Lini().WithName("Squire").Build()
+	brute := squaddie.NewSquaddieBuilder().Bandit().WithName("Brute").Build()
+	club := power.NewPowerBuilder().WithName("Club").TargetsFoe().DealsDamage(1).Build()
+	testutility.AddSquaddieWithInnatePowersToRepos(knight, nil, suite.repos, false)
+	testutility.AddSquaddieWithInnatePowersToRepos(squire, nil, suite.repos, false)
+	testutility.AddSquaddieWithInnatePowersToRepos(brute, club, suite.repos, false)
+	knight.Guard(squire.ID())
+
+	forecastClubOnSquire := powerattackforecast.NewForecastBuilder().
+		Setup(&powerusagescenario.Setup{
+			UserID:          brute.ID(),
+			PowerID:         club.ID(),
+			Targets:         []string{squire.ID()},
+			IsCounterAttack: false,
+		}).
+		Repositories(suite.repos).
+		OffenseStrategy(&squaddiestats.CalculateSquaddieOffenseStats{}).
+		Build()
+	forecastClubOnSquire.CalculateForecast()
+
+	var forecastOutput strings.Builder
+	suite.viewer.PrintForecast(forecastClubOnSquire, suite.repos, &forecastOutput)
+
+	checker.Assert(forecastOutput.String(), Equals,
+		"Knight guards Squire\n"+
+			"Brute (Club) vs Knight: +0 (21/36), for 1 damage\n",
+	)
+}
+
 func (suite *ConsoleShowsCounterAttackSuite) TestShowMultipleTargets(checker *C) {
 	resultBlotOnBanditsAndBandit2Counters := &powercommitfakes.FakeResultStrategy{}
 	resultBlotOnBanditsAndBandit2Counters.ResultPerTargetReturns([]*powercommit.ResultPerTarget{
@@ -599,6 +854,50 @@ func (suite *ConsoleShowsHitsAndMisses) TestShowPowerBarrierBurn(checker *C) {
 	checker.Assert(output.String(), Equals, "Teros (Blot) hits Bandit, for 2 damage + 1 barrier burn\n---\n")
 }
 
+func (suite *ConsoleShowsHitsAndMisses) TestShowEffectsOnAttacker(checker *C) {
+	resultBlotOnBanditDrainsLife := &powercommitfakes.FakeResultStrategy{}
+	resultBlotOnBanditDrainsLife.ResultPerTargetReturns([]*powercommit.ResultPerTarget{
+		powercommit.NewResultPerTargetBuilder().
+			User(suite.teros).
+			Power(suite.blot).
+			Target(suite.bandit).
+			AttackResult(
+				powercommit.NewAttackResultBuilder().DamageDistribution(&damagedistribution.DamageDistribution{
+					RawDamageDealt:    4,
+					ActualDamageTaken: 4,
+				}).HitPointsStolen(2).BarrierSiphoned(1).RecoilDamageTaken(3).Build(),
+			).
+			Build(),
+	})
+
+	var output strings.Builder
+	suite.viewer.PrintResult(resultBlotOnBanditDrainsLife, suite.repos, nil, &output)
+
+	checker.Assert(output.String(), Equals, "Teros (Blot) hits Bandit, for 4 damage, stealing 2 HP, siphoning 1 barrier, taking 3 recoil damage\n---\n")
+}
+
+func (suite *ConsoleShowsHitsAndMisses) TestShowAffiliationEffects(checker *C) {
+	resultBlotCharmsBandit := &powercommitfakes.FakeResultStrategy{}
+	resultBlotCharmsBandit.ResultPerTargetReturns([]*powercommit.ResultPerTarget{
+		powercommit.NewResultPerTargetBuilder().
+			User(suite.teros).
+			Power(suite.blot).
+			Target(suite.bandit).
+			AttackResult(
+				powercommit.NewAttackResultBuilder().DamageDistribution(&damagedistribution.DamageDistribution{
+					RawDamageDealt:    1,
+					ActualDamageTaken: 1,
+				}).Charmed(2).Build(),
+			).
+			Build(),
+	})
+
+	var output strings.Builder
+	suite.viewer.PrintResult(resultBlotCharmsBandit, suite.repos, nil, &output)
+
+	checker.Assert(output.String(), Equals, "Teros (Blot) hits Bandit, for 1 damage, charming for 2 turns\n---\n")
+}
+
 type ConsoleShowsVerbosity struct {
 	teros             squaddieinterface.Interface
 	lini              squaddieinterface.Interface
@@ -843,3 +1142,288 @@ func (suite *ConsoleShowsVerbosity) TestShowHealingAutoHitVerbosity(checker *C)
 
 	checker.Assert(healingOutput.String(), Equals, "Lini (healing Staff) heals Teros, for 4 healing\n   Auto-hit\n---\n")
 }
+
+type ConsoleShowsExperience struct {
+	teros  squaddieinterface.Interface
+	bandit squaddieinterface.Interface
+
+	blot powerinterface.Interface
+
+	viewer *actionviewer.ConsoleActionViewer
+	repos  *repositories.RepositoryCollection
+
+	resultBlotMissesBandit *powercommitfakes.FakeResultStrategy
+}
+
+var _ = Suite(&ConsoleShowsExperience{})
+
+func (suite *ConsoleShowsExperience) SetUpTest(checker *C) {
+	suite.repos = &repositories.RepositoryCollection{
+		SquaddieRepo: squaddie.NewSquaddieRepository(),
+		PowerRepo:    powerrepository.NewPowerRepository(),
+	}
+	suite.viewer = &actionviewer.ConsoleActionViewer{}
+
+	suite.teros = squaddie.NewSquaddieBuilder().Teros().Build()
+	suite.bandit = squaddie.NewSquaddieBuilder().Bandit().Build()
+	suite.blot = power.NewPowerBuilder().Blot().WithName("Blot").Build()
+
+	testutility.AddSquaddieWithInnatePowersToRepos(suite.teros, suite.blot, suite.repos, false)
+	suite.repos.SquaddieRepo.AddSquaddies([]squaddieinterface.Interface{suite.bandit})
+
+	suite.resultBlotMissesBandit = &powercommitfakes.FakeResultStrategy{}
+	suite.resultBlotMissesBandit.ResultPerTargetReturns([]*powercommit.ResultPerTarget{
+		powercommit.NewResultPerTargetBuilder().
+			User(suite.teros).
+			Power(suite.blot).
+			Target(suite.bandit).
+			AttackResult(powercommit.NewAttackResultBuilder().Build()).
+			Build(),
+	})
+}
+
+func (suite *ConsoleShowsExperience) TestShowExperienceGainedBeforeSeparator(checker *C) {
+	suite.viewer.PrepareResultWithExperience(
+		suite.resultBlotMissesBandit,
+		[]*experience.Award{
+			{SquaddieID: suite.teros.ID(), ExperienceGained: 1},
+		},
+		nil,
+		suite.repos,
+		nil,
+	)
+
+	checker.Assert(suite.viewer.Messages, DeepEquals, []string{
+		"Teros (Blot) misses Bandit",
+		"   Teros gains 1 XP",
+		"---",
+	})
+}
+
+func (suite *ConsoleShowsExperience) TestShowLevelUpStatGains(checker *C) {
+	smallLevel, _ := levelupbenefit.NewLevelUpBenefitBuilder().WithID("small").WithClassID("mage").
+		Aim(1).HitPoints(2).Build()
+	bigLevel, _ := levelupbenefit.NewLevelUpBenefitBuilder().WithID("big").WithClassID("mage").BigLevel().
+		GainPower("fireballID", "Fireball").LosePower(suite.blot.ID()).Build()
+
+	suite.viewer.PrepareResultWithExperience(
+		suite.resultBlotMissesBandit,
+		[]*experience.Award{
+			{SquaddieID: suite.teros.ID(), ExperienceGained: 30, LevelUpsQueued: 1},
+		},
+		[]*experience.LevelUp{
+			{SquaddieID: suite.teros.ID(), BenefitsApplied: []*levelupbenefit.LevelUpBenefit{bigLevel, smallLevel}},
+		},
+		suite.repos,
+		nil,
+	)
+
+	checker.Assert(suite.viewer.Messages, DeepEquals, []string{
+		"Teros (Blot) misses Bandit",
+		"   Teros gains 30 XP",
+		"   Teros is ready to level up",
+		"   Teros levels up: learns Fireball, forgets Blot, +2 Max HP, +1 Aim",
+		"---",
+	})
+}
+
+func (suite *ConsoleShowsExperience) TestShowLevelUpAction(checker *C) {
+	smallLevel, _ := levelupbenefit.NewLevelUpBenefitBuilder().WithID("small").WithClassID("mage").Mind(1).Build()
+
+	suite.viewer.PrepareLevelUp(
+		&experience.LevelUp{SquaddieID: suite.teros.ID(), BenefitsApplied: []*levelupbenefit.LevelUpBenefit{smallLevel}},
+		suite.repos,
+	)
+
+	checker.Assert(suite.viewer.Messages, DeepEquals, []string{
+		"Teros levels up: +1 Mind",
+		"---",
+	})
+}
+
+func (suite *ConsoleShowsExperience) TestShowEquipPowerAction(checker *C) {
+	suite.teros.EquipPower(suite.blot.ID())
+	suite.viewer.PrepareEquipPower(suite.teros.ID(), suite.repos)
+
+	checker.Assert(suite.viewer.Messages, DeepEquals, []string{
+		"Teros equips Blot",
+		"---",
+	})
+}
+
+func (suite *ConsoleShowsExperience) TestShowRelationshipChange(checker *C) {
+	suite.repos.FactionRepo = faction.NewRepository()
+	suite.repos.FactionRepo.AddFactions([]*faction.FactionMarshal{
+		{ID: "player", Name: "Teros's Party"},
+	})
+
+	suite.viewer.PrepareRelationshipChange("player", "enemy", faction.Neutral, suite.repos)
+
+	checker.Assert(suite.viewer.Messages, DeepEquals, []string{
+		"Teros's Party and enemy are now neutral",
+		"---",
+	})
+}
+
+func (suite *ConsoleShowsExperience) TestShowAffiliationOverrideInTargetStatus(checker *C) {
+	suite.bandit.OverrideAffiliation(suite.teros.AffiliationLogic(), 2)
+	suite.viewer.PrepareResultWithExperience(
+		suite.resultBlotMissesBandit,
+		nil,
+		nil,
+		suite.repos,
+		&actionviewer.ConsoleActionViewerVerbosity{ShowTargetStatus: true},
+	)
+
+	checker.Assert(suite.viewer.Messages, DeepEquals, []string{
+		"Teros (Blot) misses Bandit",
+		"   Bandit: 5/5 HP, player for 2 turns",
+		"---",
+	})
+}
+
+func (suite *ConsoleShowsExperience) TestShowSquaddieReturningToItsSide(checker *C) {
+	suite.viewer.PrepareEndTurn(suite.bandit.ID(), false, suite.repos)
+	checker.Assert(suite.viewer.Messages, HasLen, 0)
+
+	suite.viewer.PrepareEndTurn(suite.bandit.ID(), true, suite.repos)
+	checker.Assert(suite.viewer.Messages, DeepEquals, []string{
+		"Bandit returns to the enemy side",
+		"---",
+	})
+}
+
+func (suite *ConsoleShowsExperience) TestShowNextTurn(checker *C) {
+	suite.viewer.PrepareNextTurn(2)
+	checker.Assert(suite.viewer.Messages, DeepEquals, []string{
+		"Turn 2 begins",
+		"---",
+	})
+}
+
+func (suite *ConsoleShowsExperience) TestShowBattleOutcome(checker *C) {
+	suite.viewer.PrepareBattleOutcome(&objective.Outcome{
+		Result:    objective.Victory,
+		Objective: &objective.Objective{Kind: objective.DefeatBoss, SquaddieID: suite.bandit.ID()},
+	}, suite.repos)
+	suite.viewer.PrepareBattleOutcome(&objective.Outcome{
+		Result:    objective.Victory,
+		Objective: &objective.Objective{Kind: objective.SurviveTurns, Turns: 3},
+	}, suite.repos)
+	suite.viewer.PrepareBattleOutcome(&objective.Outcome{
+		Result:    objective.Victory,
+		Objective: &objective.Objective{Kind: objective.RoutFoes},
+	}, suite.repos)
+	suite.viewer.PrepareBattleOutcome(&objective.Outcome{
+		Result:    objective.Defeat,
+		Objective: &objective.Objective{Kind: objective.ProtectSquaddie, SquaddieID: suite.teros.ID()},
+	}, suite.repos)
+	suite.viewer.PrepareBattleOutcome(&objective.Outcome{Result: objective.Defeat}, suite.repos)
+	suite.viewer.PrepareBattleOutcome(nil, suite.repos)
+
+	checker.Assert(suite.viewer.Messages, DeepEquals, []string{
+		"Victory: Bandit has fallen",
+		"Victory: survived 3 turns",
+		"Victory: every foe has fallen",
+		"Defeat: Teros has fallen",
+		"Defeat: every player squaddie has fallen",
+		"The battle is undecided",
+	})
+}
+
+func (suite *ConsoleShowsExperience) TestShowTriggerEffects(checker *C) {
+	suite.repos.FactionRepo = faction.NewRepository()
+	suite.repos.FactionRepo.AddFactions([]*faction.FactionMarshal{
+		{ID: "deserters", Name: "The Deserters"},
+	})
+	suite.bandit.ChangeBaseAffiliation(affiliation.NewFaction("deserters"))
+
+	suite.viewer.PrepareTrigger(&trigger.Trigger{
+		ID: "banditDeserts",
+		Effects: []*trigger.Effect{
+			{Kind: trigger.ShowMessage, Message: "The bandit has had enough."},
+			{Kind: trigger.AddSquaddies, TemplateID: suite.bandit.ID(), SquaddieIDs: []string{suite.bandit.ID()}},
+			{Kind: trigger.LosePowers, SquaddieID: suite.teros.ID(), Powers: []*powerreference.Reference{suite.blot.GetReference()}},
+			{Kind: trigger.GainPowers, SquaddieID: suite.teros.ID(), Powers: []*powerreference.Reference{suite.blot.GetReference()}},
+			{Kind: trigger.ChangeAffiliation, SquaddieID: suite.bandit.ID(), Faction: "deserters"},
+		},
+	}, suite.repos)
+
+	checker.Assert(suite.viewer.Messages, DeepEquals, []string{
+		"The bandit has had enough.",
+		"Bandit joins the battle",
+		"Teros forgets Blot",
+		"Teros learns Blot",
+		"Bandit switches to The Deserters",
+		"---",
+	})
+}
+
+func (suite *ConsoleShowsExperience) TestShowSummonedSquaddies(checker *C) {
+	wolf := squaddie.NewSquaddieBuilder().WithName("Wolf").Build()
+	wolf.MarkAsSummon(suite.teros.ID(), 2)
+	suite.repos.SquaddieRepo.AddSquaddies([]squaddieinterface.Interface{wolf})
+
+	resultSummonsWolf := &powercommitfakes.FakeResultStrategy{}
+	resultSummonsWolf.ResultPerTargetReturns([]*powercommit.ResultPerTarget{})
+	resultSummonsWolf.SummonedSquaddieIDsReturns([]string{wolf.ID()})
+
+	suite.viewer.PrepareResultWithExperience(resultSummonsWolf, nil, nil, suite.repos, &actionviewer.ConsoleActionViewerVerbosity{})
+
+	checker.Assert(suite.viewer.Messages, DeepEquals, []string{
+		"Teros summons Wolf for 2 turns",
+		"---",
+	})
+}
+
+func (suite *ConsoleShowsExperience) TestShowLingeringEffects(checker *C) {
+	suite.teros = squaddie.NewSquaddieBuilder().Teros().HitPoints(10).Build()
+	suite.bandit = squaddie.NewSquaddieBuilder().Bandit().HitPoints(10).Build()
+	suite.repos.SquaddieRepo = squaddie.NewSquaddieRepository()
+	suite.repos.SquaddieRepo.AddSquaddies([]squaddieinterface.Interface{suite.teros, suite.bandit})
+	suite.teros.ReduceHitPoints(2)
+	suite.bandit.ReduceHitPoints(4)
+	suite.bandit.GainHitPoints(1)
+	suite.viewer.PrepareLingeringEffects(
+		[]string{suite.teros.ID(), suite.bandit.ID()},
+		map[string]int{suite.bandit.ID(): 1, suite.teros.ID(): -2},
+		suite.repos,
+	)
+
+	checker.Assert(suite.viewer.Messages, DeepEquals, []string{
+		"Teros takes 2 damage from lingering effects",
+		"   Teros: 8/10 HP",
+		"Bandit heals 1 HP from lingering effects",
+		"   Bandit: 7/10 HP",
+		"---",
+	})
+}
+
+func (suite *ConsoleShowsExperience) TestShowNothingWithoutLingeringEffects(checker *C) {
+	suite.viewer.PrepareLingeringEffects([]string{suite.teros.ID()}, map[string]int{}, suite.repos)
+	checker.Assert(suite.viewer.Messages, HasLen, 0)
+}
+
+func (suite *ConsoleShowsExperience) TestShowGuard(checker *C) {
+	lini := squaddie.NewSquaddieBuilder().Lini().Build()
+	suite.repos.SquaddieRepo.AddSquaddies([]squaddieinterface.Interface{lini})
+
+	suite.viewer.PrepareGuard(suite.teros.ID(), lini.ID(), suite.repos)
+
+	checker.Assert(suite.viewer.Messages, DeepEquals, []string{
+		"Teros starts guarding Lini",
+		"---",
+	})
+}
+
+func (suite *ConsoleShowsExperience) TestShowSummonVanishing(checker *C) {
+	wolf := squaddie.NewSquaddieBuilder().WithName("Wolf").Build()
+	suite.repos.SquaddieRepo.AddSquaddies([]squaddieinterface.Interface{wolf})
+
+	suite.viewer.PrepareSummonVanished(wolf.ID(), suite.repos)
+
+	checker.Assert(suite.viewer.Messages, DeepEquals, []string{
+		"Wolf vanishes",
+		"---",
+	})
+}
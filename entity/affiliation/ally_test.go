@@ -10,29 +10,29 @@ type AllyAffiliationSuite struct{}
 var _ = Suite(&AllyAffiliationSuite{})
 
 func (suite *AllyAffiliationSuite) TestAlliesAreFriendsWithPlayers(checker *C) {
-	ally := affiliation.NewAffiliationLogic("ally")
-	player := affiliation.NewAffiliationLogic("player")
+	ally, _ := affiliation.NewAffiliationLogic("ally")
+	player, _ := affiliation.NewAffiliationLogic("player")
 	checker.Assert(ally.IsFriendsWith(player), Equals, true)
 	checker.Assert(ally.IsFoesWith(player), Equals, false)
 }
 
 func (suite *AllyAffiliationSuite) TestAlliesAreFriendsWithOtherAllies(checker *C) {
-	ally := affiliation.NewAffiliationLogic("ally")
-	ally2 := affiliation.NewAffiliationLogic("ally")
+	ally, _ := affiliation.NewAffiliationLogic("ally")
+	ally2, _ := affiliation.NewAffiliationLogic("ally")
 	checker.Assert(ally.IsFriendsWith(ally2), Equals, true)
 	checker.Assert(ally.IsFoesWith(ally2), Equals, false)
 }
 
 func (suite *AllyAffiliationSuite) TestAlliesAreFoesOfEnemies(checker *C) {
-	ally := affiliation.NewAffiliationLogic("ally")
-	enemy := affiliation.NewAffiliationLogic("enemy")
+	ally, _ := affiliation.NewAffiliationLogic("ally")
+	enemy, _ := affiliation.NewAffiliationLogic("enemy")
 	checker.Assert(ally.IsFriendsWith(enemy), Equals, false)
 	checker.Assert(ally.IsFoesWith(enemy), Equals, true)
 }
 
 func (suite *AllyAffiliationSuite) TestAlliesAreFoesOfNeutrals(checker *C) {
-	ally := affiliation.NewAffiliationLogic("ally")
-	neutral := affiliation.NewAffiliationLogic("neutral")
+	ally, _ := affiliation.NewAffiliationLogic("ally")
+	neutral, _ := affiliation.NewAffiliationLogic("neutral")
 	checker.Assert(ally.IsFriendsWith(neutral), Equals, false)
 	checker.Assert(ally.IsFoesWith(neutral), Equals, true)
 }
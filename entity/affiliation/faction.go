@@ -0,0 +1,27 @@
+package affiliation
+
+// Faction represents units belonging to a faction defined in data, like bandits or a merchant guild.
+//   Without a relationship matrix, factions are friends with themselves and foes with everyone else.
+type Faction struct {
+	name string
+}
+
+// NewFaction returns a Faction with the given name.
+func NewFaction(name string) *Faction {
+	return &Faction{name: name}
+}
+
+// IsFriendsWith returns true if the other affiliation belongs to the same faction.
+func (a *Faction) IsFriendsWith(other Interface) bool {
+	return other.Name() == a.name
+}
+
+// IsFoesWith returns true if the other affiliation belongs to a different faction.
+func (a *Faction) IsFoesWith(other Interface) bool {
+	return other.Name() != a.name
+}
+
+// Name returns the name of the faction.
+func (a *Faction) Name() string {
+	return a.name
+}
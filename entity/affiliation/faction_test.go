@@ -0,0 +1,27 @@
+package affiliation_test
+
+import (
+	"github.com/chadius/terosgamerules/entity/affiliation"
+	. "gopkg.in/check.v1"
+)
+
+type FactionAffiliationSuite struct{}
+
+var _ = Suite(&FactionAffiliationSuite{})
+
+func (suite *FactionAffiliationSuite) TestFactionsAreFriendsWithThemselves(checker *C) {
+	bandits := affiliation.NewFaction("bandits")
+	otherBandits := affiliation.NewFaction("bandits")
+	checker.Assert(bandits.Name(), Equals, "bandits")
+	checker.Assert(bandits.IsFriendsWith(otherBandits), Equals, true)
+	checker.Assert(bandits.IsFoesWith(otherBandits), Equals, false)
+}
+
+func (suite *FactionAffiliationSuite) TestFactionsAreFoesWithEveryoneElse(checker *C) {
+	bandits := affiliation.NewFaction("bandits")
+	merchants := affiliation.NewFaction("merchants")
+	player, _ := affiliation.NewAffiliationLogic("player")
+	checker.Assert(bandits.IsFriendsWith(merchants), Equals, false)
+	checker.Assert(bandits.IsFoesWith(merchants), Equals, true)
+	checker.Assert(bandits.IsFoesWith(player), Equals, true)
+}
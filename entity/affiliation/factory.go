@@ -1,35 +1,57 @@
 package affiliation
 
-// NewAffiliationLogic returns a new healing logic object based on the keyword given. Or it returns a nohealing logic.
-func NewAffiliationLogic(keyword string) Interface {
-	affiliationLogicByKeyword := map[string]string{
-		"Player":              "Player",
-		"player":              "Player",
-		"*affiliation.Player": "Player",
-		"*affiliation.player": "Player",
-
-		"Enemy":              "Enemy",
-		"enemy":              "Enemy",
-		"*affiliation.Enemy": "Enemy",
-		"*affiliation.enemy": "Enemy",
-
-		"ally":              "Ally",
-		"Ally":              "Ally",
-		"*affiliation.ally": "Ally",
-		"*affiliation.Ally": "Ally",
-	}
+import (
+	"github.com/chadius/terosgamerules/utility"
+)
 
-	if affiliationLogicByKeyword[keyword] == "Player" {
-		return &Player{}
-	}
+var registry = newDefaultRegistry()
 
-	if affiliationLogicByKeyword[keyword] == "Enemy" {
-		return &Enemy{}
-	}
+func newDefaultRegistry() *utility.LogicRegistry {
+	registry := utility.NewLogicRegistry("affiliation logic")
+	registry.RegisterKeywords(
+		[]string{"Player", "player", "*affiliation.Player", "*affiliation.player"},
+		func() interface{} { return &Player{} },
+	)
+	registry.RegisterKeywords(
+		[]string{"Enemy", "enemy", "*affiliation.Enemy", "*affiliation.enemy"},
+		func() interface{} { return &Enemy{} },
+	)
+	registry.RegisterKeywords(
+		[]string{"ally", "Ally", "*affiliation.ally", "*affiliation.Ally"},
+		func() interface{} { return &Ally{} },
+	)
+	registry.RegisterKeywords(
+		[]string{"neutral", "Neutral", "*affiliation.neutral", "*affiliation.Neutral"},
+		func() interface{} { return &Neutral{} },
+	)
+	return registry
+}
 
-	if affiliationLogicByKeyword[keyword] == "Ally" {
-		return &Ally{}
-	}
+// Factory creates a new affiliation logic object.
+type Factory func() Interface
+
+// Register lets NewAffiliationLogic create the logic made by factory when it sees the keyword.
+//   Raises an error if the keyword is empty or already registered.
+func Register(keyword string, factory Factory) error {
+	return registry.Register(keyword, func() interface{} { return factory() })
+}
+
+// Unregister stops NewAffiliationLogic from understanding the keyword.
+func Unregister(keyword string) {
+	registry.Unregister(keyword)
+}
 
-	return &Neutral{}
+// Keywords returns every keyword NewAffiliationLogic understands, in alphabetical order.
+func Keywords() []string {
+	return registry.Keywords()
+}
+
+// NewAffiliationLogic returns a new affiliation logic object based on the keyword given.
+//   Raises an error if the keyword was never registered.
+func NewAffiliationLogic(keyword string) (Interface, error) {
+	logic, err := registry.NewLogic(keyword)
+	if err != nil {
+		return nil, err
+	}
+	return logic.(Interface), nil
 }
@@ -14,21 +14,38 @@ type FactorySuite struct{}
 var _ = Suite(&FactorySuite{})
 
 func (suite *FactorySuite) TestFactoryReturnsPlayerAffiliation(checker *C) {
-	affiliationLogic := affiliation.NewAffiliationLogic("player")
+	affiliationLogic, _ := affiliation.NewAffiliationLogic("player")
 	checker.Assert(reflect.TypeOf(affiliationLogic).String(), Equals, "*affiliation.Player")
 }
 
 func (suite *FactorySuite) TestFactoryReturnsAllyAffiliation(checker *C) {
-	affiliationLogic := affiliation.NewAffiliationLogic("ally")
+	affiliationLogic, _ := affiliation.NewAffiliationLogic("ally")
 	checker.Assert(reflect.TypeOf(affiliationLogic).String(), Equals, "*affiliation.Ally")
 }
 
 func (suite *FactorySuite) TestFactoryReturnsEnemyAffiliation(checker *C) {
-	affiliationLogic := affiliation.NewAffiliationLogic("enemy")
+	affiliationLogic, _ := affiliation.NewAffiliationLogic("enemy")
 	checker.Assert(reflect.TypeOf(affiliationLogic).String(), Equals, "*affiliation.Enemy")
 }
 
-func (suite *FactorySuite) TestWhenUnknownKeyword_ThenFactoryReturnsNeutral(checker *C) {
-	affiliationLogic := affiliation.NewAffiliationLogic("kwyjibo")
-	checker.Assert(reflect.TypeOf(affiliationLogic).String(), Equals, "*affiliation.Neutral")
+func (suite *FactorySuite) TestWhenUnknownKeyword_ThenFactoryRaisesAnError(checker *C) {
+	affiliationLogic, err := affiliation.NewAffiliationLogic("kwyjibo")
+	checker.Assert(err, ErrorMatches, `unknown affiliation logic keyword "kwyjibo"`)
+	checker.Assert(affiliationLogic, IsNil)
+}
+
+func (suite *FactorySuite) TestCanRegisterCustomLogic(checker *C) {
+	err := affiliation.Register("summoned", func() affiliation.Interface { return &affiliation.Ally{} })
+	checker.Assert(err, IsNil)
+	defer affiliation.Unregister("summoned")
+
+	affiliationLogic, err := affiliation.NewAffiliationLogic("summoned")
+	checker.Assert(err, IsNil)
+	checker.Assert(reflect.TypeOf(affiliationLogic).String(), Equals, "*affiliation.Ally")
+	checker.Assert(affiliation.Keywords(), Not(HasLen), 0)
+}
+
+func (suite *FactorySuite) TestCannotReplaceExistingLogic(checker *C) {
+	err := affiliation.Register("ally", func() affiliation.Interface { return &affiliation.Ally{} })
+	checker.Assert(err, ErrorMatches, `affiliation logic keyword ".*" is already registered`)
 }
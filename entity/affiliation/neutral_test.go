@@ -10,22 +10,22 @@ type NeutralAffiliationSuite struct{}
 var _ = Suite(&NeutralAffiliationSuite{})
 
 func (suite *NeutralAffiliationSuite) TestNeutralsAreFoesOfPlayers(checker *C) {
-	neutral := affiliation.NewAffiliationLogic("neutral")
-	player := affiliation.NewAffiliationLogic("player")
+	neutral, _ := affiliation.NewAffiliationLogic("neutral")
+	player, _ := affiliation.NewAffiliationLogic("player")
 	checker.Assert(neutral.IsFriendsWith(player), Equals, false)
 	checker.Assert(neutral.IsFoesWith(player), Equals, true)
 }
 
 func (suite *NeutralAffiliationSuite) TestNeutralsAreFoesOfAllies(checker *C) {
-	neutral := affiliation.NewAffiliationLogic("neutral")
-	ally := affiliation.NewAffiliationLogic("ally")
+	neutral, _ := affiliation.NewAffiliationLogic("neutral")
+	ally, _ := affiliation.NewAffiliationLogic("ally")
 	checker.Assert(neutral.IsFriendsWith(ally), Equals, false)
 	checker.Assert(neutral.IsFoesWith(ally), Equals, true)
 }
 
 func (suite *NeutralAffiliationSuite) TestNeutralsAreFoesWithOtherNeutrals(checker *C) {
-	neutral := affiliation.NewAffiliationLogic("neutral")
-	neutral2 := affiliation.NewAffiliationLogic("neutral")
+	neutral, _ := affiliation.NewAffiliationLogic("neutral")
+	neutral2, _ := affiliation.NewAffiliationLogic("neutral")
 	checker.Assert(neutral.IsFriendsWith(neutral2), Equals, false)
 	checker.Assert(neutral.IsFoesWith(neutral2), Equals, true)
 }
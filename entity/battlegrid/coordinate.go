@@ -0,0 +1,19 @@
+package battlegrid
+
+// Coordinate is a tile on the battle grid.
+type Coordinate struct {
+	Row    int `json:"row" yaml:"row"`
+	Column int `json:"column" yaml:"column"`
+}
+
+// DistanceTo returns the number of steps between the coordinates. Squaddies cannot move diagonally.
+func (coordinate Coordinate) DistanceTo(other Coordinate) int {
+	return absoluteValue(coordinate.Row-other.Row) + absoluteValue(coordinate.Column-other.Column)
+}
+
+func absoluteValue(value int) int {
+	if value < 0 {
+		return -value
+	}
+	return value
+}
@@ -0,0 +1,83 @@
+package battlegrid
+
+import (
+	"fmt"
+	"github.com/chadius/terosgamerules/utility"
+)
+
+// Placement puts a squaddie on a tile when the battle starts.
+type Placement struct {
+	SquaddieID string `json:"squaddie_id" yaml:"squaddie_id"`
+	Row        int    `json:"row" yaml:"row"`
+	Column     int    `json:"column" yaml:"column"`
+}
+
+// Grid tracks where squaddies stand during a battle.
+//   Squaddies that were never placed on the grid are not tracked, and can reach everyone.
+type Grid struct {
+	coordinateBySquaddieID map[string]Coordinate
+}
+
+// NewGrid generates a pointer to a new Grid.
+func NewGrid() *Grid {
+	return &Grid{
+		coordinateBySquaddieID: map[string]Coordinate{},
+	}
+}
+
+// AddPlacements puts every squaddie on its tile.
+//   Raises an error if two squaddies share a tile.
+func (grid *Grid) AddPlacements(placements []*Placement) error {
+	for _, placement := range placements {
+		err := grid.PlaceSquaddie(placement.SquaddieID, Coordinate{Row: placement.Row, Column: placement.Column})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PlaceSquaddie puts the squaddie on the tile, removing it from wherever it stood before.
+//   Raises an error if another squaddie is on the tile.
+func (grid *Grid) PlaceSquaddie(squaddieID string, coordinate Coordinate) error {
+	occupantID := grid.SquaddieAt(coordinate)
+	if occupantID != "" && occupantID != squaddieID {
+		newError := fmt.Errorf(`squaddie "%s" cannot stand at (%d, %d), "%s" is already there`, squaddieID, coordinate.Row, coordinate.Column, occupantID)
+		utility.Log(newError.Error(), 0, utility.Error)
+		return newError
+	}
+
+	grid.coordinateBySquaddieID[squaddieID] = coordinate
+	return nil
+}
+
+// SquaddieCoordinate returns where the squaddie stands.
+//   The bool is false if the squaddie is not on the grid.
+func (grid *Grid) SquaddieCoordinate(squaddieID string) (Coordinate, bool) {
+	coordinate, found := grid.coordinateBySquaddieID[squaddieID]
+	return coordinate, found
+}
+
+// SquaddieAt returns the ID of the squaddie standing on the tile, or an empty string if the tile is empty.
+func (grid *Grid) SquaddieAt(coordinate Coordinate) string {
+	for squaddieID, squaddieCoordinate := range grid.coordinateBySquaddieID {
+		if squaddieCoordinate == coordinate {
+			return squaddieID
+		}
+	}
+	return ""
+}
+
+// DistanceBetween returns the number of steps between the squaddies.
+//   The bool is false if either squaddie is not on the grid.
+func (grid *Grid) DistanceBetween(squaddieID, otherSquaddieID string) (int, bool) {
+	coordinate, found := grid.SquaddieCoordinate(squaddieID)
+	if !found {
+		return 0, false
+	}
+	otherCoordinate, otherFound := grid.SquaddieCoordinate(otherSquaddieID)
+	if !otherFound {
+		return 0, false
+	}
+	return coordinate.DistanceTo(otherCoordinate), true
+}
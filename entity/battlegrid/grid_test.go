@@ -0,0 +1,71 @@
+package battlegrid_test
+
+import (
+	"github.com/chadius/terosgamerules/entity/battlegrid"
+	. "gopkg.in/check.v1"
+	"testing"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type GridSuite struct {
+	grid *battlegrid.Grid
+}
+
+var _ = Suite(&GridSuite{})
+
+func (suite *GridSuite) SetUpTest(checker *C) {
+	suite.grid = battlegrid.NewGrid()
+}
+
+func (suite *GridSuite) TestDistanceCountsStepsWithoutDiagonals(checker *C) {
+	start := battlegrid.Coordinate{Row: 1, Column: 4}
+	checker.Assert(start.DistanceTo(battlegrid.Coordinate{Row: 1, Column: 4}), Equals, 0)
+	checker.Assert(start.DistanceTo(battlegrid.Coordinate{Row: 2, Column: 4}), Equals, 1)
+	checker.Assert(start.DistanceTo(battlegrid.Coordinate{Row: 3, Column: 1}), Equals, 5)
+}
+
+func (suite *GridSuite) TestPlacesSquaddiesOnTiles(checker *C) {
+	err := suite.grid.AddPlacements([]*battlegrid.Placement{
+		{SquaddieID: "teros", Row: 0, Column: 0},
+		{SquaddieID: "bandit", Row: 2, Column: 1},
+	})
+	checker.Assert(err, IsNil)
+
+	coordinate, found := suite.grid.SquaddieCoordinate("bandit")
+	checker.Assert(found, Equals, true)
+	checker.Assert(coordinate, Equals, battlegrid.Coordinate{Row: 2, Column: 1})
+	checker.Assert(suite.grid.SquaddieAt(battlegrid.Coordinate{Row: 0, Column: 0}), Equals, "teros")
+	checker.Assert(suite.grid.SquaddieAt(battlegrid.Coordinate{Row: 5, Column: 5}), Equals, "")
+
+	distance, bothPlaced := suite.grid.DistanceBetween("teros", "bandit")
+	checker.Assert(bothPlaced, Equals, true)
+	checker.Assert(distance, Equals, 3)
+}
+
+func (suite *GridSuite) TestSquaddiesCannotShareTiles(checker *C) {
+	suite.grid.PlaceSquaddie("teros", battlegrid.Coordinate{Row: 0, Column: 0})
+
+	err := suite.grid.PlaceSquaddie("bandit", battlegrid.Coordinate{Row: 0, Column: 0})
+	checker.Assert(err, ErrorMatches, `squaddie "bandit" cannot stand at \(0, 0\), "teros" is already there`)
+
+	_, found := suite.grid.SquaddieCoordinate("bandit")
+	checker.Assert(found, Equals, false)
+}
+
+func (suite *GridSuite) TestMovingLeavesTheOldTile(checker *C) {
+	suite.grid.PlaceSquaddie("teros", battlegrid.Coordinate{Row: 0, Column: 0})
+	err := suite.grid.PlaceSquaddie("teros", battlegrid.Coordinate{Row: 0, Column: 1})
+	checker.Assert(err, IsNil)
+	checker.Assert(suite.grid.SquaddieAt(battlegrid.Coordinate{Row: 0, Column: 0}), Equals, "")
+	checker.Assert(suite.grid.SquaddieAt(battlegrid.Coordinate{Row: 0, Column: 1}), Equals, "teros")
+}
+
+func (suite *GridSuite) TestUnplacedSquaddiesHaveNoDistance(checker *C) {
+	suite.grid.PlaceSquaddie("teros", battlegrid.Coordinate{Row: 0, Column: 0})
+	_, bothPlaced := suite.grid.DistanceBetween("teros", "lini")
+	checker.Assert(bothPlaced, Equals, false)
+
+	_, found := suite.grid.SquaddieCoordinate("lini")
+	checker.Assert(found, Equals, false)
+}
@@ -0,0 +1,92 @@
+package campaign
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/chadius/terosgamerules/utility"
+	"gopkg.in/yaml.v2"
+)
+
+// Casualty policies decide what happens to squaddies who fall during a chapter.
+const (
+	// Permadeath removes fallen squaddies from the roster for the rest of the campaign.
+	Permadeath = "permadeath"
+	// Injured makes fallen squaddies sit out the next chapters, then return at full health.
+	Injured = "injured"
+)
+
+// Campaign is an ordered list of chapters fought by the same roster.
+type Campaign struct {
+	ID              string   `json:"id" yaml:"id"`
+	Name            string   `json:"name" yaml:"name"`
+	ChapterIDs      []string `json:"chapters" yaml:"chapters"`
+	CasualtyPolicy  string   `json:"casualty_policy" yaml:"casualty_policy"`
+	InjuredChapters int      `json:"injured_chapters" yaml:"injured_chapters"`
+}
+
+// NewCampaignFromYAML reads the YAML data and returns a Campaign.
+//   Raises an error if the campaign is invalid.
+func NewCampaignFromYAML(data []byte) (*Campaign, error) {
+	return newCampaignFromDatastream(data, yaml.Unmarshal)
+}
+
+// NewCampaignFromJSON reads the JSON data and returns a Campaign.
+//   Raises an error if the campaign is invalid.
+func NewCampaignFromJSON(data []byte) (*Campaign, error) {
+	return newCampaignFromDatastream(data, json.Unmarshal)
+}
+
+func newCampaignFromDatastream(data []byte, unmarshal utility.UnmarshalFunc) (*Campaign, error) {
+	var newCampaign Campaign
+	unmarshalError := unmarshal(data, &newCampaign)
+	if unmarshalError != nil {
+		return nil, unmarshalError
+	}
+
+	err := newCampaign.CheckForErrors()
+	if err != nil {
+		return nil, err
+	}
+	return &newCampaign, nil
+}
+
+// CheckForErrors makes sure the campaign has chapters and a known casualty policy.
+//   Campaigns without a casualty policy use Permadeath.
+func (c *Campaign) CheckForErrors() error {
+	if len(c.ChapterIDs) == 0 {
+		newError := fmt.Errorf(`campaign "%s" has no chapters`, c.ID)
+		utility.Log(newError.Error(), 0, utility.Error)
+		return newError
+	}
+
+	if c.CasualtyPolicy == "" {
+		c.CasualtyPolicy = Permadeath
+	}
+
+	if c.CasualtyPolicy != Permadeath && c.CasualtyPolicy != Injured {
+		newError := fmt.Errorf(`campaign "%s" has unknown casualty policy "%s"`, c.ID, c.CasualtyPolicy)
+		utility.Log(newError.Error(), 0, utility.Error)
+		return newError
+	}
+
+	if c.CasualtyPolicy == Injured && c.InjuredChapters < 1 {
+		newError := fmt.Errorf(`campaign "%s" must injure squaddies for at least 1 chapter, found %d`, c.ID, c.InjuredChapters)
+		utility.Log(newError.Error(), 0, utility.Error)
+		return newError
+	}
+	return nil
+}
+
+// NumberOfChapters returns the number of chapters in the campaign.
+func (c *Campaign) NumberOfChapters() int {
+	return len(c.ChapterIDs)
+}
+
+// GetChapterID returns the ID of the chapter at the index.
+//   The bool is false if the index is past the last chapter.
+func (c *Campaign) GetChapterID(chapterIndex int) (string, bool) {
+	if chapterIndex < 0 || chapterIndex >= len(c.ChapterIDs) {
+		return "", false
+	}
+	return c.ChapterIDs[chapterIndex], true
+}
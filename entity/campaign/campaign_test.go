@@ -0,0 +1,58 @@
+package campaign_test
+
+import (
+	"github.com/chadius/terosgamerules/entity/campaign"
+	. "gopkg.in/check.v1"
+	"testing"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type CampaignSuite struct{}
+
+var _ = Suite(&CampaignSuite{})
+
+func (suite *CampaignSuite) TestLoadCampaignWithYAML(checker *C) {
+	newCampaign, err := campaign.NewCampaignFromYAML([]byte(`
+id: campaignBandits
+name: Bandit Troubles
+chapters:
+  - chapterAmbush
+  - chapterHideout
+casualty_policy: injured
+injured_chapters: 2
+`))
+	checker.Assert(err, IsNil)
+	checker.Assert(newCampaign.Name, Equals, "Bandit Troubles")
+	checker.Assert(newCampaign.NumberOfChapters(), Equals, 2)
+	checker.Assert(newCampaign.CasualtyPolicy, Equals, campaign.Injured)
+	checker.Assert(newCampaign.InjuredChapters, Equals, 2)
+
+	chapterID, exists := newCampaign.GetChapterID(1)
+	checker.Assert(exists, Equals, true)
+	checker.Assert(chapterID, Equals, "chapterHideout")
+
+	_, exists = newCampaign.GetChapterID(2)
+	checker.Assert(exists, Equals, false)
+}
+
+func (suite *CampaignSuite) TestCampaignsDefaultToPermadeath(checker *C) {
+	newCampaign, err := campaign.NewCampaignFromJSON([]byte(`{"id": "campaignBandits", "chapters": ["chapterAmbush"]}`))
+	checker.Assert(err, IsNil)
+	checker.Assert(newCampaign.CasualtyPolicy, Equals, campaign.Permadeath)
+}
+
+func (suite *CampaignSuite) TestRaisesErrorWithoutChapters(checker *C) {
+	_, err := campaign.NewCampaignFromJSON([]byte(`{"id": "campaignBandits"}`))
+	checker.Assert(err, ErrorMatches, `campaign "campaignBandits" has no chapters`)
+}
+
+func (suite *CampaignSuite) TestRaisesErrorWithUnknownCasualtyPolicy(checker *C) {
+	_, err := campaign.NewCampaignFromJSON([]byte(`{"id": "campaignBandits", "chapters": ["chapterAmbush"], "casualty_policy": "retire"}`))
+	checker.Assert(err, ErrorMatches, `campaign "campaignBandits" has unknown casualty policy "retire"`)
+}
+
+func (suite *CampaignSuite) TestRaisesErrorIfInjuriesDoNotLast(checker *C) {
+	_, err := campaign.NewCampaignFromJSON([]byte(`{"id": "campaignBandits", "chapters": ["chapterAmbush"], "casualty_policy": "injured"}`))
+	checker.Assert(err, ErrorMatches, `campaign "campaignBandits" must injure squaddies for at least 1 chapter, found 0`)
+}
@@ -0,0 +1,92 @@
+package campaign
+
+import (
+	"encoding/json"
+	"github.com/chadius/terosgamerules/entity/squaddie"
+	"github.com/chadius/terosgamerules/utility"
+	"gopkg.in/yaml.v2"
+)
+
+// RosterEntry records everything about a squaddie that carries over between chapters.
+//   EquippedItemIDs maps each equipment slot to the item in it.
+type RosterEntry struct {
+	Squaddie                 squaddie.BuilderOptionMarshal `json:"squaddie" yaml:"squaddie"`
+	CurrentHitPoints         int                           `json:"current_hit_points" yaml:"current_hit_points"`
+	EquippedPowerID          string                        `json:"equipped_power_id" yaml:"equipped_power_id"`
+	EquippedItemIDs          map[string]string             `json:"equipped_items" yaml:"equipped_items"`
+	InjuredChaptersRemaining int                           `json:"injured_chapters_remaining" yaml:"injured_chapters_remaining"`
+	IsDead                   bool                          `json:"is_dead" yaml:"is_dead"`
+}
+
+// SquaddieID returns the ID of the squaddie in this entry.
+func (entry *RosterEntry) SquaddieID() string {
+	return entry.Squaddie.ID
+}
+
+// IsAvailable returns true if the squaddie can be deployed in the next chapter.
+func (entry *RosterEntry) IsAvailable() bool {
+	return entry.IsDead == false && entry.InjuredChaptersRemaining <= 0
+}
+
+// SaveFile records a campaign in progress: the next chapter to play and the roster.
+//   ChapterIndex counts the chapters already completed.
+type SaveFile struct {
+	CampaignID   string         `json:"campaign_id" yaml:"campaign_id"`
+	ChapterIndex int            `json:"chapter_index" yaml:"chapter_index"`
+	Roster       []*RosterEntry `json:"roster" yaml:"roster"`
+}
+
+// NewSaveFileFromYAML reads the YAML data and returns a SaveFile.
+func NewSaveFileFromYAML(data []byte) (*SaveFile, error) {
+	return newSaveFileFromDatastream(data, yaml.Unmarshal)
+}
+
+// NewSaveFileFromJSON reads the JSON data and returns a SaveFile.
+func NewSaveFileFromJSON(data []byte) (*SaveFile, error) {
+	return newSaveFileFromDatastream(data, json.Unmarshal)
+}
+
+func newSaveFileFromDatastream(data []byte, unmarshal utility.UnmarshalFunc) (*SaveFile, error) {
+	var saveFile SaveFile
+	unmarshalError := unmarshal(data, &saveFile)
+	if unmarshalError != nil {
+		return nil, unmarshalError
+	}
+	return &saveFile, nil
+}
+
+// ToYAML serializes the save file as YAML.
+func (save *SaveFile) ToYAML() ([]byte, error) {
+	return yaml.Marshal(save)
+}
+
+// ToJSON serializes the save file as JSON.
+func (save *SaveFile) ToJSON() ([]byte, error) {
+	return json.Marshal(save)
+}
+
+// GetRosterEntry returns the entry for the squaddie, or nil if the squaddie is not on the roster.
+func (save *SaveFile) GetRosterEntry(squaddieID string) *RosterEntry {
+	for _, entry := range save.Roster {
+		if entry.SquaddieID() == squaddieID {
+			return entry
+		}
+	}
+	return nil
+}
+
+// GetAvailableSquaddieIDs returns the squaddies who can be deployed, in roster order.
+func (save *SaveFile) GetAvailableSquaddieIDs() []string {
+	squaddieIDs := []string{}
+	for _, entry := range save.Roster {
+		if entry.IsAvailable() {
+			squaddieIDs = append(squaddieIDs, entry.SquaddieID())
+		}
+	}
+	return squaddieIDs
+}
+
+// IsCampaignComplete returns true if every chapter in the campaign has been played.
+func (save *SaveFile) IsCampaignComplete(campaignToCheck *Campaign) bool {
+	return save.ChapterIndex >= campaignToCheck.NumberOfChapters()
+}
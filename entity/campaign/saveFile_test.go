@@ -0,0 +1,83 @@
+package campaign_test
+
+import (
+	"github.com/chadius/terosgamerules/entity/campaign"
+	"github.com/chadius/terosgamerules/entity/squaddie"
+	. "gopkg.in/check.v1"
+)
+
+type SaveFileSuite struct {
+	save *campaign.SaveFile
+}
+
+var _ = Suite(&SaveFileSuite{})
+
+func (suite *SaveFileSuite) SetUpTest(checker *C) {
+	suite.save = &campaign.SaveFile{
+		CampaignID:   "campaignBandits",
+		ChapterIndex: 1,
+		Roster: []*campaign.RosterEntry{
+			{
+				Squaddie:         squaddie.BuilderOptionMarshal{ID: "terosID", Name: "Teros", Affiliation: "Player", MaxHitPoints: 5},
+				CurrentHitPoints: 3,
+				EquippedPowerID:  "powerSpear",
+				EquippedItemIDs:  map[string]string{"weapon": "itemSpear"},
+			},
+			{
+				Squaddie:                 squaddie.BuilderOptionMarshal{ID: "liniID", Name: "Lini", Affiliation: "Player", MaxHitPoints: 5},
+				CurrentHitPoints:         5,
+				InjuredChaptersRemaining: 1,
+			},
+			{
+				Squaddie: squaddie.BuilderOptionMarshal{ID: "mysticMageID", Name: "Mystic Mage", Affiliation: "Player", MaxHitPoints: 3},
+				IsDead:   true,
+			},
+		},
+	}
+}
+
+func (suite *SaveFileSuite) TestOnlyHealthySurvivorsAreAvailable(checker *C) {
+	checker.Assert(suite.save.GetAvailableSquaddieIDs(), DeepEquals, []string{"terosID"})
+	checker.Assert(suite.save.GetRosterEntry("liniID").IsAvailable(), Equals, false)
+	checker.Assert(suite.save.GetRosterEntry("mysticMageID").IsAvailable(), Equals, false)
+	checker.Assert(suite.save.GetRosterEntry("banditID"), IsNil)
+}
+
+func (suite *SaveFileSuite) TestCampaignIsCompleteAfterTheLastChapter(checker *C) {
+	twoChapters := &campaign.Campaign{ID: "campaignBandits", ChapterIDs: []string{"chapterAmbush", "chapterHideout"}}
+	checker.Assert(suite.save.IsCampaignComplete(twoChapters), Equals, false)
+
+	suite.save.ChapterIndex = 2
+	checker.Assert(suite.save.IsCampaignComplete(twoChapters), Equals, true)
+}
+
+func (suite *SaveFileSuite) TestSaveFileRoundTripsThroughYAML(checker *C) {
+	data, err := suite.save.ToYAML()
+	checker.Assert(err, IsNil)
+
+	loadedSave, err := campaign.NewSaveFileFromYAML(data)
+	checker.Assert(err, IsNil)
+	checker.Assert(loadedSave.CampaignID, Equals, "campaignBandits")
+	checker.Assert(loadedSave.ChapterIndex, Equals, 1)
+	checker.Assert(loadedSave.Roster, HasLen, 3)
+
+	terosEntry := loadedSave.GetRosterEntry("terosID")
+	checker.Assert(terosEntry.Squaddie.Name, Equals, "Teros")
+	checker.Assert(terosEntry.Squaddie.MaxHitPoints, Equals, 5)
+	checker.Assert(terosEntry.CurrentHitPoints, Equals, 3)
+	checker.Assert(terosEntry.EquippedPowerID, Equals, "powerSpear")
+	checker.Assert(terosEntry.EquippedItemIDs, DeepEquals, map[string]string{"weapon": "itemSpear"})
+	checker.Assert(loadedSave.GetAvailableSquaddieIDs(), DeepEquals, []string{"terosID"})
+}
+
+func (suite *SaveFileSuite) TestSaveFileRoundTripsThroughJSON(checker *C) {
+	data, err := suite.save.ToJSON()
+	checker.Assert(err, IsNil)
+
+	loadedSave, err := campaign.NewSaveFileFromJSON(data)
+	checker.Assert(err, IsNil)
+	checker.Assert(loadedSave.ChapterIndex, Equals, 1)
+	checker.Assert(loadedSave.GetRosterEntry("terosID").EquippedItemIDs, DeepEquals, map[string]string{"weapon": "itemSpear"})
+	checker.Assert(loadedSave.GetRosterEntry("liniID").InjuredChaptersRemaining, Equals, 1)
+	checker.Assert(loadedSave.GetRosterEntry("mysticMageID").IsDead, Equals, true)
+}
@@ -11,6 +11,8 @@ type ToHitComparison struct {
 type DamageDistribution struct {
 	DamageAbsorbedByArmor   int
 	DamageAbsorbedByBarrier int
+	DamageResisted          int
+	DamageAmplified         int
 	RawDamageDealt          int
 	ExtraBarrierBurnt       int
 	TotalRawBarrierBurnt    int
@@ -18,3 +20,26 @@ type DamageDistribution struct {
 	ActualBarrierBurn       int
 	ActualDamageTaken       int
 }
+
+// ChanceToHitOutOf36 returns how many rolls of two six sided dice, out of 36, succeed with the toHitBonus.
+func ChanceToHitOutOf36(toHitBonus int) int {
+	toHitLookup := map[int]int{
+		-5: 1,
+		-4: 3,
+		-3: 6,
+		-2: 10,
+		-1: 15,
+		0:  21,
+		1:  26,
+		2:  30,
+		3:  33,
+		4:  35,
+	}
+	if toHitBonus > 4 {
+		return 36
+	}
+	if toHitBonus < -5 {
+		return 0
+	}
+	return toHitLookup[toHitBonus]
+}
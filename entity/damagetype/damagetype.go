@@ -0,0 +1,12 @@
+package damagetype
+
+// Damage types layered on top of a power's source.
+//   Powers without a damage type deal untyped damage, which nobody resists.
+//   Games can use any other keyword as a custom damage type.
+const (
+	Untyped   = ""
+	Fire      = "fire"
+	Ice       = "ice"
+	Lightning = "lightning"
+	Piercing  = "piercing"
+)
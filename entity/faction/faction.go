@@ -0,0 +1,36 @@
+package faction
+
+// Relationships factions can have with each other.
+const (
+	// Friend factions can be targeted by powers that target friends.
+	Friend = "friend"
+	// Foe factions can be targeted by powers that target foes.
+	Foe = "foe"
+	// Neutral factions cannot be targeted by powers that target friends or foes.
+	Neutral = "neutral"
+)
+
+// IsValidRelationship returns true if the relationship is Friend, Foe or Neutral.
+func IsValidRelationship(relationship string) bool {
+	return relationship == Friend || relationship == Foe || relationship == Neutral
+}
+
+// FactionMarshal names a faction, used to read factions from YAML and JSON.
+//   The ID matches the affiliation name of the squaddies in the faction.
+type FactionMarshal struct {
+	ID   string `json:"id" yaml:"id"`
+	Name string `json:"name" yaml:"name"`
+}
+
+// RelationshipMarshal describes how two factions treat each other, used to read relationships from YAML and JSON.
+type RelationshipMarshal struct {
+	FactionID      string `json:"faction_id" yaml:"faction_id"`
+	OtherFactionID string `json:"other_faction_id" yaml:"other_faction_id"`
+	Relationship   string `json:"relationship" yaml:"relationship"`
+}
+
+// RepositoryMarshal is a flattened representation of every faction and relationship.
+type RepositoryMarshal struct {
+	Factions      []*FactionMarshal      `json:"factions" yaml:"factions"`
+	Relationships []*RelationshipMarshal `json:"relationships" yaml:"relationships"`
+}
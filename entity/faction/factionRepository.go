@@ -0,0 +1,117 @@
+package faction
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/chadius/terosgamerules/utility"
+	"gopkg.in/yaml.v2"
+)
+
+// Repository tracks the factions and the relationship matrix between them.
+//   Relationships go both ways and can change during a battle.
+type Repository struct {
+	nameByFactionID              map[string]string
+	relationshipByFactionIDPairs map[string]map[string]string
+}
+
+// NewRepository generates a pointer to a new Repository.
+func NewRepository() *Repository {
+	repository := Repository{
+		map[string]string{},
+		map[string]map[string]string{},
+	}
+	return &repository
+}
+
+// Clone returns a copy of the repository, so relationships can change without changing the original.
+func (repository *Repository) Clone() *Repository {
+	clone := NewRepository()
+	for factionID, name := range repository.nameByFactionID {
+		clone.nameByFactionID[factionID] = name
+	}
+	for factionID, relationshipByOtherFactionID := range repository.relationshipByFactionIDPairs {
+		for otherFactionID, relationship := range relationshipByOtherFactionID {
+			clone.setOneWayRelationship(factionID, otherFactionID, relationship)
+		}
+	}
+	return clone
+}
+
+// AddJSONSource consumes a given bytestream and tries to analyze it.
+func (repository *Repository) AddJSONSource(data []byte) (bool, error) {
+	return repository.addSource(data, json.Unmarshal)
+}
+
+// AddYAMLSource consumes a given bytestream and tries to analyze it.
+func (repository *Repository) AddYAMLSource(data []byte) (bool, error) {
+	return repository.addSource(data, yaml.Unmarshal)
+}
+
+func (repository *Repository) addSource(data []byte, unmarshal utility.UnmarshalFunc) (bool, error) {
+	var unmarshalError error
+	var marshaledRepository RepositoryMarshal
+	unmarshalError = unmarshal(data, &marshaledRepository)
+
+	if unmarshalError != nil {
+		return false, unmarshalError
+	}
+
+	repository.AddFactions(marshaledRepository.Factions)
+	return repository.AddRelationships(marshaledRepository.Relationships)
+}
+
+// AddFactions names every faction.
+func (repository *Repository) AddFactions(factions []*FactionMarshal) {
+	for _, factionToAdd := range factions {
+		repository.nameByFactionID[factionToAdd.ID] = factionToAdd.Name
+	}
+}
+
+// AddRelationships sets every relationship.
+//   Raises an error if a relationship is unknown.
+func (repository *Repository) AddRelationships(relationships []*RelationshipMarshal) (bool, error) {
+	for _, relationship := range relationships {
+		err := repository.SetRelationship(relationship.FactionID, relationship.OtherFactionID, relationship.Relationship)
+		if err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// SetRelationship changes how both factions treat each other.
+//   Raises an error if the relationship is unknown.
+func (repository *Repository) SetRelationship(factionID, otherFactionID, relationship string) error {
+	if IsValidRelationship(relationship) == false {
+		newError := fmt.Errorf(`factions "%s" and "%s" have unknown relationship "%s"`, factionID, otherFactionID, relationship)
+		utility.Log(newError.Error(), 0, utility.Error)
+		return newError
+	}
+
+	repository.setOneWayRelationship(factionID, otherFactionID, relationship)
+	repository.setOneWayRelationship(otherFactionID, factionID, relationship)
+	return nil
+}
+
+func (repository *Repository) setOneWayRelationship(factionID, otherFactionID, relationship string) {
+	if repository.relationshipByFactionIDPairs[factionID] == nil {
+		repository.relationshipByFactionIDPairs[factionID] = map[string]string{}
+	}
+	repository.relationshipByFactionIDPairs[factionID][otherFactionID] = relationship
+}
+
+// GetRelationship returns how the factions treat each other.
+//   The bool is false if the matrix does not have a relationship between them.
+func (repository *Repository) GetRelationship(factionID, otherFactionID string) (string, bool) {
+	relationship, relationshipFound := repository.relationshipByFactionIDPairs[factionID][otherFactionID]
+	return relationship, relationshipFound
+}
+
+// GetFactionName returns the name of the faction, or the factionID if it has no name.
+func (repository *Repository) GetFactionName(factionID string) string {
+	name, nameFound := repository.nameByFactionID[factionID]
+	if nameFound == false || name == "" {
+		return factionID
+	}
+	return name
+}
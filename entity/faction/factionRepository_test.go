@@ -0,0 +1,101 @@
+package faction_test
+
+import (
+	"github.com/chadius/terosgamerules/entity/faction"
+	. "gopkg.in/check.v1"
+	"testing"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type FactionRepositorySuite struct {
+	repo *faction.Repository
+}
+
+var _ = Suite(&FactionRepositorySuite{})
+
+func (suite *FactionRepositorySuite) SetUpTest(checker *C) {
+	suite.repo = faction.NewRepository()
+}
+
+func (suite *FactionRepositorySuite) TestLoadFactionsWithYAML(checker *C) {
+	success, err := suite.repo.AddYAMLSource([]byte(`
+factions:
+  - id: bandits
+    name: Red Bandits
+  - id: merchants
+    name: Merchant Guild
+relationships:
+  - faction_id: bandits
+    other_faction_id: player
+    relationship: foe
+  - faction_id: merchants
+    other_faction_id: player
+    relationship: neutral
+`))
+	checker.Assert(err, IsNil)
+	checker.Assert(success, Equals, true)
+	checker.Assert(suite.repo.GetFactionName("bandits"), Equals, "Red Bandits")
+	checker.Assert(suite.repo.GetFactionName("player"), Equals, "player")
+
+	relationship, found := suite.repo.GetRelationship("merchants", "player")
+	checker.Assert(found, Equals, true)
+	checker.Assert(relationship, Equals, faction.Neutral)
+}
+
+func (suite *FactionRepositorySuite) TestLoadFactionsWithJSON(checker *C) {
+	success, _ := suite.repo.AddJSONSource([]byte(`{"relationships": [{"faction_id": "bandits", "other_faction_id": "merchants", "relationship": "friend"}]}`))
+	checker.Assert(success, Equals, true)
+
+	relationship, _ := suite.repo.GetRelationship("bandits", "merchants")
+	checker.Assert(relationship, Equals, faction.Friend)
+}
+
+func (suite *FactionRepositorySuite) TestRelationshipsGoBothWays(checker *C) {
+	suite.repo.SetRelationship("bandits", "player", faction.Foe)
+
+	relationship, found := suite.repo.GetRelationship("player", "bandits")
+	checker.Assert(found, Equals, true)
+	checker.Assert(relationship, Equals, faction.Foe)
+}
+
+func (suite *FactionRepositorySuite) TestRelationshipsCanChange(checker *C) {
+	suite.repo.SetRelationship("bandits", "player", faction.Foe)
+	suite.repo.SetRelationship("player", "bandits", faction.Neutral)
+
+	relationship, _ := suite.repo.GetRelationship("bandits", "player")
+	checker.Assert(relationship, Equals, faction.Neutral)
+}
+
+func (suite *FactionRepositorySuite) TestUnknownRelationshipsAreNotFound(checker *C) {
+	_, found := suite.repo.GetRelationship("bandits", "player")
+	checker.Assert(found, Equals, false)
+}
+
+func (suite *FactionRepositorySuite) TestRejectsUnknownRelationships(checker *C) {
+	err := suite.repo.SetRelationship("bandits", "player", "rivals")
+	checker.Assert(err, ErrorMatches, `factions "bandits" and "player" have unknown relationship "rivals"`)
+
+	success, loadErr := suite.repo.AddYAMLSource([]byte(`
+relationships:
+  - faction_id: bandits
+    other_faction_id: player
+    relationship: rivals
+`))
+	checker.Assert(success, Equals, false)
+	checker.Assert(loadErr, NotNil)
+}
+
+func (suite *FactionRepositorySuite) TestClonesDoNotShareRelationships(checker *C) {
+	suite.repo.AddFactions([]*faction.FactionMarshal{{ID: "bandits", Name: "Red Bandits"}})
+	suite.repo.SetRelationship("bandits", "player", faction.Foe)
+
+	clone := suite.repo.Clone()
+	clone.SetRelationship("bandits", "player", faction.Neutral)
+
+	checker.Assert(clone.GetFactionName("bandits"), Equals, "Red Bandits")
+	cloneRelationship, _ := clone.GetRelationship("player", "bandits")
+	checker.Assert(cloneRelationship, Equals, faction.Neutral)
+	originalRelationship, _ := suite.repo.GetRelationship("player", "bandits")
+	checker.Assert(originalRelationship, Equals, faction.Foe)
+}
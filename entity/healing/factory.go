@@ -1,35 +1,57 @@
 package healing
 
-// NewHealingLogic returns a new healing logic object based on the keyword given. Or it returns a nohealing logic.
-func NewHealingLogic(keyword string) Interface {
-	healingLogicByKeyword := map[string]string{
-		"Full":                   "FullMindBonus",
-		"full":                   "FullMindBonus",
-		"*healing.FullMindBonus": "FullMindBonus",
-		"healing.FullMindBonus":  "FullMindBonus",
-
-		"Half":                   "HalfMindBonus",
-		"half":                   "HalfMindBonus",
-		"*healing.HalfMindBonus": "HalfMindBonus",
-		"healing.HalfMindBonus":  "HalfMindBonus",
-
-		"Zero":                   "ZeroMindBonus",
-		"zero":                   "ZeroMindBonus",
-		"*healing.ZeroMindBonus": "ZeroMindBonus",
-		"healing.ZeroMindBonus":  "ZeroMindBonus",
-	}
+import (
+	"github.com/chadius/terosgamerules/utility"
+)
 
-	if healingLogicByKeyword[keyword] == "FullMindBonus" {
-		return &FullMindBonus{}
-	}
+var registry = newDefaultRegistry()
 
-	if healingLogicByKeyword[keyword] == "HalfMindBonus" {
-		return &HalfMindBonus{}
-	}
+func newDefaultRegistry() *utility.LogicRegistry {
+	registry := utility.NewLogicRegistry("healing logic")
+	registry.RegisterKeywords(
+		[]string{"Full", "full", "*healing.FullMindBonus", "healing.FullMindBonus"},
+		func() interface{} { return &FullMindBonus{} },
+	)
+	registry.RegisterKeywords(
+		[]string{"Half", "half", "*healing.HalfMindBonus", "healing.HalfMindBonus"},
+		func() interface{} { return &HalfMindBonus{} },
+	)
+	registry.RegisterKeywords(
+		[]string{"Zero", "zero", "*healing.ZeroMindBonus", "healing.ZeroMindBonus"},
+		func() interface{} { return &ZeroMindBonus{} },
+	)
+	registry.RegisterKeywords(
+		[]string{"NoHealing", "nohealing", "No healing", "No Healing", "*healing.NoHealing", "healing.NoHealing"},
+		func() interface{} { return &NoHealing{} },
+	)
+	return registry
+}
 
-	if healingLogicByKeyword[keyword] == "ZeroMindBonus" {
-		return &ZeroMindBonus{}
-	}
+// Factory creates a new healing logic object.
+type Factory func() Interface
+
+// Register lets NewHealingLogic create the logic made by factory when it sees the keyword.
+//   Raises an error if the keyword is empty or already registered.
+func Register(keyword string, factory Factory) error {
+	return registry.Register(keyword, func() interface{} { return factory() })
+}
+
+// Unregister stops NewHealingLogic from understanding the keyword.
+func Unregister(keyword string) {
+	registry.Unregister(keyword)
+}
 
-	return &NoHealing{}
+// Keywords returns every keyword NewHealingLogic understands, in alphabetical order.
+func Keywords() []string {
+	return registry.Keywords()
+}
+
+// NewHealingLogic returns a new healing logic object based on the keyword given.
+//   Raises an error if the keyword was never registered.
+func NewHealingLogic(keyword string) (Interface, error) {
+	logic, err := registry.NewLogic(keyword)
+	if err != nil {
+		return nil, err
+	}
+	return logic.(Interface), nil
 }
@@ -11,68 +11,85 @@ type FactorySuite struct{}
 var _ = Suite(&FactorySuite{})
 
 func (suite *FactorySuite) TestFactoryReturnsFullHeal(checker *C) {
-	healingLogic := healing.NewHealingLogic("Full")
+	healingLogic, _ := healing.NewHealingLogic("Full")
 	checker.Assert(reflect.TypeOf(healingLogic).String(), Equals, "*healing.FullMindBonus")
 
-	healingLogic = healing.NewHealingLogic("full")
+	healingLogic, _ = healing.NewHealingLogic("full")
 	checker.Assert(reflect.TypeOf(healingLogic).String(), Equals, "*healing.FullMindBonus")
 
-	healingLogic = healing.NewHealingLogic("*healing.FullMindBonus")
+	healingLogic, _ = healing.NewHealingLogic("*healing.FullMindBonus")
 	checker.Assert(reflect.TypeOf(healingLogic).String(), Equals, "*healing.FullMindBonus")
 
-	healingLogic = healing.NewHealingLogic("healing.FullMindBonus")
+	healingLogic, _ = healing.NewHealingLogic("healing.FullMindBonus")
 	checker.Assert(reflect.TypeOf(healingLogic).String(), Equals, "*healing.FullMindBonus")
 }
 
 func (suite *FactorySuite) TestFactoryReturnsHalfHeal(checker *C) {
-	healingLogic := healing.NewHealingLogic("Half")
+	healingLogic, _ := healing.NewHealingLogic("Half")
 	checker.Assert(reflect.TypeOf(healingLogic).String(), Equals, "*healing.HalfMindBonus")
 
-	healingLogic = healing.NewHealingLogic("half")
+	healingLogic, _ = healing.NewHealingLogic("half")
 	checker.Assert(reflect.TypeOf(healingLogic).String(), Equals, "*healing.HalfMindBonus")
 
-	healingLogic = healing.NewHealingLogic("*healing.HalfMindBonus")
+	healingLogic, _ = healing.NewHealingLogic("*healing.HalfMindBonus")
 	checker.Assert(reflect.TypeOf(healingLogic).String(), Equals, "*healing.HalfMindBonus")
 
-	healingLogic = healing.NewHealingLogic("healing.HalfMindBonus")
+	healingLogic, _ = healing.NewHealingLogic("healing.HalfMindBonus")
 	checker.Assert(reflect.TypeOf(healingLogic).String(), Equals, "*healing.HalfMindBonus")
 }
 
 func (suite *FactorySuite) TestFactoryReturnsZeroHeal(checker *C) {
-	healingLogic := healing.NewHealingLogic("Zero")
+	healingLogic, _ := healing.NewHealingLogic("Zero")
 	checker.Assert(reflect.TypeOf(healingLogic).String(), Equals, "*healing.ZeroMindBonus")
 
-	healingLogic = healing.NewHealingLogic("zero")
+	healingLogic, _ = healing.NewHealingLogic("zero")
 	checker.Assert(reflect.TypeOf(healingLogic).String(), Equals, "*healing.ZeroMindBonus")
 
-	healingLogic = healing.NewHealingLogic("*healing.ZeroMindBonus")
+	healingLogic, _ = healing.NewHealingLogic("*healing.ZeroMindBonus")
 	checker.Assert(reflect.TypeOf(healingLogic).String(), Equals, "*healing.ZeroMindBonus")
 
-	healingLogic = healing.NewHealingLogic("healing.ZeroMindBonus")
+	healingLogic, _ = healing.NewHealingLogic("healing.ZeroMindBonus")
 	checker.Assert(reflect.TypeOf(healingLogic).String(), Equals, "*healing.ZeroMindBonus")
 }
 
 func (suite *FactorySuite) TestFactoryReturnsNoHealing(checker *C) {
-	healingLogic := healing.NewHealingLogic("NoHealing")
+	healingLogic, _ := healing.NewHealingLogic("NoHealing")
 	checker.Assert(reflect.TypeOf(healingLogic).String(), Equals, "*healing.NoHealing")
 
-	healingLogic = healing.NewHealingLogic("nohealing")
+	healingLogic, _ = healing.NewHealingLogic("nohealing")
 	checker.Assert(reflect.TypeOf(healingLogic).String(), Equals, "*healing.NoHealing")
 
-	healingLogic = healing.NewHealingLogic("No healing")
+	healingLogic, _ = healing.NewHealingLogic("No healing")
 	checker.Assert(reflect.TypeOf(healingLogic).String(), Equals, "*healing.NoHealing")
 
-	healingLogic = healing.NewHealingLogic("No Healing")
+	healingLogic, _ = healing.NewHealingLogic("No Healing")
 	checker.Assert(reflect.TypeOf(healingLogic).String(), Equals, "*healing.NoHealing")
 
-	healingLogic = healing.NewHealingLogic("*healing.NoHealing")
+	healingLogic, _ = healing.NewHealingLogic("*healing.NoHealing")
 	checker.Assert(reflect.TypeOf(healingLogic).String(), Equals, "*healing.NoHealing")
 
-	healingLogic = healing.NewHealingLogic("healing.NoHealing")
+	healingLogic, _ = healing.NewHealingLogic("healing.NoHealing")
 	checker.Assert(reflect.TypeOf(healingLogic).String(), Equals, "*healing.NoHealing")
 }
 
-func (suite *FactorySuite) TestWhenUnknownKeyword_ThenFactoryReturnsNoHealing(checker *C) {
-	healingLogic := healing.NewHealingLogic("kwyjibo")
-	checker.Assert(reflect.TypeOf(healingLogic).String(), Equals, "*healing.NoHealing")
+func (suite *FactorySuite) TestWhenUnknownKeyword_ThenFactoryRaisesAnError(checker *C) {
+	healingLogic, err := healing.NewHealingLogic("kwyjibo")
+	checker.Assert(err, ErrorMatches, `unknown healing logic keyword "kwyjibo"`)
+	checker.Assert(healingLogic, IsNil)
+}
+
+func (suite *FactorySuite) TestCanRegisterCustomLogic(checker *C) {
+	err := healing.Register("regenerate", func() healing.Interface { return &healing.FullMindBonus{} })
+	checker.Assert(err, IsNil)
+	defer healing.Unregister("regenerate")
+
+	healingLogic, err := healing.NewHealingLogic("regenerate")
+	checker.Assert(err, IsNil)
+	checker.Assert(reflect.TypeOf(healingLogic).String(), Equals, "*healing.FullMindBonus")
+	checker.Assert(healing.Keywords(), Not(HasLen), 0)
+}
+
+func (suite *FactorySuite) TestCannotReplaceExistingLogic(checker *C) {
+	err := healing.Register("half", func() healing.Interface { return &healing.FullMindBonus{} })
+	checker.Assert(err, ErrorMatches, `healing logic keyword ".*" is already registered`)
 }
@@ -0,0 +1,133 @@
+package item
+
+import "github.com/chadius/terosgamerules/entity/powerreference"
+
+// Slots a squaddie can equip items in. Each slot holds one item at a time.
+const (
+	// Weapon items usually grant attack powers while equipped.
+	Weapon = "weapon"
+	// Armor items usually improve the squaddie's defenses.
+	Armor = "armor"
+	// Accessory items can improve any stat.
+	Accessory = "accessory"
+)
+
+// IsValidSlot returns true if squaddies can equip items in the given slot.
+func IsValidSlot(slot string) bool {
+	return slot == Weapon || slot == Armor || slot == Accessory
+}
+
+// StatModifiers are added to the squaddie's stats while the item is equipped.
+type StatModifiers struct {
+	Aim      int
+	Strength int
+	Mind     int
+	Dodge    int
+	Deflect  int
+	Armor    int
+}
+
+// Add returns the sum of both modifiers.
+func (modifiers StatModifiers) Add(other StatModifiers) StatModifiers {
+	return StatModifiers{
+		Aim:      modifiers.Aim + other.Aim,
+		Strength: modifiers.Strength + other.Strength,
+		Mind:     modifiers.Mind + other.Mind,
+		Dodge:    modifiers.Dodge + other.Dodge,
+		Deflect:  modifiers.Deflect + other.Deflect,
+		Armor:    modifiers.Armor + other.Armor,
+	}
+}
+
+// Item is a piece of equipment squaddies can carry and equip.
+type Item struct {
+	id            string
+	name          string
+	slot          string
+	modifiers     StatModifiers
+	grantedPowers []*powerreference.Reference
+	usePower      *powerreference.Reference
+}
+
+// ItemMarshal is a flattened representation of an Item, used to read items from YAML and JSON.
+type ItemMarshal struct {
+	ID   string `json:"id" yaml:"id"`
+	Name string `json:"name" yaml:"name"`
+	Slot string `json:"slot" yaml:"slot"`
+
+	Aim      int `json:"aim" yaml:"aim"`
+	Strength int `json:"strength" yaml:"strength"`
+	Mind     int `json:"mind" yaml:"mind"`
+	Dodge    int `json:"dodge" yaml:"dodge"`
+	Deflect  int `json:"deflect" yaml:"deflect"`
+	Armor    int `json:"armor" yaml:"armor"`
+
+	GrantedPowers []*powerreference.Reference `json:"powers" yaml:"powers"`
+
+	UsePower *powerreference.Reference `json:"use_power" yaml:"use_power"`
+}
+
+// NewItem returns a new Item object.
+func NewItem(itemID, itemName, slot string, modifiers StatModifiers, grantedPowers []*powerreference.Reference) *Item {
+	newItem := &Item{
+		id:            itemID,
+		name:          itemName,
+		slot:          slot,
+		modifiers:     modifiers,
+		grantedPowers: []*powerreference.Reference{},
+	}
+	for _, reference := range grantedPowers {
+		newItem.grantedPowers = append(newItem.grantedPowers, &powerreference.Reference{Name: reference.Name, PowerID: reference.PowerID})
+	}
+	return newItem
+}
+
+// NewConsumableItem returns a new Item that is used up when the squaddie uses its power.
+//   Consumable items are not equipped, so they have no slot.
+func NewConsumableItem(itemID, itemName string, usePower *powerreference.Reference) *Item {
+	newItem := NewItem(itemID, itemName, "", StatModifiers{}, nil)
+	newItem.usePower = &powerreference.Reference{Name: usePower.Name, PowerID: usePower.PowerID}
+	return newItem
+}
+
+// ID returns the item ID.
+func (i *Item) ID() string {
+	return i.id
+}
+
+// Name returns the item Name.
+func (i *Item) Name() string {
+	return i.name
+}
+
+// Slot returns the slot the item is equipped in.
+func (i *Item) Slot() string {
+	return i.slot
+}
+
+// Modifiers returns the stat changes the item gives while equipped.
+func (i *Item) Modifiers() StatModifiers {
+	return i.modifiers
+}
+
+// GetCopyOfGrantedPowerReferences returns the powers the squaddie can use while the item is equipped.
+func (i *Item) GetCopyOfGrantedPowerReferences() []*powerreference.Reference {
+	references := []*powerreference.Reference{}
+	for _, reference := range i.grantedPowers {
+		references = append(references, &powerreference.Reference{Name: reference.Name, PowerID: reference.PowerID})
+	}
+	return references
+}
+
+// IsConsumable returns true if squaddies use up the item to use its power.
+func (i *Item) IsConsumable() bool {
+	return i.usePower != nil
+}
+
+// UsePowerID returns the power used when the item is consumed, or an empty string if it cannot be consumed.
+func (i *Item) UsePowerID() string {
+	if i.IsConsumable() == false {
+		return ""
+	}
+	return i.usePower.PowerID
+}
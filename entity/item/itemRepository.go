@@ -0,0 +1,111 @@
+package item
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/chadius/terosgamerules/entity/powerreference"
+	"github.com/chadius/terosgamerules/utility"
+	"gopkg.in/yaml.v2"
+	"sort"
+)
+
+// Repository will interact with external devices to manage Items.
+type Repository struct {
+	itemsByID map[string]*Item
+}
+
+// NewRepository generates a pointer to a new Repository.
+func NewRepository() *Repository {
+	repository := Repository{
+		map[string]*Item{},
+	}
+	return &repository
+}
+
+// AddJSONSource consumes a given bytestream and tries to analyze it.
+func (repository *Repository) AddJSONSource(data []byte) (bool, error) {
+	return repository.addSource(data, json.Unmarshal)
+}
+
+// AddYAMLSource consumes a given bytestream and tries to analyze it.
+func (repository *Repository) AddYAMLSource(data []byte) (bool, error) {
+	return repository.addSource(data, yaml.Unmarshal)
+}
+
+// AddListOfItems adds multiple items directly.
+//   Raises an error if an item uses an unknown slot. Consumable items do not need a slot.
+func (repository *Repository) AddListOfItems(items []*Item) (bool, error) {
+	for _, itemToAdd := range items {
+		consumableWithoutSlot := itemToAdd.IsConsumable() && itemToAdd.Slot() == ""
+		if IsValidSlot(itemToAdd.Slot()) == false && consumableWithoutSlot == false {
+			newError := fmt.Errorf(`item "%s" has unknown slot "%s"`, itemToAdd.ID(), itemToAdd.Slot())
+			utility.Log(newError.Error(), 0, utility.Error)
+			return false, newError
+		}
+		repository.itemsByID[itemToAdd.ID()] = itemToAdd
+	}
+
+	return true, nil
+}
+
+// addSource consumes a given bytestream of the given sourceType and tries to analyze it.
+func (repository *Repository) addSource(data []byte, unmarshal utility.UnmarshalFunc) (bool, error) {
+	var unmarshalError error
+	var items []ItemMarshal
+	unmarshalError = unmarshal(data, &items)
+
+	if unmarshalError != nil {
+		return false, unmarshalError
+	}
+
+	itemsToAdd := []*Item{}
+	for _, itemToAdd := range items {
+		newItem := NewItem(
+			itemToAdd.ID,
+			itemToAdd.Name,
+			itemToAdd.Slot,
+			StatModifiers{
+				Aim:      itemToAdd.Aim,
+				Strength: itemToAdd.Strength,
+				Mind:     itemToAdd.Mind,
+				Dodge:    itemToAdd.Dodge,
+				Deflect:  itemToAdd.Deflect,
+				Armor:    itemToAdd.Armor,
+			},
+			itemToAdd.GrantedPowers,
+		)
+		if itemToAdd.UsePower != nil {
+			newItem.usePower = &powerreference.Reference{Name: itemToAdd.UsePower.Name, PowerID: itemToAdd.UsePower.PowerID}
+		}
+		itemsToAdd = append(itemsToAdd, newItem)
+	}
+
+	return repository.AddListOfItems(itemsToAdd)
+}
+
+// GetNumberOfItems returns the number of Items ready to retrieve.
+func (repository *Repository) GetNumberOfItems() int {
+	return len(repository.itemsByID)
+}
+
+// GetAllItemIDs returns the ID of every stored Item, sorted.
+func (repository *Repository) GetAllItemIDs() []string {
+	itemIDs := []string{}
+	for itemID := range repository.itemsByID {
+		itemIDs = append(itemIDs, itemID)
+	}
+	sort.Strings(itemIDs)
+	return itemIDs
+}
+
+// GetItemByID returns an Item that matches the id.
+func (repository *Repository) GetItemByID(itemID string) (*Item, error) {
+	item, itemFound := repository.itemsByID[itemID]
+	if itemFound == false {
+		newError := fmt.Errorf(`item repository: No item found with id: "%s"`, itemID)
+		utility.Log(newError.Error(), 0, utility.Error)
+		return nil, newError
+	}
+
+	return item, nil
+}
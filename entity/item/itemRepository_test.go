@@ -0,0 +1,135 @@
+package item_test
+
+import (
+	"github.com/chadius/terosgamerules/entity/item"
+	"github.com/chadius/terosgamerules/entity/powerreference"
+	. "gopkg.in/check.v1"
+	"testing"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type ItemRepositorySuite struct {
+	repo *item.Repository
+}
+
+var _ = Suite(&ItemRepositorySuite{})
+
+func (suite *ItemRepositorySuite) SetUpTest(checker *C) {
+	suite.repo = item.NewRepository()
+}
+
+func (suite *ItemRepositorySuite) TestLoadItemsWithYAML(checker *C) {
+	success, err := suite.repo.AddYAMLSource([]byte(`
+- id: itemSpear
+  name: Spear
+  slot: weapon
+  aim: 1
+  powers:
+    - name: Spear Thrust
+      id: powerSpear
+- id: itemLeather
+  name: Leather Armor
+  slot: armor
+  armor: 2
+  dodge: -1
+`))
+	checker.Assert(err, IsNil)
+	checker.Assert(success, Equals, true)
+	checker.Assert(suite.repo.GetNumberOfItems(), Equals, 2)
+	checker.Assert(suite.repo.GetAllItemIDs(), DeepEquals, []string{"itemLeather", "itemSpear"})
+
+	spear, _ := suite.repo.GetItemByID("itemSpear")
+	checker.Assert(spear.Name(), Equals, "Spear")
+	checker.Assert(spear.Slot(), Equals, item.Weapon)
+	checker.Assert(spear.Modifiers().Aim, Equals, 1)
+	checker.Assert(spear.GetCopyOfGrantedPowerReferences(), DeepEquals, []*powerreference.Reference{{Name: "Spear Thrust", PowerID: "powerSpear"}})
+
+	leather, _ := suite.repo.GetItemByID("itemLeather")
+	checker.Assert(leather.Modifiers(), Equals, item.StatModifiers{Armor: 2, Dodge: -1})
+	checker.Assert(leather.GetCopyOfGrantedPowerReferences(), HasLen, 0)
+}
+
+func (suite *ItemRepositorySuite) TestLoadItemsWithJSON(checker *C) {
+	success, _ := suite.repo.AddJSONSource([]byte(`[{"id": "itemRing", "name": "Mind Ring", "slot": "accessory", "mind": 2}]`))
+	checker.Assert(success, Equals, true)
+
+	ring, _ := suite.repo.GetItemByID("itemRing")
+	checker.Assert(ring.Slot(), Equals, item.Accessory)
+	checker.Assert(ring.Modifiers().Mind, Equals, 2)
+}
+
+func (suite *ItemRepositorySuite) TestRejectsUnknownSlot(checker *C) {
+	success, err := suite.repo.AddYAMLSource([]byte(`
+- id: itemHat
+  name: Hat
+  slot: head
+`))
+	checker.Assert(success, Equals, false)
+	checker.Assert(err, ErrorMatches, `item "itemHat" has unknown slot "head"`)
+}
+
+func (suite *ItemRepositorySuite) TestRaisesErrorWhenItemIsMissing(checker *C) {
+	missingItem, err := suite.repo.GetItemByID("itemMissing")
+	checker.Assert(missingItem, IsNil)
+	checker.Assert(err, ErrorMatches, `item repository: No item found with id: "itemMissing"`)
+}
+
+func (suite *ItemRepositorySuite) TestStatModifiersAdd(checker *C) {
+	total := item.StatModifiers{Aim: 1, Armor: 2}.Add(item.StatModifiers{Aim: 1, Mind: 3})
+	checker.Assert(total, Equals, item.StatModifiers{Aim: 2, Armor: 2, Mind: 3})
+}
+
+func (suite *ItemRepositorySuite) TestLoadConsumableItems(checker *C) {
+	success, err := suite.repo.AddYAMLSource([]byte(`
+- id: itemPotion
+  name: Potion
+  use_power:
+    name: Drink Potion
+    id: powerPotion
+`))
+	checker.Assert(err, IsNil)
+	checker.Assert(success, Equals, true)
+
+	potion, _ := suite.repo.GetItemByID("itemPotion")
+	checker.Assert(potion.IsConsumable(), Equals, true)
+	checker.Assert(potion.UsePowerID(), Equals, "powerPotion")
+	checker.Assert(potion.Slot(), Equals, "")
+}
+
+func (suite *ItemRepositorySuite) TestEquipmentIsNotConsumable(checker *C) {
+	leather := item.NewItem("itemLeather", "Leather Armor", item.Armor, item.StatModifiers{Armor: 1}, nil)
+	checker.Assert(leather.IsConsumable(), Equals, false)
+	checker.Assert(leather.UsePowerID(), Equals, "")
+}
+
+type TeamInventorySuite struct {
+	inventory *item.TeamInventory
+}
+
+var _ = Suite(&TeamInventorySuite{})
+
+func (suite *TeamInventorySuite) SetUpTest(checker *C) {
+	suite.inventory = item.NewTeamInventory()
+	suite.inventory.AddItem("player", "itemPotion", 2)
+}
+
+func (suite *TeamInventorySuite) TestTeamsShareItems(checker *C) {
+	checker.Assert(suite.inventory.CountItem("player", "itemPotion"), Equals, 2)
+	checker.Assert(suite.inventory.CountItem("enemy", "itemPotion"), Equals, 0)
+}
+
+func (suite *TeamInventorySuite) TestRemoveItemsUntilNoneAreLeft(checker *C) {
+	checker.Assert(suite.inventory.RemoveItem("player", "itemPotion"), Equals, true)
+	checker.Assert(suite.inventory.RemoveItem("player", "itemPotion"), Equals, true)
+	checker.Assert(suite.inventory.RemoveItem("player", "itemPotion"), Equals, false)
+	checker.Assert(suite.inventory.CountItem("player", "itemPotion"), Equals, 0)
+	checker.Assert(suite.inventory.RemoveItem("enemy", "itemPotion"), Equals, false)
+}
+
+func (suite *TeamInventorySuite) TestClonesDoNotShareItems(checker *C) {
+	clone := suite.inventory.Clone()
+	checker.Assert(clone.RemoveItem("player", "itemPotion"), Equals, true)
+	checker.Assert(clone.CountItem("player", "itemPotion"), Equals, 1)
+	checker.Assert(suite.inventory.CountItem("player", "itemPotion"), Equals, 2)
+}
@@ -0,0 +1,50 @@
+package item
+
+// TeamInventory tracks the items shared by every squaddie on a team.
+//   Teams are identified by their affiliation name.
+type TeamInventory struct {
+	itemCountByIDByTeam map[string]map[string]int
+}
+
+// NewTeamInventory returns an empty TeamInventory.
+func NewTeamInventory() *TeamInventory {
+	return &TeamInventory{
+		itemCountByIDByTeam: map[string]map[string]int{},
+	}
+}
+
+// Clone returns a copy of the inventory, so items can be used without changing the original.
+func (inventory *TeamInventory) Clone() *TeamInventory {
+	clone := NewTeamInventory()
+	for team, itemCountByID := range inventory.itemCountByIDByTeam {
+		for itemID, count := range itemCountByID {
+			clone.AddItem(team, itemID, count)
+		}
+	}
+	return clone
+}
+
+// AddItem gives the team more copies of the item.
+func (inventory *TeamInventory) AddItem(team, itemID string, count int) {
+	if count <= 0 {
+		return
+	}
+	if inventory.itemCountByIDByTeam[team] == nil {
+		inventory.itemCountByIDByTeam[team] = map[string]int{}
+	}
+	inventory.itemCountByIDByTeam[team][itemID] += count
+}
+
+// RemoveItem takes one copy of the item from the team. Returns false if the team has none left.
+func (inventory *TeamInventory) RemoveItem(team, itemID string) bool {
+	if inventory.CountItem(team, itemID) <= 0 {
+		return false
+	}
+	inventory.itemCountByIDByTeam[team][itemID]--
+	return true
+}
+
+// CountItem returns the number of copies of the item the team has.
+func (inventory *TeamInventory) CountItem(team, itemID string) int {
+	return inventory.itemCountByIDByTeam[team][itemID]
+}
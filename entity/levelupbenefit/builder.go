@@ -28,9 +28,12 @@ type Builder struct {
 	movementDistance     int
 	movementLogic        movement.Interface
 	movementCanHitAndRun bool
+	movementLogicError   error
 
 	powersGained []*powerreference.Reference
 	powersLost   []*powerreference.Reference
+
+	weight int
 }
 
 // NewLevelUpBenefitBuilder returns a new object used to build Term objects.
@@ -51,11 +54,13 @@ func NewLevelUpBenefitBuilder() *Builder {
 		mind:     0,
 
 		movementDistance:     0,
-		movementLogic:        movement.NewMovementLogic("foot"),
+		movementLogic:        &movement.Foot{},
 		movementCanHitAndRun: false,
 
 		powersGained: []*powerreference.Reference{},
 		powersLost:   []*powerreference.Reference{},
+
+		weight: 1,
 	}
 }
 
@@ -147,8 +152,19 @@ func (b *Builder) MovementDistance(distance int) *Builder {
 }
 
 // MovementLogic will upgrade the squaddie movement logic using the given keyword
+//   Empty keywords leave the movement logic unchanged.
+//   Unknown keywords leave it unchanged and make Build raise an error.
 func (b *Builder) MovementLogic(newMovementType string) *Builder {
-	b.movementLogic = movement.NewMovementLogic(newMovementType)
+	if newMovementType == "" {
+		return b
+	}
+
+	movementLogic, err := movement.NewMovementLogic(newMovementType)
+	if err != nil {
+		b.movementLogicError = err
+		return b
+	}
+	b.movementLogic = movementLogic
 	return b
 }
 
@@ -202,9 +218,19 @@ func (b *Builder) LosePower(powerID string) *Builder {
 	return b
 }
 
+// Weight sets the relative chance this benefit is chosen among small levels.
+func (b *Builder) Weight(weight int) *Builder {
+	b.weight = weight
+	return b
+}
+
 // Build creates a new LevelUpBenefit object.
 func (b *Builder) Build() (*LevelUpBenefit, error) {
-	return NewLevelUpBenefit(
+	if b.movementLogicError != nil {
+		return nil, b.movementLogicError
+	}
+
+	newLevelUpBenefit := NewLevelUpBenefit(
 		NewIdentification(
 			b.levelID,
 			b.classID,
@@ -231,7 +257,9 @@ func (b *Builder) Build() (*LevelUpBenefit, error) {
 			b.powersGained,
 			b.powersLost,
 		),
-	), nil
+	)
+	newLevelUpBenefit.weight = b.weight
+	return newLevelUpBenefit, nil
 }
 
 // NewLevelUpBenefitBuilderFromYAML creates a new Builder using YAML.
@@ -270,6 +298,8 @@ type BuilderMarshal struct {
 
 	PowersGained []*powerreference.Reference `json:"powers_gained" yaml:"powers_gained"`
 	PowersLost   []string                    `json:"powers_lost" yaml:"powers_lost"`
+
+	Weight int `json:"weight" yaml:"weight"`
 }
 
 // unmarshalAndApplyDataStream consumes a given bytestream of the given sourceType and tries to analyze it.
@@ -309,6 +339,10 @@ func (b *Builder) populateBuilderBasedOnMarshal(builderFields BuilderMarshal) *B
 		b.BigLevel()
 	}
 
+	if builderFields.Weight != 0 {
+		b.Weight(builderFields.Weight)
+	}
+
 	for _, reference := range builderFields.PowersGained {
 		b.GainPower(reference.PowerID, reference.Name)
 	}
@@ -127,6 +127,20 @@ func (l *LevelUpBuilderSuite) TestBuildWithAlternateMovementTypes(checker *C) {
 	checker.Assert(reflect.TypeOf(onTeleportLevelUp.MovementLogic()).String(), Equals, "*movement.Teleport")
 }
 
+func (l *LevelUpBuilderSuite) TestBuildWithWeight(checker *C) {
+	defaultLevelUp, _ := levelupbenefit.NewLevelUpBenefitBuilder().Build()
+	checker.Assert(defaultLevelUp.Weight(), Equals, 1)
+
+	heavyLevelUp, _ := levelupbenefit.NewLevelUpBenefitBuilder().Weight(5).Build()
+	checker.Assert(heavyLevelUp.Weight(), Equals, 5)
+}
+
+func (l *LevelUpBuilderSuite) TestWeightMustBePositive(checker *C) {
+	weightlessLevelUp, _ := levelupbenefit.NewLevelUpBenefitBuilder().WithID("weightless").Weight(0).Build()
+	err := weightlessLevelUp.CheckForErrors()
+	checker.Assert(err, ErrorMatches, `LevelUpBenefit "weightless" weight must be at least 1, found 0`)
+}
+
 type LevelUpBuilderDataSuite struct{}
 
 var _ = Suite(&LevelUpBuilderDataSuite{})
@@ -150,6 +164,7 @@ powers_gained:
 movement_distance: 19
 movement_type: teleport
 can_hit_and_run: true
+weight: 3
 `)
 	levelUp, _ := levelupbenefit.NewLevelUpBenefitBuilderFromYAML(yamlByteStream).Build()
 
@@ -174,6 +189,8 @@ can_hit_and_run: true
 	checker.Assert(levelUp.PowersGained(), HasLen, 1)
 	checker.Assert(levelUp.PowersGained()[0].Name, Equals, "Scimitar")
 	checker.Assert(levelUp.PowersGained()[0].PowerID, Equals, "deadbeef")
+
+	checker.Assert(levelUp.Weight(), Equals, 3)
 }
 
 func (l LevelUpBuilderDataSuite) TestUseJSONToCreateBuilder(checker *C) {
@@ -218,4 +235,6 @@ func (l LevelUpBuilderDataSuite) TestUseJSONToCreateBuilder(checker *C) {
 
 	checker.Assert(levelUp.PowersLost(), HasLen, 1)
 	checker.Assert(levelUp.PowersLost()[0].PowerID, Equals, "deadbeef")
+
+	checker.Assert(levelUp.Weight(), Equals, 1)
 }
@@ -16,6 +16,7 @@ type LevelUpBenefit struct {
 	offense        *Offense
 	powerChanges   *PowerChanges
 	movement       *squaddie.Movement
+	weight         int
 }
 
 // NewLevelUpBenefit returns a new LevelUpBenefit object.
@@ -32,6 +33,7 @@ func NewLevelUpBenefit(
 		offense:        offense,
 		movement:       movement,
 		powerChanges:   changes,
+		weight:         1,
 	}
 }
 
@@ -48,6 +50,12 @@ func (l *LevelUpBenefit) CheckForErrors() error {
 		utility.Log(newError.Error(), 0, utility.Error)
 		return newError
 	}
+
+	if l.Weight() < 1 {
+		newError := fmt.Errorf(`LevelUpBenefit "%s" weight must be at least 1, found %d`, l.ID(), l.Weight())
+		utility.Log(newError.Error(), 0, utility.Error)
+		return newError
+	}
 	return nil
 }
 
@@ -93,6 +101,11 @@ func (l LevelUpBenefit) ClassID() string {
 	return l.identification.ClassID()
 }
 
+// Weight is the relative chance this LevelUpBenefit is chosen among small levels.
+func (l LevelUpBenefit) Weight() int {
+	return l.weight
+}
+
 // LevelUpBenefitType is a getter.
 func (l LevelUpBenefit) LevelUpBenefitType() Size {
 	return l.identification.LevelUpBenefitSize()
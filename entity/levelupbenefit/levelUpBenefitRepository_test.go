@@ -228,3 +228,16 @@ func (suite *BuilderFormatSuite) TestCreateWithJSON(checker *C) {
 	checker.Assert(err, IsNil)
 	checker.Assert(levelRepo.GetNumberOfLevelUpBenefits(), Equals, 2)
 }
+
+func (suite *BuilderFormatSuite) TestRaiseErrorWithUnknownMovementLogic(checker *C) {
+	yamlByteStream := []byte(
+		`-
+  id: abcdefg0
+  class_id: class0
+  movement_type: kwyjibo
+`)
+	levelRepo := levelupbenefit.NewLevelUpBenefitRepository()
+	err := levelRepo.AddYAML(yamlByteStream)
+	checker.Assert(err, ErrorMatches, `unknown movement logic keyword "kwyjibo"`)
+	checker.Assert(levelRepo.GetNumberOfLevelUpBenefits(), Equals, 0)
+}
@@ -0,0 +1,20 @@
+package lingeringeffect
+
+// LingeringEffect changes a squaddie at the start of each of their turns until it wears off.
+type LingeringEffect struct {
+	Name             string
+	SourcePowerID    string
+	HitPointsPerTurn int
+	TurnsRemaining   int
+}
+
+// IsHarmful returns true if the effect hurts the squaddie.
+//   Cleansing powers remove harmful effects.
+func (effect *LingeringEffect) IsHarmful() bool {
+	return effect.HitPointsPerTurn < 0
+}
+
+// HasExpired returns true if the effect has no turns remaining.
+func (effect *LingeringEffect) HasExpired() bool {
+	return effect.TurnsRemaining <= 0
+}
@@ -1,36 +1,57 @@
 package movement
 
-// NewMovementLogic returns a new movement logic object based on the keyword given.
-//  Defaults to foot based movement.
-func NewMovementLogic(keyword string) Interface {
-	movementLogicByKeyword := map[string]string{
-		"light":           "light",
-		"Light":           "light",
-		"*movement.light": "light",
-		"*movement.Light": "light",
-
-		"fly":           "fly",
-		"Fly":           "fly",
-		"*movement.fly": "fly",
-		"*movement.Fly": "fly",
-
-		"teleport":           "teleport",
-		"Teleport":           "teleport",
-		"*movement.teleport": "teleport",
-		"*movement.Teleport": "teleport",
-	}
+import (
+	"github.com/chadius/terosgamerules/utility"
+)
 
-	if movementLogicByKeyword[keyword] == "light" {
-		return &Light{}
-	}
+var registry = newDefaultRegistry()
 
-	if movementLogicByKeyword[keyword] == "fly" {
-		return &Fly{}
-	}
+func newDefaultRegistry() *utility.LogicRegistry {
+	registry := utility.NewLogicRegistry("movement logic")
+	registry.RegisterKeywords(
+		[]string{"foot", "Foot", "*movement.foot", "*movement.Foot"},
+		func() interface{} { return &Foot{} },
+	)
+	registry.RegisterKeywords(
+		[]string{"light", "Light", "*movement.light", "*movement.Light"},
+		func() interface{} { return &Light{} },
+	)
+	registry.RegisterKeywords(
+		[]string{"fly", "Fly", "*movement.fly", "*movement.Fly"},
+		func() interface{} { return &Fly{} },
+	)
+	registry.RegisterKeywords(
+		[]string{"teleport", "Teleport", "*movement.teleport", "*movement.Teleport"},
+		func() interface{} { return &Teleport{} },
+	)
+	return registry
+}
 
-	if movementLogicByKeyword[keyword] == "teleport" {
-		return &Teleport{}
-	}
+// Factory creates a new movement logic object.
+type Factory func() Interface
+
+// Register lets NewMovementLogic create the logic made by factory when it sees the keyword.
+//   Raises an error if the keyword is empty or already registered.
+func Register(keyword string, factory Factory) error {
+	return registry.Register(keyword, func() interface{} { return factory() })
+}
+
+// Unregister stops NewMovementLogic from understanding the keyword.
+func Unregister(keyword string) {
+	registry.Unregister(keyword)
+}
 
-	return &Foot{}
+// Keywords returns every keyword NewMovementLogic understands, in alphabetical order.
+func Keywords() []string {
+	return registry.Keywords()
+}
+
+// NewMovementLogic returns a new movement logic object based on the keyword given.
+//   Raises an error if the keyword was never registered.
+func NewMovementLogic(keyword string) (Interface, error) {
+	logic, err := registry.NewLogic(keyword)
+	if err != nil {
+		return nil, err
+	}
+	return logic.(Interface), nil
 }
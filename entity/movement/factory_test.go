@@ -14,21 +14,38 @@ type FactorySuite struct{}
 var _ = Suite(&FactorySuite{})
 
 func (suite *FactorySuite) TestLightMovement(checker *C) {
-	powerSourceLogic := movement.NewMovementLogic("light")
+	powerSourceLogic, _ := movement.NewMovementLogic("light")
 	checker.Assert(reflect.TypeOf(powerSourceLogic).String(), Equals, "*movement.Light")
 }
 
 func (suite *FactorySuite) TestFlyMovement(checker *C) {
-	powerSourceLogic := movement.NewMovementLogic("fly")
+	powerSourceLogic, _ := movement.NewMovementLogic("fly")
 	checker.Assert(reflect.TypeOf(powerSourceLogic).String(), Equals, "*movement.Fly")
 }
 
 func (suite *FactorySuite) TestTeleportMovement(checker *C) {
-	powerSourceLogic := movement.NewMovementLogic("teleport")
+	powerSourceLogic, _ := movement.NewMovementLogic("teleport")
 	checker.Assert(reflect.TypeOf(powerSourceLogic).String(), Equals, "*movement.Teleport")
 }
 
-func (suite *FactorySuite) TestWhenUnknownKeyword_ThenFactoryReturnsFoot(checker *C) {
-	powerSourceLogic := movement.NewMovementLogic("kwyjibo")
-	checker.Assert(reflect.TypeOf(powerSourceLogic).String(), Equals, "*movement.Foot")
+func (suite *FactorySuite) TestWhenUnknownKeyword_ThenFactoryRaisesAnError(checker *C) {
+	powerSourceLogic, err := movement.NewMovementLogic("kwyjibo")
+	checker.Assert(err, ErrorMatches, `unknown movement logic keyword "kwyjibo"`)
+	checker.Assert(powerSourceLogic, IsNil)
+}
+
+func (suite *FactorySuite) TestCanRegisterCustomLogic(checker *C) {
+	err := movement.Register("burrow", func() movement.Interface { return &movement.Teleport{} })
+	checker.Assert(err, IsNil)
+	defer movement.Unregister("burrow")
+
+	powerSourceLogic, err := movement.NewMovementLogic("burrow")
+	checker.Assert(err, IsNil)
+	checker.Assert(reflect.TypeOf(powerSourceLogic).String(), Equals, "*movement.Teleport")
+	checker.Assert(movement.Keywords(), Not(HasLen), 0)
+}
+
+func (suite *FactorySuite) TestCannotReplaceExistingLogic(checker *C) {
+	err := movement.Register("fly", func() movement.Interface { return &movement.Teleport{} })
+	checker.Assert(err, ErrorMatches, `movement logic keyword ".*" is already registered`)
 }
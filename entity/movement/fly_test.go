@@ -10,25 +10,25 @@ type FlyMovementSuite struct{}
 var _ = Suite(&FlyMovementSuite{})
 
 func (suite *FlyMovementSuite) TestFlyIsGreaterThanFoot(checker *C) {
-	fly := movement.NewMovementLogic("fly")
-	foot := movement.NewMovementLogic("foot")
+	fly, _ := movement.NewMovementLogic("fly")
+	foot, _ := movement.NewMovementLogic("foot")
 	checker.Assert(fly.GreaterThan(foot), Equals, true)
 }
 
 func (suite *FlyMovementSuite) TestFlyIsGreaterThanLight(checker *C) {
-	fly := movement.NewMovementLogic("fly")
-	light := movement.NewMovementLogic("light")
+	fly, _ := movement.NewMovementLogic("fly")
+	light, _ := movement.NewMovementLogic("light")
 	checker.Assert(fly.GreaterThan(light), Equals, true)
 }
 
 func (suite *FlyMovementSuite) TestFlyIsNotGreaterThanFly(checker *C) {
-	fly := movement.NewMovementLogic("fly")
-	fly2 := movement.NewMovementLogic("fly")
+	fly, _ := movement.NewMovementLogic("fly")
+	fly2, _ := movement.NewMovementLogic("fly")
 	checker.Assert(fly.GreaterThan(fly2), Equals, false)
 }
 
 func (suite *FlyMovementSuite) TestFlyIsNotGreaterThanTeleport(checker *C) {
-	fly := movement.NewMovementLogic("fly")
-	teleport := movement.NewMovementLogic("teleport")
+	fly, _ := movement.NewMovementLogic("fly")
+	teleport, _ := movement.NewMovementLogic("teleport")
 	checker.Assert(fly.GreaterThan(teleport), Equals, false)
 }
@@ -10,25 +10,25 @@ type FootMovementSuite struct{}
 var _ = Suite(&FootMovementSuite{})
 
 func (suite *FootMovementSuite) TestFootIsNotGreaterThanFoot(checker *C) {
-	foot := movement.NewMovementLogic("foot")
-	foot2 := movement.NewMovementLogic("foot")
+	foot, _ := movement.NewMovementLogic("foot")
+	foot2, _ := movement.NewMovementLogic("foot")
 	checker.Assert(foot.GreaterThan(foot2), Equals, false)
 }
 
 func (suite *FootMovementSuite) TestFootIsNotGreaterThanLight(checker *C) {
-	foot := movement.NewMovementLogic("foot")
-	light := movement.NewMovementLogic("light")
+	foot, _ := movement.NewMovementLogic("foot")
+	light, _ := movement.NewMovementLogic("light")
 	checker.Assert(foot.GreaterThan(light), Equals, false)
 }
 
 func (suite *FootMovementSuite) TestFootIsNotGreaterThanFly(checker *C) {
-	foot := movement.NewMovementLogic("foot")
-	fly := movement.NewMovementLogic("fly")
+	foot, _ := movement.NewMovementLogic("foot")
+	fly, _ := movement.NewMovementLogic("fly")
 	checker.Assert(foot.GreaterThan(fly), Equals, false)
 }
 
 func (suite *FootMovementSuite) TestFootIsNotGreaterThanTeleport(checker *C) {
-	foot := movement.NewMovementLogic("foot")
-	teleport := movement.NewMovementLogic("teleport")
+	foot, _ := movement.NewMovementLogic("foot")
+	teleport, _ := movement.NewMovementLogic("teleport")
 	checker.Assert(foot.GreaterThan(teleport), Equals, false)
 }
@@ -10,23 +10,23 @@ type TeleportMovementSuite struct{}
 var _ = Suite(&TeleportMovementSuite{})
 
 func (suite *TeleportMovementSuite) TestTeleportIsGreaterThanFoot(checker *C) {
-	teleport := movement.NewMovementLogic("teleport")
-	foot := movement.NewMovementLogic("foot")
+	teleport, _ := movement.NewMovementLogic("teleport")
+	foot, _ := movement.NewMovementLogic("foot")
 	checker.Assert(teleport.GreaterThan(foot), Equals, true)
 }
 func (suite *TeleportMovementSuite) TestTeleportIsGreaterThanLight(checker *C) {
-	teleport := movement.NewMovementLogic("teleport")
-	light := movement.NewMovementLogic("light")
+	teleport, _ := movement.NewMovementLogic("teleport")
+	light, _ := movement.NewMovementLogic("light")
 	checker.Assert(teleport.GreaterThan(light), Equals, true)
 }
 func (suite *TeleportMovementSuite) TestTeleportIsGreaterThanFly(checker *C) {
-	teleport := movement.NewMovementLogic("teleport")
-	fly := movement.NewMovementLogic("fly")
+	teleport, _ := movement.NewMovementLogic("teleport")
+	fly, _ := movement.NewMovementLogic("fly")
 	checker.Assert(teleport.GreaterThan(fly), Equals, true)
 }
 
 func (suite *TeleportMovementSuite) TestTeleportIsNotGreaterThanTeleport(checker *C) {
-	teleport := movement.NewMovementLogic("teleport")
-	teleport2 := movement.NewMovementLogic("teleport")
+	teleport, _ := movement.NewMovementLogic("teleport")
+	teleport2, _ := movement.NewMovementLogic("teleport")
 	checker.Assert(teleport.GreaterThan(teleport2), Equals, false)
 }
@@ -0,0 +1,95 @@
+package objective
+
+import (
+	"fmt"
+	"github.com/chadius/terosgamerules/entity/battlegrid"
+	"github.com/chadius/terosgamerules/utility"
+)
+
+// Kinds of objectives a battle can have.
+const (
+	// RoutFoes wins the battle once every foe of the player squaddies has fallen.
+	RoutFoes = "rout_foes"
+	// DefeatBoss wins the battle once the squaddie has fallen.
+	DefeatBoss = "defeat_boss"
+	// SurviveTurns wins the battle once the player squaddies have survived the number of turns.
+	SurviveTurns = "survive_turns"
+	// ProtectSquaddie loses the battle if the squaddie falls.
+	ProtectSquaddie = "protect_squaddie"
+	// ReachTile wins the battle once the squaddie stands on the tile. The battle needs squaddie positions.
+	ReachTile = "reach_tile"
+)
+
+// Results a battle can end with.
+const (
+	// Victory means the player squaddies won the battle.
+	Victory = "victory"
+	// Defeat means the player squaddies lost the battle.
+	Defeat = "defeat"
+)
+
+// Objective describes one way a battle can end.
+//   SquaddieID names the boss, the squaddie to protect or the squaddie that must reach the tile.
+//   Turns counts the turns to survive.
+type Objective struct {
+	Kind       string                 `json:"kind" yaml:"kind"`
+	SquaddieID string                 `json:"squaddie_id" yaml:"squaddie_id"`
+	Turns      int                    `json:"turns" yaml:"turns"`
+	Tile       *battlegrid.Coordinate `json:"tile" yaml:"tile"`
+}
+
+// IsVictoryCondition returns true if completing the objective wins the battle,
+//   false if failing it loses the battle.
+func (o *Objective) IsVictoryCondition() bool {
+	return o.Kind != ProtectSquaddie
+}
+
+// CheckForErrors makes sure the objective is known and has the fields its kind needs.
+func (o *Objective) CheckForErrors() error {
+	switch o.Kind {
+	case RoutFoes:
+		return nil
+	case DefeatBoss, ProtectSquaddie:
+		return o.checkForSquaddie()
+	case ReachTile:
+		if o.Tile == nil {
+			newError := fmt.Errorf(`objective "%s" needs a tile`, o.Kind)
+			utility.Log(newError.Error(), 0, utility.Error)
+			return newError
+		}
+		return o.checkForSquaddie()
+	case SurviveTurns:
+		if o.Turns < 1 {
+			newError := fmt.Errorf(`objective "%s" needs at least 1 turn, found %d`, o.Kind, o.Turns)
+			utility.Log(newError.Error(), 0, utility.Error)
+			return newError
+		}
+		return nil
+	}
+
+	newError := fmt.Errorf(`unknown objective "%s"`, o.Kind)
+	utility.Log(newError.Error(), 0, utility.Error)
+	return newError
+}
+
+func (o *Objective) checkForSquaddie() error {
+	if o.SquaddieID == "" {
+		newError := fmt.Errorf(`objective "%s" needs a squaddie`, o.Kind)
+		utility.Log(newError.Error(), 0, utility.Error)
+		return newError
+	}
+	return nil
+}
+
+// Outcome describes how a battle ended.
+//   Objective is the objective that decided the battle.
+//   It is nil if the battle was lost because every player squaddie fell.
+type Outcome struct {
+	Result    string
+	Objective *Objective
+}
+
+// IsVictory returns true if the player squaddies won.
+func (o *Outcome) IsVictory() bool {
+	return o.Result == Victory
+}
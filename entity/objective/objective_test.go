@@ -0,0 +1,56 @@
+package objective_test
+
+import (
+	"github.com/chadius/terosgamerules/entity/battlegrid"
+	"github.com/chadius/terosgamerules/entity/objective"
+	. "gopkg.in/check.v1"
+	"testing"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type ObjectiveSuite struct{}
+
+var _ = Suite(&ObjectiveSuite{})
+
+func (suite *ObjectiveSuite) TestValidObjectives(checker *C) {
+	checker.Assert((&objective.Objective{Kind: objective.RoutFoes}).CheckForErrors(), IsNil)
+	checker.Assert((&objective.Objective{Kind: objective.DefeatBoss, SquaddieID: "squaddieBandit"}).CheckForErrors(), IsNil)
+	checker.Assert((&objective.Objective{Kind: objective.SurviveTurns, Turns: 3}).CheckForErrors(), IsNil)
+	checker.Assert((&objective.Objective{Kind: objective.ProtectSquaddie, SquaddieID: "squaddieLini"}).CheckForErrors(), IsNil)
+	checker.Assert((&objective.Objective{Kind: objective.ReachTile, SquaddieID: "squaddieTeros", Tile: &battlegrid.Coordinate{Row: 1, Column: 2}}).CheckForErrors(), IsNil)
+}
+
+func (suite *ObjectiveSuite) TestRaisesErrorForUnknownKind(checker *C) {
+	err := (&objective.Objective{Kind: "capture_flag"}).CheckForErrors()
+	checker.Assert(err, ErrorMatches, `unknown objective "capture_flag"`)
+}
+
+func (suite *ObjectiveSuite) TestRaisesErrorWithoutSquaddie(checker *C) {
+	err := (&objective.Objective{Kind: objective.DefeatBoss}).CheckForErrors()
+	checker.Assert(err, ErrorMatches, `objective "defeat_boss" needs a squaddie`)
+
+	err = (&objective.Objective{Kind: objective.ProtectSquaddie}).CheckForErrors()
+	checker.Assert(err, ErrorMatches, `objective "protect_squaddie" needs a squaddie`)
+
+	err = (&objective.Objective{Kind: objective.ReachTile, Tile: &battlegrid.Coordinate{Row: 1, Column: 2}}).CheckForErrors()
+	checker.Assert(err, ErrorMatches, `objective "reach_tile" needs a squaddie`)
+}
+
+func (suite *ObjectiveSuite) TestRaisesErrorWithoutTile(checker *C) {
+	err := (&objective.Objective{Kind: objective.ReachTile, SquaddieID: "squaddieTeros"}).CheckForErrors()
+	checker.Assert(err, ErrorMatches, `objective "reach_tile" needs a tile`)
+}
+
+func (suite *ObjectiveSuite) TestRaisesErrorWithoutTurns(checker *C) {
+	err := (&objective.Objective{Kind: objective.SurviveTurns}).CheckForErrors()
+	checker.Assert(err, ErrorMatches, `objective "survive_turns" needs at least 1 turn, found 0`)
+}
+
+func (suite *ObjectiveSuite) TestOnlyProtectingSquaddiesIsADefeatCondition(checker *C) {
+	checker.Assert((&objective.Objective{Kind: objective.RoutFoes}).IsVictoryCondition(), Equals, true)
+	checker.Assert((&objective.Objective{Kind: objective.DefeatBoss}).IsVictoryCondition(), Equals, true)
+	checker.Assert((&objective.Objective{Kind: objective.SurviveTurns}).IsVictoryCondition(), Equals, true)
+	checker.Assert((&objective.Objective{Kind: objective.ReachTile}).IsVictoryCondition(), Equals, true)
+	checker.Assert((&objective.Objective{Kind: objective.ProtectSquaddie}).IsVictoryCondition(), Equals, false)
+}
@@ -8,6 +8,17 @@ type AttackEffectOptions struct {
 	counterAttackPenaltyReduction int
 	canBeEquipped                 bool
 	canCounterAttack              bool
+	hitsPerUse                    int
+	lifeStealPercent              int
+	recoilDamage                  int
+	barrierSiphon                 int
+	charmTurns                    int
+	confuseTurns                  int
+	damagePerTurn                 int
+	damageOverTimeTurns           int
+	firstStrike                   bool
+	riposte                       bool
+	counterAttacksPerTurn         int
 	criticalEffectOptions         *CriticalEffectOptions
 }
 
@@ -22,6 +33,17 @@ func AttackEffectBuilder() *AttackEffectOptions {
 		counterAttackPenaltyReduction: 0,
 		canBeEquipped:                 false,
 		canCounterAttack:              false,
+		hitsPerUse:                    1,
+		lifeStealPercent:              0,
+		recoilDamage:                  0,
+		barrierSiphon:                 0,
+		charmTurns:                    0,
+		confuseTurns:                  0,
+		damagePerTurn:                 0,
+		damageOverTimeTurns:           0,
+		firstStrike:                   false,
+		riposte:                       false,
+		counterAttacksPerTurn:         0,
 		criticalEffectOptions:         nil,
 	}
 }
@@ -68,6 +90,70 @@ func (a *AttackEffectOptions) CanCounterAttack() *AttackEffectOptions {
 	return a
 }
 
+// HitsPerUse sets the number of strikes the attack makes each time it is used.
+//   Each strike rolls to hit and critically hit separately.
+func (a *AttackEffectOptions) HitsPerUse(hitsPerUse int) *AttackEffectOptions {
+	a.hitsPerUse = hitsPerUse
+	return a
+}
+
+// LifeStealPercent heals the attacker for a percentage of the damage the target took.
+func (a *AttackEffectOptions) LifeStealPercent(percent int) *AttackEffectOptions {
+	a.lifeStealPercent = percent
+	return a
+}
+
+// RecoilDamage hurts the attacker whenever the attack hits.
+func (a *AttackEffectOptions) RecoilDamage(damage int) *AttackEffectOptions {
+	a.recoilDamage = damage
+	return a
+}
+
+// BarrierSiphon moves barrier from the target to the attacker whenever the attack hits.
+func (a *AttackEffectOptions) BarrierSiphon(barrier int) *AttackEffectOptions {
+	a.barrierSiphon = barrier
+	return a
+}
+
+// Charms makes the target join the attacker's side for a number of turns whenever the attack hits.
+func (a *AttackEffectOptions) Charms(turns int) *AttackEffectOptions {
+	a.charmTurns = turns
+	return a
+}
+
+// Confuses makes the target treat everyone as a foe for a number of turns whenever the attack hits.
+func (a *AttackEffectOptions) Confuses(turns int) *AttackEffectOptions {
+	a.confuseTurns = turns
+	return a
+}
+
+// DamagesOverTime hurts the target at the start of each of their turns, for the given number of turns, whenever the attack hits.
+func (a *AttackEffectOptions) DamagesOverTime(damagePerTurn, turns int) *AttackEffectOptions {
+	a.damagePerTurn = damagePerTurn
+	a.damageOverTimeTurns = turns
+	return a
+}
+
+// FirstStrike makes counterattacks with this attack strike before the attack they answer.
+func (a *AttackEffectOptions) FirstStrike() *AttackEffectOptions {
+	a.canCounterAttack = true
+	a.firstStrike = true
+	return a
+}
+
+// Riposte makes the attacker counter the counterattack whenever this attack is countered.
+func (a *AttackEffectOptions) Riposte() *AttackEffectOptions {
+	a.canCounterAttack = true
+	a.riposte = true
+	return a
+}
+
+// CounterAttacksPerTurn limits how many times the squaddie can counterattack each turn with this attack.
+func (a *AttackEffectOptions) CounterAttacksPerTurn(limit int) *AttackEffectOptions {
+	a.counterAttacksPerTurn = limit
+	return a
+}
+
 // CriticalDealsDamage delegates to the CriticalEffectOptions.
 func (a *AttackEffectOptions) CriticalDealsDamage(damage int) *AttackEffectOptions {
 	if a.criticalEffectOptions == nil {
@@ -99,7 +185,18 @@ func (a *AttackEffectOptions) Build() *AttackingEffect {
 		a.canBeEquipped,
 		a.canCounterAttack,
 		a.counterAttackPenaltyReduction,
+		a.hitsPerUse,
 		criticalEffect,
 	)
+	newAttackingEffect.lifeStealPercent = a.lifeStealPercent
+	newAttackingEffect.recoilDamage = a.recoilDamage
+	newAttackingEffect.barrierSiphon = a.barrierSiphon
+	newAttackingEffect.charmTurns = a.charmTurns
+	newAttackingEffect.confuseTurns = a.confuseTurns
+	newAttackingEffect.damagePerTurn = a.damagePerTurn
+	newAttackingEffect.damageOverTimeTurns = a.damageOverTimeTurns
+	newAttackingEffect.firstStrike = a.firstStrike
+	newAttackingEffect.riposte = a.riposte
+	newAttackingEffect.counterAttacksPerTurn = a.counterAttacksPerTurn
 	return newAttackingEffect
 }
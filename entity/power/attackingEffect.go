@@ -16,11 +16,25 @@ type AttackingEffect struct {
 	canBeEquipped                 bool
 	canCounterAttack              bool
 	counterAttackPenaltyReduction int
+	hitsPerUse                    int
+	lifeStealPercent              int
+	recoilDamage                  int
+	barrierSiphon                 int
+	charmTurns                    int
+	confuseTurns                  int
+	damagePerTurn                 int
+	damageOverTimeTurns           int
+	firstStrike                   bool
+	riposte                       bool
+	counterAttacksPerTurn         int
 	criticalEffect                *CriticalEffect
 }
 
 // NewAttackingEffect returns a new AttackingEffect with the given options.
-func NewAttackingEffect(toHitBonus, damageBonus, extraBarrierBurn int, canBeEquipped, canCounterAttack bool, counterAttackPenaltyReduction int, criticalEffect *CriticalEffect) *AttackingEffect {
+func NewAttackingEffect(toHitBonus, damageBonus, extraBarrierBurn int, canBeEquipped, canCounterAttack bool, counterAttackPenaltyReduction, hitsPerUse int, criticalEffect *CriticalEffect) *AttackingEffect {
+	if hitsPerUse < 1 {
+		hitsPerUse = 1
+	}
 	return &AttackingEffect{
 		toHitBonus:                    toHitBonus,
 		damageBonus:                   damageBonus,
@@ -28,6 +42,7 @@ func NewAttackingEffect(toHitBonus, damageBonus, extraBarrierBurn int, canBeEqui
 		canBeEquipped:                 canBeEquipped,
 		canCounterAttack:              canCounterAttack,
 		counterAttackPenaltyReduction: counterAttackPenaltyReduction,
+		hitsPerUse:                    hitsPerUse,
 		criticalEffect:                criticalEffect,
 	}
 }
@@ -78,6 +93,62 @@ func (a *AttackingEffect) CounterAttackPenaltyReduction() int {
 	return a.counterAttackPenaltyReduction
 }
 
+// LifeStealPercent returns the percentage of damage dealt that heals the attacker.
+func (a *AttackingEffect) LifeStealPercent() int {
+	return a.lifeStealPercent
+}
+
+// RecoilDamage returns the damage the attacker takes when the attack hits.
+func (a *AttackingEffect) RecoilDamage() int {
+	return a.recoilDamage
+}
+
+// BarrierSiphon returns the most barrier the attacker can take from the target when the attack hits.
+func (a *AttackingEffect) BarrierSiphon() int {
+	return a.barrierSiphon
+}
+
+// CharmTurns returns the number of turns the target fights for the attacker's side when the attack hits.
+func (a *AttackingEffect) CharmTurns() int {
+	return a.charmTurns
+}
+
+// ConfuseTurns returns the number of turns the target treats everyone as a foe when the attack hits.
+func (a *AttackingEffect) ConfuseTurns() int {
+	return a.confuseTurns
+}
+
+// DamagePerTurn returns the damage the target takes at the start of each of their turns after the attack hits.
+func (a *AttackingEffect) DamagePerTurn() int {
+	return a.damagePerTurn
+}
+
+// DamageOverTimeTurns returns the number of turns the target keeps taking damage after the attack hits.
+func (a *AttackingEffect) DamageOverTimeTurns() int {
+	return a.damageOverTimeTurns
+}
+
+// FirstStrike returns true if counterattacks with this power strike before the attack they answer.
+func (a *AttackingEffect) FirstStrike() bool {
+	return a.firstStrike
+}
+
+// Riposte returns true if the attacker counters the counterattack when this power is countered.
+func (a *AttackingEffect) Riposte() bool {
+	return a.riposte
+}
+
+// CounterAttacksPerTurn returns the number of times the squaddie can counterattack each turn with this power.
+//   0 means there is no limit.
+func (a *AttackingEffect) CounterAttacksPerTurn() int {
+	return a.counterAttacksPerTurn
+}
+
+// HitsPerUse returns the number of strikes the attack makes each time it is used.
+func (a *AttackingEffect) HitsPerUse() int {
+	return a.hitsPerUse
+}
+
 // CriticalHitThreshold delegates.
 func (a *AttackingEffect) CriticalHitThreshold() int {
 	return a.criticalEffect.CriticalHitThreshold()
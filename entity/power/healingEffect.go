@@ -2,7 +2,11 @@ package power
 
 // HealingEffect is a power designed to restore hit points and cure ailments.
 type HealingEffect struct {
-	hitPointsHealed int
+	hitPointsHealed        int
+	barrierRestored        int
+	hitPointsHealedPerTurn int
+	healOverTimeTurns      int
+	cleanses               bool
 }
 
 // NewHealingEffect creates a new HealingEffect object.
@@ -16,3 +20,28 @@ func NewHealingEffect(hitPointsHealed int) *HealingEffect {
 func (h *HealingEffect) HitPointsHealed() int {
 	return h.hitPointsHealed
 }
+
+// BarrierRestored returns the amount of barrier the target regains.
+func (h *HealingEffect) BarrierRestored() int {
+	return h.barrierRestored
+}
+
+// HitPointsHealedPerTurn returns the hit points the target regains at the start of each of their turns.
+func (h *HealingEffect) HitPointsHealedPerTurn() int {
+	return h.hitPointsHealedPerTurn
+}
+
+// HealOverTimeTurns returns the number of turns the healing over time lasts.
+func (h *HealingEffect) HealOverTimeTurns() int {
+	return h.healOverTimeTurns
+}
+
+// HealsOverTime returns true if the effect keeps healing the target on later turns.
+func (h *HealingEffect) HealsOverTime() bool {
+	return h.hitPointsHealedPerTurn > 0 && h.healOverTimeTurns > 0
+}
+
+// Cleanses returns true if the effect removes the target's harmful lingering effects.
+func (h *HealingEffect) Cleanses() bool {
+	return h.cleanses
+}
@@ -2,7 +2,11 @@ package power
 
 // HealingEffectOptions is used to create healing effects.
 type HealingEffectOptions struct {
-	hitPointsHealed int
+	hitPointsHealed        int
+	barrierRestored        int
+	hitPointsHealedPerTurn int
+	healOverTimeTurns      int
+	cleanses               bool
 }
 
 // HealingEffectBuilder creates a HealingEffectOptions with default values.
@@ -10,7 +14,11 @@ type HealingEffectOptions struct {
 //   final object.
 func HealingEffectBuilder() *HealingEffectOptions {
 	return &HealingEffectOptions{
-		hitPointsHealed: 0,
+		hitPointsHealed:        0,
+		barrierRestored:        0,
+		hitPointsHealedPerTurn: 0,
+		healOverTimeTurns:      0,
+		cleanses:               false,
 	}
 }
 
@@ -20,10 +28,33 @@ func (h *HealingEffectOptions) HitPointsHealed(heal int) *HealingEffectOptions {
 	return h
 }
 
+// BarrierRestored sets the amount of barrier restored.
+func (h *HealingEffectOptions) BarrierRestored(barrier int) *HealingEffectOptions {
+	h.barrierRestored = barrier
+	return h
+}
+
+// HealsOverTime heals the target at the start of each of their turns, for the given number of turns.
+func (h *HealingEffectOptions) HealsOverTime(hitPointsPerTurn, turns int) *HealingEffectOptions {
+	h.hitPointsHealedPerTurn = hitPointsPerTurn
+	h.healOverTimeTurns = turns
+	return h
+}
+
+// Cleanses removes the target's harmful lingering effects.
+func (h *HealingEffectOptions) Cleanses() *HealingEffectOptions {
+	h.cleanses = true
+	return h
+}
+
 // Build uses the HealingEffectOptions to create a healingEffect.
 func (h *HealingEffectOptions) Build() *HealingEffect {
 	newHealingEffect := NewHealingEffect(
 		h.hitPointsHealed,
 	)
+	newHealingEffect.barrierRestored = h.barrierRestored
+	newHealingEffect.hitPointsHealedPerTurn = h.hitPointsHealedPerTurn
+	newHealingEffect.healOverTimeTurns = h.healOverTimeTurns
+	newHealingEffect.cleanses = h.cleanses
 	return newHealingEffect
 }
@@ -19,6 +19,15 @@ type Power struct {
 	healingEffect    *HealingEffect
 	healingLogic     healing.Interface
 	targetLogic      []target.Interface
+	manaCost         int
+	manaRestored     int
+	cooldown         int
+	chargesPerBattle int
+	reach            int
+	damageType       string
+	summonTemplateID string
+	summonTurns      int
+	summonLimit      int
 }
 
 // GetReference returns a new PowerReference.
@@ -45,6 +54,7 @@ func NewPower(name, id string, powerSourceLogic powersource.Interface, attackEff
 		healingEffect:    healingEffect,
 		healingLogic:     healingLogic,
 		targetLogic:      targetLogicObjects,
+		reach:            1,
 	}
 	return &newAttackingPower
 }
@@ -142,6 +152,99 @@ func (p *Power) CanCounterAttack() bool {
 	return p.attackEffect.CanCounterAttack()
 }
 
+// HitsPerUse delegates. Powers that cannot attack make no strikes.
+func (p *Power) HitsPerUse() int {
+	if !p.CanAttack() {
+		return 0
+	}
+	return p.attackEffect.HitsPerUse()
+}
+
+// LifeStealPercent delegates.
+func (p *Power) LifeStealPercent() int {
+	if !p.CanAttack() {
+		return 0
+	}
+	return p.attackEffect.LifeStealPercent()
+}
+
+// RecoilDamage delegates.
+func (p *Power) RecoilDamage() int {
+	if !p.CanAttack() {
+		return 0
+	}
+	return p.attackEffect.RecoilDamage()
+}
+
+// BarrierSiphon delegates.
+func (p *Power) BarrierSiphon() int {
+	if !p.CanAttack() {
+		return 0
+	}
+	return p.attackEffect.BarrierSiphon()
+}
+
+// CharmTurns delegates.
+func (p *Power) CharmTurns() int {
+	if !p.CanAttack() {
+		return 0
+	}
+	return p.attackEffect.CharmTurns()
+}
+
+// ConfuseTurns delegates.
+func (p *Power) ConfuseTurns() int {
+	if !p.CanAttack() {
+		return 0
+	}
+	return p.attackEffect.ConfuseTurns()
+}
+
+// DamagePerTurn delegates.
+func (p *Power) DamagePerTurn() int {
+	if !p.CanAttack() {
+		return 0
+	}
+	return p.attackEffect.DamagePerTurn()
+}
+
+// DamageOverTimeTurns delegates.
+func (p *Power) DamageOverTimeTurns() int {
+	if !p.CanAttack() {
+		return 0
+	}
+	return p.attackEffect.DamageOverTimeTurns()
+}
+
+// DamagesOverTime returns true if the target keeps taking damage after the attack hits.
+func (p *Power) DamagesOverTime() bool {
+	return p.DamagePerTurn() > 0 && p.DamageOverTimeTurns() > 0
+}
+
+// FirstStrike delegates.
+func (p *Power) FirstStrike() bool {
+	if !p.CanCounterAttack() {
+		return false
+	}
+	return p.attackEffect.FirstStrike()
+}
+
+// Riposte delegates.
+func (p *Power) Riposte() bool {
+	if !p.CanCounterAttack() {
+		return false
+	}
+	return p.attackEffect.Riposte()
+}
+
+// CounterAttacksPerTurn delegates.
+func (p *Power) CounterAttacksPerTurn() int {
+	if !p.CanCounterAttack() {
+		return 0
+	}
+	return p.attackEffect.CounterAttacksPerTurn()
+}
+
 // CanCritical returns true if this power critically hit.
 func (p *Power) CanCritical() bool {
 	return p.attackEffect.CanCriticallyHit()
@@ -196,6 +299,68 @@ func (p *Power) ExtraCriticalHitDamage() int {
 	return p.attackEffect.ExtraCriticalHitDamage()
 }
 
+// ManaCost returns the mana the user spends to use this power.
+func (p *Power) ManaCost() int {
+	return p.manaCost
+}
+
+// ManaRestored returns the mana the target regains when this power is used on them.
+func (p *Power) ManaRestored() int {
+	return p.manaRestored
+}
+
+// RestoresMana returns true if this power restores the target's mana.
+func (p *Power) RestoresMana() bool {
+	return p.manaRestored > 0
+}
+
+// DamageType returns the kind of damage this power deals, on top of its power source.
+//   Returns an empty string if the damage is untyped.
+func (p *Power) DamageType() string {
+	return p.damageType
+}
+
+// Cooldown returns the number of turns the user must wait before using this power again.
+//   0 means the power can be used every turn.
+func (p *Power) Cooldown() int {
+	return p.cooldown
+}
+
+// ChargesPerBattle returns the number of times a squaddie can use this power each battle.
+//   0 means the power has unlimited uses.
+func (p *Power) ChargesPerBattle() int {
+	return p.chargesPerBattle
+}
+
+// Range returns how many steps away the target can be.
+//   Only matters when both squaddies stand on the battle grid.
+func (p *Power) Range() int {
+	return p.reach
+}
+
+// SummonTemplateID returns the ID of the squaddie this power summons.
+//   Returns an empty string if the power does not summon.
+func (p *Power) SummonTemplateID() string {
+	return p.summonTemplateID
+}
+
+// SummonTurns returns the number of turns a summon lasts before it vanishes.
+//   0 means the summon lasts until it falls.
+func (p *Power) SummonTurns() int {
+	return p.summonTurns
+}
+
+// SummonLimit returns the number of summons the user can have in the battle before it cannot use this power.
+//   0 means there is no limit.
+func (p *Power) SummonLimit() int {
+	return p.summonLimit
+}
+
+// CanSummon returns true if this power summons a squaddie.
+func (p *Power) CanSummon() bool {
+	return p.summonTemplateID != ""
+}
+
 // CanHeal returns true if this power can be used to heal.
 func (p *Power) CanHeal() bool {
 	return reflect.TypeOf(p.HealingLogic()).String() != "*healing.NoHealing"
@@ -206,6 +371,31 @@ func (p *Power) HitPointsHealed() int {
 	return p.healingEffect.HitPointsHealed()
 }
 
+// BarrierRestored delegates.
+func (p *Power) BarrierRestored() int {
+	return p.healingEffect.BarrierRestored()
+}
+
+// HitPointsHealedPerTurn delegates.
+func (p *Power) HitPointsHealedPerTurn() int {
+	return p.healingEffect.HitPointsHealedPerTurn()
+}
+
+// HealOverTimeTurns delegates.
+func (p *Power) HealOverTimeTurns() int {
+	return p.healingEffect.HealOverTimeTurns()
+}
+
+// HealsOverTime delegates.
+func (p *Power) HealsOverTime() bool {
+	return p.healingEffect.HealsOverTime()
+}
+
+// Cleanses delegates.
+func (p *Power) Cleanses() bool {
+	return p.healingEffect.Cleanses()
+}
+
 // HealingLogic returns the module used for healing.
 func (p *Power) HealingLogic() healing.Interface {
 	return p.healingLogic
@@ -233,6 +423,34 @@ func (p *Power) HasSameStatsAs(other powerinterface.Interface) bool {
 		return false
 	}
 
+	if p.ManaCost() != other.ManaCost() {
+		return false
+	}
+	if p.ManaRestored() != other.ManaRestored() {
+		return false
+	}
+	if p.DamageType() != other.DamageType() {
+		return false
+	}
+	if p.Cooldown() != other.Cooldown() {
+		return false
+	}
+	if p.ChargesPerBattle() != other.ChargesPerBattle() {
+		return false
+	}
+	if p.Range() != other.Range() {
+		return false
+	}
+	if p.SummonTemplateID() != other.SummonTemplateID() {
+		return false
+	}
+	if p.SummonTurns() != other.SummonTurns() {
+		return false
+	}
+	if p.SummonLimit() != other.SummonLimit() {
+		return false
+	}
+
 	return true
 }
 
@@ -243,6 +461,18 @@ func (p *Power) hasSameHealingEffectAs(other powerinterface.Interface) bool {
 	if reflect.TypeOf(p.HealingLogic()).String() != reflect.TypeOf(other.HealingLogic()).String() {
 		return false
 	}
+	if p.BarrierRestored() != other.BarrierRestored() {
+		return false
+	}
+	if p.HitPointsHealedPerTurn() != other.HitPointsHealedPerTurn() {
+		return false
+	}
+	if p.HealOverTimeTurns() != other.HealOverTimeTurns() {
+		return false
+	}
+	if p.Cleanses() != other.Cleanses() {
+		return false
+	}
 	return true
 }
 
@@ -269,6 +499,39 @@ func (p *Power) hasSameAttackEffectAs(other powerinterface.Interface) bool {
 		if p.CounterAttackPenaltyReduction() != other.CounterAttackPenaltyReduction() {
 			return false
 		}
+		if p.HitsPerUse() != other.HitsPerUse() {
+			return false
+		}
+		if p.LifeStealPercent() != other.LifeStealPercent() {
+			return false
+		}
+		if p.RecoilDamage() != other.RecoilDamage() {
+			return false
+		}
+		if p.BarrierSiphon() != other.BarrierSiphon() {
+			return false
+		}
+		if p.CharmTurns() != other.CharmTurns() {
+			return false
+		}
+		if p.ConfuseTurns() != other.ConfuseTurns() {
+			return false
+		}
+		if p.DamagePerTurn() != other.DamagePerTurn() {
+			return false
+		}
+		if p.DamageOverTimeTurns() != other.DamageOverTimeTurns() {
+			return false
+		}
+		if p.FirstStrike() != other.FirstStrike() {
+			return false
+		}
+		if p.Riposte() != other.Riposte() {
+			return false
+		}
+		if p.CounterAttacksPerTurn() != other.CounterAttacksPerTurn() {
+			return false
+		}
 
 		if p.CanCritical() != other.CanCritical() {
 			return false
@@ -8,7 +8,6 @@ import (
 	"github.com/chadius/terosgamerules/entity/target"
 	"github.com/chadius/terosgamerules/utility"
 	"gopkg.in/yaml.v2"
-	"reflect"
 )
 
 // Builder covers options used to make Power objects.
@@ -22,6 +21,16 @@ type Builder struct {
 	healingEffectOptions *HealingEffectOptions
 	attackEffectOptions  *AttackEffectOptions
 	healingLogic         healing.Interface
+	manaCost             int
+	manaRestored         int
+	cooldown             int
+	chargesPerBattle     int
+	reach                int
+	damageType           string
+	summonTemplateID     string
+	summonTurns          int
+	summonLimit          int
+	keywordError         error
 }
 
 // NewPowerBuilder creates a Builder with default values.
@@ -34,10 +43,19 @@ func NewPowerBuilder() *Builder {
 		targetSelf:           false,
 		targetFriend:         false,
 		targetFoe:            false,
-		powerSourceLogic:     powersource.NewPowerSourceLogic("physical"),
+		powerSourceLogic:     &powersource.Physical{},
 		healingEffectOptions: HealingEffectBuilder(),
 		attackEffectOptions:  nil,
 		healingLogic:         &healing.NoHealing{},
+		manaCost:             0,
+		manaRestored:         0,
+		cooldown:             0,
+		chargesPerBattle:     0,
+		reach:                1,
+		damageType:           "",
+		summonTemplateID:     "",
+		summonTurns:          0,
+		summonLimit:          0,
 	}
 }
 
@@ -55,13 +73,13 @@ func (p *Builder) WithID(id string) *Builder {
 
 // IsPhysical sets the power type to physical.
 func (p *Builder) IsPhysical() *Builder {
-	p.powerSourceLogic = powersource.NewPowerSourceLogic("physical")
+	p.powerSourceLogic = &powersource.Physical{}
 	return p
 }
 
 // IsSpell sets the power type to spell.
 func (p *Builder) IsSpell() *Builder {
-	p.powerSourceLogic = powersource.NewPowerSourceLogic("spell")
+	p.powerSourceLogic = &powersource.Spell{}
 	return p
 }
 
@@ -89,6 +107,24 @@ func (p *Builder) HitPointsHealed(heal int) *Builder {
 	return p
 }
 
+// BarrierRestored delegates to the HealingEffectOptions.
+func (p *Builder) BarrierRestored(barrier int) *Builder {
+	p.healingEffectOptions.BarrierRestored(barrier)
+	return p
+}
+
+// HealsOverTime delegates to the HealingEffectOptions.
+func (p *Builder) HealsOverTime(hitPointsPerTurn, turns int) *Builder {
+	p.healingEffectOptions.HealsOverTime(hitPointsPerTurn, turns)
+	return p
+}
+
+// Cleanses delegates to the HealingEffectOptions.
+func (p *Builder) Cleanses() *Builder {
+	p.healingEffectOptions.Cleanses()
+	return p
+}
+
 // HealingAdjustmentBasedOnUserMindFull delegates to the HealingEffectOptions.
 func (p *Builder) HealingAdjustmentBasedOnUserMindFull() *Builder {
 	p.healingLogic = &healing.FullMindBonus{}
@@ -108,8 +144,82 @@ func (p *Builder) HealingAdjustmentBasedOnUserMindZero() *Builder {
 }
 
 // WithHealingLogic adds HealingLogic, using the given keyword
+//   Empty keywords leave the healing logic unchanged.
+//   Unknown keywords leave it unchanged and make UsingYAML and UsingJSON raise an error.
 func (p *Builder) WithHealingLogic(keyword string) *Builder {
-	p.healingLogic = healing.NewHealingLogic(keyword)
+	if keyword == "" {
+		return p
+	}
+
+	healingLogic, err := healing.NewHealingLogic(keyword)
+	if err != nil {
+		p.keywordError = err
+		return p
+	}
+	p.healingLogic = healingLogic
+	return p
+}
+
+// WithPowerSourceLogic sets the PowerSourceLogic, using the given keyword
+//   Empty keywords leave the power source logic unchanged.
+//   Unknown keywords leave it unchanged and make UsingYAML and UsingJSON raise an error.
+func (p *Builder) WithPowerSourceLogic(keyword string) *Builder {
+	if keyword == "" {
+		return p
+	}
+
+	powerSourceLogic, err := powersource.NewPowerSourceLogic(keyword)
+	if err != nil {
+		p.keywordError = err
+		return p
+	}
+	p.powerSourceLogic = powerSourceLogic
+	return p
+}
+
+// ManaCost sets the mana the user spends to use the power.
+func (p *Builder) ManaCost(manaCost int) *Builder {
+	p.manaCost = manaCost
+	return p
+}
+
+// RestoresMana sets the mana the target regains when the power is used on them.
+func (p *Builder) RestoresMana(manaRestored int) *Builder {
+	p.manaRestored = manaRestored
+	return p
+}
+
+// DamageType sets the kind of damage the power deals, such as fire or ice.
+func (p *Builder) DamageType(damageType string) *Builder {
+	p.damageType = damageType
+	return p
+}
+
+// Cooldown sets the number of turns the user must wait before using the power again.
+func (p *Builder) Cooldown(turns int) *Builder {
+	p.cooldown = turns
+	return p
+}
+
+// ChargesPerBattle sets the number of times a squaddie can use the power each battle.
+func (p *Builder) ChargesPerBattle(charges int) *Builder {
+	p.chargesPerBattle = charges
+	return p
+}
+
+// Range sets how many steps away the target can be. Powers reach adjacent squaddies by default.
+func (p *Builder) Range(reach int) *Builder {
+	p.reach = reach
+	return p
+}
+
+// Summons makes the power summon a copy of the template squaddie.
+//   The summon vanishes after the number of turns, or lasts until it falls if turns is 0.
+//   The user cannot summon while it already has limit summons in the battle, unless limit is 0.
+func (p *Builder) Summons(templateID string, turns, limit int) *Builder {
+	p.summonTemplateID = templateID
+	p.summonTurns = turns
+	p.summonLimit = limit
 	return p
 }
 
@@ -176,6 +286,96 @@ func (p *Builder) CanCounterAttack() *Builder {
 	return p
 }
 
+// HitsPerUse delegates to the AttackEffectOptions.
+func (p *Builder) HitsPerUse(hitsPerUse int) *Builder {
+	if p.attackEffectOptions == nil {
+		p.attackEffectOptions = AttackEffectBuilder()
+	}
+	p.attackEffectOptions.HitsPerUse(hitsPerUse)
+	return p
+}
+
+// LifeStealPercent delegates to the AttackEffectOptions.
+func (p *Builder) LifeStealPercent(percent int) *Builder {
+	if p.attackEffectOptions == nil {
+		p.attackEffectOptions = AttackEffectBuilder()
+	}
+	p.attackEffectOptions.LifeStealPercent(percent)
+	return p
+}
+
+// RecoilDamage delegates to the AttackEffectOptions.
+func (p *Builder) RecoilDamage(damage int) *Builder {
+	if p.attackEffectOptions == nil {
+		p.attackEffectOptions = AttackEffectBuilder()
+	}
+	p.attackEffectOptions.RecoilDamage(damage)
+	return p
+}
+
+// BarrierSiphon delegates to the AttackEffectOptions.
+func (p *Builder) BarrierSiphon(barrier int) *Builder {
+	if p.attackEffectOptions == nil {
+		p.attackEffectOptions = AttackEffectBuilder()
+	}
+	p.attackEffectOptions.BarrierSiphon(barrier)
+	return p
+}
+
+// Charms delegates to the AttackEffectOptions.
+func (p *Builder) Charms(turns int) *Builder {
+	if p.attackEffectOptions == nil {
+		p.attackEffectOptions = AttackEffectBuilder()
+	}
+	p.attackEffectOptions.Charms(turns)
+	return p
+}
+
+// Confuses delegates to the AttackEffectOptions.
+func (p *Builder) Confuses(turns int) *Builder {
+	if p.attackEffectOptions == nil {
+		p.attackEffectOptions = AttackEffectBuilder()
+	}
+	p.attackEffectOptions.Confuses(turns)
+	return p
+}
+
+// DamagesOverTime delegates to the AttackEffectOptions.
+func (p *Builder) DamagesOverTime(damagePerTurn, turns int) *Builder {
+	if p.attackEffectOptions == nil {
+		p.attackEffectOptions = AttackEffectBuilder()
+	}
+	p.attackEffectOptions.DamagesOverTime(damagePerTurn, turns)
+	return p
+}
+
+// FirstStrike delegates to the AttackEffectOptions.
+func (p *Builder) FirstStrike() *Builder {
+	if p.attackEffectOptions == nil {
+		p.attackEffectOptions = AttackEffectBuilder()
+	}
+	p.attackEffectOptions.FirstStrike()
+	return p
+}
+
+// Riposte delegates to the AttackEffectOptions.
+func (p *Builder) Riposte() *Builder {
+	if p.attackEffectOptions == nil {
+		p.attackEffectOptions = AttackEffectBuilder()
+	}
+	p.attackEffectOptions.Riposte()
+	return p
+}
+
+// CounterAttacksPerTurn delegates to the AttackEffectOptions.
+func (p *Builder) CounterAttacksPerTurn(limit int) *Builder {
+	if p.attackEffectOptions == nil {
+		p.attackEffectOptions = AttackEffectBuilder()
+	}
+	p.attackEffectOptions.CounterAttacksPerTurn(limit)
+	return p
+}
+
 // CriticalDealsDamage delegates to the AttackEffectOptions.
 func (p *Builder) CriticalDealsDamage(damage int) *Builder {
 	if p.attackEffectOptions == nil {
@@ -204,13 +404,13 @@ func (p *Builder) Build() *Power {
 
 	targetOptions := []target.Interface{}
 	if p.targetSelf {
-		targetOptions = append(targetOptions, target.NewTargetingLogic("self"))
+		targetOptions = append(targetOptions, &target.Self{})
 	}
 	if p.targetFriend {
-		targetOptions = append(targetOptions, target.NewTargetingLogic("friend"))
+		targetOptions = append(targetOptions, &target.Friend{})
 	}
 	if p.targetFoe {
-		targetOptions = append(targetOptions, target.NewTargetingLogic("foe"))
+		targetOptions = append(targetOptions, &target.Foe{})
 	}
 
 	newPower := NewPower(
@@ -222,6 +422,15 @@ func (p *Builder) Build() *Power {
 		p.healingLogic,
 		targetOptions,
 	)
+	newPower.manaCost = p.manaCost
+	newPower.manaRestored = p.manaRestored
+	newPower.cooldown = p.cooldown
+	newPower.damageType = p.damageType
+	newPower.chargesPerBattle = p.chargesPerBattle
+	newPower.reach = p.reach
+	newPower.summonTemplateID = p.summonTemplateID
+	newPower.summonTurns = p.summonTurns
+	newPower.summonLimit = p.summonLimit
 	return newPower
 }
 
@@ -271,58 +480,99 @@ type BuilderOptionMarshal struct {
 	CriticalHitThresholdBonus int  `json:"critical_hit_threshold_bonus" yaml:"critical_hit_threshold_bonus"`
 	CriticalDamage            int  `json:"critical_damage" yaml:"critical_damage"`
 
+	DamageType string `json:"damage_type" yaml:"damage_type"`
+	HitsPerUse int    `json:"hits_per_use" yaml:"hits_per_use"`
+
+	LifeStealPercent int `json:"life_steal_percent" yaml:"life_steal_percent"`
+	RecoilDamage     int `json:"recoil_damage" yaml:"recoil_damage"`
+	BarrierSiphon    int `json:"barrier_siphon" yaml:"barrier_siphon"`
+
+	CharmTurns   int `json:"charm_turns" yaml:"charm_turns"`
+	ConfuseTurns int `json:"confuse_turns" yaml:"confuse_turns"`
+
+	DamagePerTurn       int `json:"damage_per_turn" yaml:"damage_per_turn"`
+	DamageOverTimeTurns int `json:"damage_over_time_turns" yaml:"damage_over_time_turns"`
+
+	FirstStrike           bool `json:"first_strike" yaml:"first_strike"`
+	Riposte               bool `json:"riposte" yaml:"riposte"`
+	CounterAttacksPerTurn int  `json:"counter_attacks_per_turn" yaml:"counter_attacks_per_turn"`
+
 	HealingLogic    string `json:"healing_logic" yaml:"healing_logic"`
 	HitPointsHealed int    `json:"hit_points_healed" yaml:"hit_points_healed"`
+
+	BarrierRestored        int  `json:"barrier_restored" yaml:"barrier_restored"`
+	HitPointsHealedPerTurn int  `json:"hit_points_healed_per_turn" yaml:"hit_points_healed_per_turn"`
+	HealOverTimeTurns      int  `json:"heal_over_time_turns" yaml:"heal_over_time_turns"`
+	Cleanses               bool `json:"cleanses" yaml:"cleanses"`
+
+	ManaCost     int `json:"mana_cost" yaml:"mana_cost"`
+	ManaRestored int `json:"mana_restored" yaml:"mana_restored"`
+
+	Cooldown         int `json:"cooldown" yaml:"cooldown"`
+	ChargesPerBattle int `json:"charges_per_battle" yaml:"charges_per_battle"`
+	Range            int `json:"range" yaml:"range"`
+
+	SummonTemplateID string `json:"summon_template_id" yaml:"summon_template_id"`
+	SummonTurns      int    `json:"summon_turns" yaml:"summon_turns"`
+	SummonLimit      int    `json:"summon_limit" yaml:"summon_limit"`
 }
 
 // UsingYAML uses the yaml data to generate Builder.
-func (p *Builder) UsingYAML(yamlData []byte) *Builder {
+//   Raises an error if the data cannot be read or uses an unknown logic keyword.
+func (p *Builder) UsingYAML(yamlData []byte) (*Builder, error) {
 	return p.usingByteStreamForOneOption(yamlData, yaml.Unmarshal)
 }
 
 // UsingJSON uses the yaml data to generate Builder.
-func (p *Builder) UsingJSON(jsonData []byte) *Builder {
+//   Raises an error if the data cannot be read or uses an unknown logic keyword.
+func (p *Builder) UsingJSON(jsonData []byte) (*Builder, error) {
 	return p.usingByteStreamForOneOption(jsonData, json.Unmarshal)
 }
 
-func (p *Builder) usingByteStreamForOneOption(data []byte, unmarshal utility.UnmarshalFunc) *Builder {
+func (p *Builder) usingByteStreamForOneOption(data []byte, unmarshal utility.UnmarshalFunc) (*Builder, error) {
 	var unmarshalError error
 	var marshaledOptions BuilderOptionMarshal
 	unmarshalError = unmarshal(data, &marshaledOptions)
 
 	if unmarshalError != nil {
-		return p
+		return p, unmarshalError
 	}
 
-	return p.usingMarshaledOptions(&marshaledOptions)
+	p.usingMarshaledOptions(&marshaledOptions)
+	return p, p.keywordError
 }
 
 // CreatePowerBuilderOptionsFromYAML takes a YAML stream and converts them to a list of Builder.
-func CreatePowerBuilderOptionsFromYAML(yamlData []byte) []*Builder {
+//   Raises an error if the stream cannot be read or a power uses an unknown logic keyword.
+func CreatePowerBuilderOptionsFromYAML(yamlData []byte) ([]*Builder, error) {
 	return usingByteStreamForMultipleOptions(yamlData, yaml.Unmarshal)
 }
 
 // CreatePowerBuilderOptionsFromJSON takes a JSON stream and converts them to a list of Builder.
-func CreatePowerBuilderOptionsFromJSON(jsonData []byte) []*Builder {
+//   Raises an error if the stream cannot be read or a power uses an unknown logic keyword.
+func CreatePowerBuilderOptionsFromJSON(jsonData []byte) ([]*Builder, error) {
 	return usingByteStreamForMultipleOptions(jsonData, json.Unmarshal)
 }
 
-func usingByteStreamForMultipleOptions(data []byte, unmarshal utility.UnmarshalFunc) []*Builder {
+func usingByteStreamForMultipleOptions(data []byte, unmarshal utility.UnmarshalFunc) ([]*Builder, error) {
 	var unmarshalError error
 	var allMarshaledOptions []BuilderOptionMarshal
 	unmarshalError = unmarshal(data, &allMarshaledOptions)
 
 	if unmarshalError != nil {
-		return nil
+		return nil, unmarshalError
 	}
 
 	builderOptions := []*Builder{}
 	for _, marshaledOptions := range allMarshaledOptions {
 		newOption := NewPowerBuilder().usingMarshaledOptions(&marshaledOptions)
+		if newOption.keywordError != nil {
+			return nil, newOption.keywordError
+		}
 		builderOptions = append(builderOptions, newOption)
 	}
 
-	return builderOptions
+	return builderOptions, nil
 }
 
 func (p *Builder) usingMarshaledOptions(marshaledOptions *BuilderOptionMarshal) *Builder {
@@ -330,7 +580,11 @@ func (p *Builder) usingMarshaledOptions(marshaledOptions *BuilderOptionMarshal)
 
 	if marshaledOptions.CanAttack {
 		p.ToHitBonus(marshaledOptions.ToHitBonus).DealsDamage(marshaledOptions.DamageBonus).
-			ExtraBarrierBurn(marshaledOptions.ExtraBarrierBurn).CounterAttackPenaltyReduction(marshaledOptions.CounterAttackPenaltyReduction)
+			ExtraBarrierBurn(marshaledOptions.ExtraBarrierBurn).CounterAttackPenaltyReduction(marshaledOptions.CounterAttackPenaltyReduction).
+			DamageType(marshaledOptions.DamageType).HitsPerUse(marshaledOptions.HitsPerUse).
+			LifeStealPercent(marshaledOptions.LifeStealPercent).RecoilDamage(marshaledOptions.RecoilDamage).BarrierSiphon(marshaledOptions.BarrierSiphon).
+			Charms(marshaledOptions.CharmTurns).Confuses(marshaledOptions.ConfuseTurns).CounterAttacksPerTurn(marshaledOptions.CounterAttacksPerTurn).
+			DamagesOverTime(marshaledOptions.DamagePerTurn, marshaledOptions.DamageOverTimeTurns)
 
 		if marshaledOptions.CanBeEquipped {
 			p.CanBeEquipped()
@@ -340,6 +594,14 @@ func (p *Builder) usingMarshaledOptions(marshaledOptions *BuilderOptionMarshal)
 			p.CanCounterAttack()
 		}
 
+		if marshaledOptions.FirstStrike {
+			p.FirstStrike()
+		}
+
+		if marshaledOptions.Riposte {
+			p.Riposte()
+		}
+
 		if marshaledOptions.CanCritical {
 			p.CriticalHitThresholdBonus(marshaledOptions.CriticalHitThresholdBonus).CriticalDealsDamage(marshaledOptions.CriticalDamage)
 		}
@@ -347,8 +609,19 @@ func (p *Builder) usingMarshaledOptions(marshaledOptions *BuilderOptionMarshal)
 
 	p.HitPointsHealed(marshaledOptions.HitPointsHealed)
 	p.WithHealingLogic(marshaledOptions.HealingLogic)
+	p.BarrierRestored(marshaledOptions.BarrierRestored).HealsOverTime(marshaledOptions.HitPointsHealedPerTurn, marshaledOptions.HealOverTimeTurns)
+	if marshaledOptions.Cleanses {
+		p.Cleanses()
+	}
+
+	p.ManaCost(marshaledOptions.ManaCost).RestoresMana(marshaledOptions.ManaRestored)
+	p.Cooldown(marshaledOptions.Cooldown).ChargesPerBattle(marshaledOptions.ChargesPerBattle)
+	if marshaledOptions.Range > 0 {
+		p.Range(marshaledOptions.Range)
+	}
+	p.Summons(marshaledOptions.SummonTemplateID, marshaledOptions.SummonTurns, marshaledOptions.SummonLimit)
 
-	p.powerSourceLogic = powersource.NewPowerSourceLogic(marshaledOptions.PowerSource)
+	p.WithPowerSourceLogic(marshaledOptions.PowerSource)
 
 	if marshaledOptions.TargetSelf == true {
 		p.TargetsSelf()
@@ -371,19 +644,29 @@ func (p *Builder) CloneOf(source powerinterface.Interface) *Builder {
 	p.cloneTargeting(source)
 	p.cloneAttackEffect(source)
 	p.cloneHealingEffect(source)
+	p.ManaCost(source.ManaCost()).RestoresMana(source.ManaRestored())
+	p.Cooldown(source.Cooldown()).ChargesPerBattle(source.ChargesPerBattle()).Range(source.Range())
+	p.Summons(source.SummonTemplateID(), source.SummonTurns(), source.SummonLimit())
 
 	return p
 }
 
 func (p *Builder) cloneHealingEffect(source powerinterface.Interface) {
 	p.HitPointsHealed(source.HitPointsHealed())
-	p.WithHealingLogic(reflect.TypeOf(source.HealingLogic()).String())
+	p.healingLogic = source.HealingLogic()
+	p.BarrierRestored(source.BarrierRestored()).HealsOverTime(source.HitPointsHealedPerTurn(), source.HealOverTimeTurns())
+	if source.Cleanses() {
+		p.Cleanses()
+	}
 }
 
 func (p *Builder) cloneAttackEffect(source powerinterface.Interface) {
 	if source.CanAttack() {
 		p.ToHitBonus(source.ToHitBonus()).DealsDamage(source.DamageBonus()).ExtraBarrierBurn(source.ExtraBarrierBurn()).
-			CounterAttackPenaltyReduction(source.CounterAttackPenaltyReduction())
+			CounterAttackPenaltyReduction(source.CounterAttackPenaltyReduction()).DamageType(source.DamageType()).
+			HitsPerUse(source.HitsPerUse()).LifeStealPercent(source.LifeStealPercent()).RecoilDamage(source.RecoilDamage()).
+			BarrierSiphon(source.BarrierSiphon()).Charms(source.CharmTurns()).Confuses(source.ConfuseTurns()).
+			CounterAttacksPerTurn(source.CounterAttacksPerTurn()).DamagesOverTime(source.DamagePerTurn(), source.DamageOverTimeTurns())
 
 		if source.CanCritical() {
 			p.CriticalHitThresholdBonus(source.CriticalHitThresholdBonus()).CriticalDealsDamage(source.ExtraCriticalHitDamage())
@@ -395,6 +678,12 @@ func (p *Builder) cloneAttackEffect(source powerinterface.Interface) {
 		if source.CanCounterAttack() {
 			p.CanCounterAttack()
 		}
+		if source.FirstStrike() {
+			p.FirstStrike()
+		}
+		if source.Riposte() {
+			p.Riposte()
+		}
 
 		if source.CanPowerTargetFriend() {
 			p.TargetsFriend()
@@ -423,7 +712,5 @@ func (p *Builder) cloneTargeting(source powerinterface.Interface) {
 }
 
 func (p *Builder) clonePowerType(source powerinterface.Interface) {
-	p.powerSourceLogic = powersource.NewPowerSourceLogic(
-		source.PowerSourceLogic().Name(),
-	)
+	p.powerSourceLogic = source.PowerSourceLogic()
 }
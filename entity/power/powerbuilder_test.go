@@ -66,6 +66,46 @@ func (suite *PowerBuilder) TestHitPointsHealed(checker *C) {
 	checker.Assert(5, Equals, bigHeals.HitPointsHealed())
 }
 
+func (suite *PowerBuilder) TestManaCostAndRestoration(checker *C) {
+	manaPower := power.NewPowerBuilder().ManaCost(3).RestoresMana(2).Build()
+	checker.Assert(3, Equals, manaPower.ManaCost())
+	checker.Assert(2, Equals, manaPower.ManaRestored())
+	checker.Assert(true, Equals, manaPower.RestoresMana())
+
+	freePower := power.NewPowerBuilder().Build()
+	checker.Assert(0, Equals, freePower.ManaCost())
+	checker.Assert(false, Equals, freePower.RestoresMana())
+}
+
+func (suite *PowerBuilder) TestCooldownAndCharges(checker *C) {
+	ultimate := power.NewPowerBuilder().Cooldown(3).ChargesPerBattle(1).Build()
+	checker.Assert(3, Equals, ultimate.Cooldown())
+	checker.Assert(1, Equals, ultimate.ChargesPerBattle())
+
+	unlimitedPower := power.NewPowerBuilder().Build()
+	checker.Assert(0, Equals, unlimitedPower.Cooldown())
+	checker.Assert(0, Equals, unlimitedPower.ChargesPerBattle())
+}
+
+func (suite *PowerBuilder) TestRange(checker *C) {
+	bow := power.NewPowerBuilder().Range(3).Build()
+	checker.Assert(bow.Range(), Equals, 3)
+
+	sword := power.NewPowerBuilder().Build()
+	checker.Assert(sword.Range(), Equals, 1)
+}
+
+func (suite *PowerBuilder) TestSummons(checker *C) {
+	callWolf := power.NewPowerBuilder().Summons("templateWolf", 2, 1).Build()
+	checker.Assert(callWolf.CanSummon(), Equals, true)
+	checker.Assert(callWolf.SummonTemplateID(), Equals, "templateWolf")
+	checker.Assert(callWolf.SummonTurns(), Equals, 2)
+	checker.Assert(callWolf.SummonLimit(), Equals, 1)
+
+	spear := power.NewPowerBuilder().Spear().Build()
+	checker.Assert(spear.CanSummon(), Equals, false)
+}
+
 func (suite *PowerBuilder) TestBuildAttackEffectToHitBonus(checker *C) {
 	damageEffect := power.NewPowerBuilder().ToHitBonus(2).Build()
 	checker.Assert(2, Equals, damageEffect.ToHitBonus())
@@ -101,6 +141,19 @@ func (suite *PowerBuilder) TestBuildAttackEffectCanCounterAttack(checker *C) {
 	checker.Assert(true, Equals, sword.CanCounterAttack())
 }
 
+func (suite *PowerBuilder) TestBuildAttackEffectCounterVariants(checker *C) {
+	pike := power.NewPowerBuilder().FirstStrike().CounterAttacksPerTurn(2).Build()
+	checker.Assert(pike.CanCounterAttack(), Equals, true)
+	checker.Assert(pike.FirstStrike(), Equals, true)
+	checker.Assert(pike.Riposte(), Equals, false)
+	checker.Assert(pike.CounterAttacksPerTurn(), Equals, 2)
+
+	rapier := power.NewPowerBuilder().Riposte().Build()
+	checker.Assert(rapier.CanCounterAttack(), Equals, true)
+	checker.Assert(rapier.Riposte(), Equals, true)
+	checker.Assert(rapier.CounterAttacksPerTurn(), Equals, 0)
+}
+
 func (suite *PowerBuilder) TestBuildCriticalEffectDamage(checker *C) {
 	criticalDamageEffect := power.NewPowerBuilder().CriticalDealsDamage(8).Build()
 	checker.Assert(8, Equals, criticalDamageEffect.ExtraCriticalHitDamage())
@@ -188,11 +241,36 @@ counter_attack_penalty_reduction: 7
 can_critical: true
 critical_hit_threshold_bonus: 9
 critical_damage: 11
+mana_cost: 13
+damage_type: fire
+hits_per_use: 2
+barrier_restored: 4
+hit_points_healed_per_turn: 2
+heal_over_time_turns: 3
+cleanses: true
+life_steal_percent: 50
+recoil_damage: 3
+barrier_siphon: 1
+charm_turns: 2
+confuse_turns: 1
+damage_per_turn: 2
+damage_over_time_turns: 3
+first_strike: true
+riposte: true
+counter_attacks_per_turn: 2
+cooldown: 2
+charges_per_battle: 1
+range: 3
+summon_template_id: templateWolf
+summon_turns: 3
+summon_limit: 2
 `)
 }
 
 func (suite *YAMLBuilderSuite) TestIdentificationMatchesNewPower(checker *C) {
-	yamlPower := power.NewPowerBuilder().UsingYAML(suite.yamlData).Build()
+	yamlPowerBuilder, err := power.NewPowerBuilder().UsingYAML(suite.yamlData)
+	checker.Assert(err, IsNil)
+	yamlPower := yamlPowerBuilder.Build()
 
 	checker.Assert(yamlPower.ID(), Equals, "power_id")
 	checker.Assert(yamlPower.Name(), Equals, "Power name")
@@ -200,14 +278,18 @@ func (suite *YAMLBuilderSuite) TestIdentificationMatchesNewPower(checker *C) {
 }
 
 func (suite *YAMLBuilderSuite) TestTargetingMatchesNewPower(checker *C) {
-	yamlPower := power.NewPowerBuilder().UsingYAML(suite.yamlData).Build()
+	yamlPowerBuilder, err := power.NewPowerBuilder().UsingYAML(suite.yamlData)
+	checker.Assert(err, IsNil)
+	yamlPower := yamlPowerBuilder.Build()
 	checker.Assert(yamlPower.CanPowerTargetSelf(), Equals, true)
 	checker.Assert(yamlPower.CanPowerTargetFoe(), Equals, true)
 	checker.Assert(yamlPower.CanPowerTargetFriend(), Equals, false)
 }
 
 func (suite *YAMLBuilderSuite) TestAttackEffectMatchesNewPower(checker *C) {
-	yamlPower := power.NewPowerBuilder().UsingYAML(suite.yamlData).Build()
+	yamlPowerBuilder, err := power.NewPowerBuilder().UsingYAML(suite.yamlData)
+	checker.Assert(err, IsNil)
+	yamlPower := yamlPowerBuilder.Build()
 
 	checker.Assert(yamlPower.ToHitBonus(), Equals, 2)
 	checker.Assert(yamlPower.DamageBonus(), Equals, 3)
@@ -218,18 +300,129 @@ func (suite *YAMLBuilderSuite) TestAttackEffectMatchesNewPower(checker *C) {
 }
 
 func (suite *YAMLBuilderSuite) TestCriticalEffectMatchesNewPower(checker *C) {
-	yamlPower := power.NewPowerBuilder().UsingYAML(suite.yamlData).Build()
+	yamlPowerBuilder, err := power.NewPowerBuilder().UsingYAML(suite.yamlData)
+	checker.Assert(err, IsNil)
+	yamlPower := yamlPowerBuilder.Build()
 
 	checker.Assert(yamlPower.CriticalHitThreshold(), Equals, power.CriticalHitThresholdInitialValue-9)
 	checker.Assert(yamlPower.ExtraCriticalHitDamage(), Equals, 11)
 }
 
 func (suite *YAMLBuilderSuite) TestPowersThatCannotHealHaveNoHealingLogic(checker *C) {
-	yamlPower := power.NewPowerBuilder().UsingYAML(suite.yamlData).Build()
+	yamlPowerBuilder, err := power.NewPowerBuilder().UsingYAML(suite.yamlData)
+	checker.Assert(err, IsNil)
+	yamlPower := yamlPowerBuilder.Build()
 	checker.Assert(reflect.TypeOf(yamlPower.HealingLogic()).String(), Equals, "*healing.NoHealing")
 	checker.Assert(yamlPower.CanHeal(), Equals, false)
 }
 
+func (suite *YAMLBuilderSuite) TestManaCostMatchesNewPower(checker *C) {
+	yamlPowerBuilder, err := power.NewPowerBuilder().UsingYAML(suite.yamlData)
+	checker.Assert(err, IsNil)
+	yamlPower := yamlPowerBuilder.Build()
+	checker.Assert(yamlPower.ManaCost(), Equals, 13)
+	checker.Assert(yamlPower.RestoresMana(), Equals, false)
+}
+
+func (suite *YAMLBuilderSuite) TestDamageTypeMatchesNewPower(checker *C) {
+	yamlPowerBuilder, err := power.NewPowerBuilder().UsingYAML(suite.yamlData)
+	checker.Assert(err, IsNil)
+	yamlPower := yamlPowerBuilder.Build()
+	checker.Assert(yamlPower.DamageType(), Equals, "fire")
+}
+
+func (suite *YAMLBuilderSuite) TestHitsPerUseMatchesNewPower(checker *C) {
+	yamlPowerBuilder, err := power.NewPowerBuilder().UsingYAML(suite.yamlData)
+	checker.Assert(err, IsNil)
+	yamlPower := yamlPowerBuilder.Build()
+	checker.Assert(yamlPower.HitsPerUse(), Equals, 2)
+}
+
+func (suite *YAMLBuilderSuite) TestAttackerEffectsMatchNewPower(checker *C) {
+	yamlPowerBuilder, err := power.NewPowerBuilder().UsingYAML(suite.yamlData)
+	checker.Assert(err, IsNil)
+	yamlPower := yamlPowerBuilder.Build()
+	checker.Assert(yamlPower.LifeStealPercent(), Equals, 50)
+	checker.Assert(yamlPower.RecoilDamage(), Equals, 3)
+	checker.Assert(yamlPower.BarrierSiphon(), Equals, 1)
+}
+
+func (suite *YAMLBuilderSuite) TestAffiliationEffectsMatchNewPower(checker *C) {
+	yamlPowerBuilder, err := power.NewPowerBuilder().UsingYAML(suite.yamlData)
+	checker.Assert(err, IsNil)
+	yamlPower := yamlPowerBuilder.Build()
+	checker.Assert(yamlPower.CharmTurns(), Equals, 2)
+	checker.Assert(yamlPower.ConfuseTurns(), Equals, 1)
+}
+
+func (suite *YAMLBuilderSuite) TestDamageOverTimeMatchesNewPower(checker *C) {
+	yamlPowerBuilder, err := power.NewPowerBuilder().UsingYAML(suite.yamlData)
+	checker.Assert(err, IsNil)
+	yamlPower := yamlPowerBuilder.Build()
+	checker.Assert(yamlPower.DamagePerTurn(), Equals, 2)
+	checker.Assert(yamlPower.DamageOverTimeTurns(), Equals, 3)
+	checker.Assert(yamlPower.DamagesOverTime(), Equals, true)
+}
+
+func (suite *YAMLBuilderSuite) TestCounterAttackVariantsMatchNewPower(checker *C) {
+	yamlPowerBuilder, err := power.NewPowerBuilder().UsingYAML(suite.yamlData)
+	checker.Assert(err, IsNil)
+	yamlPower := yamlPowerBuilder.Build()
+	checker.Assert(yamlPower.FirstStrike(), Equals, true)
+	checker.Assert(yamlPower.Riposte(), Equals, true)
+	checker.Assert(yamlPower.CounterAttacksPerTurn(), Equals, 2)
+}
+
+func (suite *YAMLBuilderSuite) TestSupportEffectsMatchNewPower(checker *C) {
+	yamlPowerBuilder, err := power.NewPowerBuilder().UsingYAML(suite.yamlData)
+	checker.Assert(err, IsNil)
+	yamlPower := yamlPowerBuilder.Build()
+	checker.Assert(yamlPower.BarrierRestored(), Equals, 4)
+	checker.Assert(yamlPower.HitPointsHealedPerTurn(), Equals, 2)
+	checker.Assert(yamlPower.HealOverTimeTurns(), Equals, 3)
+	checker.Assert(yamlPower.HealsOverTime(), Equals, true)
+	checker.Assert(yamlPower.Cleanses(), Equals, true)
+}
+
+func (suite *YAMLBuilderSuite) TestCooldownAndChargesMatchNewPower(checker *C) {
+	yamlPowerBuilder, err := power.NewPowerBuilder().UsingYAML(suite.yamlData)
+	checker.Assert(err, IsNil)
+	yamlPower := yamlPowerBuilder.Build()
+	checker.Assert(yamlPower.Cooldown(), Equals, 2)
+	checker.Assert(yamlPower.ChargesPerBattle(), Equals, 1)
+	checker.Assert(yamlPower.Range(), Equals, 3)
+}
+
+func (suite *YAMLBuilderSuite) TestSummonMatchesNewPower(checker *C) {
+	yamlPowerBuilder, err := power.NewPowerBuilder().UsingYAML(suite.yamlData)
+	checker.Assert(err, IsNil)
+	yamlPower := yamlPowerBuilder.Build()
+	checker.Assert(yamlPower.SummonTemplateID(), Equals, "templateWolf")
+	checker.Assert(yamlPower.SummonTurns(), Equals, 3)
+	checker.Assert(yamlPower.SummonLimit(), Equals, 2)
+}
+
+func (suite *YAMLBuilderSuite) TestRaiseErrorWithUnknownLogicKeywords(checker *C) {
+	_, err := power.NewPowerBuilder().UsingYAML([]byte(`
+id: powerKwyjibo
+source: kwyjibo
+`))
+	checker.Assert(err, ErrorMatches, `unknown power source logic keyword "kwyjibo"`)
+
+	_, err = power.NewPowerBuilder().UsingYAML([]byte(`
+id: powerKwyjibo
+healing_logic: kwyjibo
+`))
+	checker.Assert(err, ErrorMatches, `unknown healing logic keyword "kwyjibo"`)
+
+	builders, err := power.CreatePowerBuilderOptionsFromYAML([]byte(`
+- id: powerKwyjibo
+  source: kwyjibo
+`))
+	checker.Assert(err, ErrorMatches, `unknown power source logic keyword "kwyjibo"`)
+	checker.Assert(builders, IsNil)
+}
+
 type JSONBuilderSuite struct {
 	jsonData []byte
 }
@@ -245,13 +438,16 @@ func (suite *JSONBuilderSuite) SetUpTest(checker *C) {
    "source": "physical",
    "can_heal": true,
    "healing_logic": "half",
-   "hit_points_healed": 2
+   "hit_points_healed": 2,
+   "mana_restored": 4
 }
 `)
 }
 
 func (suite *JSONBuilderSuite) TestIdentificationMatchesNewPower(checker *C) {
-	jsonPower := power.NewPowerBuilder().UsingJSON(suite.jsonData).Build()
+	jsonPowerBuilder, err := power.NewPowerBuilder().UsingJSON(suite.jsonData)
+	checker.Assert(err, IsNil)
+	jsonPower := jsonPowerBuilder.Build()
 
 	checker.Assert(jsonPower.ID(), Equals, "power_id")
 	checker.Assert(jsonPower.Name(), Equals, "Power name")
@@ -259,10 +455,13 @@ func (suite *JSONBuilderSuite) TestIdentificationMatchesNewPower(checker *C) {
 }
 
 func (suite *JSONBuilderSuite) TestHealingMatchesNewPower(checker *C) {
-	jsonPower := power.NewPowerBuilder().UsingJSON(suite.jsonData).Build()
+	jsonPowerBuilder, err := power.NewPowerBuilder().UsingJSON(suite.jsonData)
+	checker.Assert(err, IsNil)
+	jsonPower := jsonPowerBuilder.Build()
 
 	checker.Assert(reflect.TypeOf(jsonPower.HealingLogic()).String(), Equals, "*healing.HalfMindBonus")
 	checker.Assert(jsonPower.HitPointsHealed(), Equals, 2)
+	checker.Assert(jsonPower.ManaRestored(), Equals, 4)
 }
 
 type BuildCopySuite struct {
@@ -287,6 +486,94 @@ func (suite *BuildCopySuite) TestCopyHealingPower(checker *C) {
 	checker.Assert(copyHealingStaff.HasSameStatsAs(suite.healingStaff), Equals, true)
 }
 
+func (suite *BuildCopySuite) TestCopyManaCosts(checker *C) {
+	expensiveSpear := power.NewPowerBuilder().CloneOf(suite.spear).ManaCost(2).RestoresMana(1).Build()
+	copyExpensiveSpear := power.NewPowerBuilder().CloneOf(expensiveSpear).Build()
+	checker.Assert(copyExpensiveSpear.HasSameStatsAs(expensiveSpear), Equals, true)
+	checker.Assert(expensiveSpear.HasSameStatsAs(suite.spear), Equals, false)
+}
+
+func (suite *BuildCopySuite) TestCopyDamageType(checker *C) {
+	fireSpear := power.NewPowerBuilder().CloneOf(suite.spear).DamageType("fire").Build()
+	copyFireSpear := power.NewPowerBuilder().CloneOf(fireSpear).Build()
+	checker.Assert(copyFireSpear.DamageType(), Equals, "fire")
+	checker.Assert(fireSpear.HasSameStatsAs(suite.spear), Equals, false)
+}
+
+func (suite *BuildCopySuite) TestCopyHitsPerUse(checker *C) {
+	doubleSpear := power.NewPowerBuilder().CloneOf(suite.spear).HitsPerUse(2).Build()
+	copyDoubleSpear := power.NewPowerBuilder().CloneOf(doubleSpear).Build()
+	checker.Assert(copyDoubleSpear.HitsPerUse(), Equals, 2)
+	checker.Assert(doubleSpear.HasSameStatsAs(suite.spear), Equals, false)
+	checker.Assert(suite.spear.HitsPerUse(), Equals, 1)
+}
+
+func (suite *BuildCopySuite) TestCopyAttackerEffects(checker *C) {
+	vampireSpear := power.NewPowerBuilder().CloneOf(suite.spear).LifeStealPercent(50).RecoilDamage(1).BarrierSiphon(2).Build()
+	copyVampireSpear := power.NewPowerBuilder().CloneOf(vampireSpear).Build()
+	checker.Assert(copyVampireSpear.HasSameStatsAs(vampireSpear), Equals, true)
+	checker.Assert(vampireSpear.HasSameStatsAs(suite.spear), Equals, false)
+}
+
+func (suite *BuildCopySuite) TestCopyAffiliationEffects(checker *C) {
+	charmingSpear := power.NewPowerBuilder().CloneOf(suite.spear).Charms(2).Confuses(1).Build()
+	copyCharmingSpear := power.NewPowerBuilder().CloneOf(charmingSpear).Build()
+	checker.Assert(copyCharmingSpear.HasSameStatsAs(charmingSpear), Equals, true)
+	checker.Assert(copyCharmingSpear.CharmTurns(), Equals, 2)
+	checker.Assert(copyCharmingSpear.ConfuseTurns(), Equals, 1)
+	checker.Assert(charmingSpear.HasSameStatsAs(suite.spear), Equals, false)
+}
+
+func (suite *BuildCopySuite) TestCopyDamageOverTime(checker *C) {
+	poisonedSpear := power.NewPowerBuilder().CloneOf(suite.spear).DamagesOverTime(2, 3).Build()
+	copyPoisonedSpear := power.NewPowerBuilder().CloneOf(poisonedSpear).Build()
+	checker.Assert(copyPoisonedSpear.HasSameStatsAs(poisonedSpear), Equals, true)
+	checker.Assert(copyPoisonedSpear.DamagePerTurn(), Equals, 2)
+	checker.Assert(copyPoisonedSpear.DamageOverTimeTurns(), Equals, 3)
+	checker.Assert(poisonedSpear.HasSameStatsAs(suite.spear), Equals, false)
+}
+
+func (suite *BuildCopySuite) TestCopyCounterAttackVariants(checker *C) {
+	duelingSpear := power.NewPowerBuilder().CloneOf(suite.spear).FirstStrike().Riposte().CounterAttacksPerTurn(1).Build()
+	copyDuelingSpear := power.NewPowerBuilder().CloneOf(duelingSpear).Build()
+	checker.Assert(copyDuelingSpear.HasSameStatsAs(duelingSpear), Equals, true)
+	checker.Assert(copyDuelingSpear.FirstStrike(), Equals, true)
+	checker.Assert(copyDuelingSpear.Riposte(), Equals, true)
+	checker.Assert(copyDuelingSpear.CounterAttacksPerTurn(), Equals, 1)
+	checker.Assert(duelingSpear.HasSameStatsAs(suite.spear), Equals, false)
+}
+
+func (suite *BuildCopySuite) TestCopySupportEffects(checker *C) {
+	ward := power.NewPowerBuilder().HealingStaff().WithName("Ward").BarrierRestored(2).HealsOverTime(1, 3).Cleanses().Build()
+	copyWard := power.NewPowerBuilder().CloneOf(ward).Build()
+	checker.Assert(copyWard.HasSameStatsAs(ward), Equals, true)
+	checker.Assert(copyWard.Cleanses(), Equals, true)
+}
+
+func (suite *BuildCopySuite) TestCopyCooldownAndCharges(checker *C) {
+	ultimateSpear := power.NewPowerBuilder().CloneOf(suite.spear).Cooldown(2).ChargesPerBattle(1).Build()
+	copyUltimateSpear := power.NewPowerBuilder().CloneOf(ultimateSpear).Build()
+	checker.Assert(copyUltimateSpear.HasSameStatsAs(ultimateSpear), Equals, true)
+	checker.Assert(ultimateSpear.HasSameStatsAs(suite.spear), Equals, false)
+}
+
+func (suite *BuildCopySuite) TestCopyRange(checker *C) {
+	longSpear := power.NewPowerBuilder().CloneOf(suite.spear).Range(2).Build()
+	copyLongSpear := power.NewPowerBuilder().CloneOf(longSpear).Build()
+	checker.Assert(copyLongSpear.Range(), Equals, 2)
+	checker.Assert(longSpear.HasSameStatsAs(suite.spear), Equals, false)
+}
+
+func (suite *BuildCopySuite) TestCopySummon(checker *C) {
+	callWolf := power.NewPowerBuilder().WithName("Call Wolf").TargetsSelf().Summons("templateWolf", 2, 1).Build()
+	copyCallWolf := power.NewPowerBuilder().CloneOf(callWolf).Build()
+	checker.Assert(copyCallWolf.HasSameStatsAs(callWolf), Equals, true)
+	checker.Assert(copyCallWolf.SummonTemplateID(), Equals, "templateWolf")
+
+	callBear := power.NewPowerBuilder().CloneOf(callWolf).Summons("templateBear", 2, 1).Build()
+	checker.Assert(callBear.HasSameStatsAs(callWolf), Equals, false)
+}
+
 func (suite *BuildCopySuite) TestCopyCriticalAttackPower(checker *C) {
 	criticalSpear := power.NewPowerBuilder().CloneOf(suite.spear).CriticalDealsDamage(10).CriticalHitThresholdBonus(2).Build()
 	copyCriticalSpear := power.NewPowerBuilder().CloneOf(criticalSpear).Build()
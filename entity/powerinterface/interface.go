@@ -16,6 +16,18 @@ type Interface interface {
 	ToHitBonus() int
 	DamageBonus() int
 	ExtraBarrierBurn() int
+	HitsPerUse() int
+	LifeStealPercent() int
+	RecoilDamage() int
+	BarrierSiphon() int
+	CharmTurns() int
+	ConfuseTurns() int
+	DamagePerTurn() int
+	DamageOverTimeTurns() int
+	DamagesOverTime() bool
+	FirstStrike() bool
+	Riposte() bool
+	CounterAttacksPerTurn() int
 	CounterAttackPenaltyReduction() int
 	CanCritical() bool
 	CriticalHitThresholdBonus() int
@@ -28,6 +40,22 @@ type Interface interface {
 	PowerSourceLogic() powersource.Interface
 	GetReference() *powerreference.Reference
 	CanHeal() bool
+	BarrierRestored() int
+	HitPointsHealedPerTurn() int
+	HealOverTimeTurns() int
+	HealsOverTime() bool
+	Cleanses() bool
+	ManaCost() int
+	ManaRestored() int
+	RestoresMana() bool
+	DamageType() string
+	Cooldown() int
+	ChargesPerBattle() int
+	Range() int
+	SummonTemplateID() string
+	SummonTurns() int
+	SummonLimit() int
+	CanSummon() bool
 	CounterAttackPenalty() (int, error)
 	CanCriticallyHit() bool
 	CriticalHitThreshold() int
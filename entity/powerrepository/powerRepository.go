@@ -1,10 +1,10 @@
 package powerrepository
 
 import (
-	"errors"
 	"github.com/chadius/terosgamerules/entity/power"
 	"github.com/chadius/terosgamerules/entity/powerinterface"
 	"github.com/chadius/terosgamerules/utility"
+	"sort"
 )
 
 // Repository will interact with external devices to manage Powers.
@@ -22,9 +22,9 @@ func NewPowerRepository() *Repository {
 
 // AddJSONSource consumes a given bytestream and tries to analyze it.
 func (repository *Repository) AddJSONSource(data []byte) (bool, error) {
-	builderOptions := power.CreatePowerBuilderOptionsFromJSON(data)
-	if builderOptions == nil {
-		return false, errors.New("could not create Builder with given JSON")
+	builderOptions, err := power.CreatePowerBuilderOptionsFromJSON(data)
+	if err != nil {
+		return false, err
 	}
 
 	powersToAdd := []powerinterface.Interface{}
@@ -38,9 +38,9 @@ func (repository *Repository) AddJSONSource(data []byte) (bool, error) {
 
 // AddYAMLSource consumes a given bytestream and tries to analyze it.
 func (repository *Repository) AddYAMLSource(data []byte) (bool, error) {
-	builderOptions := power.CreatePowerBuilderOptionsFromYAML(data)
-	if builderOptions == nil {
-		return false, errors.New("could not create Builder with given YAML")
+	builderOptions, err := power.CreatePowerBuilderOptionsFromYAML(data)
+	if err != nil {
+		return false, err
 	}
 
 	powersToAdd := []powerinterface.Interface{}
@@ -99,6 +99,16 @@ func (repository *Repository) GetNumberOfPowers() int {
 	return len(repository.powersByID)
 }
 
+// GetAllPowerIDs returns the ID of every stored Power, sorted.
+func (repository *Repository) GetAllPowerIDs() []string {
+	powerIDs := []string{}
+	for powerID := range repository.powersByID {
+		powerIDs = append(powerIDs, powerID)
+	}
+	sort.Strings(powerIDs)
+	return powerIDs
+}
+
 // GetPowerByID returns the Power stored by powerID.
 func (repository *Repository) GetPowerByID(powerID string) powerinterface.Interface {
 	return repository.powersByID[powerID]
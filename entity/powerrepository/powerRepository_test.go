@@ -57,6 +57,10 @@ func (suite *PowerCreationSuite) TestReturnNilIfIDDoesNotExist(checker *C) {
 	checker.Assert(nonExistentPower, IsNil)
 }
 
+func (suite *PowerCreationSuite) TestGetAllPowerIDsInOrder(checker *C) {
+	checker.Assert(suite.repo.GetAllPowerIDs(), DeepEquals, []string{"spearLevel1", "spearLevel2"})
+}
+
 func (suite *PowerCreationSuite) TestSearchForPowerByName(checker *C) {
 	allSpearPowers := suite.repo.GetAllPowersByName("Spear")
 	checker.Assert(allSpearPowers, HasLen, 2)
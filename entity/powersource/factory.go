@@ -1,17 +1,49 @@
 package powersource
 
-// NewPowerSourceLogic returns a new power source logic object based on the keyword given. Or it returns a nohealing logic.
-func NewPowerSourceLogic(keyword string) Interface {
-	powersourceLogicByKeyword := map[string]string{
-		"spell":              "spell",
-		"Spell":              "spell",
-		"*powersource.spell": "spell",
-		"*powersource.Spell": "spell",
-	}
+import (
+	"github.com/chadius/terosgamerules/utility"
+)
 
-	if powersourceLogicByKeyword[keyword] == "spell" {
-		return &Spell{}
-	}
+var registry = newDefaultRegistry()
+
+func newDefaultRegistry() *utility.LogicRegistry {
+	registry := utility.NewLogicRegistry("power source logic")
+	registry.RegisterKeywords(
+		[]string{"physical", "Physical", "*powersource.physical", "*powersource.Physical"},
+		func() interface{} { return &Physical{} },
+	)
+	registry.RegisterKeywords(
+		[]string{"spell", "Spell", "*powersource.spell", "*powersource.Spell"},
+		func() interface{} { return &Spell{} },
+	)
+	return registry
+}
+
+// Factory creates a new power source logic object.
+type Factory func() Interface
+
+// Register lets NewPowerSourceLogic create the logic made by factory when it sees the keyword.
+//   Raises an error if the keyword is empty or already registered.
+func Register(keyword string, factory Factory) error {
+	return registry.Register(keyword, func() interface{} { return factory() })
+}
+
+// Unregister stops NewPowerSourceLogic from understanding the keyword.
+func Unregister(keyword string) {
+	registry.Unregister(keyword)
+}
 
-	return &Physical{}
+// Keywords returns every keyword NewPowerSourceLogic understands, in alphabetical order.
+func Keywords() []string {
+	return registry.Keywords()
+}
+
+// NewPowerSourceLogic returns a new power source logic object based on the keyword given.
+//   Raises an error if the keyword was never registered.
+func NewPowerSourceLogic(keyword string) (Interface, error) {
+	logic, err := registry.NewLogic(keyword)
+	if err != nil {
+		return nil, err
+	}
+	return logic.(Interface), nil
 }
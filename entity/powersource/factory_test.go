@@ -14,11 +14,28 @@ type FactorySuite struct{}
 var _ = Suite(&FactorySuite{})
 
 func (suite *FactorySuite) TestSpellSource(checker *C) {
-	powerSourceLogic := powersource.NewPowerSourceLogic("spell")
+	powerSourceLogic, _ := powersource.NewPowerSourceLogic("spell")
 	checker.Assert(reflect.TypeOf(powerSourceLogic).String(), Equals, "*powersource.Spell")
 }
 
-func (suite *FactorySuite) TestWhenUnknownKeyword_ThenFactoryReturnsPhysical(checker *C) {
-	powerSourceLogic := powersource.NewPowerSourceLogic("kwyjibo")
-	checker.Assert(reflect.TypeOf(powerSourceLogic).String(), Equals, "*powersource.Physical")
+func (suite *FactorySuite) TestWhenUnknownKeyword_ThenFactoryRaisesAnError(checker *C) {
+	powerSourceLogic, err := powersource.NewPowerSourceLogic("kwyjibo")
+	checker.Assert(err, ErrorMatches, `unknown power source logic keyword "kwyjibo"`)
+	checker.Assert(powerSourceLogic, IsNil)
+}
+
+func (suite *FactorySuite) TestCanRegisterCustomLogic(checker *C) {
+	err := powersource.Register("psychic", func() powersource.Interface { return &powersource.Spell{} })
+	checker.Assert(err, IsNil)
+	defer powersource.Unregister("psychic")
+
+	powerSourceLogic, err := powersource.NewPowerSourceLogic("psychic")
+	checker.Assert(err, IsNil)
+	checker.Assert(reflect.TypeOf(powerSourceLogic).String(), Equals, "*powersource.Spell")
+	checker.Assert(powersource.Keywords(), Not(HasLen), 0)
+}
+
+func (suite *FactorySuite) TestCannotReplaceExistingLogic(checker *C) {
+	err := powersource.Register("spell", func() powersource.Interface { return &powersource.Spell{} })
+	checker.Assert(err, ErrorMatches, `power source logic keyword ".*" is already registered`)
 }
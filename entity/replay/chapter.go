@@ -1,22 +1,96 @@
 package replay
 
 import (
+	"fmt"
+	"github.com/chadius/terosgamerules/entity/battlegrid"
+	"github.com/chadius/terosgamerules/entity/faction"
+	"github.com/chadius/terosgamerules/entity/objective"
+	"github.com/chadius/terosgamerules/entity/trigger"
 	"github.com/chadius/terosgamerules/utility"
 	"gopkg.in/yaml.v2"
 )
 
+// Kinds of actions a SquaddieAction can describe.
+const (
+	// UsePower has the user use the power on the targets. Actions without a kind use powers.
+	UsePower = "use_power"
+	// LevelUp gives the user a level in their current class, choosing the big level if they qualify for one.
+	LevelUp = "level_up"
+	// ChangeClass switches the user's current class.
+	ChangeClass = "change_class"
+	// EquipPower makes the user equip the power.
+	EquipPower = "equip_power"
+	// EquipItem makes the user equip an item from their inventory.
+	EquipItem = "equip_item"
+	// UnequipItem makes the user unequip the item in the slot.
+	UnequipItem = "unequip_item"
+	// UseItem has the user consume an item, using its power on the targets.
+	UseItem = "use_item"
+	// EndTurn ends the user's turn, counting down any override to their affiliation and, for summons, their time left in battle.
+	EndTurn = "end_turn"
+	// Guard has the user guard the first target, taking the attacks aimed at it until the next turn starts.
+	Guard = "guard"
+	// Overwatch has the user attack the first foe that moves into the range of the power, or its equipped power.
+	Overwatch = "overwatch"
+	// Move has the user walk to the destination on the battle grid, triggering overwatch along the way.
+	Move = "move"
+	// ChangeRelationship changes how two factions treat each other.
+	ChangeRelationship = "change_relationship"
+	// NextTurn ends the current turn of the battle, ending the turn of every squaddie who has not ended it yet, and starts the next one.
+	NextTurn = "next_turn"
+)
+
 // SquaddieAction records everything a squaddie could have performed in a single turn.
 type SquaddieAction struct {
+	Kind       string   `json:"kind" yaml:"kind"`
 	RandomSeed int64    `json:"random_seed" yaml:"random_seed"`
 	UserID     string   `json:"user_id" yaml:"user_id"`
 	PowerID    string   `json:"power_id" yaml:"power_id"`
 	TargetIDs  []string `json:"target_ids" yaml:"target_ids"`
+	BigLevelID string   `json:"big_level_id" yaml:"big_level_id"`
+	ClassID    string   `json:"class_id" yaml:"class_id"`
+	ItemID     string   `json:"item_id" yaml:"item_id"`
+	Slot       string   `json:"slot" yaml:"slot"`
+
+	Destination *battlegrid.Coordinate `json:"destination" yaml:"destination"`
+
+	FactionID      string `json:"faction_id" yaml:"faction_id"`
+	OtherFactionID string `json:"other_faction_id" yaml:"other_faction_id"`
+	Relationship   string `json:"relationship" yaml:"relationship"`
+
+	LevelUpChoices []*LevelUpChoice `json:"level_up_choices" yaml:"level_up_choices"`
+}
+
+// LevelUpChoice names the big level a squaddie chooses when it resolves a pending level up.
+type LevelUpChoice struct {
+	SquaddieID string `json:"squaddie_id" yaml:"squaddie_id"`
+	BigLevelID string `json:"big_level_id" yaml:"big_level_id"`
+}
+
+// TeamItemStack gives every squaddie on a team shared copies of an item.
+type TeamItemStack struct {
+	Team   string `json:"team" yaml:"team"`
+	ItemID string `json:"item_id" yaml:"item_id"`
+	Count  int    `json:"count" yaml:"count"`
 }
 
 // ChapterReplay contains the information needed to recreate a replay of one chapter in a game.
+//   The objectives are written for the squaddies with PlayerAffiliation, an affiliation or faction.
+//   Chapters without one are written for the player affiliation.
 type ChapterReplay struct {
 	Version string            `json:"version" yaml:"version"`
 	Actions []*SquaddieAction `json:"actions" yaml:"actions"`
+
+	TeamItems []*TeamItemStack `json:"team_items" yaml:"team_items"`
+
+	Factions      []*faction.FactionMarshal      `json:"factions" yaml:"factions"`
+	Relationships []*faction.RelationshipMarshal `json:"relationships" yaml:"relationships"`
+
+	Positions []*battlegrid.Placement `json:"positions" yaml:"positions"`
+
+	PlayerAffiliation string                 `json:"player_affiliation" yaml:"player_affiliation"`
+	Objectives        []*objective.Objective `json:"objectives" yaml:"objectives"`
+	Triggers          []*trigger.Trigger     `json:"triggers" yaml:"triggers"`
 }
 
 // NewCreateMapReplayFromYAML reads the YAML data and returns a list of Map objects.
@@ -25,6 +99,7 @@ func NewCreateMapReplayFromYAML(data []byte) (*ChapterReplay, error) {
 }
 
 // newCreateMapReplayFromDatastream consumes a given bytestream and tries to create multiple objects from it
+//   Raises an error if an action has a kind that is not listed above.
 func newCreateMapReplayFromDatastream(data []byte, unmarshal utility.UnmarshalFunc) (*ChapterReplay, error) {
 	var unmarshalError error
 	var chapterReplay ChapterReplay
@@ -34,5 +109,30 @@ func newCreateMapReplayFromDatastream(data []byte, unmarshal utility.UnmarshalFu
 		return nil, unmarshalError
 	}
 
+	for _, action := range chapterReplay.Actions {
+		if !IsKnownKind(action.GetKind()) {
+			newError := fmt.Errorf("unknown action kind %q", action.Kind)
+			utility.Log(newError.Error(), 0, utility.Error)
+			return nil, newError
+		}
+	}
 	return &chapterReplay, nil
 }
+
+// IsKnownKind returns true if the kind is one of the kinds of actions listed above.
+func IsKnownKind(kind string) bool {
+	switch kind {
+	case UsePower, LevelUp, ChangeClass, EquipPower, EquipItem, UnequipItem, UseItem,
+		EndTurn, Guard, Overwatch, Move, ChangeRelationship, NextTurn:
+		return true
+	}
+	return false
+}
+
+// GetKind returns the kind of action, treating actions without a kind as UsePower.
+func (action *SquaddieAction) GetKind() string {
+	if action.Kind == "" {
+		return UsePower
+	}
+	return action.Kind
+}
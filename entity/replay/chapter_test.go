@@ -1,7 +1,10 @@
 package replay_test
 
 import (
+	"github.com/chadius/terosgamerules/entity/battlegrid"
+	"github.com/chadius/terosgamerules/entity/objective"
 	"github.com/chadius/terosgamerules/entity/replay"
+	"github.com/chadius/terosgamerules/entity/trigger"
 	. "gopkg.in/check.v1"
 	"testing"
 )
@@ -41,3 +44,157 @@ actions:
 	checker.Assert(replayCommands.Actions[0].TargetIDs[0], Equals, "squaddie_bandit_0")
 	checker.Assert(replayCommands.Actions[0].TargetIDs[1], Equals, "squaddie_bandit_1")
 }
+
+func (suite *MapReplayTest) TestConsumeProgressionActions(checker *C) {
+	yamlByteStream := []byte(`---
+version: 0.1F
+actions:
+  -
+    kind: level_up
+    user_id: squaddie_teros
+    big_level_id: level_mage_big
+  -
+    kind: change_class
+    user_id: squaddie_teros
+    class_id: class_dimension_walker
+  -
+    kind: equip_power
+    user_id: squaddie_teros
+    power_id: power_blot
+  -
+    user_id: squaddie_teros
+    power_id: power_blot
+    target_ids:
+    - squaddie_bandit_0
+`)
+	replayCommands, err := replay.NewCreateMapReplayFromYAML(yamlByteStream)
+	checker.Assert(err, IsNil)
+	checker.Assert(replayCommands.Actions, HasLen, 4)
+	checker.Assert(replayCommands.Actions[0].GetKind(), Equals, replay.LevelUp)
+	checker.Assert(replayCommands.Actions[0].BigLevelID, Equals, "level_mage_big")
+	checker.Assert(replayCommands.Actions[1].GetKind(), Equals, replay.ChangeClass)
+	checker.Assert(replayCommands.Actions[1].ClassID, Equals, "class_dimension_walker")
+	checker.Assert(replayCommands.Actions[2].GetKind(), Equals, replay.EquipPower)
+	checker.Assert(replayCommands.Actions[2].PowerID, Equals, "power_blot")
+	checker.Assert(replayCommands.Actions[3].GetKind(), Equals, replay.UsePower)
+}
+
+func (suite *MapReplayTest) TestConsumeObjectivesAndTurns(checker *C) {
+	yamlByteStream := []byte(`---
+version: 0.1F
+objectives:
+  -
+    kind: defeat_boss
+    squaddie_id: squaddie_bandit_boss
+  -
+    kind: survive_turns
+    turns: 3
+actions:
+  -
+    kind: next_turn
+`)
+	replayCommands, err := replay.NewCreateMapReplayFromYAML(yamlByteStream)
+	checker.Assert(err, IsNil)
+	checker.Assert(replayCommands.Objectives, HasLen, 2)
+	checker.Assert(replayCommands.Objectives[0].Kind, Equals, objective.DefeatBoss)
+	checker.Assert(replayCommands.Objectives[0].SquaddieID, Equals, "squaddie_bandit_boss")
+	checker.Assert(replayCommands.Objectives[1].Kind, Equals, objective.SurviveTurns)
+	checker.Assert(replayCommands.Objectives[1].Turns, Equals, 3)
+	checker.Assert(replayCommands.Actions[0].GetKind(), Equals, replay.NextTurn)
+}
+
+func (suite *MapReplayTest) TestConsumeTriggers(checker *C) {
+	yamlByteStream := []byte(`---
+version: 0.1F
+triggers:
+  -
+    id: reinforcements
+    condition:
+      kind: hit_points_below
+      squaddie_id: squaddie_bandit_boss
+      percent: 50
+    effects:
+      -
+        kind: add_squaddies
+        template_id: squaddie_bandit
+        squaddie_ids:
+          - squaddie_bandit_1
+          - squaddie_bandit_2
+      -
+        kind: show_message
+        message: Reinforcements arrive!
+actions:
+  -
+    kind: next_turn
+`)
+	replayCommands, err := replay.NewCreateMapReplayFromYAML(yamlByteStream)
+	checker.Assert(err, IsNil)
+	checker.Assert(replayCommands.Triggers, HasLen, 1)
+	checker.Assert(replayCommands.Triggers[0].ID, Equals, "reinforcements")
+	checker.Assert(replayCommands.Triggers[0].Condition.Kind, Equals, trigger.HitPointsBelow)
+	checker.Assert(replayCommands.Triggers[0].Condition.SquaddieID, Equals, "squaddie_bandit_boss")
+	checker.Assert(replayCommands.Triggers[0].Condition.Percent, Equals, 50)
+	checker.Assert(replayCommands.Triggers[0].Effects, HasLen, 2)
+	checker.Assert(replayCommands.Triggers[0].Effects[0].Kind, Equals, trigger.AddSquaddies)
+	checker.Assert(replayCommands.Triggers[0].Effects[0].TemplateID, Equals, "squaddie_bandit")
+	checker.Assert(replayCommands.Triggers[0].Effects[0].SquaddieIDs, DeepEquals, []string{"squaddie_bandit_1", "squaddie_bandit_2"})
+	checker.Assert(replayCommands.Triggers[0].Effects[1].Message, Equals, "Reinforcements arrive!")
+}
+
+func (suite *MapReplayTest) TestConsumePositionsAndMovement(checker *C) {
+	yamlByteStream := []byte(`---
+version: 0.1F
+positions:
+  - squaddie_id: squaddie_teros
+    row: 1
+    column: 2
+actions:
+  -
+    kind: overwatch
+    user_id: squaddie_teros
+    power_id: power_bow
+  -
+    kind: move
+    user_id: squaddie_bandit
+    destination:
+      row: 3
+      column: 2
+`)
+	replayCommands, err := replay.NewCreateMapReplayFromYAML(yamlByteStream)
+	checker.Assert(err, IsNil)
+	checker.Assert(replayCommands.Positions, HasLen, 1)
+	checker.Assert(*replayCommands.Positions[0], Equals, battlegrid.Placement{SquaddieID: "squaddie_teros", Row: 1, Column: 2})
+	checker.Assert(replayCommands.Actions[0].GetKind(), Equals, replay.Overwatch)
+	checker.Assert(replayCommands.Actions[1].GetKind(), Equals, replay.Move)
+	checker.Assert(*replayCommands.Actions[1].Destination, Equals, battlegrid.Coordinate{Row: 3, Column: 2})
+}
+
+func (suite *MapReplayTest) TestUnknownActionKindsRaiseAnError(checker *C) {
+	yamlByteStream := []byte(`---
+version: 0.1F
+actions:
+  -
+    kind: end_trun
+    user_id: squaddie_teros
+`)
+	_, err := replay.NewCreateMapReplayFromYAML(yamlByteStream)
+	checker.Assert(err, ErrorMatches, `unknown action kind "end_trun"`)
+}
+
+func (suite *MapReplayTest) TestActionsWithoutAKindUsePowers(checker *C) {
+	yamlByteStream := []byte(`---
+version: 0.1F
+actions:
+  -
+    user_id: squaddie_teros
+    power_id: power_blot
+  -
+    kind: use_power
+    user_id: squaddie_teros
+    power_id: power_blot
+`)
+	replayCommands, err := replay.NewCreateMapReplayFromYAML(yamlByteStream)
+	checker.Assert(err, IsNil)
+	checker.Assert(replayCommands.Actions[0].GetKind(), Equals, replay.UsePower)
+	checker.Assert(replayCommands.Actions[1].GetKind(), Equals, replay.UsePower)
+}
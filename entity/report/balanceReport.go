@@ -0,0 +1,243 @@
+package report
+
+import (
+	"encoding/csv"
+	"fmt"
+	"github.com/chadius/terosgamerules/entity/damagedistribution"
+	"github.com/chadius/terosgamerules/entity/powerusagescenario"
+	"github.com/chadius/terosgamerules/entity/squaddie"
+	"github.com/chadius/terosgamerules/entity/squaddieinterface"
+	"github.com/chadius/terosgamerules/usecase/powerattackforecast"
+	"github.com/chadius/terosgamerules/usecase/repositories"
+	"github.com/chadius/terosgamerules/usecase/squaddiestats"
+	"github.com/chadius/terosgamerules/utility"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// RoundsToKillNever means the power can never reduce the target's hit points to 0.
+const RoundsToKillNever = 0
+
+// BalanceReport compares every attack power against every squaddie.
+//   There is one PowerReport per attack power.
+type BalanceReport struct {
+	AttackerID   string
+	PowerReports []*PowerReport
+}
+
+// PowerVersusSquaddieRow forecasts what happens when the attacker uses the power on a healthy target.
+//   The target starts with full hit points and barrier, no matter how hurt it is in the repository.
+//   Damage and BarrierBurn are for a single strike, after barrier and armor absorb their share.
+//   Powers that make several strikes per use deal them HitsPerUse times.
+//   RoundsToKill counts how many uses in a row it takes to knock out the target, if every strike hits.
+type PowerVersusSquaddieRow struct {
+	TargetID           string
+	TargetName         string
+	ToHitBonus         int
+	ChanceToHitOutOf36 int
+	HitsPerUse         int
+	NormalDamage       int
+	CanCritical        bool
+	CriticalHitDamage  int
+	BarrierBurn        int
+	RoundsToKill       int
+}
+
+// NewBalanceReport forecasts the attacker using every attack power against every other squaddie.
+//   Powers and targets are sorted by ID. The attacker does not need to know the powers.
+func NewBalanceReport(attackerID string, repos *repositories.RepositoryCollection) (*BalanceReport, error) {
+	if repos.SquaddieRepo.GetOriginalSquaddieByID(attackerID) == nil {
+		newError := fmt.Errorf(`balance report attacker "%s" does not exist`, attackerID)
+		utility.Log(newError.Error(), 0, utility.Error)
+		return nil, newError
+	}
+
+	balanceReport := &BalanceReport{
+		AttackerID:   attackerID,
+		PowerReports: []*PowerReport{},
+	}
+
+	for _, powerID := range repos.PowerRepo.GetAllPowerIDs() {
+		powerToReport := repos.PowerRepo.GetPowerByID(powerID)
+		if powerToReport.CanAttack() == false {
+			continue
+		}
+
+		powerReport := &PowerReport{
+			AttackerID:              attackerID,
+			PowerID:                 powerID,
+			PowerName:               powerToReport.Name(),
+			AttackingPowerReports:   []*AttackingPowerReport{},
+			PowerVersusSquaddieRows: []*PowerVersusSquaddieRow{},
+		}
+
+		for _, targetID := range repos.SquaddieRepo.GetAllSquaddieIDs() {
+			if targetID == attackerID {
+				continue
+			}
+
+			row, err := newPowerVersusSquaddieRow(&powerusagescenario.Setup{
+				UserID:          attackerID,
+				PowerID:         powerID,
+				Targets:         []string{targetID},
+				IsCounterAttack: false,
+			}, repos)
+			if err != nil {
+				return nil, err
+			}
+			powerReport.PowerVersusSquaddieRows = append(powerReport.PowerVersusSquaddieRows, row)
+		}
+		balanceReport.PowerReports = append(balanceReport.PowerReports, powerReport)
+	}
+	return balanceReport, nil
+}
+
+// newPowerVersusSquaddieRow forecasts the setup against a healthy copy of the target.
+func newPowerVersusSquaddieRow(setup *powerusagescenario.Setup, repos *repositories.RepositoryCollection) (*PowerVersusSquaddieRow, error) {
+	target := repos.SquaddieRepo.GetOriginalSquaddieByID(setup.Targets[0])
+	healthyTarget, err := repos.SquaddieRepo.CloneSquaddieWithNewID(target, target.ID())
+	if err != nil {
+		return nil, err
+	}
+	healthyTarget.SetHPToMax()
+	healthyTarget.SetBarrierToMax()
+
+	scratchSquaddieRepo := squaddie.NewSquaddieRepository()
+	scratchSquaddieRepo.AddSquaddie(repos.SquaddieRepo.GetOriginalSquaddieByID(setup.UserID))
+	scratchSquaddieRepo.AddSquaddie(healthyTarget)
+	scratchRepos := &repositories.RepositoryCollection{
+		SquaddieRepo: scratchSquaddieRepo,
+		PowerRepo:    repos.PowerRepo,
+		ItemRepo:     repos.ItemRepo,
+		FactionRepo:  repos.FactionRepo,
+	}
+
+	attack := calculateAttackForecast(setup, scratchRepos)
+	row := &PowerVersusSquaddieRow{
+		TargetID:           healthyTarget.ID(),
+		TargetName:         healthyTarget.Name(),
+		ToHitBonus:         attack.VersusContext.ToHit().ToHitBonus,
+		ChanceToHitOutOf36: damagedistribution.ChanceToHitOutOf36(attack.VersusContext.ToHit().ToHitBonus),
+		HitsPerUse:         attack.AttackerContext.HitsPerUse(),
+		NormalDamage:       attack.VersusContext.NormalDamage().RawDamageDealt,
+		CanCritical:        attack.VersusContext.CanCritical(),
+		BarrierBurn:        attack.VersusContext.NormalDamage().TotalRawBarrierBurnt,
+	}
+	if row.CanCritical {
+		row.CriticalHitDamage = attack.VersusContext.CriticalHitDamage().RawDamageDealt
+	}
+	row.RoundsToKill = calculateRoundsToKill(setup, row.HitsPerUse, healthyTarget, scratchRepos)
+	return row, nil
+}
+
+func calculateAttackForecast(setup *powerusagescenario.Setup, repos *repositories.RepositoryCollection) *powerattackforecast.AttackForecast {
+	forecast := powerattackforecast.NewForecastBuilder().
+		Setup(setup).
+		Repositories(repos).
+		OffenseStrategy(&squaddiestats.CalculateSquaddieOffenseStats{}).
+		Build()
+	return forecast.CalculateAttackForecast(setup.Targets[0])
+}
+
+// calculateRoundsToKill uses the power on the target until it falls, striking hitsPerUse times each round.
+//   Returns RoundsToKillNever if the attack stops making progress first.
+func calculateRoundsToKill(setup *powerusagescenario.Setup, hitsPerUse int, target squaddieinterface.Interface, repos *repositories.RepositoryCollection) int {
+	rounds := 0
+	for {
+		rounds++
+		for strike := 0; strike < hitsPerUse; strike++ {
+			damage := calculateAttackForecast(setup, repos).VersusContext.NormalDamage()
+			if damage.IsFatalToTarget {
+				return rounds
+			}
+			if damage.RawDamageDealt == 0 && damage.TotalRawBarrierBurnt == 0 {
+				return RoundsToKillNever
+			}
+			target.ReduceBarrier(damage.TotalRawBarrierBurnt)
+			target.ReduceHitPoints(damage.RawDamageDealt)
+		}
+	}
+}
+
+var balanceReportHeaders = []string{
+	"Power",
+	"Target",
+	"To Hit Bonus",
+	"Chance To Hit (out of 36)",
+	"Hits Per Use",
+	"Normal Damage Per Strike",
+	"Critical Hit Damage Per Strike",
+	"Barrier Burn Per Strike",
+	"Rounds To Kill",
+}
+
+func (row *PowerVersusSquaddieRow) getCells(powerName string) []string {
+	criticalHitDamage := "-"
+	if row.CanCritical {
+		criticalHitDamage = strconv.Itoa(row.CriticalHitDamage)
+	}
+
+	roundsToKill := "never"
+	if row.RoundsToKill != RoundsToKillNever {
+		roundsToKill = strconv.Itoa(row.RoundsToKill)
+	}
+
+	return []string{
+		powerName,
+		row.TargetName,
+		strconv.Itoa(row.ToHitBonus),
+		strconv.Itoa(row.ChanceToHitOutOf36),
+		strconv.Itoa(row.HitsPerUse),
+		strconv.Itoa(row.NormalDamage),
+		criticalHitDamage,
+		strconv.Itoa(row.BarrierBurn),
+		roundsToKill,
+	}
+}
+
+// WriteCSV writes the report as comma separated values, with a header row.
+func (b *BalanceReport) WriteCSV(output io.Writer) error {
+	csvWriter := csv.NewWriter(output)
+	err := csvWriter.Write(balanceReportHeaders)
+	if err != nil {
+		return err
+	}
+	for _, powerReport := range b.PowerReports {
+		for _, row := range powerReport.PowerVersusSquaddieRows {
+			err = csvWriter.Write(row.getCells(powerReport.PowerName))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+// WriteMarkdown writes the report as a Markdown table.
+func (b *BalanceReport) WriteMarkdown(output io.Writer) error {
+	separators := []string{}
+	for range balanceReportHeaders {
+		separators = append(separators, "---")
+	}
+
+	lines := [][]string{balanceReportHeaders, separators}
+	for _, powerReport := range b.PowerReports {
+		for _, row := range powerReport.PowerVersusSquaddieRows {
+			cells := []string{}
+			for _, cell := range row.getCells(powerReport.PowerName) {
+				cells = append(cells, strings.ReplaceAll(cell, "|", `\|`))
+			}
+			lines = append(lines, cells)
+		}
+	}
+
+	for _, cells := range lines {
+		_, err := io.WriteString(output, "| "+strings.Join(cells, " | ")+" |\n")
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
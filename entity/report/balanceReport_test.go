@@ -0,0 +1,164 @@
+package report_test
+
+import (
+	"github.com/chadius/terosgamerules/entity/power"
+	"github.com/chadius/terosgamerules/entity/powerinterface"
+	"github.com/chadius/terosgamerules/entity/powerrepository"
+	"github.com/chadius/terosgamerules/entity/report"
+	"github.com/chadius/terosgamerules/entity/squaddie"
+	"github.com/chadius/terosgamerules/entity/squaddieinterface"
+	"github.com/chadius/terosgamerules/usecase/repositories"
+	. "gopkg.in/check.v1"
+	"strings"
+	"testing"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type BalanceReportSuite struct {
+	teros      squaddieinterface.Interface
+	bandit     squaddieinterface.Interface
+	mysticMage squaddieinterface.Interface
+
+	axe          powerinterface.Interface
+	blot         powerinterface.Interface
+	healingStaff powerinterface.Interface
+
+	repos *repositories.RepositoryCollection
+}
+
+var _ = Suite(&BalanceReportSuite{})
+
+func (suite *BalanceReportSuite) SetUpTest(checker *C) {
+	suite.teros = squaddie.NewSquaddieBuilder().Teros().Strength(2).Mind(1).Build()
+	suite.bandit = squaddie.NewSquaddieBuilder().Bandit().HitPoints(5).Armor(1).Build()
+	suite.mysticMage = squaddie.NewSquaddieBuilder().MysticMage().HitPoints(5).Barrier(3).Deflect(1).Armor(9).Build()
+	suite.mysticMage.SetBarrierToMax()
+
+	suite.axe = power.NewPowerBuilder().Axe().Build()
+	suite.blot = power.NewPowerBuilder().Blot().Build()
+	suite.healingStaff = power.NewPowerBuilder().HealingStaff().Build()
+
+	squaddieRepo := squaddie.NewSquaddieRepository()
+	squaddieRepo.AddSquaddies([]squaddieinterface.Interface{suite.teros, suite.bandit, suite.mysticMage})
+
+	powerRepo := powerrepository.NewPowerRepository()
+	powerRepo.AddSlicePowerSource([]powerinterface.Interface{suite.axe, suite.blot, suite.healingStaff})
+
+	suite.repos = &repositories.RepositoryCollection{
+		SquaddieRepo: squaddieRepo,
+		PowerRepo:    powerRepo,
+	}
+}
+
+func (suite *BalanceReportSuite) TestReportsEveryAttackPowerAgainstEveryOtherSquaddie(checker *C) {
+	balanceReport, err := report.NewBalanceReport(suite.teros.ID(), suite.repos)
+	checker.Assert(err, IsNil)
+	checker.Assert(balanceReport.PowerReports, HasLen, 2)
+
+	axeReport := balanceReport.PowerReports[0]
+	checker.Assert(axeReport.AttackerID, Equals, suite.teros.ID())
+	checker.Assert(axeReport.PowerID, Equals, suite.axe.ID())
+	checker.Assert(axeReport.PowerVersusSquaddieRows, HasLen, 2)
+	checker.Assert(axeReport.PowerVersusSquaddieRows[0].TargetID, Equals, suite.bandit.ID())
+	checker.Assert(axeReport.PowerVersusSquaddieRows[1].TargetID, Equals, suite.mysticMage.ID())
+
+	blotReport := balanceReport.PowerReports[1]
+	checker.Assert(blotReport.PowerID, Equals, suite.blot.ID())
+	checker.Assert(blotReport.PowerVersusSquaddieRows, HasLen, 2)
+	checker.Assert(blotReport.PowerVersusSquaddieRows[0].TargetID, Equals, suite.bandit.ID())
+	checker.Assert(blotReport.PowerVersusSquaddieRows[1].TargetID, Equals, suite.mysticMage.ID())
+}
+
+func (suite *BalanceReportSuite) TestUsesTheForecast(checker *C) {
+	balanceReport, _ := report.NewBalanceReport(suite.teros.ID(), suite.repos)
+	axeVersusBandit := balanceReport.PowerReports[0].PowerVersusSquaddieRows[0]
+	checker.Assert(axeVersusBandit.ToHitBonus, Equals, 1)
+	checker.Assert(axeVersusBandit.ChanceToHitOutOf36, Equals, 26)
+	checker.Assert(axeVersusBandit.NormalDamage, Equals, 2)
+	checker.Assert(axeVersusBandit.BarrierBurn, Equals, 0)
+	checker.Assert(axeVersusBandit.RoundsToKill, Equals, 3)
+
+	blotVersusMage := balanceReport.PowerReports[1].PowerVersusSquaddieRows[1]
+	checker.Assert(blotVersusMage.ToHitBonus, Equals, -1)
+	checker.Assert(blotVersusMage.ChanceToHitOutOf36, Equals, 15)
+	checker.Assert(blotVersusMage.NormalDamage, Equals, 1)
+	checker.Assert(blotVersusMage.BarrierBurn, Equals, 3)
+	checker.Assert(blotVersusMage.RoundsToKill, Equals, 2)
+}
+
+func (suite *BalanceReportSuite) TestRoundsToKillCountsEveryStrikeEachRound(checker *C) {
+	twinAxe := power.NewPowerBuilder().Axe().WithName("twin axe").WithID("powerTwinAxe").HitsPerUse(2).Build()
+	suite.repos.PowerRepo.AddSlicePowerSource([]powerinterface.Interface{twinAxe})
+
+	balanceReport, _ := report.NewBalanceReport(suite.teros.ID(), suite.repos)
+	checker.Assert(balanceReport.PowerReports, HasLen, 3)
+	twinAxeVersusBandit := balanceReport.PowerReports[2].PowerVersusSquaddieRows[0]
+	checker.Assert(twinAxeVersusBandit.HitsPerUse, Equals, 2)
+	checker.Assert(twinAxeVersusBandit.NormalDamage, Equals, 2)
+	checker.Assert(twinAxeVersusBandit.RoundsToKill, Equals, 2)
+
+	axeVersusBandit := balanceReport.PowerReports[0].PowerVersusSquaddieRows[0]
+	checker.Assert(axeVersusBandit.HitsPerUse, Equals, 1)
+	checker.Assert(axeVersusBandit.RoundsToKill, Equals, 3)
+}
+
+func (suite *BalanceReportSuite) TestRoundsToKillCanBeNever(checker *C) {
+	balanceReport, _ := report.NewBalanceReport(suite.teros.ID(), suite.repos)
+	axeVersusMage := balanceReport.PowerReports[0].PowerVersusSquaddieRows[1]
+	checker.Assert(axeVersusMage.NormalDamage, Equals, 0)
+	checker.Assert(axeVersusMage.RoundsToKill, Equals, report.RoundsToKillNever)
+}
+
+func (suite *BalanceReportSuite) TestDoesNotChangeTheTargets(checker *C) {
+	report.NewBalanceReport(suite.teros.ID(), suite.repos)
+	checker.Assert(suite.bandit.CurrentHitPoints(), Equals, 5)
+	checker.Assert(suite.mysticMage.CurrentBarrier(), Equals, 3)
+}
+
+func (suite *BalanceReportSuite) TestTargetsStartHealthy(checker *C) {
+	suite.bandit.ReduceHitPoints(4)
+	suite.mysticMage.ReduceBarrier(3)
+
+	balanceReport, _ := report.NewBalanceReport(suite.teros.ID(), suite.repos)
+	axeVersusBandit := balanceReport.PowerReports[0].PowerVersusSquaddieRows[0]
+	checker.Assert(axeVersusBandit.RoundsToKill, Equals, 3)
+
+	blotVersusMage := balanceReport.PowerReports[1].PowerVersusSquaddieRows[1]
+	checker.Assert(blotVersusMage.NormalDamage, Equals, 1)
+	checker.Assert(blotVersusMage.BarrierBurn, Equals, 3)
+	checker.Assert(blotVersusMage.RoundsToKill, Equals, 2)
+
+	checker.Assert(suite.bandit.CurrentHitPoints(), Equals, 1)
+	checker.Assert(suite.mysticMage.CurrentBarrier(), Equals, 0)
+}
+
+func (suite *BalanceReportSuite) TestRaisesAnErrorForUnknownAttacker(checker *C) {
+	_, err := report.NewBalanceReport("squaddieDoesNotExist", suite.repos)
+	checker.Assert(err, ErrorMatches, `balance report attacker "squaddieDoesNotExist" does not exist`)
+}
+
+func (suite *BalanceReportSuite) TestWritesCSV(checker *C) {
+	balanceReport, _ := report.NewBalanceReport(suite.teros.ID(), suite.repos)
+	var output strings.Builder
+	err := balanceReport.WriteCSV(&output)
+	checker.Assert(err, IsNil)
+
+	lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+	checker.Assert(lines, HasLen, 5)
+	checker.Assert(lines[0], Equals, "Power,Target,To Hit Bonus,Chance To Hit (out of 36),Hits Per Use,Normal Damage Per Strike,Critical Hit Damage Per Strike,Barrier Burn Per Strike,Rounds To Kill")
+	checker.Assert(lines[2], Equals, "axe,Mystic Mage,1,26,1,0,-,3,never")
+}
+
+func (suite *BalanceReportSuite) TestWritesMarkdown(checker *C) {
+	balanceReport, _ := report.NewBalanceReport(suite.teros.ID(), suite.repos)
+	var output strings.Builder
+	err := balanceReport.WriteMarkdown(&output)
+	checker.Assert(err, IsNil)
+
+	lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+	checker.Assert(lines, HasLen, 6)
+	checker.Assert(lines[0], Equals, "| Power | Target | To Hit Bonus | Chance To Hit (out of 36) | Hits Per Use | Normal Damage Per Strike | Critical Hit Damage Per Strike | Barrier Burn Per Strike | Rounds To Kill |")
+	checker.Assert(lines[1], Equals, "| --- | --- | --- | --- | --- | --- | --- | --- | --- |")
+	checker.Assert(lines[5], Matches, `\| blot \| Mystic Mage \| -1 \| 15 \| 1 \| 1 \| .* \| 3 \| 2 \|`)
+}
@@ -1,10 +1,13 @@
 package report
 
 // PowerReport shows what happened after committing to using the power.
+//   Balance reports fill PowerVersusSquaddieRows instead, to show what would happen against each target.
 type PowerReport struct {
-	AttackerID            string
-	PowerID               string
-	AttackingPowerReports []*AttackingPowerReport
+	AttackerID              string
+	PowerID                 string
+	PowerName               string
+	AttackingPowerReports   []*AttackingPowerReport
+	PowerVersusSquaddieRows []*PowerVersusSquaddieRow
 }
 
 // AttackingPowerReport shows what happened after using a power with an attackEffect.
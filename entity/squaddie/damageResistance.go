@@ -0,0 +1,31 @@
+package squaddie
+
+// DamageResistance tracks how well the squaddie resists each damage type.
+//   Percentages are positive for resistances and negative for weaknesses.
+type DamageResistance struct {
+	percentByDamageType map[string]int
+}
+
+// NewDamageResistance returns a new DamageResistance object.
+func NewDamageResistance(percentByDamageType map[string]int) *DamageResistance {
+	resistance := &DamageResistance{percentByDamageType: map[string]int{}}
+	for damageType, percent := range percentByDamageType {
+		resistance.percentByDamageType[damageType] = percent
+	}
+	return resistance
+}
+
+// ResistancePercent returns the percentage of damage of this type the squaddie resists.
+//   Negative values mean the squaddie takes extra damage.
+func (resistance *DamageResistance) ResistancePercent(damageType string) int {
+	return resistance.percentByDamageType[damageType]
+}
+
+// ResistancePercentByDamageType returns a copy of every resistance and weakness.
+func (resistance *DamageResistance) ResistancePercentByDamageType() map[string]int {
+	percentByDamageType := map[string]int{}
+	for damageType, percent := range resistance.percentByDamageType {
+		percentByDamageType[damageType] = percent
+	}
+	return percentByDamageType
+}
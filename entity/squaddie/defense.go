@@ -95,6 +95,17 @@ func (defense *Defense) GainHitPoints(hitPoints int) int {
 	return actualHealingReceived
 }
 
+// GainBarrier restores the squaddie's Barrier and returns the amount restored.
+//   Barrier cannot be raised above the maximum.
+func (defense *Defense) GainBarrier(barrier int) int {
+	actualBarrierGained := barrier
+	if defense.currentBarrier+actualBarrierGained >= defense.maxBarrier {
+		actualBarrierGained = defense.maxBarrier - defense.currentBarrier
+	}
+	defense.currentBarrier += actualBarrierGained
+	return actualBarrierGained
+}
+
 // MaxHitPoints returns the value.
 func (defense *Defense) MaxHitPoints() int {
 	return defense.maxHitPoints
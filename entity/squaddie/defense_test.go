@@ -94,6 +94,14 @@ func (suite *SquaddieDefenseSuite) TestGainHitPoints(checker *C) {
 	checker.Assert(healingAmount, Equals, suite.teros.MaxHitPoints()-1)
 }
 
+func (suite *SquaddieDefenseSuite) TestGainBarrier(checker *C) {
+	suite.teros.SetBarrierToMax()
+	suite.teros.ReduceBarrier(suite.teros.MaxBarrier() - 1)
+	barrierGained := suite.teros.GainBarrier(suite.teros.MaxBarrier())
+	checker.Assert(barrierGained, Equals, suite.teros.MaxBarrier()-1)
+	checker.Assert(suite.teros.CurrentBarrier(), Equals, suite.teros.MaxBarrier())
+}
+
 type improveDefense struct {
 	initialDefense *squaddie.Defense
 }
@@ -0,0 +1,53 @@
+package squaddie
+
+// ExperiencePerLevelUp is the number of experience points a squaddie needs to earn a level up.
+const ExperiencePerLevelUp = 100
+
+// Experience tracks the squaddie's experience points and the level ups they earned but have not applied yet.
+type Experience struct {
+	experiencePoints int
+	levelUpsPending  int
+}
+
+// NewExperience returns a new Experience object.
+func NewExperience(experiencePoints, levelUpsPending int) *Experience {
+	return &Experience{
+		experiencePoints: experiencePoints,
+		levelUpsPending:  levelUpsPending,
+	}
+}
+
+// ExperiencePoints returns the value.
+func (experience *Experience) ExperiencePoints() int {
+	return experience.experiencePoints
+}
+
+// LevelUpsPending returns the value.
+func (experience *Experience) LevelUpsPending() int {
+	return experience.levelUpsPending
+}
+
+// GainExperience adds the experience points.
+//   Every ExperiencePerLevelUp points are converted into a pending level up.
+//   Returns the number of level ups queued by this gain.
+func (experience *Experience) GainExperience(experiencePoints int) int {
+	if experiencePoints <= 0 {
+		return 0
+	}
+
+	experience.experiencePoints += experiencePoints
+	levelUpsQueued := experience.experiencePoints / ExperiencePerLevelUp
+	experience.experiencePoints = experience.experiencePoints % ExperiencePerLevelUp
+	experience.levelUpsPending += levelUpsQueued
+	return levelUpsQueued
+}
+
+// ConsumePendingLevelUp removes one pending level up.
+//   Returns false if there were no level ups to consume.
+func (experience *Experience) ConsumePendingLevelUp() bool {
+	if experience.levelUpsPending <= 0 {
+		return false
+	}
+	experience.levelUpsPending--
+	return true
+}
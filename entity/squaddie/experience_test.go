@@ -0,0 +1,51 @@
+package squaddie_test
+
+import (
+	"github.com/chadius/terosgamerules/entity/squaddie"
+	. "gopkg.in/check.v1"
+)
+
+type SquaddieExperienceSuite struct {
+	experience *squaddie.Experience
+}
+
+var _ = Suite(&SquaddieExperienceSuite{})
+
+func (suite *SquaddieExperienceSuite) SetUpTest(checker *C) {
+	suite.experience = squaddie.NewExperience(0, 0)
+}
+
+func (suite *SquaddieExperienceSuite) TestGainExperienceBelowThreshold(checker *C) {
+	levelUpsQueued := suite.experience.GainExperience(40)
+	checker.Assert(levelUpsQueued, Equals, 0)
+	checker.Assert(suite.experience.ExperiencePoints(), Equals, 40)
+	checker.Assert(suite.experience.LevelUpsPending(), Equals, 0)
+}
+
+func (suite *SquaddieExperienceSuite) TestCrossingThresholdQueuesLevelUpAndKeepsRemainder(checker *C) {
+	suite.experience.GainExperience(90)
+	levelUpsQueued := suite.experience.GainExperience(30)
+	checker.Assert(levelUpsQueued, Equals, 1)
+	checker.Assert(suite.experience.ExperiencePoints(), Equals, 20)
+	checker.Assert(suite.experience.LevelUpsPending(), Equals, 1)
+}
+
+func (suite *SquaddieExperienceSuite) TestLargeGainQueuesMultipleLevelUps(checker *C) {
+	levelUpsQueued := suite.experience.GainExperience(250)
+	checker.Assert(levelUpsQueued, Equals, 2)
+	checker.Assert(suite.experience.ExperiencePoints(), Equals, 50)
+	checker.Assert(suite.experience.LevelUpsPending(), Equals, 2)
+}
+
+func (suite *SquaddieExperienceSuite) TestIgnoresNonPositiveGains(checker *C) {
+	checker.Assert(suite.experience.GainExperience(-10), Equals, 0)
+	checker.Assert(suite.experience.ExperiencePoints(), Equals, 0)
+}
+
+func (suite *SquaddieExperienceSuite) TestConsumePendingLevelUp(checker *C) {
+	checker.Assert(suite.experience.ConsumePendingLevelUp(), Equals, false)
+
+	suite.experience.GainExperience(squaddie.ExperiencePerLevelUp)
+	checker.Assert(suite.experience.ConsumePendingLevelUp(), Equals, true)
+	checker.Assert(suite.experience.LevelUpsPending(), Equals, 0)
+}
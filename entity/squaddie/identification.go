@@ -10,6 +10,12 @@ type Identification struct {
 	squaddieID       string
 	squaddieName     string
 	affiliationLogic affiliation.Interface
+
+	affiliationOverride      affiliation.Interface
+	affiliationOverrideTurns int
+
+	summonerID  string
+	summonTurns int
 }
 
 // NewIdentification creates a new Identification object.
@@ -37,6 +43,110 @@ func (identification *Identification) Name() string {
 }
 
 // AffiliationLogic shows what affiliation the squaddie is a part of.
+//   Temporary overrides take priority over the squaddie's base affiliation.
 func (identification *Identification) AffiliationLogic() affiliation.Interface {
+	if identification.HasAffiliationOverride() {
+		return identification.affiliationOverride
+	}
 	return identification.affiliationLogic
 }
+
+// BaseAffiliationLogic shows what affiliation the squaddie belongs to, ignoring overrides.
+func (identification *Identification) BaseAffiliationLogic() affiliation.Interface {
+	return identification.affiliationLogic
+}
+
+// ChangeBaseAffiliation makes the squaddie belong to the new affiliation.
+//   Temporary overrides still take priority until they wear off.
+func (identification *Identification) ChangeBaseAffiliation(newAffiliation affiliation.Interface) {
+	if newAffiliation == nil {
+		return
+	}
+	identification.affiliationLogic = newAffiliation
+}
+
+// OverrideAffiliation makes the squaddie act as part of the new affiliation for a number of turns.
+//   Replaces any existing override. Overrides without turns are ignored.
+func (identification *Identification) OverrideAffiliation(newAffiliation affiliation.Interface, turns int) {
+	if newAffiliation == nil || turns <= 0 {
+		return
+	}
+	identification.affiliationOverride = newAffiliation
+	identification.affiliationOverrideTurns = turns
+}
+
+// HasAffiliationOverride returns true if the squaddie is temporarily acting for another affiliation.
+func (identification *Identification) HasAffiliationOverride() bool {
+	return identification.affiliationOverride != nil && identification.affiliationOverrideTurns > 0
+}
+
+// AffiliationOverrideTurnsRemaining returns the number of turns until the override wears off.
+func (identification *Identification) AffiliationOverrideTurnsRemaining() int {
+	if !identification.HasAffiliationOverride() {
+		return 0
+	}
+	return identification.affiliationOverrideTurns
+}
+
+// ReduceAffiliationOverrideDuration uses up a turn of the override.
+//   Returns true if the override wore off and the squaddie returned to its base affiliation.
+func (identification *Identification) ReduceAffiliationOverrideDuration() bool {
+	if !identification.HasAffiliationOverride() {
+		return false
+	}
+	identification.affiliationOverrideTurns--
+	if identification.affiliationOverrideTurns > 0 {
+		return false
+	}
+	identification.affiliationOverride = nil
+	identification.affiliationOverrideTurns = 0
+	return true
+}
+
+// RemoveAffiliationOverride returns the squaddie to its base affiliation right away.
+//   Returns true if there was an override to remove.
+func (identification *Identification) RemoveAffiliationOverride() bool {
+	if !identification.HasAffiliationOverride() {
+		return false
+	}
+	identification.affiliationOverride = nil
+	identification.affiliationOverrideTurns = 0
+	return true
+}
+
+// MarkAsSummon records the squaddie as summoned by the summoner.
+//   The summon vanishes after the number of turns. If turns is 0, it lasts until it falls.
+func (identification *Identification) MarkAsSummon(summonerID string, turns int) {
+	identification.summonerID = summonerID
+	identification.summonTurns = turns
+	if turns < 0 {
+		identification.summonTurns = 0
+	}
+}
+
+// SummonerID returns the ID of the squaddie that summoned this one.
+//   Returns an empty string if the squaddie was not summoned.
+func (identification *Identification) SummonerID() string {
+	return identification.summonerID
+}
+
+// IsSummon returns true if another squaddie summoned this one.
+func (identification *Identification) IsSummon() bool {
+	return identification.summonerID != ""
+}
+
+// SummonTurnsRemaining returns the number of turns until the summon vanishes.
+//   0 means the summon lasts until it falls.
+func (identification *Identification) SummonTurnsRemaining() int {
+	return identification.summonTurns
+}
+
+// ReduceSummonDuration uses up a turn of the summon's duration.
+//   Returns true if the summon's time ran out.
+func (identification *Identification) ReduceSummonDuration() bool {
+	if !identification.IsSummon() || identification.summonTurns <= 0 {
+		return false
+	}
+	identification.summonTurns--
+	return identification.summonTurns == 0
+}
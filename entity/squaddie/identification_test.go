@@ -1,6 +1,7 @@
 package squaddie_test
 
 import (
+	"github.com/chadius/terosgamerules/entity/affiliation"
 	"github.com/chadius/terosgamerules/entity/squaddie"
 	"github.com/chadius/terosgamerules/entity/squaddieclass"
 	"github.com/chadius/terosgamerules/entity/squaddieinterface"
@@ -35,3 +36,135 @@ func (suite *SquaddieIdentificationCreationTests) TestGetANewID(checker *C) {
 	suite.teros.SetNewIDToRandom()
 	checker.Assert(suite.teros.ID(), Not(Equals), initialID)
 }
+
+type AffiliationOverrideSuite struct {
+	teros  squaddieinterface.Interface
+	bandit squaddieinterface.Interface
+}
+
+var _ = Suite(&AffiliationOverrideSuite{})
+
+func (suite *AffiliationOverrideSuite) SetUpTest(checker *C) {
+	suite.teros = squaddie.NewSquaddieBuilder().Teros().Build()
+	suite.bandit = squaddie.NewSquaddieBuilder().Bandit().Build()
+}
+
+func (suite *AffiliationOverrideSuite) TestSquaddiesHaveNoOverrideByDefault(checker *C) {
+	checker.Assert(suite.bandit.HasAffiliationOverride(), Equals, false)
+	checker.Assert(suite.bandit.AffiliationOverrideTurnsRemaining(), Equals, 0)
+	checker.Assert(suite.bandit.AffiliationLogic().Name(), Equals, "enemy")
+	checker.Assert(suite.bandit.ReduceAffiliationOverrideDuration(), Equals, false)
+}
+
+func (suite *AffiliationOverrideSuite) TestOverrideChangesAffiliation(checker *C) {
+	suite.bandit.OverrideAffiliation(suite.teros.AffiliationLogic(), 2)
+
+	checker.Assert(suite.bandit.HasAffiliationOverride(), Equals, true)
+	checker.Assert(suite.bandit.AffiliationOverrideTurnsRemaining(), Equals, 2)
+	checker.Assert(suite.bandit.AffiliationLogic().Name(), Equals, "player")
+	checker.Assert(suite.bandit.BaseAffiliationLogic().Name(), Equals, "enemy")
+	checker.Assert(suite.bandit.AffiliationLogic().IsFriendsWith(suite.teros.AffiliationLogic()), Equals, true)
+}
+
+func (suite *AffiliationOverrideSuite) TestOverrideWithoutTurnsIsIgnored(checker *C) {
+	suite.bandit.OverrideAffiliation(suite.teros.AffiliationLogic(), 0)
+	checker.Assert(suite.bandit.HasAffiliationOverride(), Equals, false)
+	checker.Assert(suite.bandit.AffiliationLogic().Name(), Equals, "enemy")
+}
+
+func (suite *AffiliationOverrideSuite) TestOverrideRevertsWhenItWearsOff(checker *C) {
+	suite.bandit.OverrideAffiliation(suite.teros.AffiliationLogic(), 2)
+
+	checker.Assert(suite.bandit.ReduceAffiliationOverrideDuration(), Equals, false)
+	checker.Assert(suite.bandit.AffiliationLogic().Name(), Equals, "player")
+	checker.Assert(suite.bandit.AffiliationOverrideTurnsRemaining(), Equals, 1)
+
+	checker.Assert(suite.bandit.ReduceAffiliationOverrideDuration(), Equals, true)
+	checker.Assert(suite.bandit.HasAffiliationOverride(), Equals, false)
+	checker.Assert(suite.bandit.AffiliationLogic().Name(), Equals, "enemy")
+}
+
+func (suite *AffiliationOverrideSuite) TestOverrideCanBeRemovedEarly(checker *C) {
+	suite.bandit.OverrideAffiliation(suite.teros.AffiliationLogic(), 2)
+
+	checker.Assert(suite.bandit.RemoveAffiliationOverride(), Equals, true)
+	checker.Assert(suite.bandit.HasAffiliationOverride(), Equals, false)
+	checker.Assert(suite.bandit.AffiliationLogic().Name(), Equals, "enemy")
+	checker.Assert(suite.bandit.RemoveAffiliationOverride(), Equals, false)
+}
+
+func (suite *AffiliationOverrideSuite) TestOverrideDoesNotChangeStats(checker *C) {
+	charmedBandit := squaddie.NewSquaddieBuilder().Bandit().WithID(suite.bandit.ID()).Build()
+	charmedBandit.OverrideAffiliation(suite.teros.AffiliationLogic(), 2)
+	checker.Assert(charmedBandit.HasSameStatsAs(suite.bandit), Equals, true)
+}
+
+func (suite *AffiliationOverrideSuite) TestClonesKeepTheOverride(checker *C) {
+	suite.bandit.OverrideAffiliation(suite.teros.AffiliationLogic(), 2)
+	repo := squaddie.NewSquaddieRepository()
+	clone, _ := repo.CloneSquaddieWithNewID(suite.bandit, "")
+
+	checker.Assert(clone.BaseAffiliationLogic().Name(), Equals, "enemy")
+	checker.Assert(clone.AffiliationLogic().Name(), Equals, "player")
+	checker.Assert(clone.AffiliationOverrideTurnsRemaining(), Equals, 2)
+}
+
+func (suite *AffiliationOverrideSuite) TestChangeBaseAffiliation(checker *C) {
+	suite.bandit.ChangeBaseAffiliation(suite.teros.AffiliationLogic())
+	checker.Assert(suite.bandit.BaseAffiliationLogic().Name(), Equals, "player")
+	checker.Assert(suite.bandit.AffiliationLogic().Name(), Equals, "player")
+}
+
+func (suite *AffiliationOverrideSuite) TestOverrideTakesPriorityOverChangedBaseAffiliation(checker *C) {
+	suite.teros.OverrideAffiliation(suite.bandit.AffiliationLogic(), 1)
+	suite.teros.ChangeBaseAffiliation(affiliation.NewFaction("deserters"))
+
+	checker.Assert(suite.teros.AffiliationLogic().Name(), Equals, "enemy")
+	checker.Assert(suite.teros.ReduceAffiliationOverrideDuration(), Equals, true)
+	checker.Assert(suite.teros.AffiliationLogic().Name(), Equals, "deserters")
+}
+
+type SummonSuite struct {
+	teros squaddieinterface.Interface
+	wolf  squaddieinterface.Interface
+}
+
+var _ = Suite(&SummonSuite{})
+
+func (suite *SummonSuite) SetUpTest(checker *C) {
+	suite.teros = squaddie.NewSquaddieBuilder().Teros().Build()
+	suite.wolf = squaddie.NewSquaddieBuilder().WithName("wolf").WithID("wolf").AsPlayer().Build()
+}
+
+func (suite *SummonSuite) TestSquaddiesAreNotSummonsByDefault(checker *C) {
+	checker.Assert(suite.teros.IsSummon(), Equals, false)
+	checker.Assert(suite.teros.SummonerID(), Equals, "")
+	checker.Assert(suite.teros.ReduceSummonDuration(), Equals, false)
+}
+
+func (suite *SummonSuite) TestSummonVanishesWhenItsTimeRunsOut(checker *C) {
+	suite.wolf.MarkAsSummon(suite.teros.ID(), 2)
+	checker.Assert(suite.wolf.IsSummon(), Equals, true)
+	checker.Assert(suite.wolf.SummonerID(), Equals, suite.teros.ID())
+	checker.Assert(suite.wolf.SummonTurnsRemaining(), Equals, 2)
+
+	checker.Assert(suite.wolf.ReduceSummonDuration(), Equals, false)
+	checker.Assert(suite.wolf.SummonTurnsRemaining(), Equals, 1)
+	checker.Assert(suite.wolf.ReduceSummonDuration(), Equals, true)
+	checker.Assert(suite.wolf.SummonTurnsRemaining(), Equals, 0)
+}
+
+func (suite *SummonSuite) TestSummonWithoutTurnsLastsUntilItFalls(checker *C) {
+	suite.wolf.MarkAsSummon(suite.teros.ID(), 0)
+	checker.Assert(suite.wolf.IsSummon(), Equals, true)
+	checker.Assert(suite.wolf.ReduceSummonDuration(), Equals, false)
+}
+
+func (suite *SummonSuite) TestClonesKeepTheSummoner(checker *C) {
+	suite.wolf.MarkAsSummon(suite.teros.ID(), 2)
+	repo := squaddie.NewSquaddieRepository()
+	clone, _ := repo.CloneSquaddieWithNewID(suite.wolf, "")
+
+	checker.Assert(clone.SummonerID(), Equals, suite.teros.ID())
+	checker.Assert(clone.SummonTurnsRemaining(), Equals, 2)
+}
@@ -9,6 +9,7 @@ type IdentificationBuilderOptions struct {
 	name             string
 	id               string
 	affiliationLogic affiliation.Interface
+	keywordError     error
 }
 
 // IdentificationBuilder creates a IdentificationBuilderOptions with default values.
@@ -52,6 +53,12 @@ func (i *IdentificationBuilderOptions) AsAlly() *IdentificationBuilderOptions {
 	return i
 }
 
+// InFaction makes the Identification a member of the named faction.
+func (i *IdentificationBuilderOptions) InFaction(factionName string) *IdentificationBuilderOptions {
+	i.affiliationLogic = affiliation.NewFaction(factionName)
+	return i
+}
+
 // AsNeutral makes the Identification as a Neutral.
 func (i *IdentificationBuilderOptions) AsNeutral() *IdentificationBuilderOptions {
 	i.affiliationLogic = &affiliation.Neutral{}
@@ -64,7 +71,23 @@ func (i *IdentificationBuilderOptions) Build() *Identification {
 	return newIdentification
 }
 
-// WithAffiliationLogic sets the affiliation logic.
+// WithAffiliationLogic sets the affiliation logic using the given keyword.
+//   Empty keywords leave the affiliation logic unchanged.
+//   Unknown keywords leave it unchanged and are reported by KeywordError.
 func (i *IdentificationBuilderOptions) WithAffiliationLogic(keyword string) {
-	i.affiliationLogic = affiliation.NewAffiliationLogic(keyword)
+	if keyword == "" {
+		return
+	}
+
+	affiliationLogic, err := affiliation.NewAffiliationLogic(keyword)
+	if err != nil {
+		i.keywordError = err
+		return
+	}
+	i.affiliationLogic = affiliationLogic
+}
+
+// KeywordError returns the error from the last unknown affiliation logic keyword, or nil.
+func (i *IdentificationBuilderOptions) KeywordError() error {
+	return i.keywordError
 }
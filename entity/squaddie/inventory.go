@@ -0,0 +1,91 @@
+package squaddie
+
+import "sort"
+
+// Inventory tracks the items a squaddie carries and the ones it has equipped.
+//   It also remembers which powers each equipped item granted, so they can be taken away when it is unequipped.
+type Inventory struct {
+	itemIDs               []string
+	equippedItemIDBySlot  map[string]string
+	grantedPowerIDsBySlot map[string][]string
+}
+
+// AddItem adds a copy of the item to the inventory.
+func (inventory *Inventory) AddItem(itemID string) {
+	inventory.itemIDs = append(inventory.itemIDs, itemID)
+}
+
+// RemoveItem removes one copy of the item. Returns false if the squaddie does not carry it.
+func (inventory *Inventory) RemoveItem(itemID string) bool {
+	for index, carriedItemID := range inventory.itemIDs {
+		if carriedItemID == itemID {
+			inventory.itemIDs = append(inventory.itemIDs[:index], inventory.itemIDs[index+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// HasItem returns true if the squaddie carries at least one copy of the item.
+func (inventory *Inventory) HasItem(itemID string) bool {
+	return inventory.CountItem(itemID) > 0
+}
+
+// CountItem returns the number of copies of the item the squaddie carries.
+func (inventory *Inventory) CountItem(itemID string) int {
+	count := 0
+	for _, carriedItemID := range inventory.itemIDs {
+		if carriedItemID == itemID {
+			count++
+		}
+	}
+	return count
+}
+
+// GetCopyOfItemIDs returns every item the squaddie carries, in the order they were added.
+func (inventory *Inventory) GetCopyOfItemIDs() []string {
+	return append([]string{}, inventory.itemIDs...)
+}
+
+// EquipItem puts the item in the slot, remembering the powers it granted.
+func (inventory *Inventory) EquipItem(slot, itemID string, grantedPowerIDs []string) {
+	if inventory.equippedItemIDBySlot == nil {
+		inventory.equippedItemIDBySlot = map[string]string{}
+	}
+	if inventory.grantedPowerIDsBySlot == nil {
+		inventory.grantedPowerIDsBySlot = map[string][]string{}
+	}
+
+	inventory.equippedItemIDBySlot[slot] = itemID
+	inventory.grantedPowerIDsBySlot[slot] = append([]string{}, grantedPowerIDs...)
+}
+
+// UnequipItem empties the slot.
+//   Returns the item that was in the slot and the powers it granted.
+func (inventory *Inventory) UnequipItem(slot string) (string, []string) {
+	itemID := inventory.equippedItemIDBySlot[slot]
+	grantedPowerIDs := inventory.grantedPowerIDsBySlot[slot]
+	delete(inventory.equippedItemIDBySlot, slot)
+	delete(inventory.grantedPowerIDsBySlot, slot)
+	return itemID, grantedPowerIDs
+}
+
+// GetEquippedItemID returns the item equipped in the slot, or an empty string if the slot is empty.
+func (inventory *Inventory) GetEquippedItemID(slot string) string {
+	return inventory.equippedItemIDBySlot[slot]
+}
+
+// GetGrantedPowerIDs returns the powers the item in the slot granted when it was equipped.
+func (inventory *Inventory) GetGrantedPowerIDs(slot string) []string {
+	return append([]string{}, inventory.grantedPowerIDsBySlot[slot]...)
+}
+
+// GetEquippedSlots returns every slot with an equipped item, sorted.
+func (inventory *Inventory) GetEquippedSlots() []string {
+	slots := []string{}
+	for slot := range inventory.equippedItemIDBySlot {
+		slots = append(slots, slot)
+	}
+	sort.Strings(slots)
+	return slots
+}
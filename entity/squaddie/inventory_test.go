@@ -0,0 +1,66 @@
+package squaddie_test
+
+import (
+	"github.com/chadius/terosgamerules/entity/squaddie"
+	"github.com/chadius/terosgamerules/entity/squaddieinterface"
+	. "gopkg.in/check.v1"
+)
+
+type SquaddieInventorySuite struct {
+	teros squaddieinterface.Interface
+}
+
+var _ = Suite(&SquaddieInventorySuite{})
+
+func (suite *SquaddieInventorySuite) SetUpTest(checker *C) {
+	suite.teros = squaddie.NewSquaddieBuilder().Teros().AddItem("itemSpear").AddItem("itemPotion").AddItem("itemPotion").Build()
+}
+
+func (suite *SquaddieInventorySuite) TestSquaddieCarriesItems(checker *C) {
+	checker.Assert(suite.teros.GetCopyOfItemIDs(), DeepEquals, []string{"itemSpear", "itemPotion", "itemPotion"})
+	checker.Assert(suite.teros.HasItem("itemSpear"), Equals, true)
+	checker.Assert(suite.teros.CountItem("itemPotion"), Equals, 2)
+	checker.Assert(suite.teros.HasItem("itemMissing"), Equals, false)
+}
+
+func (suite *SquaddieInventorySuite) TestRemoveOneCopyOfAnItem(checker *C) {
+	checker.Assert(suite.teros.RemoveItem("itemPotion"), Equals, true)
+	checker.Assert(suite.teros.CountItem("itemPotion"), Equals, 1)
+	checker.Assert(suite.teros.RemoveItem("itemMissing"), Equals, false)
+}
+
+func (suite *SquaddieInventorySuite) TestEquipAndUnequipItems(checker *C) {
+	suite.teros.EquipItem("weapon", "itemSpear", []string{"powerSpear"})
+	checker.Assert(suite.teros.GetEquippedItemID("weapon"), Equals, "itemSpear")
+	checker.Assert(suite.teros.GetGrantedPowerIDs("weapon"), DeepEquals, []string{"powerSpear"})
+	checker.Assert(suite.teros.GetEquippedItemSlots(), DeepEquals, []string{"weapon"})
+
+	itemID, grantedPowerIDs := suite.teros.UnequipItem("weapon")
+	checker.Assert(itemID, Equals, "itemSpear")
+	checker.Assert(grantedPowerIDs, DeepEquals, []string{"powerSpear"})
+	checker.Assert(suite.teros.GetEquippedItemID("weapon"), Equals, "")
+	checker.Assert(suite.teros.GetEquippedItemSlots(), HasLen, 0)
+}
+
+func (suite *SquaddieInventorySuite) TestCloneCopiesInventory(checker *C) {
+	suite.teros.EquipItem("weapon", "itemSpear", []string{"powerSpear"})
+
+	repo := squaddie.NewSquaddieRepository()
+	clone, _ := repo.CloneSquaddieWithNewID(suite.teros, "")
+	checker.Assert(clone.GetCopyOfItemIDs(), DeepEquals, suite.teros.GetCopyOfItemIDs())
+	checker.Assert(clone.GetEquippedItemID("weapon"), Equals, "itemSpear")
+	checker.Assert(clone.GetGrantedPowerIDs("weapon"), DeepEquals, []string{"powerSpear"})
+}
+
+func (suite *SquaddieInventorySuite) TestLoadItemsFromYAML(checker *C) {
+	yamlTerosBuilder, err := squaddie.NewSquaddieBuilder().UsingYAML([]byte(`
+id: squaddieTeros
+name: Teros
+items:
+  - itemSpear
+  - itemLeather
+`))
+	checker.Assert(err, IsNil)
+	yamlTeros := yamlTerosBuilder.Build()
+	checker.Assert(yamlTeros.GetCopyOfItemIDs(), DeepEquals, []string{"itemSpear", "itemLeather"})
+}
@@ -0,0 +1,56 @@
+package squaddie
+
+import "github.com/chadius/terosgamerules/entity/lingeringeffect"
+
+// LingeringEffects tracks the effects that change the squaddie at the start of each turn.
+type LingeringEffects struct {
+	effects []*lingeringeffect.LingeringEffect
+}
+
+// Add starts tracking a copy of the effect. Expired effects are ignored.
+func (lingeringEffects *LingeringEffects) Add(effect *lingeringeffect.LingeringEffect) {
+	if effect == nil || effect.HasExpired() {
+		return
+	}
+	effectCopy := *effect
+	lingeringEffects.effects = append(lingeringEffects.effects, &effectCopy)
+}
+
+// All returns copies of every effect, in the order they were added.
+func (lingeringEffects *LingeringEffects) All() []*lingeringeffect.LingeringEffect {
+	effectCopies := []*lingeringeffect.LingeringEffect{}
+	for _, effect := range lingeringEffects.effects {
+		effectCopy := *effect
+		effectCopies = append(effectCopies, &effectCopy)
+	}
+	return effectCopies
+}
+
+// Tick uses up a turn of every effect and returns the total hit point change.
+//   Positive values heal the squaddie, negative values hurt them. Expired effects are removed.
+func (lingeringEffects *LingeringEffects) Tick() int {
+	hitPointChange := 0
+	remainingEffects := []*lingeringeffect.LingeringEffect{}
+	for _, effect := range lingeringEffects.effects {
+		hitPointChange += effect.HitPointsPerTurn
+		effect.TurnsRemaining--
+		if !effect.HasExpired() {
+			remainingEffects = append(remainingEffects, effect)
+		}
+	}
+	lingeringEffects.effects = remainingEffects
+	return hitPointChange
+}
+
+// RemoveHarmful removes every harmful effect and returns the number removed.
+func (lingeringEffects *LingeringEffects) RemoveHarmful() int {
+	remainingEffects := []*lingeringeffect.LingeringEffect{}
+	for _, effect := range lingeringEffects.effects {
+		if !effect.IsHarmful() {
+			remainingEffects = append(remainingEffects, effect)
+		}
+	}
+	effectsRemoved := len(lingeringEffects.effects) - len(remainingEffects)
+	lingeringEffects.effects = remainingEffects
+	return effectsRemoved
+}
@@ -0,0 +1,59 @@
+package squaddie_test
+
+import (
+	"github.com/chadius/terosgamerules/entity/lingeringeffect"
+	"github.com/chadius/terosgamerules/entity/squaddie"
+	"github.com/chadius/terosgamerules/entity/squaddieinterface"
+	. "gopkg.in/check.v1"
+)
+
+type SquaddieLingeringEffectsSuite struct {
+	teros squaddieinterface.Interface
+}
+
+var _ = Suite(&SquaddieLingeringEffectsSuite{})
+
+func (suite *SquaddieLingeringEffectsSuite) SetUpTest(checker *C) {
+	suite.teros = squaddie.NewSquaddieBuilder().Teros().HitPoints(10).Build()
+	suite.teros.ReduceHitPoints(5)
+}
+
+func (suite *SquaddieLingeringEffectsSuite) TestHealingOverTimeTicksEachTurnUntilItExpires(checker *C) {
+	suite.teros.AddLingeringEffect(&lingeringeffect.LingeringEffect{Name: "Regrowth", HitPointsPerTurn: 2, TurnsRemaining: 2})
+
+	checker.Assert(suite.teros.ApplyLingeringEffects(), Equals, 2)
+	checker.Assert(suite.teros.CurrentHitPoints(), Equals, 7)
+	checker.Assert(suite.teros.LingeringEffects(), HasLen, 1)
+	checker.Assert(suite.teros.LingeringEffects()[0].TurnsRemaining, Equals, 1)
+
+	checker.Assert(suite.teros.ApplyLingeringEffects(), Equals, 2)
+	checker.Assert(suite.teros.LingeringEffects(), HasLen, 0)
+
+	checker.Assert(suite.teros.ApplyLingeringEffects(), Equals, 0)
+	checker.Assert(suite.teros.CurrentHitPoints(), Equals, 9)
+}
+
+func (suite *SquaddieLingeringEffectsSuite) TestHarmfulEffectsReduceHitPoints(checker *C) {
+	suite.teros.AddLingeringEffect(&lingeringeffect.LingeringEffect{Name: "Poison", HitPointsPerTurn: -3, TurnsRemaining: 3})
+	suite.teros.AddLingeringEffect(&lingeringeffect.LingeringEffect{Name: "Regrowth", HitPointsPerTurn: 1, TurnsRemaining: 3})
+
+	checker.Assert(suite.teros.ApplyLingeringEffects(), Equals, -2)
+	checker.Assert(suite.teros.CurrentHitPoints(), Equals, 3)
+}
+
+func (suite *SquaddieLingeringEffectsSuite) TestRemoveHarmfulEffects(checker *C) {
+	suite.teros.AddLingeringEffect(&lingeringeffect.LingeringEffect{Name: "Poison", HitPointsPerTurn: -3, TurnsRemaining: 3})
+	suite.teros.AddLingeringEffect(&lingeringeffect.LingeringEffect{Name: "Regrowth", HitPointsPerTurn: 1, TurnsRemaining: 3})
+
+	checker.Assert(suite.teros.RemoveHarmfulLingeringEffects(), Equals, 1)
+	checker.Assert(suite.teros.LingeringEffects(), HasLen, 1)
+	checker.Assert(suite.teros.LingeringEffects()[0].Name, Equals, "Regrowth")
+}
+
+func (suite *SquaddieLingeringEffectsSuite) TestEffectsAreCopied(checker *C) {
+	regrowth := &lingeringeffect.LingeringEffect{Name: "Regrowth", HitPointsPerTurn: 1, TurnsRemaining: 3}
+	suite.teros.AddLingeringEffect(regrowth)
+	suite.teros.ApplyLingeringEffects()
+
+	checker.Assert(regrowth.TurnsRemaining, Equals, 3)
+}
@@ -0,0 +1,73 @@
+package squaddie
+
+// Mana is the resource squaddies spend to use powers.
+type Mana struct {
+	currentMana      int
+	maxMana          int
+	manaRegeneration int
+}
+
+// NewMana returns a new Mana object.
+func NewMana(currentMana, maxMana, manaRegeneration int) *Mana {
+	return &Mana{
+		currentMana:      currentMana,
+		maxMana:          maxMana,
+		manaRegeneration: manaRegeneration,
+	}
+}
+
+// CurrentMana returns the value.
+func (mana *Mana) CurrentMana() int {
+	return mana.currentMana
+}
+
+// MaxMana returns the value.
+func (mana *Mana) MaxMana() int {
+	return mana.maxMana
+}
+
+// ManaRegeneration returns the amount of mana regained every turn.
+func (mana *Mana) ManaRegeneration() int {
+	return mana.manaRegeneration
+}
+
+// SetManaToMax restores the Squaddie's Mana.
+func (mana *Mana) SetManaToMax() {
+	mana.currentMana = mana.maxMana
+}
+
+// ReduceMana spends the squaddie's Mana.
+//   Mana cannot be reduced below 0. Returns the amount actually spent.
+func (mana *Mana) ReduceMana(cost int) int {
+	actualManaSpent := cost
+	if mana.currentMana < cost {
+		actualManaSpent = mana.currentMana
+	}
+	mana.currentMana -= actualManaSpent
+	return actualManaSpent
+}
+
+// GainMana restores the squaddie's Mana, up to the maximum.
+//   Returns the amount actually restored.
+func (mana *Mana) GainMana(amount int) int {
+	actualManaRestored := amount
+	if mana.currentMana+actualManaRestored >= mana.maxMana {
+		actualManaRestored = mana.maxMana - mana.currentMana
+	}
+	if actualManaRestored < 0 {
+		return 0
+	}
+	mana.currentMana += actualManaRestored
+	return actualManaRestored
+}
+
+// RegenerateMana restores the Mana the squaddie regains each turn.
+//   Returns the amount actually restored.
+func (mana *Mana) RegenerateMana() int {
+	return mana.GainMana(mana.manaRegeneration)
+}
+
+// CanAfford returns true if the squaddie has at least cost Mana.
+func (mana *Mana) CanAfford(cost int) bool {
+	return mana.currentMana >= cost
+}
@@ -0,0 +1,44 @@
+package squaddie_test
+
+import (
+	"github.com/chadius/terosgamerules/entity/squaddie"
+	. "gopkg.in/check.v1"
+)
+
+type SquaddieManaSuite struct {
+	mana *squaddie.Mana
+}
+
+var _ = Suite(&SquaddieManaSuite{})
+
+func (suite *SquaddieManaSuite) SetUpTest(checker *C) {
+	suite.mana = squaddie.NewMana(5, 5, 2)
+}
+
+func (suite *SquaddieManaSuite) TestReduceManaCannotGoBelowZero(checker *C) {
+	checker.Assert(suite.mana.ReduceMana(3), Equals, 3)
+	checker.Assert(suite.mana.CurrentMana(), Equals, 2)
+	checker.Assert(suite.mana.ReduceMana(3), Equals, 2)
+	checker.Assert(suite.mana.CurrentMana(), Equals, 0)
+}
+
+func (suite *SquaddieManaSuite) TestGainManaCannotExceedMax(checker *C) {
+	suite.mana.ReduceMana(2)
+	checker.Assert(suite.mana.GainMana(5), Equals, 2)
+	checker.Assert(suite.mana.CurrentMana(), Equals, 5)
+}
+
+func (suite *SquaddieManaSuite) TestRegenerateMana(checker *C) {
+	suite.mana.ReduceMana(5)
+	checker.Assert(suite.mana.RegenerateMana(), Equals, 2)
+	checker.Assert(suite.mana.CurrentMana(), Equals, 2)
+
+	suite.mana.SetManaToMax()
+	checker.Assert(suite.mana.RegenerateMana(), Equals, 0)
+}
+
+func (suite *SquaddieManaSuite) TestCanAfford(checker *C) {
+	suite.mana.ReduceMana(2)
+	checker.Assert(suite.mana.CanAfford(3), Equals, true)
+	checker.Assert(suite.mana.CanAfford(4), Equals, false)
+}
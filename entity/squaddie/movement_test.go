@@ -30,11 +30,11 @@ type improveMovement struct {
 var _ = Suite(&improveMovement{})
 
 func (suite *improveMovement) SetUpTest(checker *C) {
-	suite.initialMovement = squaddie.NewMovement(2, false, movement.NewMovementLogic("foot"))
+	suite.initialMovement = squaddie.NewMovement(2, false, &movement.Foot{})
 }
 
 func (suite *improveMovement) TestWhenImproveIsCalled_ThenMovementImproves(checker *C) {
-	suite.initialMovement.Improve(3, true, movement.NewMovementLogic("fly"))
+	suite.initialMovement.Improve(3, true, &movement.Fly{})
 
 	checker.Assert(suite.initialMovement.MovementDistance(), Equals, 5)
 	checker.Assert(reflect.TypeOf(suite.initialMovement.MovementLogic()).String(), Equals, "*movement.Fly")
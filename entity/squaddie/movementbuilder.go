@@ -7,6 +7,7 @@ type MovementBuilderOptions struct {
 	distance      int
 	canHitAndRun  bool
 	movementLogic movement.Interface
+	keywordError  error
 }
 
 // MovementBuilder creates a MovementBuilderOptions with default values.
@@ -16,7 +17,7 @@ func MovementBuilder() *MovementBuilderOptions {
 	return &MovementBuilderOptions{
 		distance:      0,
 		canHitAndRun:  false,
-		movementLogic: movement.NewMovementLogic("foot"),
+		movementLogic: &movement.Foot{},
 	}
 }
 
@@ -53,11 +54,32 @@ func (m *MovementBuilderOptions) Teleport() *MovementBuilderOptions {
 }
 
 // WithMovementLogicKeyword creates movement logic object using the given keyword.
+//   Empty keywords leave the movement logic unchanged.
+//   Unknown keywords leave it unchanged and are reported by KeywordError.
 func (m *MovementBuilderOptions) WithMovementLogicKeyword(keyword string) *MovementBuilderOptions {
-	m.movementLogic = movement.NewMovementLogic(keyword)
+	if keyword == "" {
+		return m
+	}
+
+	movementLogic, err := movement.NewMovementLogic(keyword)
+	if err != nil {
+		m.keywordError = err
+		return m
+	}
+	return m.WithMovementLogic(movementLogic)
+}
+
+// WithMovementLogic sets the movement logic.
+func (m *MovementBuilderOptions) WithMovementLogic(movementLogic movement.Interface) *MovementBuilderOptions {
+	m.movementLogic = movementLogic
 	return m
 }
 
+// KeywordError returns the error from the last unknown movement logic keyword, or nil.
+func (m *MovementBuilderOptions) KeywordError() error {
+	return m.keywordError
+}
+
 // Build uses the MovementBuilderOptions to create a Movement.
 func (m *MovementBuilderOptions) Build() *Movement {
 	newMovement := NewMovement(m.distance, m.canHitAndRun, m.movementLogic)
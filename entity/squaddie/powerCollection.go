@@ -5,9 +5,12 @@ import (
 )
 
 // PowerCollection tracks what powers the squaddie has as well as what is in use.
+//   It also remembers how often each power was used this battle, so cooldowns and charges can be enforced.
 type PowerCollection struct {
-	powerReferences          []*powerreference.Reference
-	currentlyEquippedPowerID string
+	powerReferences            []*powerreference.Reference
+	currentlyEquippedPowerID   string
+	remainingCooldownByPowerID map[string]int
+	chargesUsedByPowerID       map[string]int
 }
 
 // GetCopyOfPowerReferences returns a list of all the powers the squaddie has access to.
@@ -85,3 +88,41 @@ func (powerCollection *PowerCollection) RemovePowerReferenceByPowerID(powerID st
 		)
 	}
 }
+
+// RemainingCooldown returns the number of turns until the power can be used again.
+func (powerCollection *PowerCollection) RemainingCooldown(powerID string) int {
+	return powerCollection.remainingCooldownByPowerID[powerID]
+}
+
+// ChargesUsed returns the number of times the power was used this battle.
+func (powerCollection *PowerCollection) ChargesUsed(powerID string) int {
+	return powerCollection.chargesUsedByPowerID[powerID]
+}
+
+// MarkPowerUsed spends a charge of the power and starts its cooldown.
+func (powerCollection *PowerCollection) MarkPowerUsed(powerID string, cooldown int) {
+	if powerCollection.remainingCooldownByPowerID == nil {
+		powerCollection.remainingCooldownByPowerID = map[string]int{}
+	}
+	if powerCollection.chargesUsedByPowerID == nil {
+		powerCollection.chargesUsedByPowerID = map[string]int{}
+	}
+
+	powerCollection.remainingCooldownByPowerID[powerID] = cooldown
+	powerCollection.chargesUsedByPowerID[powerID]++
+}
+
+// ReduceCooldowns counts down every power's cooldown by 1 turn.
+func (powerCollection *PowerCollection) ReduceCooldowns() {
+	for powerID, remainingCooldown := range powerCollection.remainingCooldownByPowerID {
+		if remainingCooldown > 0 {
+			powerCollection.remainingCooldownByPowerID[powerID] = remainingCooldown - 1
+		}
+	}
+}
+
+// ResetPowerUsage clears all cooldowns and restores all charges, as if a new battle started.
+func (powerCollection *PowerCollection) ResetPowerUsage() {
+	powerCollection.remainingCooldownByPowerID = map[string]int{}
+	powerCollection.chargesUsedByPowerID = map[string]int{}
+}
@@ -91,3 +91,31 @@ func (suite *SquaddiePowerCollectionTests) TestSquaddieHasEquippedPower(checker
 	suite.teros.ClearPowerReferences()
 	checker.Assert(suite.teros.HasEquippedPower(), Equals, false)
 }
+
+func (suite *SquaddiePowerCollectionTests) TestUsingAPowerStartsItsCooldown(checker *C) {
+	checker.Assert(suite.teros.RemainingPowerCooldown(suite.attackA.ID()), Equals, 0)
+
+	suite.teros.MarkPowerUsed(suite.attackA.ID(), 2)
+	checker.Assert(suite.teros.RemainingPowerCooldown(suite.attackA.ID()), Equals, 2)
+
+	suite.teros.ReducePowerCooldowns()
+	checker.Assert(suite.teros.RemainingPowerCooldown(suite.attackA.ID()), Equals, 1)
+	suite.teros.ReducePowerCooldowns()
+	suite.teros.ReducePowerCooldowns()
+	checker.Assert(suite.teros.RemainingPowerCooldown(suite.attackA.ID()), Equals, 0)
+}
+
+func (suite *SquaddiePowerCollectionTests) TestUsingAPowerSpendsACharge(checker *C) {
+	checker.Assert(suite.teros.PowerChargesUsed(suite.attackA.ID()), Equals, 0)
+
+	suite.teros.MarkPowerUsed(suite.attackA.ID(), 0)
+	suite.teros.MarkPowerUsed(suite.attackA.ID(), 0)
+	checker.Assert(suite.teros.PowerChargesUsed(suite.attackA.ID()), Equals, 2)
+}
+
+func (suite *SquaddiePowerCollectionTests) TestResetPowerUsage(checker *C) {
+	suite.teros.MarkPowerUsed(suite.attackA.ID(), 3)
+	suite.teros.ResetPowerUsage()
+	checker.Assert(suite.teros.RemainingPowerCooldown(suite.attackA.ID()), Equals, 0)
+	checker.Assert(suite.teros.PowerChargesUsed(suite.attackA.ID()), Equals, 0)
+}
@@ -3,6 +3,7 @@ package squaddie
 import (
 	"github.com/chadius/terosgamerules/entity/affiliation"
 	"github.com/chadius/terosgamerules/entity/damagedistribution"
+	"github.com/chadius/terosgamerules/entity/lingeringeffect"
 	"github.com/chadius/terosgamerules/entity/movement"
 	"github.com/chadius/terosgamerules/entity/powerreference"
 	"github.com/chadius/terosgamerules/entity/squaddieclass"
@@ -18,6 +19,12 @@ type Squaddie struct {
 	offense         Offense
 	movement        Movement
 	powerCollection PowerCollection
+	experience      Experience
+	mana            Mana
+	resistance      DamageResistance
+	lingering       LingeringEffects
+	inventory       Inventory
+	turnState       TurnState
 }
 
 // NewSquaddie returns a Squaddie object.
@@ -48,6 +55,66 @@ func (s *Squaddie) AffiliationLogic() affiliation.Interface {
 	return s.identification.AffiliationLogic()
 }
 
+// RemoveAffiliationOverride delegates.
+func (s *Squaddie) RemoveAffiliationOverride() bool {
+	return s.identification.RemoveAffiliationOverride()
+}
+
+// BaseAffiliationLogic delegates.
+func (s *Squaddie) BaseAffiliationLogic() affiliation.Interface {
+	return s.identification.BaseAffiliationLogic()
+}
+
+// ChangeBaseAffiliation delegates.
+func (s *Squaddie) ChangeBaseAffiliation(newAffiliation affiliation.Interface) {
+	s.identification.ChangeBaseAffiliation(newAffiliation)
+}
+
+// OverrideAffiliation delegates.
+func (s *Squaddie) OverrideAffiliation(newAffiliation affiliation.Interface, turns int) {
+	s.identification.OverrideAffiliation(newAffiliation, turns)
+}
+
+// HasAffiliationOverride delegates.
+func (s *Squaddie) HasAffiliationOverride() bool {
+	return s.identification.HasAffiliationOverride()
+}
+
+// AffiliationOverrideTurnsRemaining delegates.
+func (s *Squaddie) AffiliationOverrideTurnsRemaining() int {
+	return s.identification.AffiliationOverrideTurnsRemaining()
+}
+
+// ReduceAffiliationOverrideDuration delegates.
+func (s *Squaddie) ReduceAffiliationOverrideDuration() bool {
+	return s.identification.ReduceAffiliationOverrideDuration()
+}
+
+// MarkAsSummon delegates.
+func (s *Squaddie) MarkAsSummon(summonerID string, turns int) {
+	s.identification.MarkAsSummon(summonerID, turns)
+}
+
+// SummonerID delegates.
+func (s *Squaddie) SummonerID() string {
+	return s.identification.SummonerID()
+}
+
+// IsSummon delegates.
+func (s *Squaddie) IsSummon() bool {
+	return s.identification.IsSummon()
+}
+
+// SummonTurnsRemaining delegates.
+func (s *Squaddie) SummonTurnsRemaining() int {
+	return s.identification.SummonTurnsRemaining()
+}
+
+// ReduceSummonDuration delegates.
+func (s *Squaddie) ReduceSummonDuration() bool {
+	return s.identification.ReduceSummonDuration()
+}
+
 // Name delegates.
 func (s *Squaddie) Name() string {
 	return s.identification.Name()
@@ -113,6 +180,11 @@ func (s *Squaddie) ReduceBarrier(damage int) {
 	s.defense.ReduceBarrier(damage)
 }
 
+// GainBarrier delegates.
+func (s *Squaddie) GainBarrier(barrier int) int {
+	return s.defense.GainBarrier(barrier)
+}
+
 // SetBarrierToMax delegates.
 func (s *Squaddie) SetBarrierToMax() {
 	s.defense.SetBarrierToMax()
@@ -133,6 +205,151 @@ func (s *Squaddie) TakeDamageDistribution(distribution *damagedistribution.Damag
 	s.defense.TakeDamageDistribution(distribution)
 }
 
+// CurrentMana delegates.
+func (s *Squaddie) CurrentMana() int {
+	return s.mana.CurrentMana()
+}
+
+// MaxMana delegates.
+func (s *Squaddie) MaxMana() int {
+	return s.mana.MaxMana()
+}
+
+// ManaRegeneration delegates.
+func (s *Squaddie) ManaRegeneration() int {
+	return s.mana.ManaRegeneration()
+}
+
+// SetManaToMax delegates.
+func (s *Squaddie) SetManaToMax() {
+	s.mana.SetManaToMax()
+}
+
+// ReduceMana delegates.
+func (s *Squaddie) ReduceMana(cost int) int {
+	return s.mana.ReduceMana(cost)
+}
+
+// GainMana delegates.
+func (s *Squaddie) GainMana(amount int) int {
+	return s.mana.GainMana(amount)
+}
+
+// RegenerateMana delegates.
+func (s *Squaddie) RegenerateMana() int {
+	return s.mana.RegenerateMana()
+}
+
+// AddLingeringEffect delegates.
+func (s *Squaddie) AddLingeringEffect(effect *lingeringeffect.LingeringEffect) {
+	s.lingering.Add(effect)
+}
+
+// LingeringEffects delegates.
+func (s *Squaddie) LingeringEffects() []*lingeringeffect.LingeringEffect {
+	return s.lingering.All()
+}
+
+// RemoveHarmfulLingeringEffects delegates.
+func (s *Squaddie) RemoveHarmfulLingeringEffects() int {
+	return s.lingering.RemoveHarmful()
+}
+
+// ApplyLingeringEffects ticks every lingering effect at the start of the squaddie's turn.
+//   Returns the hit points actually gained, or a negative number if the squaddie lost hit points.
+func (s *Squaddie) ApplyLingeringEffects() int {
+	hitPointChange := s.lingering.Tick()
+	if hitPointChange < 0 {
+		return -s.defense.ReduceHitPoints(-hitPointChange)
+	}
+	return s.defense.GainHitPoints(hitPointChange)
+}
+
+// AddItem delegates.
+func (s *Squaddie) AddItem(itemID string) {
+	s.inventory.AddItem(itemID)
+}
+
+// RemoveItem delegates.
+func (s *Squaddie) RemoveItem(itemID string) bool {
+	return s.inventory.RemoveItem(itemID)
+}
+
+// HasItem delegates.
+func (s *Squaddie) HasItem(itemID string) bool {
+	return s.inventory.HasItem(itemID)
+}
+
+// CountItem delegates.
+func (s *Squaddie) CountItem(itemID string) int {
+	return s.inventory.CountItem(itemID)
+}
+
+// GetCopyOfItemIDs delegates.
+func (s *Squaddie) GetCopyOfItemIDs() []string {
+	return s.inventory.GetCopyOfItemIDs()
+}
+
+// EquipItem delegates.
+func (s *Squaddie) EquipItem(slot, itemID string, grantedPowerIDs []string) {
+	s.inventory.EquipItem(slot, itemID, grantedPowerIDs)
+}
+
+// UnequipItem delegates.
+func (s *Squaddie) UnequipItem(slot string) (string, []string) {
+	return s.inventory.UnequipItem(slot)
+}
+
+// GetEquippedItemID delegates.
+func (s *Squaddie) GetEquippedItemID(slot string) string {
+	return s.inventory.GetEquippedItemID(slot)
+}
+
+// GetGrantedPowerIDs delegates.
+func (s *Squaddie) GetGrantedPowerIDs(slot string) []string {
+	return s.inventory.GetGrantedPowerIDs(slot)
+}
+
+// GetEquippedItemSlots delegates.
+func (s *Squaddie) GetEquippedItemSlots() []string {
+	return s.inventory.GetEquippedSlots()
+}
+
+// CanAffordManaCost delegates.
+func (s *Squaddie) CanAffordManaCost(cost int) bool {
+	return s.mana.CanAfford(cost)
+}
+
+// DamageResistancePercent delegates.
+func (s *Squaddie) DamageResistancePercent(damageType string) int {
+	return s.resistance.ResistancePercent(damageType)
+}
+
+// DamageResistancePercentByDamageType delegates.
+func (s *Squaddie) DamageResistancePercentByDamageType() map[string]int {
+	return s.resistance.ResistancePercentByDamageType()
+}
+
+// ExperiencePoints delegates.
+func (s *Squaddie) ExperiencePoints() int {
+	return s.experience.ExperiencePoints()
+}
+
+// LevelUpsPending delegates.
+func (s *Squaddie) LevelUpsPending() int {
+	return s.experience.LevelUpsPending()
+}
+
+// GainExperience delegates.
+func (s *Squaddie) GainExperience(experiencePoints int) int {
+	return s.experience.GainExperience(experiencePoints)
+}
+
+// ConsumePendingLevelUp delegates.
+func (s *Squaddie) ConsumePendingLevelUp() bool {
+	return s.experience.ConsumePendingLevelUp()
+}
+
 // ImproveOffense delegates.
 func (s *Squaddie) ImproveOffense(aim, strength, mind int) {
 	s.offense.Improve(aim, strength, mind)
@@ -180,7 +397,11 @@ func (s *Squaddie) HasSameStatsAs(other squaddieinterface.Interface) bool {
 		return false
 	}
 
-	if reflect.TypeOf(s.AffiliationLogic()).String() != reflect.TypeOf(other.AffiliationLogic()).String() {
+	if reflect.TypeOf(s.BaseAffiliationLogic()).String() != reflect.TypeOf(other.BaseAffiliationLogic()).String() {
+		return false
+	}
+
+	if s.BaseAffiliationLogic().Name() != other.BaseAffiliationLogic().Name() {
 		return false
 	}
 
@@ -190,6 +411,12 @@ func (s *Squaddie) HasSameStatsAs(other squaddieinterface.Interface) bool {
 	if !s.hasSameOffenseAs(other) {
 		return false
 	}
+	if !s.hasSameManaAs(other) {
+		return false
+	}
+	if !s.hasSameResistancesAs(other) {
+		return false
+	}
 	if !s.hasSameMovementAs(other) {
 		return false
 	}
@@ -254,6 +481,33 @@ func (s *Squaddie) hasSameDefenseAs(other squaddieinterface.Interface) bool {
 	return true
 }
 
+func (s *Squaddie) hasSameManaAs(other squaddieinterface.Interface) bool {
+	if s.MaxMana() != other.MaxMana() {
+		return false
+	}
+	if s.CurrentMana() != other.CurrentMana() {
+		return false
+	}
+	if s.ManaRegeneration() != other.ManaRegeneration() {
+		return false
+	}
+	return true
+}
+
+func (s *Squaddie) hasSameResistancesAs(other squaddieinterface.Interface) bool {
+	resistances := s.DamageResistancePercentByDamageType()
+	otherResistances := other.DamageResistancePercentByDamageType()
+	if len(resistances) != len(otherResistances) {
+		return false
+	}
+	for damageType, percent := range resistances {
+		if otherResistances[damageType] != percent {
+			return false
+		}
+	}
+	return true
+}
+
 func (s *Squaddie) hasSamePowersAs(other squaddieinterface.Interface) bool {
 	powerCollection := s.GetCopyOfPowerReferences()
 	otherCollection := other.GetCopyOfPowerReferences()
@@ -374,6 +628,82 @@ func (s *Squaddie) RemovePowerReferenceByPowerID(powerID string) {
 	s.powerCollection.RemovePowerReferenceByPowerID(powerID)
 }
 
+// RemainingPowerCooldown delegates.
+func (s *Squaddie) RemainingPowerCooldown(powerID string) int {
+	return s.powerCollection.RemainingCooldown(powerID)
+}
+
+// PowerChargesUsed delegates.
+func (s *Squaddie) PowerChargesUsed(powerID string) int {
+	return s.powerCollection.ChargesUsed(powerID)
+}
+
+// MarkPowerUsed delegates.
+func (s *Squaddie) MarkPowerUsed(powerID string, cooldown int) {
+	s.powerCollection.MarkPowerUsed(powerID, cooldown)
+}
+
+// ReducePowerCooldowns delegates.
+func (s *Squaddie) ReducePowerCooldowns() {
+	s.powerCollection.ReduceCooldowns()
+}
+
+// CounterAttacksThisTurn delegates.
+func (s *Squaddie) CounterAttacksThisTurn() int {
+	return s.turnState.CounterAttacksThisTurn()
+}
+
+// MarkCounterAttackMade delegates.
+func (s *Squaddie) MarkCounterAttackMade() {
+	s.turnState.MarkCounterAttackMade()
+}
+
+// GuardedSquaddieID delegates.
+func (s *Squaddie) GuardedSquaddieID() string {
+	return s.turnState.GuardedSquaddieID()
+}
+
+// Guard delegates.
+func (s *Squaddie) Guard(squaddieID string) {
+	s.turnState.Guard(squaddieID)
+}
+
+// OverwatchPowerID delegates.
+func (s *Squaddie) OverwatchPowerID() string {
+	return s.turnState.OverwatchPowerID()
+}
+
+// StartOverwatch delegates.
+func (s *Squaddie) StartOverwatch(powerID string) {
+	s.turnState.StartOverwatch(powerID)
+}
+
+// EndOverwatch delegates.
+func (s *Squaddie) EndOverwatch() {
+	s.turnState.EndOverwatch()
+}
+
+// TurnEnded delegates.
+func (s *Squaddie) TurnEnded() bool {
+	return s.turnState.TurnEnded()
+}
+
+// EndTurn delegates.
+func (s *Squaddie) EndTurn() {
+	s.turnState.EndTurn()
+}
+
+// ResetTurnState delegates.
+func (s *Squaddie) ResetTurnState() {
+	s.turnState.Reset()
+}
+
+// ResetPowerUsage clears all cooldowns, charges and the turn state, as if a new battle started.
+func (s *Squaddie) ResetPowerUsage() {
+	s.powerCollection.ResetPowerUsage()
+	s.turnState.Reset()
+}
+
 // GetLevelCountsByClass delegates.
 func (s *Squaddie) GetLevelCountsByClass() map[string]int {
 	return s.classProgress.GetLevelCountsByClass()
@@ -2,9 +2,12 @@ package squaddie
 
 import (
 	"encoding/json"
+	"errors"
 	"github.com/chadius/terosgamerules/entity/squaddieinterface"
 	"github.com/chadius/terosgamerules/utility"
 	"gopkg.in/yaml.v2"
+	"reflect"
+	"sort"
 )
 
 // Repository will interact with external devices to manage Squaddies.
@@ -65,7 +68,11 @@ func (repository *Repository) unmarshalDataAndAddSquaddies(data []byte, unmarsha
 	}
 
 	for _, instruction := range builderInstructions {
-		newSquaddie := NewSquaddieFromMarshal(instruction).Build()
+		newSquaddieBuilder, err := NewSquaddieFromMarshal(instruction)
+		if err != nil {
+			return false, err
+		}
+		newSquaddie := newSquaddieBuilder.Build()
 
 		newSquaddie.SetHPToMax()
 		success, err := repository.tryToAddSquaddie(newSquaddie)
@@ -90,11 +97,28 @@ func (repository *Repository) GetNumberOfSquaddies() int {
 	return len(repository.squaddiesByID)
 }
 
+// GetAllSquaddieIDs returns the ID of every stored Squaddie, sorted.
+func (repository *Repository) GetAllSquaddieIDs() []string {
+	squaddieIDs := []string{}
+	for squaddieID := range repository.squaddiesByID {
+		squaddieIDs = append(squaddieIDs, squaddieID)
+	}
+	sort.Strings(squaddieIDs)
+	return squaddieIDs
+}
+
 //CloneSquaddieWithNewID uses the base Squaddie to create a new one.
 //  All fields will be the same except the squaddieID.
 //  If newID isn't empty, the clone squaddieID is set to that.
 //  Otherwise, it is randomly generated.
+//  Raises an error if there is no base Squaddie.
 func (repository *Repository) CloneSquaddieWithNewID(base squaddieinterface.Interface, newID string) (squaddieinterface.Interface, error) {
+	if base == nil || reflect.ValueOf(base).IsNil() {
+		newError := errors.New("cannot clone a squaddie that does not exist")
+		utility.Log(newError.Error(), 0, utility.Error)
+		return nil, newError
+	}
+
 	cloneBuilder := NewSquaddieBuilder().CloneOf(base)
 	if newID != "" {
 		cloneBuilder.WithID(newID)
@@ -105,6 +129,30 @@ func (repository *Repository) CloneSquaddieWithNewID(base squaddieinterface.Inte
 
 	clone.ReduceHitPoints(clone.MaxHitPoints() - base.CurrentHitPoints())
 	clone.ReduceBarrier(clone.MaxBarrier() - base.CurrentBarrier())
+	clone.ReduceMana(clone.MaxMana() - base.CurrentMana())
+	for _, reference := range base.GetCopyOfPowerReferences() {
+		for charge := 0; charge < base.PowerChargesUsed(reference.PowerID); charge++ {
+			clone.MarkPowerUsed(reference.PowerID, base.RemainingPowerCooldown(reference.PowerID))
+		}
+	}
+	for counterAttack := 0; counterAttack < base.CounterAttacksThisTurn(); counterAttack++ {
+		clone.MarkCounterAttackMade()
+	}
+	clone.Guard(base.GuardedSquaddieID())
+	clone.StartOverwatch(base.OverwatchPowerID())
+	if base.TurnEnded() {
+		clone.EndTurn()
+	}
+	clone.OverrideAffiliation(base.AffiliationLogic(), base.AffiliationOverrideTurnsRemaining())
+	if base.IsSummon() {
+		clone.MarkAsSummon(base.SummonerID(), base.SummonTurnsRemaining())
+	}
+	for _, effect := range base.LingeringEffects() {
+		clone.AddLingeringEffect(effect)
+	}
+	for _, slot := range base.GetEquippedItemSlots() {
+		clone.EquipItem(slot, base.GetEquippedItemID(slot), base.GetGrantedPowerIDs(slot))
+	}
 	return clone, nil
 }
 
@@ -3,6 +3,7 @@ package squaddie_test
 import (
 	"github.com/chadius/terosgamerules/entity/power"
 	"github.com/chadius/terosgamerules/entity/powerinterface"
+	"github.com/chadius/terosgamerules/entity/powerreference"
 	"github.com/chadius/terosgamerules/entity/squaddie"
 	squaddieClassBuilder "github.com/chadius/terosgamerules/entity/squaddieclass"
 	"github.com/chadius/terosgamerules/entity/squaddieinterface"
@@ -177,6 +178,14 @@ func (suite *SquaddieRepositorySuite) TestAddSquaddieDirectly(checker *C) {
 	checker.Assert(suite.squaddieRepository.GetNumberOfSquaddies(), Equals, 1)
 }
 
+func (suite *SquaddieRepositorySuite) TestGetAllSquaddieIDsInOrder(checker *C) {
+	suite.squaddieRepository.AddSquaddies([]squaddieinterface.Interface{
+		squaddie.NewSquaddieBuilder().WithID("squaddieB").Build(),
+		squaddie.NewSquaddieBuilder().WithID("squaddieA").Build(),
+	})
+	checker.Assert(suite.squaddieRepository.GetAllSquaddieIDs(), DeepEquals, []string{"squaddieA", "squaddieB"})
+}
+
 func (suite *SquaddieRepositorySuite) TestCloneSquaddie(checker *C) {
 
 }
@@ -213,13 +222,20 @@ func (suite *SquaddieCloneSuite) TestCloneUsesGivenID(checker *C) {
 	checker.Assert(clone.ID(), Equals, "12345")
 }
 
+func (suite *SquaddieCloneSuite) TestCannotCloneMissingSquaddies(checker *C) {
+	clone, err := suite.squaddieRepository.CloneSquaddieWithNewID(suite.squaddieRepository.GetOriginalSquaddieByID("missing"), "12345")
+	checker.Assert(err, ErrorMatches, "cannot clone a squaddie that does not exist")
+	checker.Assert(clone, IsNil)
+}
+
 func (suite *SquaddieCloneSuite) TestCloneCopiesBasicStats(checker *C) {
 	originalSquaddie := squaddie.NewSquaddieBuilder().WithName("Base").
-		HitPoints(9).Barrier(7).
+		HitPoints(9).Barrier(7).Mana(19).ManaRegeneration(3).
 		Aim(2).Strength(3).Mind(5).Dodge(11).Deflect(13).Armor(17).
 		Build()
 	originalSquaddie.ReduceHitPoints(originalSquaddie.MaxHitPoints() - 1)
 	originalSquaddie.ReduceBarrier(originalSquaddie.MaxBarrier() - 2)
+	originalSquaddie.ReduceMana(4)
 
 	clone, _ := suite.squaddieRepository.CloneSquaddieWithNewID(originalSquaddie, "")
 	checker.Assert(clone.CurrentHitPoints(), Equals, originalSquaddie.CurrentHitPoints())
@@ -232,6 +248,30 @@ func (suite *SquaddieCloneSuite) TestCloneCopiesBasicStats(checker *C) {
 	checker.Assert(clone.CurrentBarrier(), Equals, originalSquaddie.CurrentBarrier())
 	checker.Assert(clone.MaxBarrier(), Equals, originalSquaddie.MaxBarrier())
 	checker.Assert(clone.Armor(), Equals, originalSquaddie.Armor())
+	checker.Assert(clone.CurrentMana(), Equals, 15)
+	checker.Assert(clone.MaxMana(), Equals, originalSquaddie.MaxMana())
+	checker.Assert(clone.ManaRegeneration(), Equals, originalSquaddie.ManaRegeneration())
+}
+
+func (suite *SquaddieCloneSuite) TestCloneCopiesDamageResistances(checker *C) {
+	originalSquaddie := squaddie.NewSquaddieBuilder().WithName("Base").
+		DamageResistance("fire", 50).DamageResistance("ice", -25).Build()
+
+	clone, _ := suite.squaddieRepository.CloneSquaddieWithNewID(originalSquaddie, "")
+	checker.Assert(clone.DamageResistancePercentByDamageType(), DeepEquals, originalSquaddie.DamageResistancePercentByDamageType())
+	checker.Assert(clone.HasSameStatsAs(originalSquaddie), Equals, true)
+}
+
+func (suite *SquaddieCloneSuite) TestCloneCopiesPowerUsage(checker *C) {
+	originalSquaddie := squaddie.NewSquaddieBuilder().WithName("Base").Build()
+	originalSquaddie.AddPowerReference(&powerreference.Reference{Name: "Ultimate", PowerID: "ultimateID"})
+	originalSquaddie.MarkPowerUsed("ultimateID", 3)
+	originalSquaddie.MarkPowerUsed("ultimateID", 3)
+	originalSquaddie.ReducePowerCooldowns()
+
+	clone, _ := suite.squaddieRepository.CloneSquaddieWithNewID(originalSquaddie, "")
+	checker.Assert(clone.RemainingPowerCooldown("ultimateID"), Equals, 2)
+	checker.Assert(clone.PowerChargesUsed("ultimateID"), Equals, 2)
 }
 
 func (suite *SquaddieCloneSuite) TestCloneCopiesMovement(checker *C) {
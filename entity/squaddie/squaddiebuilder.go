@@ -2,12 +2,13 @@ package squaddie
 
 import (
 	"encoding/json"
+	"github.com/chadius/terosgamerules/entity/affiliation"
 	"github.com/chadius/terosgamerules/entity/powerreference"
 	"github.com/chadius/terosgamerules/entity/squaddieclass"
 	"github.com/chadius/terosgamerules/entity/squaddieinterface"
 	"github.com/chadius/terosgamerules/utility"
 	"gopkg.in/yaml.v2"
-	"reflect"
+	"sort"
 )
 
 // Builder is used to define the parameters for a squaddie builder.
@@ -21,6 +22,12 @@ type Builder struct {
 	levelsConsumedByClassID map[string]*[]string
 	classIDToUse            string
 	baseClassID             string
+	experiencePoints        int
+	levelUpsPending         int
+	maxMana                 int
+	manaRegeneration        int
+	resistanceByDamageType  map[string]int
+	itemIDsToAdd            []string
 }
 
 // NewSquaddieBuilder creates a Builder with default values.
@@ -37,6 +44,12 @@ func NewSquaddieBuilder() *Builder {
 		classIDToUse:            "",
 		baseClassID:             "",
 		levelsConsumedByClassID: map[string]*[]string{},
+		experiencePoints:        0,
+		levelUpsPending:         0,
+		maxMana:                 0,
+		manaRegeneration:        0,
+		resistanceByDamageType:  map[string]int{},
+		itemIDsToAdd:            []string{},
 	}
 }
 
@@ -75,6 +88,12 @@ func (s *Builder) AsAlly() *Builder {
 	return s
 }
 
+// InFaction delegates to the IdentificationBuilderOptions.
+func (s *Builder) InFaction(factionName string) *Builder {
+	s.identificationOptions.InFaction(factionName)
+	return s
+}
+
 // AsNeutral delegates to the IdentificationBuilderOptions.
 func (s *Builder) AsNeutral() *Builder {
 	s.identificationOptions.AsNeutral()
@@ -129,6 +148,33 @@ func (s *Builder) Deflect(deflect int) *Builder {
 	return s
 }
 
+// Mana sets the squaddie's maximum mana. Squaddies start with full mana.
+func (s *Builder) Mana(maxMana int) *Builder {
+	s.maxMana = maxMana
+	return s
+}
+
+// ManaRegeneration sets the amount of mana the squaddie regains every turn.
+func (s *Builder) ManaRegeneration(manaRegeneration int) *Builder {
+	s.manaRegeneration = manaRegeneration
+	return s
+}
+
+// DamageResistance sets the percentage of damage of this type the squaddie resists.
+//   Use a negative percentage to make the squaddie weak to the damage type.
+func (s *Builder) DamageResistance(damageType string, percent int) *Builder {
+	s.resistanceByDamageType[damageType] = percent
+	return s
+}
+
+// DamageResistances sets the percentage of damage the squaddie resists, for each damage type.
+func (s *Builder) DamageResistances(percentByDamageType map[string]int) *Builder {
+	for damageType, percent := range percentByDamageType {
+		s.DamageResistance(damageType, percent)
+	}
+	return s
+}
+
 // MoveDistance delegates to the MovementBuilderOptions.
 func (s *Builder) MoveDistance(distance int) *Builder {
 	s.movementOptions.Distance(distance)
@@ -177,6 +223,12 @@ func (s *Builder) AddPowerByReference(newPowerReference *powerreference.Referenc
 	return s
 }
 
+// AddItem adds a copy of the item to the squaddie's inventory.
+func (s *Builder) AddItem(itemID string) *Builder {
+	s.itemIDsToAdd = append(s.itemIDsToAdd, itemID)
+	return s
+}
+
 // AddClassByReference adds the class to the squaddie's list of possible classes.
 func (s *Builder) AddClassByReference(newClassReference *squaddieclass.ClassReference) *Builder {
 	s.classReferencesToAdd = append(s.classReferencesToAdd, newClassReference)
@@ -201,6 +253,18 @@ func (s *Builder) SetBaseClassByID(targetClassID string) *Builder {
 	return s
 }
 
+// ExperiencePoints sets the squaddie's experience points.
+func (s *Builder) ExperiencePoints(experiencePoints int) *Builder {
+	s.experiencePoints = experiencePoints
+	return s
+}
+
+// LevelUpsPending sets the number of level ups the squaddie earned but has not applied.
+func (s *Builder) LevelUpsPending(levelUpsPending int) *Builder {
+	s.levelUpsPending = levelUpsPending
+	return s
+}
+
 // Build uses the Builder to create a Squaddie.
 func (s *Builder) Build() squaddieinterface.Interface {
 	newSquaddie := NewSquaddie(
@@ -210,6 +274,9 @@ func (s *Builder) Build() squaddieinterface.Interface {
 		s.movementOptions.Build(),
 		squaddieclass.NewClassProgress("", "", nil),
 	)
+	newSquaddie.experience = *NewExperience(s.experiencePoints, s.levelUpsPending)
+	newSquaddie.mana = *NewMana(s.maxMana, s.maxMana, s.manaRegeneration)
+	newSquaddie.resistance = *NewDamageResistance(s.resistanceByDamageType)
 
 	for _, newPowerReference := range s.powerReferencesToAdd {
 		newSquaddie.AddPowerReference(newPowerReference)
@@ -219,6 +286,10 @@ func (s *Builder) Build() squaddieinterface.Interface {
 		newSquaddie.AddClass(newClassReference)
 	}
 
+	for _, itemID := range s.itemIDsToAdd {
+		newSquaddie.AddItem(itemID)
+	}
+
 	if s.baseClassID != "" {
 		newSquaddie.SetBaseClassIfNoBaseClass(s.baseClassID)
 	}
@@ -269,6 +340,7 @@ type BuilderOptionMarshal struct {
 	ID          string `json:"id" yaml:"id"`
 	Name        string `json:"name" yaml:"name"`
 	Affiliation string `json:"affiliation" yaml:"affiliation"`
+	Faction     string `json:"faction" yaml:"faction"`
 
 	MaxHitPoints int `json:"max_hit_points" yaml:"max_hit_points"`
 	Dodge        int `json:"dodge" yaml:"dodge"`
@@ -280,12 +352,22 @@ type BuilderOptionMarshal struct {
 	Strength int `json:"strength" yaml:"strength"`
 	Mind     int `json:"mind" yaml:"mind"`
 
+	MaxMana          int `json:"max_mana" yaml:"max_mana"`
+	ManaRegeneration int `json:"mana_regeneration" yaml:"mana_regeneration"`
+
+	DamageResistances map[string]int `json:"damage_resistances" yaml:"damage_resistances"`
+
 	MovementDistance     int    `json:"movement_distance" yaml:"movement_distance"`
 	MovementLogic        string `json:"movement_type" yaml:"movement_type"`
 	MovementCanHitAndRun bool   `json:"hit_and_run" yaml:"hit_and_run"`
 
+	ExperiencePoints int `json:"experience_points" yaml:"experience_points"`
+	LevelUpsPending  int `json:"level_ups_pending" yaml:"level_ups_pending"`
+
 	ClassProgress   []*classProgressMarshal     `json:"class_progress" yaml:"class_progress"`
 	PowerReferences []*powerreference.Reference `json:"powers" yaml:"powers"`
+
+	ItemIDs []string `json:"items" yaml:"items"`
 }
 
 type classProgressMarshal struct {
@@ -297,31 +379,39 @@ type classProgressMarshal struct {
 }
 
 // UsingYAML uses the yaml data to generate Builder.
-func (s *Builder) UsingYAML(yamlData []byte) *Builder {
+//   Raises an error if the data cannot be read or uses an unknown logic keyword.
+func (s *Builder) UsingYAML(yamlData []byte) (*Builder, error) {
 	return s.usingByteStream(yamlData, yaml.Unmarshal)
 }
 
 // UsingJSON uses the json data to generate Builder.
-func (s *Builder) UsingJSON(jsonData []byte) *Builder {
+//   Raises an error if the data cannot be read or uses an unknown logic keyword.
+func (s *Builder) UsingJSON(jsonData []byte) (*Builder, error) {
 	return s.usingByteStream(jsonData, json.Unmarshal)
 }
 
-func (s *Builder) usingByteStream(data []byte, unmarshal utility.UnmarshalFunc) *Builder {
+func (s *Builder) usingByteStream(data []byte, unmarshal utility.UnmarshalFunc) (*Builder, error) {
 	var unmarshalError error
 	var marshaledOptions BuilderOptionMarshal
 
 	unmarshalError = unmarshal(data, &marshaledOptions)
 
 	if unmarshalError != nil {
-		return s
+		return s, unmarshalError
 	}
 
 	s.WithID(marshaledOptions.ID).WithName(marshaledOptions.Name).
 		HitPoints(marshaledOptions.MaxHitPoints).Dodge(marshaledOptions.Dodge).Deflect(marshaledOptions.Deflect).Barrier(marshaledOptions.MaxBarrier).Armor(marshaledOptions.Armor).
 		Aim(marshaledOptions.Aim).Strength(marshaledOptions.Strength).Mind(marshaledOptions.Mind).
-		MoveDistance(marshaledOptions.MovementDistance)
+		Mana(marshaledOptions.MaxMana).ManaRegeneration(marshaledOptions.ManaRegeneration).
+		MoveDistance(marshaledOptions.MovementDistance).
+		ExperiencePoints(marshaledOptions.ExperiencePoints).LevelUpsPending(marshaledOptions.LevelUpsPending).
+		DamageResistances(marshaledOptions.DamageResistances)
 
 	s.WithAffiliationLogic(marshaledOptions.Affiliation)
+	if marshaledOptions.Faction != "" {
+		s.InFaction(marshaledOptions.Faction)
+	}
 
 	s.WithMovementLogicKeyword(marshaledOptions.MovementLogic)
 
@@ -335,6 +425,10 @@ func (s *Builder) usingByteStream(data []byte, unmarshal utility.UnmarshalFunc)
 		}
 	}
 
+	for _, itemID := range marshaledOptions.ItemIDs {
+		s.AddItem(itemID)
+	}
+
 	if marshaledOptions.ClassProgress != nil {
 		for _, progress := range marshaledOptions.ClassProgress {
 			s.AddClassByReference(&squaddieclass.ClassReference{
@@ -351,7 +445,7 @@ func (s *Builder) usingByteStream(data []byte, unmarshal utility.UnmarshalFunc)
 		}
 	}
 
-	return s
+	return s, s.keywordError()
 }
 
 // CloneOf modifies the Builder based on the source, except for the classID.
@@ -359,16 +453,20 @@ func (s *Builder) CloneOf(source squaddieinterface.Interface) *Builder {
 	s.WithName(source.Name()).
 		HitPoints(source.MaxHitPoints()).Deflect(source.Deflect()).Barrier(source.MaxBarrier()).Armor(source.Armor()).Dodge(source.Dodge()).
 		Aim(source.Aim()).Strength(source.Strength()).Mind(source.Mind()).
-		MoveDistance(source.MovementDistance())
+		Mana(source.MaxMana()).ManaRegeneration(source.ManaRegeneration()).
+		MoveDistance(source.MovementDistance()).
+		ExperiencePoints(source.ExperiencePoints()).LevelUpsPending(source.LevelUpsPending()).
+		DamageResistances(source.DamageResistancePercentByDamageType())
 	s.cloneAffiliation(source)
 	s.cloneMovement(source)
 	s.clonePowerReferences(source)
 	s.cloneClassProgress(source)
+	s.cloneItems(source)
 	return s
 }
 
 func (s *Builder) cloneMovement(source squaddieinterface.Interface) {
-	s.WithMovementLogicKeyword(reflect.TypeOf(source.MovementLogic()).String())
+	s.movementOptions.WithMovementLogic(source.MovementLogic())
 
 	if source.MovementCanHitAndRun() {
 		s.CanHitAndRun()
@@ -376,7 +474,7 @@ func (s *Builder) cloneMovement(source squaddieinterface.Interface) {
 }
 
 func (s *Builder) cloneAffiliation(source squaddieinterface.Interface) {
-	s.WithAffiliationLogic(source.AffiliationLogic().Name())
+	s.identificationOptions.affiliationLogic = source.BaseAffiliationLogic()
 }
 
 func (s *Builder) clonePowerReferences(source squaddieinterface.Interface) {
@@ -385,6 +483,12 @@ func (s *Builder) clonePowerReferences(source squaddieinterface.Interface) {
 	}
 }
 
+func (s *Builder) cloneItems(source squaddieinterface.Interface) {
+	for _, itemID := range source.GetCopyOfItemIDs() {
+		s.AddItem(itemID)
+	}
+}
+
 func (s *Builder) cloneClassProgress(source squaddieinterface.Interface) {
 	for classID, classLevelsConsumed := range *source.ClassLevelsConsumed() {
 		s.AddClassByReference(&squaddieclass.ClassReference{
@@ -399,10 +503,70 @@ func (s *Builder) cloneClassProgress(source squaddieinterface.Interface) {
 	s.SetBaseClassByID(source.BaseClassID())
 }
 
+// NewMarshalFromSquaddie flattens the squaddie's stats, class progress, powers and items
+//   so it can be saved and rebuilt with NewSquaddieFromMarshal.
+func NewMarshalFromSquaddie(source squaddieinterface.Interface) BuilderOptionMarshal {
+	marshal := BuilderOptionMarshal{
+		ID:                   source.ID(),
+		Name:                 source.Name(),
+		MaxHitPoints:         source.MaxHitPoints(),
+		Dodge:                source.Dodge(),
+		Deflect:              source.Deflect(),
+		MaxBarrier:           source.MaxBarrier(),
+		Armor:                source.Armor(),
+		Aim:                  source.Aim(),
+		Strength:             source.Strength(),
+		Mind:                 source.Mind(),
+		MaxMana:              source.MaxMana(),
+		ManaRegeneration:     source.ManaRegeneration(),
+		DamageResistances:    source.DamageResistancePercentByDamageType(),
+		MovementDistance:     source.MovementDistance(),
+		MovementLogic:        source.MovementLogic().Name(),
+		MovementCanHitAndRun: source.MovementCanHitAndRun(),
+		ExperiencePoints:     source.ExperiencePoints(),
+		LevelUpsPending:      source.LevelUpsPending(),
+		ClassProgress:        []*classProgressMarshal{},
+		PowerReferences:      source.GetCopyOfPowerReferences(),
+		ItemIDs:              source.GetCopyOfItemIDs(),
+	}
+
+	if _, isFaction := source.BaseAffiliationLogic().(*affiliation.Faction); isFaction {
+		marshal.Faction = source.BaseAffiliationLogic().Name()
+	} else {
+		marshal.Affiliation = source.BaseAffiliationLogic().Name()
+	}
+
+	classIDs := []string{}
+	for classID := range *source.ClassLevelsConsumed() {
+		classIDs = append(classIDs, classID)
+	}
+	sort.Strings(classIDs)
+	for _, classID := range classIDs {
+		classLevelsConsumed := (*source.ClassLevelsConsumed())[classID]
+		marshal.ClassProgress = append(marshal.ClassProgress, &classProgressMarshal{
+			BaseClass:      classID == source.BaseClassID(),
+			CurrentClass:   classID == source.CurrentClassID(),
+			ClassID:        classID,
+			ClassName:      classLevelsConsumed.GetClassName(),
+			LevelsConsumed: classLevelsConsumed.GetLevelsConsumed(),
+		})
+	}
+	return marshal
+}
+
 // NewSquaddieFromMarshal creates a new NewSquaddieBuilder with fields based on the Marshal object
-func NewSquaddieFromMarshal(builderFields BuilderOptionMarshal) *Builder {
+//   Raises an error if the Marshal object uses an unknown logic keyword.
+func NewSquaddieFromMarshal(builderFields BuilderOptionMarshal) (*Builder, error) {
 	s := NewSquaddieBuilder().populateBuilderBasedOnMarshal(builderFields)
-	return s
+	return s, s.keywordError()
+}
+
+// keywordError returns the error from the last unknown logic keyword given to the builder, or nil.
+func (s *Builder) keywordError() error {
+	if s.identificationOptions.KeywordError() != nil {
+		return s.identificationOptions.KeywordError()
+	}
+	return s.movementOptions.KeywordError()
 }
 
 func (s *Builder) populateBuilderBasedOnMarshal(builderFields BuilderOptionMarshal) *Builder {
@@ -411,14 +575,22 @@ func (s *Builder) populateBuilderBasedOnMarshal(builderFields BuilderOptionMarsh
 		Aim(builderFields.Aim).
 		Strength(builderFields.Strength).
 		Mind(builderFields.Mind).
+		Mana(builderFields.MaxMana).
+		ManaRegeneration(builderFields.ManaRegeneration).
 		HitPoints(builderFields.MaxHitPoints).
 		Barrier(builderFields.MaxBarrier).
 		Armor(builderFields.Armor).
 		Dodge(builderFields.Dodge).
 		Deflect(builderFields.Deflect).
-		MoveDistance(builderFields.MovementDistance)
+		MoveDistance(builderFields.MovementDistance).
+		ExperiencePoints(builderFields.ExperiencePoints).
+		LevelUpsPending(builderFields.LevelUpsPending).
+		DamageResistances(builderFields.DamageResistances)
 
 	s.WithAffiliationLogic(builderFields.Affiliation)
+	if builderFields.Faction != "" {
+		s.InFaction(builderFields.Faction)
+	}
 
 	if builderFields.MovementCanHitAndRun {
 		s.CanHitAndRun()
@@ -453,5 +625,9 @@ func (s *Builder) populateBuilderBasedOnMarshal(builderFields BuilderOptionMarsh
 		s.AddClassLevelsConsumed(progress.ClassID, &progress.LevelsConsumed)
 	}
 
+	for _, itemID := range builderFields.ItemIDs {
+		s.AddItem(itemID)
+	}
+
 	return s
 }
@@ -199,9 +199,16 @@ armor: 9
 aim: 11
 strength: 13
 mind: 17
+max_mana: 21
+mana_regeneration: 2
+damage_resistances:
+  fire: 50
+  ice: -25
 movement_distance: 19
 movement_type: light
 hit_and_run: true
+experience_points: 42
+level_ups_pending: 1
 powers:
 - id: shove_id
   name: Shove
@@ -222,7 +229,9 @@ class_progress:
 }
 
 func (suite *YAMLBuilderSuite) TestIdentificationMatchesNewSquaddie(checker *C) {
-	yamlSquaddie := squaddie.NewSquaddieBuilder().UsingYAML(suite.yamlData).Build()
+	yamlSquaddieBuilder, err := squaddie.NewSquaddieBuilder().UsingYAML(suite.yamlData)
+	checker.Assert(err, IsNil)
+	yamlSquaddie := yamlSquaddieBuilder.Build()
 
 	checker.Assert(yamlSquaddie.ID(), Equals, "squaddie_yaml")
 	checker.Assert(yamlSquaddie.Name(), Equals, "YAML squaddie")
@@ -230,7 +239,9 @@ func (suite *YAMLBuilderSuite) TestIdentificationMatchesNewSquaddie(checker *C)
 }
 
 func (suite *YAMLBuilderSuite) TestDefenseMatchesNewSquaddie(checker *C) {
-	yamlSquaddie := squaddie.NewSquaddieBuilder().UsingYAML(suite.yamlData).Build()
+	yamlSquaddieBuilder, err := squaddie.NewSquaddieBuilder().UsingYAML(suite.yamlData)
+	checker.Assert(err, IsNil)
+	yamlSquaddie := yamlSquaddieBuilder.Build()
 
 	checker.Assert(yamlSquaddie.MaxHitPoints(), Equals, 2)
 	checker.Assert(yamlSquaddie.Dodge(), Equals, 3)
@@ -240,23 +251,58 @@ func (suite *YAMLBuilderSuite) TestDefenseMatchesNewSquaddie(checker *C) {
 }
 
 func (suite *YAMLBuilderSuite) TestOffenseMatchesNewSquaddie(checker *C) {
-	yamlSquaddie := squaddie.NewSquaddieBuilder().UsingYAML(suite.yamlData).Build()
+	yamlSquaddieBuilder, err := squaddie.NewSquaddieBuilder().UsingYAML(suite.yamlData)
+	checker.Assert(err, IsNil)
+	yamlSquaddie := yamlSquaddieBuilder.Build()
 
 	checker.Assert(yamlSquaddie.Aim(), Equals, 11)
 	checker.Assert(yamlSquaddie.Strength(), Equals, 13)
 	checker.Assert(yamlSquaddie.Mind(), Equals, 17)
 }
 
+func (suite *YAMLBuilderSuite) TestManaMatchesNewSquaddie(checker *C) {
+	yamlSquaddieBuilder, err := squaddie.NewSquaddieBuilder().UsingYAML(suite.yamlData)
+	checker.Assert(err, IsNil)
+	yamlSquaddie := yamlSquaddieBuilder.Build()
+
+	checker.Assert(yamlSquaddie.MaxMana(), Equals, 21)
+	checker.Assert(yamlSquaddie.CurrentMana(), Equals, 21)
+	checker.Assert(yamlSquaddie.ManaRegeneration(), Equals, 2)
+}
+
+func (suite *YAMLBuilderSuite) TestDamageResistancesMatchNewSquaddie(checker *C) {
+	yamlSquaddieBuilder, err := squaddie.NewSquaddieBuilder().UsingYAML(suite.yamlData)
+	checker.Assert(err, IsNil)
+	yamlSquaddie := yamlSquaddieBuilder.Build()
+
+	checker.Assert(yamlSquaddie.DamageResistancePercent("fire"), Equals, 50)
+	checker.Assert(yamlSquaddie.DamageResistancePercent("ice"), Equals, -25)
+	checker.Assert(yamlSquaddie.DamageResistancePercent("lightning"), Equals, 0)
+}
+
 func (suite *YAMLBuilderSuite) TestMovementMatchesNewSquaddie(checker *C) {
-	yamlSquaddie := squaddie.NewSquaddieBuilder().UsingYAML(suite.yamlData).Build()
+	yamlSquaddieBuilder, err := squaddie.NewSquaddieBuilder().UsingYAML(suite.yamlData)
+	checker.Assert(err, IsNil)
+	yamlSquaddie := yamlSquaddieBuilder.Build()
 
 	checker.Assert(yamlSquaddie.MovementDistance(), Equals, 19)
 	checker.Assert(yamlSquaddie.MovementLogic().Name(), Equals, "light")
 	checker.Assert(yamlSquaddie.MovementCanHitAndRun(), Equals, true)
 }
 
+func (suite *YAMLBuilderSuite) TestExperienceMatchesNewSquaddie(checker *C) {
+	yamlSquaddieBuilder, err := squaddie.NewSquaddieBuilder().UsingYAML(suite.yamlData)
+	checker.Assert(err, IsNil)
+	yamlSquaddie := yamlSquaddieBuilder.Build()
+
+	checker.Assert(yamlSquaddie.ExperiencePoints(), Equals, 42)
+	checker.Assert(yamlSquaddie.LevelUpsPending(), Equals, 1)
+}
+
 func (suite *YAMLBuilderSuite) TestPowersMatchesNewSquaddie(checker *C) {
-	yamlSquaddie := squaddie.NewSquaddieBuilder().UsingYAML(suite.yamlData).Build()
+	yamlSquaddieBuilder, err := squaddie.NewSquaddieBuilder().UsingYAML(suite.yamlData)
+	checker.Assert(err, IsNil)
+	yamlSquaddie := yamlSquaddieBuilder.Build()
 
 	powerReferences := yamlSquaddie.GetCopyOfPowerReferences()
 	checker.Assert(powerReferences, HasLen, 2)
@@ -267,7 +313,9 @@ func (suite *YAMLBuilderSuite) TestPowersMatchesNewSquaddie(checker *C) {
 }
 
 func (suite *YAMLBuilderSuite) TestClassesMatchesNewSquaddie(checker *C) {
-	yamlSquaddie := squaddie.NewSquaddieBuilder().UsingYAML(suite.yamlData).Build()
+	yamlSquaddieBuilder, err := squaddie.NewSquaddieBuilder().UsingYAML(suite.yamlData)
+	checker.Assert(err, IsNil)
+	yamlSquaddie := yamlSquaddieBuilder.Build()
 
 	checker.Assert(yamlSquaddie.BaseClassID(), Equals, "baseClassID")
 	checker.Assert(yamlSquaddie.CurrentClassID(), Equals, "currentClassID")
@@ -284,6 +332,25 @@ func (suite *YAMLBuilderSuite) TestClassesMatchesNewSquaddie(checker *C) {
 	checker.Assert(classLevelsConsumed["currentClassID"].GetLevelsConsumed()[1], Equals, "advanced level1")
 }
 
+func (suite *YAMLBuilderSuite) TestRaiseErrorWithUnknownLogicKeywords(checker *C) {
+	_, err := squaddie.NewSquaddieBuilder().UsingYAML([]byte(`
+id: squaddieTeros
+affiliation: kwyjibo
+`))
+	checker.Assert(err, ErrorMatches, `unknown affiliation logic keyword "kwyjibo"`)
+
+	_, err = squaddie.NewSquaddieBuilder().UsingYAML([]byte(`
+id: squaddieTeros
+movement_type: kwyjibo
+`))
+	checker.Assert(err, ErrorMatches, `unknown movement logic keyword "kwyjibo"`)
+}
+
+func (suite *YAMLBuilderSuite) TestRaiseErrorWithUnreadableData(checker *C) {
+	_, err := squaddie.NewSquaddieBuilder().UsingYAML([]byte(`id: [squaddieTeros`))
+	checker.Assert(err, NotNil)
+}
+
 type JSONBuilderSuite struct {
 	jsonData []byte
 }
@@ -305,6 +372,9 @@ func (suite *JSONBuilderSuite) SetUpTest(checker *C) {
 	"aim": 7,
 	"strength": 5,
 	"mind": 3,
+	"max_mana": 29,
+	"mana_regeneration": 3,
+	"damage_resistances": {"piercing": 10},
 	"movement_distance": 2,
 	"movement_type": "teleport",
 	"hit_and_run": true,
@@ -340,7 +410,9 @@ func (suite *JSONBuilderSuite) SetUpTest(checker *C) {
 }
 
 func (suite *JSONBuilderSuite) TestIdentificationMatchesNewSquaddie(checker *C) {
-	jsonSquaddie := squaddie.NewSquaddieBuilder().UsingJSON(suite.jsonData).Build()
+	jsonSquaddieBuilder, err := squaddie.NewSquaddieBuilder().UsingJSON(suite.jsonData)
+	checker.Assert(err, IsNil)
+	jsonSquaddie := jsonSquaddieBuilder.Build()
 
 	checker.Assert(jsonSquaddie.ID(), Equals, "squaddie_json")
 	checker.Assert(jsonSquaddie.Name(), Equals, "JSON squaddie")
@@ -348,7 +420,9 @@ func (suite *JSONBuilderSuite) TestIdentificationMatchesNewSquaddie(checker *C)
 }
 
 func (suite *JSONBuilderSuite) TestDefenseMatchesNewSquaddie(checker *C) {
-	jsonSquaddie := squaddie.NewSquaddieBuilder().UsingJSON(suite.jsonData).Build()
+	jsonSquaddieBuilder, err := squaddie.NewSquaddieBuilder().UsingJSON(suite.jsonData)
+	checker.Assert(err, IsNil)
+	jsonSquaddie := jsonSquaddieBuilder.Build()
 
 	checker.Assert(jsonSquaddie.MaxHitPoints(), Equals, 23)
 	checker.Assert(jsonSquaddie.Dodge(), Equals, 19)
@@ -358,7 +432,9 @@ func (suite *JSONBuilderSuite) TestDefenseMatchesNewSquaddie(checker *C) {
 }
 
 func (suite *JSONBuilderSuite) TestOffenseMatchesNewSquaddie(checker *C) {
-	jsonSquaddie := squaddie.NewSquaddieBuilder().UsingJSON(suite.jsonData).Build()
+	jsonSquaddieBuilder, err := squaddie.NewSquaddieBuilder().UsingJSON(suite.jsonData)
+	checker.Assert(err, IsNil)
+	jsonSquaddie := jsonSquaddieBuilder.Build()
 
 	checker.Assert(jsonSquaddie.Aim(), Equals, 7)
 	checker.Assert(jsonSquaddie.Strength(), Equals, 5)
@@ -366,8 +442,28 @@ func (suite *JSONBuilderSuite) TestOffenseMatchesNewSquaddie(checker *C) {
 
 }
 
+func (suite *JSONBuilderSuite) TestManaMatchesNewSquaddie(checker *C) {
+	jsonSquaddieBuilder, err := squaddie.NewSquaddieBuilder().UsingJSON(suite.jsonData)
+	checker.Assert(err, IsNil)
+	jsonSquaddie := jsonSquaddieBuilder.Build()
+
+	checker.Assert(jsonSquaddie.MaxMana(), Equals, 29)
+	checker.Assert(jsonSquaddie.CurrentMana(), Equals, 29)
+	checker.Assert(jsonSquaddie.ManaRegeneration(), Equals, 3)
+}
+
+func (suite *JSONBuilderSuite) TestDamageResistancesMatchNewSquaddie(checker *C) {
+	jsonSquaddieBuilder, err := squaddie.NewSquaddieBuilder().UsingJSON(suite.jsonData)
+	checker.Assert(err, IsNil)
+	jsonSquaddie := jsonSquaddieBuilder.Build()
+
+	checker.Assert(jsonSquaddie.DamageResistancePercentByDamageType(), DeepEquals, map[string]int{"piercing": 10})
+}
+
 func (suite *JSONBuilderSuite) TestMovementMatchesNewSquaddie(checker *C) {
-	jsonSquaddie := squaddie.NewSquaddieBuilder().UsingJSON(suite.jsonData).Build()
+	jsonSquaddieBuilder, err := squaddie.NewSquaddieBuilder().UsingJSON(suite.jsonData)
+	checker.Assert(err, IsNil)
+	jsonSquaddie := jsonSquaddieBuilder.Build()
 
 	checker.Assert(jsonSquaddie.MovementDistance(), Equals, 2)
 	checker.Assert(jsonSquaddie.MovementLogic().Name(), Equals, "teleport")
@@ -375,7 +471,9 @@ func (suite *JSONBuilderSuite) TestMovementMatchesNewSquaddie(checker *C) {
 }
 
 func (suite *JSONBuilderSuite) TestPowersMatchesNewSquaddie(checker *C) {
-	jsonSquaddie := squaddie.NewSquaddieBuilder().UsingYAML(suite.jsonData).Build()
+	jsonSquaddieBuilder, err := squaddie.NewSquaddieBuilder().UsingYAML(suite.jsonData)
+	checker.Assert(err, IsNil)
+	jsonSquaddie := jsonSquaddieBuilder.Build()
 
 	powerReferences := jsonSquaddie.GetCopyOfPowerReferences()
 	checker.Assert(powerReferences, HasLen, 2)
@@ -386,7 +484,9 @@ func (suite *JSONBuilderSuite) TestPowersMatchesNewSquaddie(checker *C) {
 }
 
 func (suite *JSONBuilderSuite) TestClassesMatchesNewSquaddie(checker *C) {
-	jsonSquaddie := squaddie.NewSquaddieBuilder().UsingJSON(suite.jsonData).Build()
+	jsonSquaddieBuilder, err := squaddie.NewSquaddieBuilder().UsingJSON(suite.jsonData)
+	checker.Assert(err, IsNil)
+	jsonSquaddie := jsonSquaddieBuilder.Build()
 
 	checker.Assert(jsonSquaddie.BaseClassID(), Equals, "baseClassID")
 	checker.Assert(jsonSquaddie.CurrentClassID(), Equals, "currentClassID")
@@ -436,6 +536,13 @@ func (suite *BuildCopySuite) TestCopySquaddieMovement(checker *C) {
 	checker.Assert(cloneTeros.HasSameStatsAs(mobileTeros), Equals, true)
 }
 
+func (suite *BuildCopySuite) TestCopySquaddieExperience(checker *C) {
+	veteranTeros := squaddie.NewSquaddieBuilder().CloneOf(suite.teros).ExperiencePoints(42).LevelUpsPending(2).Build()
+	cloneTeros := squaddie.NewSquaddieBuilder().CloneOf(veteranTeros).Build()
+	checker.Assert(cloneTeros.ExperiencePoints(), Equals, 42)
+	checker.Assert(cloneTeros.LevelUpsPending(), Equals, 2)
+}
+
 func (suite *BuildCopySuite) TestCopySquaddiePowers(checker *C) {
 	armedTeros := squaddie.NewSquaddieBuilder().CloneOf(suite.teros).AddPowerByReference(&powerreference.Reference{
 		Name:    "Spear",
@@ -459,3 +566,49 @@ func (suite *BuildCopySuite) TestCopyClasses(checker *C) {
 	cloneTeros := squaddie.NewSquaddieBuilder().CloneOf(experiencedTeros).Build()
 	checker.Assert(cloneTeros.HasSameStatsAs(experiencedTeros), Equals, true)
 }
+
+type MarshalFromSquaddieSuite struct {
+	teros squaddieinterface.Interface
+}
+
+var _ = Suite(&MarshalFromSquaddieSuite{})
+
+func (suite *MarshalFromSquaddieSuite) SetUpTest(checker *C) {
+	suite.teros = squaddie.NewSquaddieBuilder().Teros().
+		HitPoints(7).Aim(2).Strength(3).Mind(5).Barrier(1).Armor(2).Dodge(3).Deflect(4).
+		MovementFly().MoveDistance(4).CanHitAndRun().
+		ExperiencePoints(42).LevelUpsPending(1).
+		AddPowerByReference(&powerreference.Reference{Name: "Spear", PowerID: "powerIDForSpear"}).
+		AddClassByReference(&classEntity.ClassReference{ID: "scholarID", Name: "Scholar"}).
+		AddClassByReference(&classEntity.ClassReference{ID: "advancedScholarID", Name: "Advanced Scholar"}).
+		AddItem("itemSpear").
+		Build()
+	suite.teros.SetBaseClassIfNoBaseClass("scholarID")
+	suite.teros.SetClass("advancedScholarID")
+	suite.teros.MarkLevelUpBenefitAsConsumed("scholarID", "scholarLevel1")
+}
+
+func (suite *MarshalFromSquaddieSuite) TestMarshalRebuildsTheSameSquaddie(checker *C) {
+	rebuiltTerosBuilder, err := squaddie.NewSquaddieFromMarshal(squaddie.NewMarshalFromSquaddie(suite.teros))
+	checker.Assert(err, IsNil)
+	rebuiltTeros := rebuiltTerosBuilder.Build()
+	checker.Assert(rebuiltTeros.HasSameStatsAs(suite.teros), Equals, true)
+	checker.Assert(rebuiltTeros.ExperiencePoints(), Equals, 42)
+	checker.Assert(rebuiltTeros.LevelUpsPending(), Equals, 1)
+	checker.Assert(rebuiltTeros.HasItem("itemSpear"), Equals, true)
+	checker.Assert(rebuiltTeros.CurrentClassID(), Equals, "advancedScholarID")
+}
+
+func (suite *MarshalFromSquaddieSuite) TestMarshalKeepsFactions(checker *C) {
+	smuggler := squaddie.NewSquaddieBuilder().WithName("Smuggler").InFaction("smugglers").Build()
+	marshal := squaddie.NewMarshalFromSquaddie(smuggler)
+	checker.Assert(marshal.Faction, Equals, "smugglers")
+	checker.Assert(marshal.Affiliation, Equals, "")
+}
+
+func (suite *MarshalFromSquaddieSuite) TestRaiseErrorWhenMarshalHasUnknownAffiliation(checker *C) {
+	marshal := squaddie.NewMarshalFromSquaddie(suite.teros)
+	marshal.Affiliation = "kwyjibo"
+	_, err := squaddie.NewSquaddieFromMarshal(marshal)
+	checker.Assert(err, ErrorMatches, `unknown affiliation logic keyword "kwyjibo"`)
+}
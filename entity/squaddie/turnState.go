@@ -0,0 +1,63 @@
+package squaddie
+
+// TurnState tracks what the squaddie did this turn. It is cleared at the start of every turn.
+type TurnState struct {
+	counterAttacksThisTurn int
+	guardedSquaddieID      string
+	overwatchPowerID       string
+	turnEnded              bool
+}
+
+// CounterAttacksThisTurn returns the number of times the squaddie counterattacked this turn.
+func (turnState *TurnState) CounterAttacksThisTurn() int {
+	return turnState.counterAttacksThisTurn
+}
+
+// MarkCounterAttackMade records the squaddie counterattacked this turn.
+func (turnState *TurnState) MarkCounterAttackMade() {
+	turnState.counterAttacksThisTurn++
+}
+
+// GuardedSquaddieID returns the ID of the squaddie being guarded, or an empty string if the squaddie is not guarding.
+func (turnState *TurnState) GuardedSquaddieID() string {
+	return turnState.guardedSquaddieID
+}
+
+// Guard makes the squaddie take the attacks aimed at the squaddie with the given ID.
+func (turnState *TurnState) Guard(squaddieID string) {
+	turnState.guardedSquaddieID = squaddieID
+}
+
+// OverwatchPowerID returns the ID of the power the squaddie attacks with while on overwatch,
+//   or an empty string if the squaddie is not on overwatch.
+func (turnState *TurnState) OverwatchPowerID() string {
+	return turnState.overwatchPowerID
+}
+
+// StartOverwatch makes the squaddie attack with the power when a foe moves into its range.
+func (turnState *TurnState) StartOverwatch(powerID string) {
+	turnState.overwatchPowerID = powerID
+}
+
+// EndOverwatch takes the squaddie off overwatch.
+func (turnState *TurnState) EndOverwatch() {
+	turnState.overwatchPowerID = ""
+}
+
+// TurnEnded returns true if the squaddie already ended its turn.
+func (turnState *TurnState) TurnEnded() bool {
+	return turnState.turnEnded
+}
+
+// EndTurn records the squaddie ended its turn.
+func (turnState *TurnState) EndTurn() {
+	turnState.turnEnded = true
+}
+
+// Reset lets the squaddie counterattack and end its turn again and ends its guard and overwatch, as if a new turn started.
+func (turnState *TurnState) Reset() {
+	turnState.counterAttacksThisTurn = 0
+	turnState.guardedSquaddieID = ""
+	turnState.overwatchPowerID = ""
+	turnState.turnEnded = false
+}
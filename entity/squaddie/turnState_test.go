@@ -0,0 +1,70 @@
+package squaddie_test
+
+import (
+	"github.com/chadius/terosgamerules/entity/squaddie"
+	"github.com/chadius/terosgamerules/entity/squaddieinterface"
+	. "gopkg.in/check.v1"
+)
+
+type SquaddieTurnStateSuite struct {
+	teros squaddieinterface.Interface
+}
+
+var _ = Suite(&SquaddieTurnStateSuite{})
+
+func (suite *SquaddieTurnStateSuite) SetUpTest(checker *C) {
+	suite.teros = squaddie.NewSquaddieBuilder().Teros().Build()
+}
+
+func (suite *SquaddieTurnStateSuite) TestCountsCounterAttacksUntilReset(checker *C) {
+	checker.Assert(suite.teros.CounterAttacksThisTurn(), Equals, 0)
+
+	suite.teros.MarkCounterAttackMade()
+	suite.teros.MarkCounterAttackMade()
+	checker.Assert(suite.teros.CounterAttacksThisTurn(), Equals, 2)
+
+	suite.teros.ResetTurnState()
+	checker.Assert(suite.teros.CounterAttacksThisTurn(), Equals, 0)
+}
+
+func (suite *SquaddieTurnStateSuite) TestGuardsUntilReset(checker *C) {
+	checker.Assert(suite.teros.GuardedSquaddieID(), Equals, "")
+
+	suite.teros.Guard("lini")
+	checker.Assert(suite.teros.GuardedSquaddieID(), Equals, "lini")
+
+	suite.teros.ResetTurnState()
+	checker.Assert(suite.teros.GuardedSquaddieID(), Equals, "")
+}
+
+func (suite *SquaddieTurnStateSuite) TestOverwatchesUntilEndedOrReset(checker *C) {
+	checker.Assert(suite.teros.OverwatchPowerID(), Equals, "")
+
+	suite.teros.StartOverwatch("spear")
+	checker.Assert(suite.teros.OverwatchPowerID(), Equals, "spear")
+	suite.teros.EndOverwatch()
+	checker.Assert(suite.teros.OverwatchPowerID(), Equals, "")
+
+	suite.teros.StartOverwatch("spear")
+	suite.teros.ResetTurnState()
+	checker.Assert(suite.teros.OverwatchPowerID(), Equals, "")
+}
+
+func (suite *SquaddieTurnStateSuite) TestEndsTurnUntilReset(checker *C) {
+	checker.Assert(suite.teros.TurnEnded(), Equals, false)
+
+	suite.teros.EndTurn()
+	checker.Assert(suite.teros.TurnEnded(), Equals, true)
+
+	suite.teros.ResetTurnState()
+	checker.Assert(suite.teros.TurnEnded(), Equals, false)
+}
+
+func (suite *SquaddieTurnStateSuite) TestResettingPowerUsageClearsTheTurnState(checker *C) {
+	suite.teros.MarkCounterAttackMade()
+	suite.teros.Guard("lini")
+
+	suite.teros.ResetPowerUsage()
+	checker.Assert(suite.teros.CounterAttacksThisTurn(), Equals, 0)
+	checker.Assert(suite.teros.GuardedSquaddieID(), Equals, "")
+}
@@ -8,6 +8,14 @@ type Class struct {
 	initialBigLevelID string
 }
 
+// ClassMarshal is a flattened representation of a Class, used to read classes from YAML and JSON.
+type ClassMarshal struct {
+	ID                string `json:"id" yaml:"id"`
+	Name              string `json:"name" yaml:"name"`
+	BaseClassRequired bool   `json:"base_class_required" yaml:"base_class_required"`
+	InitialBigLevelID string `json:"initial_big_level_id" yaml:"initial_big_level_id"`
+}
+
 // NewClass returns a new class object.
 func NewClass(classID, className string, baseClassRequired bool, classInitialBigLevelID string) *Class {
 	return &Class{
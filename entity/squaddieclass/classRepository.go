@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/chadius/terosgamerules/utility"
+	"gopkg.in/yaml.v2"
+	"sort"
 )
 
 // Repository will interact with external devices to manage Squaddie Classes.
@@ -34,21 +36,21 @@ func (repository *Repository) AddListOfClasses(classes []*Class) (bool, error) {
 }
 
 // AddYAMLSource consumes a given bytestream and tries to analyze it.
-//func (repository *Repository) AddYAMLSource(data []byte) (bool, error) {
-//	return repository.addSource(data, yaml.Unmarshal)
-//}
+func (repository *Repository) AddYAMLSource(data []byte) (bool, error) {
+	return repository.addSource(data, yaml.Unmarshal)
+}
 
 // AddSource consumes a given bytestream of the given sourceType and tries to analyze it.
 func (repository *Repository) addSource(data []byte, unmarshal utility.UnmarshalFunc) (bool, error) {
 	var unmarshalError error
-	var classes []Class
+	var classes []ClassMarshal
 	unmarshalError = unmarshal(data, &classes)
 
 	if unmarshalError != nil {
 		return false, unmarshalError
 	}
 	for _, classToAdd := range classes {
-		repository.classesByID[classToAdd.ID()] = &classToAdd
+		repository.classesByID[classToAdd.ID] = NewClass(classToAdd.ID, classToAdd.Name, classToAdd.BaseClassRequired, classToAdd.InitialBigLevelID)
 	}
 
 	return true, nil
@@ -59,6 +61,18 @@ func (repository *Repository) GetNumberOfClasses() int {
 	return len(repository.classesByID)
 }
 
+// GetAllClasses returns every Class, sorted by ID.
+func (repository *Repository) GetAllClasses() []*Class {
+	classes := []*Class{}
+	for _, class := range repository.classesByID {
+		classes = append(classes, class)
+	}
+	sort.Slice(classes, func(i, j int) bool {
+		return classes[i].ID() < classes[j].ID()
+	})
+	return classes
+}
+
 // GetClassByID returns a Class that matches the id.
 func (repository *Repository) GetClassByID(classID string) (*Class, error) {
 	class, classFound := repository.classesByID[classID]
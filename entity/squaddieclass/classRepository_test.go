@@ -25,6 +25,31 @@ func (suite *ClassRepositoryUnmarshalSuite) TestLoadClassesWithJSON(checker *C)
 	success, _ := suite.repo.AddJSONSource(suite.jsonByteStream)
 	checker.Assert(success, Equals, true)
 	checker.Assert(suite.repo.GetNumberOfClasses(), Equals, 1)
+
+	mage, _ := suite.repo.GetClassByID("aaaaaaaa")
+	checker.Assert(mage.Name(), Equals, "Mage")
+}
+
+func (suite *ClassRepositoryUnmarshalSuite) TestLoadClassesWithYAML(checker *C) {
+	success, _ := suite.repo.AddYAMLSource([]byte(`
+- id: mageID
+  name: Mage
+- id: dimensionWalkerID
+  name: Dimension Walker
+  base_class_required: true
+  initial_big_level_id: dimensionWalkerLevel0
+`))
+	checker.Assert(success, Equals, true)
+	checker.Assert(suite.repo.GetNumberOfClasses(), Equals, 2)
+
+	mage, _ := suite.repo.GetClassByID("mageID")
+	checker.Assert(mage.Name(), Equals, "Mage")
+	checker.Assert(mage.BaseClassRequired(), Equals, false)
+
+	dimensionWalker, _ := suite.repo.GetClassByID("dimensionWalkerID")
+	checker.Assert(dimensionWalker.Name(), Equals, "Dimension Walker")
+	checker.Assert(dimensionWalker.BaseClassRequired(), Equals, true)
+	checker.Assert(dimensionWalker.InitialBigLevelID(), Equals, "dimensionWalkerLevel0")
 }
 
 func (suite *ClassRepositoryUnmarshalSuite) TestLoadClassesDirectly(checker *C) {
@@ -64,3 +89,10 @@ func (suite *ClassRepositoryRetrieveSuite) TestRaiseErrorWhenClassDoesNotExist(c
 	_, err := suite.repo.GetClassByID("bad classID")
 	checker.Assert(err, ErrorMatches, `class repository: No class found with id: "bad classID"`)
 }
+
+func (suite *ClassRepositoryRetrieveSuite) TestGetAllClassesSortedByID(checker *C) {
+	allClasses := suite.repo.GetAllClasses()
+	checker.Assert(allClasses, HasLen, 2)
+	checker.Assert(allClasses[0].ID(), Equals, suite.mageClass.ID())
+	checker.Assert(allClasses[1].ID(), Equals, suite.dimensionWalkerClass.ID())
+}
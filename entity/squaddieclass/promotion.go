@@ -0,0 +1,24 @@
+package squaddieclass
+
+// Promotion lets a squaddie in one class advance to another class.
+//   The squaddie must consume MinimumLevelsInClass levels in FromClassID,
+//   as well as every big level in BigLevelsRequired.
+//   Promotions from the same class that share an ExclusiveGroup are mutually exclusive:
+//   once a squaddie takes one, the others are no longer available.
+type Promotion struct {
+	FromClassID          string   `json:"from_class_id" yaml:"from_class_id"`
+	ToClassID            string   `json:"to_class_id" yaml:"to_class_id"`
+	MinimumLevelsInClass int      `json:"minimum_levels_in_class" yaml:"minimum_levels_in_class"`
+	BigLevelsRequired    []string `json:"big_levels_required" yaml:"big_levels_required"`
+	ExclusiveGroup       string   `json:"exclusive_group" yaml:"exclusive_group"`
+}
+
+// IsExclusiveWith returns true if both promotions start from the same class and share an ExclusiveGroup.
+func (p *Promotion) IsExclusiveWith(other *Promotion) bool {
+	if p.ExclusiveGroup == "" {
+		return false
+	}
+	return p.FromClassID == other.FromClassID &&
+		p.ExclusiveGroup == other.ExclusiveGroup &&
+		p.ToClassID != other.ToClassID
+}
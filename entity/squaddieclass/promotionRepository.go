@@ -0,0 +1,212 @@
+package squaddieclass
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/chadius/terosgamerules/utility"
+	"gopkg.in/yaml.v2"
+	"sort"
+	"strings"
+)
+
+// PromotionRepository holds the promotions between classes, forming a class tree.
+type PromotionRepository struct {
+	promotionsByFromClassID map[string][]*Promotion
+}
+
+// NewPromotionRepository generates a pointer to a new PromotionRepository.
+func NewPromotionRepository() *PromotionRepository {
+	return &PromotionRepository{
+		promotionsByFromClassID: map[string][]*Promotion{},
+	}
+}
+
+// AddPromotions adds multiple promotions directly.
+func (repository *PromotionRepository) AddPromotions(promotions []*Promotion) (bool, error) {
+	for _, promotion := range promotions {
+		repository.promotionsByFromClassID[promotion.FromClassID] = append(
+			repository.promotionsByFromClassID[promotion.FromClassID],
+			promotion,
+		)
+	}
+	return true, nil
+}
+
+// AddJSONSource consumes a given bytestream and tries to analyze it.
+func (repository *PromotionRepository) AddJSONSource(data []byte) (bool, error) {
+	return repository.addSource(data, json.Unmarshal)
+}
+
+// AddYAMLSource consumes a given bytestream and tries to analyze it.
+func (repository *PromotionRepository) AddYAMLSource(data []byte) (bool, error) {
+	return repository.addSource(data, yaml.Unmarshal)
+}
+
+func (repository *PromotionRepository) addSource(data []byte, unmarshal utility.UnmarshalFunc) (bool, error) {
+	var promotions []*Promotion
+	unmarshalError := unmarshal(data, &promotions)
+	if unmarshalError != nil {
+		return false, unmarshalError
+	}
+	return repository.AddPromotions(promotions)
+}
+
+// GetNumberOfPromotions returns the number of Promotions ready to retrieve.
+func (repository *PromotionRepository) GetNumberOfPromotions() int {
+	count := 0
+	for _, promotions := range repository.promotionsByFromClassID {
+		count = count + len(promotions)
+	}
+	return count
+}
+
+// GetPromotionsFromClass returns the promotions that start from the given class, in the order they were added.
+func (repository *PromotionRepository) GetPromotionsFromClass(classID string) []*Promotion {
+	return append([]*Promotion{}, repository.promotionsByFromClassID[classID]...)
+}
+
+// GetPromotionsToClass returns the promotions that lead to the given class.
+func (repository *PromotionRepository) GetPromotionsToClass(classID string) []*Promotion {
+	promotionsToClass := []*Promotion{}
+	for _, fromClassID := range repository.getSortedFromClassIDs() {
+		for _, promotion := range repository.promotionsByFromClassID[fromClassID] {
+			if promotion.ToClassID == classID {
+				promotionsToClass = append(promotionsToClass, promotion)
+			}
+		}
+	}
+	return promotionsToClass
+}
+
+// Validate makes sure the promotions form a usable class tree.
+//   Raises an error if a promotion uses an unknown class, the promotions form a cycle,
+//   or a class that requires a base class cannot be reached from any class that does not.
+func (repository *PromotionRepository) Validate(classRepo *Repository) error {
+	err := repository.checkForUnknownClasses(classRepo)
+	if err != nil {
+		return err
+	}
+
+	err = repository.checkForCycles()
+	if err != nil {
+		return err
+	}
+
+	return repository.checkForUnreachableClasses(classRepo)
+}
+
+func (repository *PromotionRepository) checkForUnknownClasses(classRepo *Repository) error {
+	for _, fromClassID := range repository.getSortedFromClassIDs() {
+		for _, promotion := range repository.promotionsByFromClassID[fromClassID] {
+			for _, classID := range []string{promotion.FromClassID, promotion.ToClassID} {
+				if _, err := classRepo.GetClassByID(classID); err != nil {
+					newError := fmt.Errorf(`promotion from "%s" to "%s" uses unknown class "%s"`, promotion.FromClassID, promotion.ToClassID, classID)
+					utility.Log(newError.Error(), 0, utility.Error)
+					return newError
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (repository *PromotionRepository) checkForCycles() error {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	visitStatusByClassID := map[string]int{}
+	path := []string{}
+
+	var visit func(classID string) error
+	visit = func(classID string) error {
+		switch visitStatusByClassID[classID] {
+		case visited:
+			return nil
+		case visiting:
+			cycleStart := 0
+			for index, pathClassID := range path {
+				if pathClassID == classID {
+					cycleStart = index
+				}
+			}
+			cycle := append(append([]string{}, path[cycleStart:]...), classID)
+			newError := fmt.Errorf(`promotions form a cycle: %s`, strings.Join(cycle, " -> "))
+			utility.Log(newError.Error(), 0, utility.Error)
+			return newError
+		}
+
+		visitStatusByClassID[classID] = visiting
+		path = append(path, classID)
+		for _, promotion := range repository.promotionsByFromClassID[classID] {
+			err := visit(promotion.ToClassID)
+			if err != nil {
+				return err
+			}
+		}
+		path = path[:len(path)-1]
+		visitStatusByClassID[classID] = visited
+		return nil
+	}
+
+	for _, fromClassID := range repository.getSortedFromClassIDs() {
+		if visitStatusByClassID[fromClassID] != unvisited {
+			continue
+		}
+		err := visit(fromClassID)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (repository *PromotionRepository) checkForUnreachableClasses(classRepo *Repository) error {
+	reachableClassIDs := map[string]bool{}
+	classIDsToVisit := []string{}
+	for _, class := range classRepo.GetAllClasses() {
+		if class.BaseClassRequired() == false {
+			reachableClassIDs[class.ID()] = true
+			classIDsToVisit = append(classIDsToVisit, class.ID())
+		}
+	}
+
+	for len(classIDsToVisit) > 0 {
+		classID := classIDsToVisit[0]
+		classIDsToVisit = classIDsToVisit[1:]
+		for _, promotion := range repository.promotionsByFromClassID[classID] {
+			if reachableClassIDs[promotion.ToClassID] {
+				continue
+			}
+			reachableClassIDs[promotion.ToClassID] = true
+			classIDsToVisit = append(classIDsToVisit, promotion.ToClassID)
+		}
+	}
+
+	for _, class := range classRepo.GetAllClasses() {
+		if reachableClassIDs[class.ID()] || repository.isClassInTree(class.ID()) == false {
+			continue
+		}
+		newError := fmt.Errorf(`class "%s" cannot be reached by any promotion`, class.ID())
+		utility.Log(newError.Error(), 0, utility.Error)
+		return newError
+	}
+	return nil
+}
+
+func (repository *PromotionRepository) isClassInTree(classID string) bool {
+	if len(repository.promotionsByFromClassID[classID]) > 0 {
+		return true
+	}
+	return len(repository.GetPromotionsToClass(classID)) > 0
+}
+
+func (repository *PromotionRepository) getSortedFromClassIDs() []string {
+	fromClassIDs := []string{}
+	for fromClassID := range repository.promotionsByFromClassID {
+		fromClassIDs = append(fromClassIDs, fromClassID)
+	}
+	sort.Strings(fromClassIDs)
+	return fromClassIDs
+}
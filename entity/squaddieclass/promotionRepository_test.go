@@ -0,0 +1,93 @@
+package squaddieclass_test
+
+import (
+	"github.com/chadius/terosgamerules/entity/squaddieclass"
+	. "gopkg.in/check.v1"
+)
+
+type PromotionRepositorySuite struct {
+	classRepo     *squaddieclass.Repository
+	promotionRepo *squaddieclass.PromotionRepository
+}
+
+var _ = Suite(&PromotionRepositorySuite{})
+
+func (suite *PromotionRepositorySuite) SetUpTest(checker *C) {
+	suite.classRepo = squaddieclass.NewRepository()
+	suite.classRepo.AddListOfClasses([]*squaddieclass.Class{
+		squaddieclass.ClassBuilder().WithID("squire").WithName("Squire").Build(),
+		squaddieclass.ClassBuilder().WithID("knight").WithName("Knight").RequiresBaseClass().Build(),
+		squaddieclass.ClassBuilder().WithID("ranger").WithName("Ranger").RequiresBaseClass().Build(),
+		squaddieclass.ClassBuilder().WithID("paladin").WithName("Paladin").RequiresBaseClass().Build(),
+	})
+	suite.promotionRepo = squaddieclass.NewPromotionRepository()
+}
+
+func (suite *PromotionRepositorySuite) TestLoadPromotionsWithYAML(checker *C) {
+	success, err := suite.promotionRepo.AddYAMLSource([]byte(`
+- from_class_id: squire
+  to_class_id: knight
+  minimum_levels_in_class: 5
+  big_levels_required:
+    - squireMastery
+  exclusive_group: squireCareer
+- from_class_id: squire
+  to_class_id: ranger
+  exclusive_group: squireCareer
+`))
+	checker.Assert(err, IsNil)
+	checker.Assert(success, Equals, true)
+	checker.Assert(suite.promotionRepo.GetNumberOfPromotions(), Equals, 2)
+
+	promotions := suite.promotionRepo.GetPromotionsFromClass("squire")
+	checker.Assert(promotions, HasLen, 2)
+	checker.Assert(promotions[0].ToClassID, Equals, "knight")
+	checker.Assert(promotions[0].MinimumLevelsInClass, Equals, 5)
+	checker.Assert(promotions[0].BigLevelsRequired, DeepEquals, []string{"squireMastery"})
+	checker.Assert(promotions[0].IsExclusiveWith(promotions[1]), Equals, true)
+}
+
+func (suite *PromotionRepositorySuite) TestLoadPromotionsWithJSON(checker *C) {
+	success, err := suite.promotionRepo.AddJSONSource([]byte(`[{"from_class_id": "knight", "to_class_id": "paladin"}]`))
+	checker.Assert(err, IsNil)
+	checker.Assert(success, Equals, true)
+	checker.Assert(suite.promotionRepo.GetPromotionsToClass("paladin"), HasLen, 1)
+	checker.Assert(suite.promotionRepo.GetPromotionsFromClass("paladin"), HasLen, 0)
+}
+
+func (suite *PromotionRepositorySuite) TestValidTreePassesValidation(checker *C) {
+	suite.promotionRepo.AddPromotions([]*squaddieclass.Promotion{
+		{FromClassID: "squire", ToClassID: "knight"},
+		{FromClassID: "squire", ToClassID: "ranger"},
+		{FromClassID: "knight", ToClassID: "paladin"},
+		{FromClassID: "ranger", ToClassID: "paladin"},
+	})
+	checker.Assert(suite.promotionRepo.Validate(suite.classRepo), IsNil)
+}
+
+func (suite *PromotionRepositorySuite) TestValidationFindsUnknownClasses(checker *C) {
+	suite.promotionRepo.AddPromotions([]*squaddieclass.Promotion{
+		{FromClassID: "squire", ToClassID: "dragoon"},
+	})
+	err := suite.promotionRepo.Validate(suite.classRepo)
+	checker.Assert(err, ErrorMatches, `promotion from "squire" to "dragoon" uses unknown class "dragoon"`)
+}
+
+func (suite *PromotionRepositorySuite) TestValidationFindsCycles(checker *C) {
+	suite.promotionRepo.AddPromotions([]*squaddieclass.Promotion{
+		{FromClassID: "squire", ToClassID: "knight"},
+		{FromClassID: "knight", ToClassID: "paladin"},
+		{FromClassID: "paladin", ToClassID: "knight"},
+	})
+	err := suite.promotionRepo.Validate(suite.classRepo)
+	checker.Assert(err, ErrorMatches, `promotions form a cycle: knight -> paladin -> knight`)
+}
+
+func (suite *PromotionRepositorySuite) TestValidationFindsUnreachableClasses(checker *C) {
+	suite.promotionRepo.AddPromotions([]*squaddieclass.Promotion{
+		{FromClassID: "squire", ToClassID: "knight"},
+		{FromClassID: "ranger", ToClassID: "paladin"},
+	})
+	err := suite.promotionRepo.Validate(suite.classRepo)
+	checker.Assert(err, ErrorMatches, `class "paladin" cannot be reached by any promotion`)
+}
@@ -3,6 +3,7 @@ package squaddieinterface
 import (
 	"github.com/chadius/terosgamerules/entity/affiliation"
 	"github.com/chadius/terosgamerules/entity/damagedistribution"
+	"github.com/chadius/terosgamerules/entity/lingeringeffect"
 	"github.com/chadius/terosgamerules/entity/movement"
 	"github.com/chadius/terosgamerules/entity/powerreference"
 	"github.com/chadius/terosgamerules/entity/squaddieclass"
@@ -15,6 +16,18 @@ type Interface interface {
 	ID() string
 	Name() string
 	AffiliationLogic() affiliation.Interface
+	BaseAffiliationLogic() affiliation.Interface
+	ChangeBaseAffiliation(newAffiliation affiliation.Interface)
+	OverrideAffiliation(newAffiliation affiliation.Interface, turns int)
+	HasAffiliationOverride() bool
+	AffiliationOverrideTurnsRemaining() int
+	ReduceAffiliationOverrideDuration() bool
+	RemoveAffiliationOverride() bool
+	MarkAsSummon(summonerID string, turns int)
+	SummonerID() string
+	IsSummon() bool
+	SummonTurnsRemaining() int
+	ReduceSummonDuration() bool
 	SetNewIDToRandom()
 
 	ImproveMovement(int, bool, movement.Interface)
@@ -37,12 +50,42 @@ type Interface interface {
 	IsDead() bool
 	TakeDamageDistribution(distribution *damagedistribution.DamageDistribution)
 	GainHitPoints(healingAmount int) int
+	GainBarrier(barrier int) int
+	CurrentMana() int
+	MaxMana() int
+	ManaRegeneration() int
+	SetManaToMax()
+	ReduceMana(int) int
+	GainMana(int) int
+	RegenerateMana() int
+	AddLingeringEffect(effect *lingeringeffect.LingeringEffect)
+	LingeringEffects() []*lingeringeffect.LingeringEffect
+	RemoveHarmfulLingeringEffects() int
+	ApplyLingeringEffects() int
+	AddItem(itemID string)
+	RemoveItem(itemID string) bool
+	HasItem(itemID string) bool
+	CountItem(itemID string) int
+	GetCopyOfItemIDs() []string
+	EquipItem(slot, itemID string, grantedPowerIDs []string)
+	UnequipItem(slot string) (string, []string)
+	GetEquippedItemID(slot string) string
+	GetGrantedPowerIDs(slot string) []string
+	GetEquippedItemSlots() []string
+	CanAffordManaCost(int) bool
+	DamageResistancePercent(damageType string) int
+	DamageResistancePercentByDamageType() map[string]int
 
 	ImproveOffense(int, int, int)
 	Aim() int
 	Mind() int
 	Strength() int
 
+	ExperiencePoints() int
+	LevelUpsPending() int
+	GainExperience(int) int
+	ConsumePendingLevelUp() bool
+
 	GetLevelCountsByClass() map[string]int
 	BaseClassID() string
 	AddClass(*squaddieclass.ClassReference)
@@ -64,4 +107,19 @@ type Interface interface {
 	RemovePowerReferences([]*powerreference.Reference)
 	GetEquippedPowerID() string
 	RemovePowerReferenceByPowerID(powerID string)
+	RemainingPowerCooldown(powerID string) int
+	PowerChargesUsed(powerID string) int
+	MarkPowerUsed(powerID string, cooldown int)
+	ReducePowerCooldowns()
+	CounterAttacksThisTurn() int
+	MarkCounterAttackMade()
+	GuardedSquaddieID() string
+	Guard(squaddieID string)
+	OverwatchPowerID() string
+	StartOverwatch(powerID string)
+	EndOverwatch()
+	TurnEnded() bool
+	EndTurn()
+	ResetTurnState()
+	ResetPowerUsage()
 }
@@ -1,27 +1,53 @@
 package target
 
-// NewTargetingLogic returns a new target logic object based on the keyword given.
-//  Defaults to Self target.
-func NewTargetingLogic(keyword string) Interface {
-	targetLogicByKeyword := map[string]string{
-		"friend":           "friend",
-		"Friend":           "friend",
-		"*movement.friend": "friend",
-		"*movement.Friend": "friend",
-
-		"foe":           "foe",
-		"Foe":           "foe",
-		"*movement.foe": "foe",
-		"*movement.Foe": "foe",
-	}
+import (
+	"github.com/chadius/terosgamerules/utility"
+)
 
-	if targetLogicByKeyword[keyword] == "friend" {
-		return &Friend{}
-	}
+var registry = newDefaultRegistry()
 
-	if targetLogicByKeyword[keyword] == "foe" {
-		return &Foe{}
-	}
+func newDefaultRegistry() *utility.LogicRegistry {
+	registry := utility.NewLogicRegistry("target logic")
+	registry.RegisterKeywords(
+		[]string{"self", "Self", "*target.self", "*target.Self"},
+		func() interface{} { return &Self{} },
+	)
+	registry.RegisterKeywords(
+		[]string{"friend", "Friend", "*target.friend", "*target.Friend"},
+		func() interface{} { return &Friend{} },
+	)
+	registry.RegisterKeywords(
+		[]string{"foe", "Foe", "*target.foe", "*target.Foe"},
+		func() interface{} { return &Foe{} },
+	)
+	return registry
+}
+
+// Factory creates a new target logic object.
+type Factory func() Interface
+
+// Register lets NewTargetingLogic create the logic made by factory when it sees the keyword.
+//   Raises an error if the keyword is empty or already registered.
+func Register(keyword string, factory Factory) error {
+	return registry.Register(keyword, func() interface{} { return factory() })
+}
+
+// Unregister stops NewTargetingLogic from understanding the keyword.
+func Unregister(keyword string) {
+	registry.Unregister(keyword)
+}
 
-	return &Self{}
+// Keywords returns every keyword NewTargetingLogic understands, in alphabetical order.
+func Keywords() []string {
+	return registry.Keywords()
+}
+
+// NewTargetingLogic returns a new target logic object based on the keyword given.
+//   Raises an error if the keyword was never registered.
+func NewTargetingLogic(keyword string) (Interface, error) {
+	logic, err := registry.NewLogic(keyword)
+	if err != nil {
+		return nil, err
+	}
+	return logic.(Interface), nil
 }
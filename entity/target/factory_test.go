@@ -14,16 +14,33 @@ type FactorySuite struct{}
 var _ = Suite(&FactorySuite{})
 
 func (suite *FactorySuite) TestFriendTargeting(checker *C) {
-	targetingLogic := target.NewTargetingLogic("friend")
+	targetingLogic, _ := target.NewTargetingLogic("friend")
 	checker.Assert(reflect.TypeOf(targetingLogic).String(), Equals, "*target.Friend")
 }
 
 func (suite *FactorySuite) TestFoeTargeting(checker *C) {
-	targetingLogic := target.NewTargetingLogic("foe")
+	targetingLogic, _ := target.NewTargetingLogic("foe")
 	checker.Assert(reflect.TypeOf(targetingLogic).String(), Equals, "*target.Foe")
 }
 
-func (suite *FactorySuite) TestWhenUnknownKeyword_ThenFactoryReturnsSelf(checker *C) {
-	targetingLogic := target.NewTargetingLogic("kwyjibo")
-	checker.Assert(reflect.TypeOf(targetingLogic).String(), Equals, "*target.Self")
+func (suite *FactorySuite) TestWhenUnknownKeyword_ThenFactoryRaisesAnError(checker *C) {
+	targetingLogic, err := target.NewTargetingLogic("kwyjibo")
+	checker.Assert(err, ErrorMatches, `unknown target logic keyword "kwyjibo"`)
+	checker.Assert(targetingLogic, IsNil)
+}
+
+func (suite *FactorySuite) TestCanRegisterCustomLogic(checker *C) {
+	err := target.Register("everyone", func() target.Interface { return &target.Foe{} })
+	checker.Assert(err, IsNil)
+	defer target.Unregister("everyone")
+
+	targetingLogic, err := target.NewTargetingLogic("everyone")
+	checker.Assert(err, IsNil)
+	checker.Assert(reflect.TypeOf(targetingLogic).String(), Equals, "*target.Foe")
+	checker.Assert(target.Keywords(), Not(HasLen), 0)
+}
+
+func (suite *FactorySuite) TestCannotReplaceExistingLogic(checker *C) {
+	err := target.Register("foe", func() target.Interface { return &target.Foe{} })
+	checker.Assert(err, ErrorMatches, `target logic keyword ".*" is already registered`)
 }
@@ -11,7 +11,7 @@ type TargetFoeSuite struct{}
 var _ = Suite(&TargetFoeSuite{})
 
 func (suite *TargetFoeSuite) TestTargetFoeTargetsFoes(checker *C) {
-	targeting := target.NewTargetingLogic("foe")
+	targeting, _ := target.NewTargetingLogic("foe")
 	user := squaddie.NewSquaddieBuilder().AsPlayer().Build()
 	enemy := squaddie.NewSquaddieBuilder().AsEnemy().Build()
 
@@ -19,7 +19,7 @@ func (suite *TargetFoeSuite) TestTargetFoeTargetsFoes(checker *C) {
 }
 
 func (suite *TargetFoeSuite) TestTargetFoeDoesNotWorkOnAllies(checker *C) {
-	targeting := target.NewTargetingLogic("foe")
+	targeting, _ := target.NewTargetingLogic("foe")
 	user := squaddie.NewSquaddieBuilder().AsPlayer().Build()
 	teammate := squaddie.NewSquaddieBuilder().AsPlayer().Build()
 
@@ -11,7 +11,7 @@ type TargetFriendSuite struct{}
 var _ = Suite(&TargetFriendSuite{})
 
 func (suite *TargetFriendSuite) TestTargetFriendDoesWorkOnFriends(checker *C) {
-	targeting := target.NewTargetingLogic("friend")
+	targeting, _ := target.NewTargetingLogic("friend")
 	user := squaddie.NewSquaddieBuilder().AsPlayer().Build()
 	teammate := squaddie.NewSquaddieBuilder().AsPlayer().Build()
 
@@ -19,7 +19,7 @@ func (suite *TargetFriendSuite) TestTargetFriendDoesWorkOnFriends(checker *C) {
 }
 
 func (suite *TargetFriendSuite) TestTargetFriendWorksOnFriends(checker *C) {
-	targeting := target.NewTargetingLogic("friend")
+	targeting, _ := target.NewTargetingLogic("friend")
 	user := squaddie.NewSquaddieBuilder().AsPlayer().Build()
 	enemy := squaddie.NewSquaddieBuilder().AsEnemy().Build()
 
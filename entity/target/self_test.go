@@ -11,7 +11,7 @@ type TargetSelfSuite struct{}
 var _ = Suite(&TargetSelfSuite{})
 
 func (suite *TargetSelfSuite) TestTargetSelfDoesNotWorkOnOtherSquaddie(checker *C) {
-	targeting := target.NewTargetingLogic("self")
+	targeting, _ := target.NewTargetingLogic("self")
 	user := squaddie.NewSquaddieBuilder().WithID("user").AsPlayer().Build()
 	teammate := squaddie.NewSquaddieBuilder().WithID("teammate").AsPlayer().Build()
 
@@ -19,7 +19,7 @@ func (suite *TargetSelfSuite) TestTargetSelfDoesNotWorkOnOtherSquaddie(checker *
 }
 
 func (suite *TargetSelfSuite) TestTargetSelfWorksOnUser(checker *C) {
-	targeting := target.NewTargetingLogic("self")
+	targeting, _ := target.NewTargetingLogic("self")
 	user := squaddie.NewSquaddieBuilder().AsPlayer().Build()
 
 	checker.Assert(targeting.SquaddieCanTargetOtherSquaddie(user, user), Equals, true)
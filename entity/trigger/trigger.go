@@ -0,0 +1,201 @@
+package trigger
+
+import (
+	"fmt"
+	"github.com/chadius/terosgamerules/entity/affiliation"
+	"github.com/chadius/terosgamerules/entity/powerreference"
+	"github.com/chadius/terosgamerules/entity/squaddieinterface"
+	"github.com/chadius/terosgamerules/utility"
+)
+
+// Kinds of conditions that fire a trigger.
+const (
+	// HitPointsBelow fires once the squaddie's hit points drop below the percentage of its maximum.
+	HitPointsBelow = "hit_points_below"
+	// SquaddieFelled fires once the squaddie falls.
+	SquaddieFelled = "squaddie_felled"
+	// TurnReached fires once the battle reaches the turn.
+	TurnReached = "turn_reached"
+	// ActionCount fires once the script has played the number of actions.
+	ActionCount = "action_count"
+)
+
+// Kinds of effects a trigger can have.
+const (
+	// AddSquaddies clones the template squaddie once for every new squaddie ID.
+	AddSquaddies = "add_squaddies"
+	// GainPowers gives the squaddie the powers.
+	GainPowers = "gain_powers"
+	// LosePowers takes the powers away from the squaddie.
+	LosePowers = "lose_powers"
+	// ChangeAffiliation moves the squaddie to another affiliation or faction.
+	ChangeAffiliation = "change_affiliation"
+	// ShowMessage shows the message.
+	ShowMessage = "show_message"
+)
+
+// Condition describes when a trigger fires.
+type Condition struct {
+	Kind       string `json:"kind" yaml:"kind"`
+	SquaddieID string `json:"squaddie_id" yaml:"squaddie_id"`
+	Percent    int    `json:"percent" yaml:"percent"`
+	Turn       int    `json:"turn" yaml:"turn"`
+	Actions    int    `json:"actions" yaml:"actions"`
+}
+
+// Effect describes one change a trigger makes to the battle.
+//   Affiliation is an affiliation keyword, Faction names a faction. Only one of them should be set.
+type Effect struct {
+	Kind        string                      `json:"kind" yaml:"kind"`
+	SquaddieID  string                      `json:"squaddie_id" yaml:"squaddie_id"`
+	TemplateID  string                      `json:"template_id" yaml:"template_id"`
+	SquaddieIDs []string                    `json:"squaddie_ids" yaml:"squaddie_ids"`
+	Powers      []*powerreference.Reference `json:"powers" yaml:"powers"`
+	Affiliation string                      `json:"affiliation" yaml:"affiliation"`
+	Faction     string                      `json:"faction" yaml:"faction"`
+	Message     string                      `json:"message" yaml:"message"`
+}
+
+// Trigger applies its effects, in order, the first time its condition is met.
+type Trigger struct {
+	ID        string    `json:"id" yaml:"id"`
+	Condition Condition `json:"condition" yaml:"condition"`
+	Effects   []*Effect `json:"effects" yaml:"effects"`
+}
+
+// BattleProgress counts how far the battle has gone.
+//   Turn starts at 1. ActionsPlayed counts every action in the script played so far.
+type BattleProgress struct {
+	Turn          int
+	ActionsPlayed int
+}
+
+// CheckForErrors makes sure the trigger's condition and effects are known and have the fields they need.
+func (t *Trigger) CheckForErrors() error {
+	if t.ID == "" {
+		newError := fmt.Errorf("trigger needs an id")
+		utility.Log(newError.Error(), 0, utility.Error)
+		return newError
+	}
+
+	err := t.Condition.checkForErrors(t.ID)
+	if err != nil {
+		return err
+	}
+
+	if len(t.Effects) == 0 {
+		newError := fmt.Errorf(`trigger "%s" has no effects`, t.ID)
+		utility.Log(newError.Error(), 0, utility.Error)
+		return newError
+	}
+	for _, effect := range t.Effects {
+		err = effect.checkForErrors(t.ID)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Condition) checkForErrors(triggerID string) error {
+	switch c.Kind {
+	case HitPointsBelow:
+		if c.SquaddieID == "" {
+			newError := fmt.Errorf(`trigger "%s" condition "%s" needs a squaddie`, triggerID, c.Kind)
+			utility.Log(newError.Error(), 0, utility.Error)
+			return newError
+		}
+		if c.Percent < 1 || c.Percent > 100 {
+			newError := fmt.Errorf(`trigger "%s" condition "%s" needs a percent from 1 to 100, found %d`, triggerID, c.Kind, c.Percent)
+			utility.Log(newError.Error(), 0, utility.Error)
+			return newError
+		}
+		return nil
+	case SquaddieFelled:
+		if c.SquaddieID == "" {
+			newError := fmt.Errorf(`trigger "%s" condition "%s" needs a squaddie`, triggerID, c.Kind)
+			utility.Log(newError.Error(), 0, utility.Error)
+			return newError
+		}
+		return nil
+	case TurnReached:
+		if c.Turn < 1 {
+			newError := fmt.Errorf(`trigger "%s" condition "%s" needs a turn of at least 1, found %d`, triggerID, c.Kind, c.Turn)
+			utility.Log(newError.Error(), 0, utility.Error)
+			return newError
+		}
+		return nil
+	case ActionCount:
+		if c.Actions < 1 {
+			newError := fmt.Errorf(`trigger "%s" condition "%s" needs at least 1 action, found %d`, triggerID, c.Kind, c.Actions)
+			utility.Log(newError.Error(), 0, utility.Error)
+			return newError
+		}
+		return nil
+	}
+	newError := fmt.Errorf(`trigger "%s" has unknown condition "%s"`, triggerID, c.Kind)
+	utility.Log(newError.Error(), 0, utility.Error)
+	return newError
+}
+
+func (e *Effect) checkForErrors(triggerID string) error {
+	switch e.Kind {
+	case AddSquaddies:
+		if e.TemplateID == "" || len(e.SquaddieIDs) == 0 {
+			newError := fmt.Errorf(`trigger "%s" effect "%s" needs a template and new squaddie ids`, triggerID, e.Kind)
+			utility.Log(newError.Error(), 0, utility.Error)
+			return newError
+		}
+		return nil
+	case GainPowers, LosePowers:
+		if e.SquaddieID == "" || len(e.Powers) == 0 {
+			newError := fmt.Errorf(`trigger "%s" effect "%s" needs a squaddie and powers`, triggerID, e.Kind)
+			utility.Log(newError.Error(), 0, utility.Error)
+			return newError
+		}
+		return nil
+	case ChangeAffiliation:
+		if e.SquaddieID == "" {
+			newError := fmt.Errorf(`trigger "%s" effect "%s" needs a squaddie`, triggerID, e.Kind)
+			utility.Log(newError.Error(), 0, utility.Error)
+			return newError
+		}
+		_, err := e.NewAffiliationLogic()
+		return err
+	case ShowMessage:
+		if e.Message == "" {
+			newError := fmt.Errorf(`trigger "%s" effect "%s" needs a message`, triggerID, e.Kind)
+			utility.Log(newError.Error(), 0, utility.Error)
+			return newError
+		}
+		return nil
+	}
+	newError := fmt.Errorf(`trigger "%s" has unknown effect "%s"`, triggerID, e.Kind)
+	utility.Log(newError.Error(), 0, utility.Error)
+	return newError
+}
+
+// NewAffiliationLogic returns the affiliation a ChangeAffiliation effect moves the squaddie to.
+//   Raises an error if neither Faction nor a known Affiliation keyword is set.
+func (e *Effect) NewAffiliationLogic() (affiliation.Interface, error) {
+	if e.Faction != "" {
+		return affiliation.NewFaction(e.Faction), nil
+	}
+	return affiliation.NewAffiliationLogic(e.Affiliation)
+}
+
+// IsMet returns true if the condition is met.
+//   conditionSquaddie is the squaddie named by the condition, or nil if it is not in the battle yet.
+func (c *Condition) IsMet(progress *BattleProgress, conditionSquaddie squaddieinterface.Interface) bool {
+	switch c.Kind {
+	case HitPointsBelow:
+		return conditionSquaddie != nil && conditionSquaddie.CurrentHitPoints()*100 < conditionSquaddie.MaxHitPoints()*c.Percent
+	case SquaddieFelled:
+		return conditionSquaddie != nil && conditionSquaddie.IsDead()
+	case TurnReached:
+		return progress.Turn >= c.Turn
+	case ActionCount:
+		return progress.ActionsPlayed >= c.Actions
+	}
+	return false
+}
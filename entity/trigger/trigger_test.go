@@ -0,0 +1,146 @@
+package trigger_test
+
+import (
+	"github.com/chadius/terosgamerules/entity/powerreference"
+	"github.com/chadius/terosgamerules/entity/squaddie"
+	"github.com/chadius/terosgamerules/entity/trigger"
+	. "gopkg.in/check.v1"
+	"testing"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type TriggerErrorSuite struct {
+	message *trigger.Effect
+}
+
+var _ = Suite(&TriggerErrorSuite{})
+
+func (suite *TriggerErrorSuite) SetUpTest(checker *C) {
+	suite.message = &trigger.Effect{Kind: trigger.ShowMessage, Message: "Reinforcements!"}
+}
+
+func (suite *TriggerErrorSuite) TestValidTrigger(checker *C) {
+	bossEnrages := &trigger.Trigger{
+		ID:        "bossEnrages",
+		Condition: trigger.Condition{Kind: trigger.HitPointsBelow, SquaddieID: "squaddieBoss", Percent: 50},
+		Effects: []*trigger.Effect{
+			{Kind: trigger.AddSquaddies, TemplateID: "squaddieGuard", SquaddieIDs: []string{"guard0", "guard1"}},
+			{Kind: trigger.GainPowers, SquaddieID: "squaddieBoss", Powers: []*powerreference.Reference{{Name: "Rage", PowerID: "powerRage"}}},
+			{Kind: trigger.LosePowers, SquaddieID: "squaddieBoss", Powers: []*powerreference.Reference{{Name: "Axe", PowerID: "powerAxe"}}},
+			{Kind: trigger.ChangeAffiliation, SquaddieID: "squaddieBoss", Faction: "berserkers"},
+			suite.message,
+		},
+	}
+	checker.Assert(bossEnrages.CheckForErrors(), IsNil)
+}
+
+func (suite *TriggerErrorSuite) TestRaisesErrorWithoutID(checker *C) {
+	err := (&trigger.Trigger{
+		Condition: trigger.Condition{Kind: trigger.TurnReached, Turn: 2},
+		Effects:   []*trigger.Effect{suite.message},
+	}).CheckForErrors()
+	checker.Assert(err, ErrorMatches, "trigger needs an id")
+}
+
+func (suite *TriggerErrorSuite) TestRaisesErrorWithoutEffects(checker *C) {
+	err := (&trigger.Trigger{
+		ID:        "nothingHappens",
+		Condition: trigger.Condition{Kind: trigger.TurnReached, Turn: 2},
+	}).CheckForErrors()
+	checker.Assert(err, ErrorMatches, `trigger "nothingHappens" has no effects`)
+}
+
+func (suite *TriggerErrorSuite) checkCondition(condition trigger.Condition) error {
+	return (&trigger.Trigger{
+		ID:        "test",
+		Condition: condition,
+		Effects:   []*trigger.Effect{suite.message},
+	}).CheckForErrors()
+}
+
+func (suite *TriggerErrorSuite) checkEffect(effect *trigger.Effect) error {
+	return (&trigger.Trigger{
+		ID:        "test",
+		Condition: trigger.Condition{Kind: trigger.ActionCount, Actions: 1},
+		Effects:   []*trigger.Effect{effect},
+	}).CheckForErrors()
+}
+
+func (suite *TriggerErrorSuite) TestRaisesErrorForInvalidConditions(checker *C) {
+	err := suite.checkCondition(trigger.Condition{Kind: "squaddie_arrives"})
+	checker.Assert(err, ErrorMatches, `trigger "test" has unknown condition "squaddie_arrives"`)
+
+	err = suite.checkCondition(trigger.Condition{Kind: trigger.HitPointsBelow, Percent: 50})
+	checker.Assert(err, ErrorMatches, `trigger "test" condition "hit_points_below" needs a squaddie`)
+
+	err = suite.checkCondition(trigger.Condition{Kind: trigger.HitPointsBelow, SquaddieID: "squaddieBoss", Percent: 150})
+	checker.Assert(err, ErrorMatches, `trigger "test" condition "hit_points_below" needs a percent from 1 to 100, found 150`)
+
+	err = suite.checkCondition(trigger.Condition{Kind: trigger.SquaddieFelled})
+	checker.Assert(err, ErrorMatches, `trigger "test" condition "squaddie_felled" needs a squaddie`)
+
+	err = suite.checkCondition(trigger.Condition{Kind: trigger.TurnReached})
+	checker.Assert(err, ErrorMatches, `trigger "test" condition "turn_reached" needs a turn of at least 1, found 0`)
+
+	err = suite.checkCondition(trigger.Condition{Kind: trigger.ActionCount})
+	checker.Assert(err, ErrorMatches, `trigger "test" condition "action_count" needs at least 1 action, found 0`)
+}
+
+func (suite *TriggerErrorSuite) TestRaisesErrorForInvalidEffects(checker *C) {
+	err := suite.checkEffect(&trigger.Effect{Kind: "explode"})
+	checker.Assert(err, ErrorMatches, `trigger "test" has unknown effect "explode"`)
+
+	err = suite.checkEffect(&trigger.Effect{Kind: trigger.AddSquaddies, TemplateID: "squaddieGuard"})
+	checker.Assert(err, ErrorMatches, `trigger "test" effect "add_squaddies" needs a template and new squaddie ids`)
+
+	err = suite.checkEffect(&trigger.Effect{Kind: trigger.GainPowers, SquaddieID: "squaddieBoss"})
+	checker.Assert(err, ErrorMatches, `trigger "test" effect "gain_powers" needs a squaddie and powers`)
+
+	err = suite.checkEffect(&trigger.Effect{Kind: trigger.ChangeAffiliation, Affiliation: "enemy"})
+	checker.Assert(err, ErrorMatches, `trigger "test" effect "change_affiliation" needs a squaddie`)
+
+	err = suite.checkEffect(&trigger.Effect{Kind: trigger.ChangeAffiliation, SquaddieID: "squaddieBoss", Affiliation: "pirates"})
+	checker.Assert(err, NotNil)
+
+	err = suite.checkEffect(&trigger.Effect{Kind: trigger.ShowMessage})
+	checker.Assert(err, ErrorMatches, `trigger "test" effect "show_message" needs a message`)
+}
+
+type TriggerConditionSuite struct{}
+
+var _ = Suite(&TriggerConditionSuite{})
+
+func (suite *TriggerConditionSuite) TestHitPointsBelowPercent(checker *C) {
+	boss := squaddie.NewSquaddieBuilder().Bandit().HitPoints(10).Build()
+	boss.SetHPToMax()
+	condition := &trigger.Condition{Kind: trigger.HitPointsBelow, SquaddieID: boss.ID(), Percent: 50}
+	progress := &trigger.BattleProgress{Turn: 1}
+
+	boss.ReduceHitPoints(5)
+	checker.Assert(condition.IsMet(progress, boss), Equals, false)
+	boss.ReduceHitPoints(1)
+	checker.Assert(condition.IsMet(progress, boss), Equals, true)
+	checker.Assert(condition.IsMet(progress, nil), Equals, false)
+}
+
+func (suite *TriggerConditionSuite) TestSquaddieFelled(checker *C) {
+	boss := squaddie.NewSquaddieBuilder().Bandit().HitPoints(10).Build()
+	boss.SetHPToMax()
+	condition := &trigger.Condition{Kind: trigger.SquaddieFelled, SquaddieID: boss.ID()}
+	progress := &trigger.BattleProgress{Turn: 1}
+
+	checker.Assert(condition.IsMet(progress, boss), Equals, false)
+	boss.ReduceHitPoints(10)
+	checker.Assert(condition.IsMet(progress, boss), Equals, true)
+}
+
+func (suite *TriggerConditionSuite) TestTurnReachedAndActionCount(checker *C) {
+	turnThree := &trigger.Condition{Kind: trigger.TurnReached, Turn: 3}
+	fourActions := &trigger.Condition{Kind: trigger.ActionCount, Actions: 4}
+
+	checker.Assert(turnThree.IsMet(&trigger.BattleProgress{Turn: 2, ActionsPlayed: 4}, nil), Equals, false)
+	checker.Assert(turnThree.IsMet(&trigger.BattleProgress{Turn: 3, ActionsPlayed: 4}, nil), Equals, true)
+	checker.Assert(fourActions.IsMet(&trigger.BattleProgress{Turn: 3, ActionsPlayed: 3}, nil), Equals, false)
+	checker.Assert(fourActions.IsMet(&trigger.BattleProgress{Turn: 1, ActionsPlayed: 4}, nil), Equals, true)
+}
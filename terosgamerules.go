@@ -5,9 +5,18 @@ import (
 	"fmt"
 	"github.com/chadius/terosgamerules/entity/actioncontroller"
 	"github.com/chadius/terosgamerules/entity/actionviewer"
+	"github.com/chadius/terosgamerules/entity/battlegrid"
+	"github.com/chadius/terosgamerules/entity/faction"
+	"github.com/chadius/terosgamerules/entity/item"
+	"github.com/chadius/terosgamerules/entity/levelupbenefit"
+	"github.com/chadius/terosgamerules/entity/objective"
 	"github.com/chadius/terosgamerules/entity/powerrepository"
+	"github.com/chadius/terosgamerules/entity/powerusagescenario"
 	"github.com/chadius/terosgamerules/entity/replay"
 	"github.com/chadius/terosgamerules/entity/squaddie"
+	"github.com/chadius/terosgamerules/entity/squaddieclass"
+	"github.com/chadius/terosgamerules/entity/trigger"
+	"github.com/chadius/terosgamerules/usecase/battleturn"
 	"github.com/chadius/terosgamerules/usecase/powerequip"
 	"github.com/chadius/terosgamerules/usecase/repositories"
 	"github.com/chadius/terosgamerules/utility"
@@ -21,92 +30,444 @@ import (
 //counterfeiter:generate . RulesStrategy
 // RulesStrategy shapes the expected messages and the expected responses when running the rules.
 type RulesStrategy interface {
-	ReplayBattleScript(scriptFileHandle, squaddieFileHandle, powerFileHandle io.Reader, output io.Writer) error
+	ReplayBattleScript(scriptFileHandle, squaddieFileHandle, powerFileHandle io.Reader, output io.Writer) (*objective.Outcome, error)
+	ReplayBattleScriptWithOptions(options *ReplayOptions, output io.Writer) (*objective.Outcome, error)
+}
+
+// ReplayOptions holds the input streams used to replay a battle script.
+//  The script, squaddie and power streams are required.
+//  The level and class streams let the script level up squaddies and change their classes.
+//  The item stream lets the script equip, unequip and use items.
+type ReplayOptions struct {
+	ScriptFileHandle   io.Reader
+	SquaddieFileHandle io.Reader
+	PowerFileHandle    io.Reader
+	LevelFileHandle    io.Reader
+	ClassFileHandle    io.Reader
+	ItemFileHandle     io.Reader
 }
 
 type GameRules struct{}
 
 // ReplayBattleScript uses the input streams to read and replay several rounds of combat,
 //  writing the results to a supplied output stream.
-func (g *GameRules) ReplayBattleScript(scriptFileHandle, squaddieFileHandle, powerFileHandle io.Reader, output io.Writer) error {
+//  Returns the outcome of the battle, or nil if the script has no objectives or the battle is undecided.
+func (g *GameRules) ReplayBattleScript(scriptFileHandle, squaddieFileHandle, powerFileHandle io.Reader, output io.Writer) (*objective.Outcome, error) {
+	return g.ReplayBattleScriptWithOptions(&ReplayOptions{
+		ScriptFileHandle:   scriptFileHandle,
+		SquaddieFileHandle: squaddieFileHandle,
+		PowerFileHandle:    powerFileHandle,
+	}, output)
+}
+
+// ReplayBattleScriptWithOptions works like ReplayBattleScript,
+//  but also reads the optional streams in options.
+func (g *GameRules) ReplayBattleScriptWithOptions(options *ReplayOptions, output io.Writer) (*objective.Outcome, error) {
 	utility.Logger = &utility.FileLogger{}
 
-	squaddieRepo, squaddieErr := g.createSquaddieRepo(squaddieFileHandle)
+	squaddieRepo, squaddieErr := g.createSquaddieRepo(options.SquaddieFileHandle)
 	if squaddieErr != nil {
-		return squaddieErr
+		return nil, squaddieErr
 	}
 
-	powerRepo, powerErr := g.createPowerRepo(powerFileHandle)
+	powerRepo, powerErr := g.createPowerRepo(options.PowerFileHandle)
 	if powerErr != nil {
-		return powerErr
+		return nil, powerErr
 	}
 
-	chapterReplay, scriptErr := g.createChapterReplay(scriptFileHandle)
+	chapterReplay, scriptErr := g.createChapterReplay(options.ScriptFileHandle)
 	if scriptErr != nil {
-		return scriptErr
+		return nil, scriptErr
+	}
+
+	levelRepo, levelErr := g.createLevelRepo(options.LevelFileHandle)
+	if levelErr != nil {
+		return nil, levelErr
+	}
+
+	classRepo, classErr := g.createClassRepo(options.ClassFileHandle)
+	if classErr != nil {
+		return nil, classErr
+	}
+
+	itemRepo, itemErr := g.createItemRepo(options.ItemFileHandle)
+	if itemErr != nil {
+		return nil, itemErr
 	}
 
 	repos := &repositories.RepositoryCollection{
 		PowerRepo:    powerRepo,
 		SquaddieRepo: squaddieRepo,
+		LevelRepo:    levelRepo,
+		ClassRepo:    classRepo,
+		ItemRepo:     itemRepo,
 	}
 
 	controller := actioncontroller.WhiteRoomController{}
 	viewer := actionviewer.ConsoleActionViewer{}
-	g.processSquaddieActions(chapterReplay, &viewer, &controller, repos)
+	outcome := g.processSquaddieActions(chapterReplay, &viewer, &controller, repos)
 
 	viewer.PrintMessages(output)
-	return nil
+	return outcome, nil
 }
 
+// processSquaddieActions replays every action until the script ends, an action fails or the battle is decided.
+//  Returns the outcome of the battle, or nil if it is undecided.
 func (g *GameRules) processSquaddieActions(
 	chapterReplay *replay.ChapterReplay,
 	viewer *actionviewer.ConsoleActionViewer,
 	controller *actioncontroller.WhiteRoomController,
-	repositories *repositories.RepositoryCollection) {
-	g.initializeAllSquaddies(chapterReplay, repositories)
+	repositories *repositories.RepositoryCollection) *objective.Outcome {
+	err := g.initializeGrid(chapterReplay, repositories)
+	if err != nil {
+		viewer.Messages = append(viewer.Messages, err.Error())
+		return nil
+	}
+	err = controller.CheckBattleObjectives(chapterReplay.Objectives, repositories)
+	if err != nil {
+		viewer.Messages = append(viewer.Messages, err.Error())
+		return nil
+	}
+	err = controller.CheckBattleTriggers(chapterReplay.Triggers, repositories)
+	if err != nil {
+		viewer.Messages = append(viewer.Messages, err.Error())
+		return nil
+	}
+	squaddieIDs := g.initializeAllSquaddies(chapterReplay, repositories)
+	g.initializeTeamInventory(chapterReplay, repositories)
+	err = g.initializeFactions(chapterReplay, repositories)
+	if err != nil {
+		viewer.Messages = append(viewer.Messages, err.Error())
+		return nil
+	}
+
+	progress := &trigger.BattleProgress{Turn: 1}
+	firedTriggerIDs := map[string]bool{}
 	for _, action := range chapterReplay.Actions {
-		continueProcessing := g.processSquaddieAction(
-			action,
-			viewer,
-			controller,
-			repositories,
-		)
+		if action.GetKind() == replay.NextTurn {
+			progress.Turn++
+			turnStart := controller.StartNewTurn(squaddieIDs, repositories)
+			for _, turnEnd := range turnStart.TurnEnds {
+				g.reportTurnEnd(turnEnd, viewer, repositories)
+			}
+			viewer.PrepareNextTurn(progress.Turn)
+			viewer.PrepareLingeringEffects(squaddieIDs, turnStart.HitPointChangeBySquaddieID, repositories)
+		} else {
+			summonedSquaddieIDs, continueProcessing := g.processSquaddieAction(
+				action,
+				viewer,
+				controller,
+				repositories,
+			)
+
+			if continueProcessing == false {
+				return nil
+			}
+			squaddieIDs = append(squaddieIDs, summonedSquaddieIDs...)
+		}
+
+		progress.ActionsPlayed++
+
+		addedSquaddieIDs, err := g.fireTriggers(chapterReplay.Triggers, firedTriggerIDs, progress, viewer, controller, repositories)
+		if err != nil {
+			viewer.Messages = append(viewer.Messages, err.Error())
+			return nil
+		}
+		squaddieIDs = append(squaddieIDs, addedSquaddieIDs...)
 
-		if continueProcessing == false {
-			break
+		if len(chapterReplay.Objectives) == 0 {
+			continue
+		}
+		outcome := controller.CheckBattleOutcome(chapterReplay.Objectives, chapterReplay.PlayerAffiliation, squaddieIDs, progress.Turn-1, repositories)
+		if outcome != nil {
+			viewer.PrepareBattleOutcome(outcome, repositories)
+			return outcome
 		}
 	}
+
+	if len(chapterReplay.Objectives) > 0 {
+		viewer.PrepareBattleOutcome(nil, repositories)
+	}
+	return nil
 }
 
+// processSquaddieAction performs the action and shows the results.
+//  Returns the IDs of any squaddies the action summoned and false if processing should stop.
 func (g *GameRules) processSquaddieAction(
 	action *replay.SquaddieAction,
 	viewer *actionviewer.ConsoleActionViewer,
 	controller *actioncontroller.WhiteRoomController,
-	repositories *repositories.RepositoryCollection) bool {
+	repositories *repositories.RepositoryCollection) ([]string, bool) {
+
+	switch action.GetKind() {
+	case replay.LevelUp:
+		levelUp, err := controller.LevelUpSquaddie(action.UserID, action.BigLevelID, action.RandomSeed, repositories)
+		if err != nil {
+			viewer.Messages = append(viewer.Messages, err.Error())
+			return nil, false
+		}
+		viewer.PrepareLevelUp(levelUp, repositories)
+		return nil, true
+	case replay.ChangeClass:
+		err := controller.ChangeSquaddieClass(action.UserID, action.ClassID, repositories)
+		if err != nil {
+			viewer.Messages = append(viewer.Messages, err.Error())
+			return nil, false
+		}
+		viewer.PrepareClassChange(action.UserID, repositories)
+		return nil, true
+	case replay.EquipPower:
+		err := controller.EquipSquaddiePower(action.UserID, action.PowerID, repositories)
+		if err != nil {
+			viewer.Messages = append(viewer.Messages, err.Error())
+			return nil, false
+		}
+		viewer.PrepareEquipPower(action.UserID, repositories)
+		return nil, true
+	case replay.EquipItem:
+		err := controller.EquipSquaddieItem(action.UserID, action.ItemID, repositories)
+		if err != nil {
+			viewer.Messages = append(viewer.Messages, err.Error())
+			return nil, false
+		}
+		viewer.PrepareEquipItem(action.UserID, action.ItemID, repositories)
+		return nil, true
+	case replay.UnequipItem:
+		itemID, err := controller.UnequipSquaddieItem(action.UserID, action.Slot, repositories)
+		if err != nil {
+			viewer.Messages = append(viewer.Messages, err.Error())
+			return nil, false
+		}
+		viewer.PrepareUnequipItem(action.UserID, itemID, repositories)
+		return nil, true
+	case replay.UseItem:
+		return g.processItemUse(action, viewer, controller, repositories)
+	case replay.EndTurn:
+		turnEnd, err := controller.EndSquaddieTurn(action.UserID, repositories)
+		if err != nil {
+			viewer.Messages = append(viewer.Messages, err.Error())
+			return nil, false
+		}
+		g.reportTurnEnd(turnEnd, viewer, repositories)
+		return nil, true
+	case replay.Guard:
+		return nil, g.processGuard(action, viewer, controller, repositories)
+	case replay.Overwatch:
+		err := controller.StartOverwatch(action.UserID, action.PowerID, repositories)
+		if err != nil {
+			viewer.Messages = append(viewer.Messages, err.Error())
+			return nil, false
+		}
+		viewer.PrepareOverwatch(action.UserID, repositories)
+		return nil, true
+	case replay.Move:
+		return g.processMove(action, viewer, controller, repositories)
+	case replay.ChangeRelationship:
+		err := controller.ChangeFactionRelationship(action.FactionID, action.OtherFactionID, action.Relationship, repositories)
+		if err != nil {
+			viewer.Messages = append(viewer.Messages, err.Error())
+			return nil, false
+		}
+		viewer.PrepareRelationshipChange(action.FactionID, action.OtherFactionID, action.Relationship, repositories)
+		return nil, true
+	case replay.UsePower:
+		// Powers are used below.
+	default:
+		newError := fmt.Errorf("unknown action kind %q", action.Kind)
+		utility.Log(newError.Error(), 0, utility.Error)
+		viewer.Messages = append(viewer.Messages, newError.Error())
+		return nil, false
+	}
 
 	powerSetup := controller.SetupAction(action.UserID, action.TargetIDs, action.PowerID)
 
-	reasonsForInvalidAction := controller.CheckForValidAction(powerSetup, repositories)
-	if len(reasonsForInvalidAction) > 0 {
-		for _, reason := range reasonsForInvalidAction {
-			for _, description := range reason.Description {
-				viewer.Messages = append(viewer.Messages, description)
-			}
-		}
-		return false
+	if g.reportInvalidAction(powerSetup, viewer, controller, repositories) {
+		return nil, false
 	}
 
 	forecast := controller.GenerateForecast(powerSetup, repositories)
 	viewer.PrepareForecast(forecast, repositories)
 
-	result := controller.GenerateResult(forecast, repositories, true, action.RandomSeed)
-	viewer.PrepareResult(result, repositories, &actionviewer.ConsoleActionViewerVerbosity{
+	result, err := controller.GenerateResult(forecast, repositories, true, action.RandomSeed)
+	if err != nil {
+		viewer.Messages = append(viewer.Messages, err.Error())
+		return nil, false
+	}
+	awards := controller.AwardExperience(result, repositories)
+	levelUps := controller.ResolveLevelUps(awards, g.getBigLevelChoicesBySquaddieID(action), action.RandomSeed, repositories)
+	viewer.PrepareResultWithExperience(result, awards, levelUps, repositories, &actionviewer.ConsoleActionViewerVerbosity{
 		ShowTargetStatus: true,
 	})
+	return result.SummonedSquaddieIDs(), true
+}
+
+// reportTurnEnd shows the squaddie returning to its side and its summon vanishing when its turn ended.
+func (g *GameRules) reportTurnEnd(
+	turnEnd *battleturn.TurnEnd,
+	viewer *actionviewer.ConsoleActionViewer,
+	repositories *repositories.RepositoryCollection) {
+	viewer.PrepareEndTurn(turnEnd.SquaddieID, turnEnd.AffiliationRestored, repositories)
+	if turnEnd.SummonVanished {
+		viewer.PrepareSummonVanished(turnEnd.SquaddieID, repositories)
+	}
+}
+
+// processGuard makes the user guard the first target and shows it. Returns false if processing should stop.
+func (g *GameRules) processGuard(
+	action *replay.SquaddieAction,
+	viewer *actionviewer.ConsoleActionViewer,
+	controller *actioncontroller.WhiteRoomController,
+	repositories *repositories.RepositoryCollection) bool {
+
+	if len(action.TargetIDs) == 0 {
+		newError := fmt.Errorf(`squaddie "%s" must name a squaddie to guard`, action.UserID)
+		utility.Log(newError.Error(), 0, utility.Error)
+		viewer.Messages = append(viewer.Messages, newError.Error())
+		return false
+	}
+
+	err := controller.GuardSquaddie(action.UserID, action.TargetIDs[0], repositories)
+	if err != nil {
+		viewer.Messages = append(viewer.Messages, err.Error())
+		return false
+	}
+	viewer.PrepareGuard(action.UserID, action.TargetIDs[0], repositories)
 	return true
 }
 
+// processMove moves the user and has every squaddie whose overwatch it triggered attack the user, in order.
+//  Reactions that cannot be performed, such as attacking a squaddie that already fell, are skipped.
+//  Returns the IDs of any squaddies the reactions summoned and false if processing should stop.
+func (g *GameRules) processMove(
+	action *replay.SquaddieAction,
+	viewer *actionviewer.ConsoleActionViewer,
+	controller *actioncontroller.WhiteRoomController,
+	repositories *repositories.RepositoryCollection) ([]string, bool) {
+
+	if action.Destination == nil {
+		newError := fmt.Errorf(`squaddie "%s" must name a destination to move to`, action.UserID)
+		utility.Log(newError.Error(), 0, utility.Error)
+		viewer.Messages = append(viewer.Messages, newError.Error())
+		return nil, false
+	}
+
+	overwatcherIDs, err := controller.MoveSquaddie(action.UserID, *action.Destination, repositories)
+	if err != nil {
+		viewer.Messages = append(viewer.Messages, err.Error())
+		return nil, false
+	}
+	viewer.PrepareMove(action.UserID, overwatcherIDs, repositories)
+
+	summonedSquaddieIDs := []string{}
+	for _, overwatcherID := range overwatcherIDs {
+		powerSetup := controller.TriggerOverwatch(overwatcherID, action.UserID, repositories)
+		if len(controller.CheckForValidAction(powerSetup, repositories)) > 0 {
+			continue
+		}
+
+		forecast := controller.GenerateForecast(powerSetup, repositories)
+		viewer.PrepareForecast(forecast, repositories)
+
+		result, err := controller.GenerateResult(forecast, repositories, true, action.RandomSeed)
+		if err != nil {
+			viewer.Messages = append(viewer.Messages, err.Error())
+			return nil, false
+		}
+		awards := controller.AwardExperience(result, repositories)
+		levelUps := controller.ResolveLevelUps(awards, g.getBigLevelChoicesBySquaddieID(action), action.RandomSeed, repositories)
+		viewer.PrepareResultWithExperience(result, awards, levelUps, repositories, &actionviewer.ConsoleActionViewerVerbosity{
+			ShowTargetStatus: true,
+		})
+		summonedSquaddieIDs = append(summonedSquaddieIDs, result.SummonedSquaddieIDs()...)
+	}
+	return summonedSquaddieIDs, true
+}
+
+// fireTriggers applies the effects of every trigger whose condition was met, in the order they were declared.
+//  Each trigger fires once. Returns the IDs of the squaddies the triggers added to the battle.
+func (g *GameRules) fireTriggers(
+	triggers []*trigger.Trigger,
+	firedTriggerIDs map[string]bool,
+	progress *trigger.BattleProgress,
+	viewer *actionviewer.ConsoleActionViewer,
+	controller *actioncontroller.WhiteRoomController,
+	repositories *repositories.RepositoryCollection) ([]string, error) {
+
+	addedSquaddieIDs := []string{}
+	for _, triggerToFire := range controller.GetTriggersToFire(triggers, firedTriggerIDs, progress, repositories) {
+		firedTriggerIDs[triggerToFire.ID] = true
+		newSquaddieIDs, err := controller.ApplyTriggerEffects(triggerToFire, repositories)
+		if err != nil {
+			return nil, err
+		}
+		viewer.PrepareTrigger(triggerToFire, repositories)
+		addedSquaddieIDs = append(addedSquaddieIDs, newSquaddieIDs...)
+	}
+	return addedSquaddieIDs, nil
+}
+
+// processItemUse has the squaddie consume an item, using its power like any other power.
+//  The item is used up before its power takes effect. Using items does not award experience.
+func (g *GameRules) processItemUse(
+	action *replay.SquaddieAction,
+	viewer *actionviewer.ConsoleActionViewer,
+	controller *actioncontroller.WhiteRoomController,
+	repositories *repositories.RepositoryCollection) ([]string, bool) {
+
+	powerSetup, err := controller.SetupItemUse(action.UserID, action.ItemID, action.TargetIDs, repositories)
+	if err != nil {
+		viewer.Messages = append(viewer.Messages, err.Error())
+		return nil, false
+	}
+
+	if g.reportInvalidAction(powerSetup, viewer, controller, repositories) {
+		return nil, false
+	}
+
+	forecast := controller.GenerateForecast(powerSetup, repositories)
+	viewer.PrepareForecast(forecast, repositories)
+
+	err = controller.ConsumeItem(action.UserID, action.ItemID, repositories)
+	if err != nil {
+		viewer.Messages = append(viewer.Messages, err.Error())
+		return nil, false
+	}
+	result, err := controller.GenerateResult(forecast, repositories, true, action.RandomSeed)
+	if err != nil {
+		viewer.Messages = append(viewer.Messages, err.Error())
+		return nil, false
+	}
+	viewer.PrepareItemUse(action.ItemID, result, repositories, &actionviewer.ConsoleActionViewerVerbosity{
+		ShowTargetStatus: true,
+	})
+	return result.SummonedSquaddieIDs(), true
+}
+
+// reportInvalidAction adds a message for every reason the action cannot be performed.
+//  Returns true if the action is invalid.
+func (g *GameRules) reportInvalidAction(
+	powerSetup *powerusagescenario.Setup,
+	viewer *actionviewer.ConsoleActionViewer,
+	controller *actioncontroller.WhiteRoomController,
+	repositories *repositories.RepositoryCollection) bool {
+
+	reasonsForInvalidAction := controller.CheckForValidAction(powerSetup, repositories)
+	for _, reason := range reasonsForInvalidAction {
+		for _, description := range reason.Description {
+			viewer.Messages = append(viewer.Messages, description)
+		}
+	}
+	return len(reasonsForInvalidAction) > 0
+}
+
+func (g *GameRules) getBigLevelChoicesBySquaddieID(action *replay.SquaddieAction) map[string]string {
+	bigLevelIDBySquaddieID := map[string]string{}
+	for _, choice := range action.LevelUpChoices {
+		bigLevelIDBySquaddieID[choice.SquaddieID] = choice.BigLevelID
+	}
+	return bigLevelIDBySquaddieID
+}
+
 func (g *GameRules) loadSquaddieRepo(squaddieYamlData []byte) (repo *squaddie.Repository) {
 	squaddieRepo := squaddie.NewSquaddieRepository()
 	err := squaddieRepo.AddSquaddiesUsingYAML(squaddieYamlData)
@@ -125,21 +486,63 @@ func (g *GameRules) loadPowerRepo(powerYamlData []byte) (*powerrepository.Reposi
 	return powerRepo, nil
 }
 
-func (g *GameRules) initializeAllSquaddies(replay *replay.ChapterReplay, repositories *repositories.RepositoryCollection) {
-	squaddiesFound := map[string]bool{}
-
+// initializeAllSquaddies prepares every squaddie named in the actions, objectives or triggers for battle.
+//  Squaddies that triggers add later are skipped. Returns the IDs of the prepared squaddies in the order they were found.
+func (g *GameRules) initializeAllSquaddies(replay *replay.ChapterReplay, repositories *repositories.RepositoryCollection) []string {
+	namedSquaddieIDs := []string{}
 	for _, action := range replay.Actions {
-		if squaddiesFound[action.UserID] != true {
-			g.loadAndInitializeSquaddie(action.UserID, repositories)
-			squaddiesFound[action.UserID] = true
-		}
-		for _, targetID := range action.TargetIDs {
-			if squaddiesFound[targetID] != true {
-				g.loadAndInitializeSquaddie(targetID, repositories)
-				squaddiesFound[targetID] = true
-			}
+		namedSquaddieIDs = append(namedSquaddieIDs, action.UserID)
+		namedSquaddieIDs = append(namedSquaddieIDs, action.TargetIDs...)
+	}
+	for _, position := range replay.Positions {
+		namedSquaddieIDs = append(namedSquaddieIDs, position.SquaddieID)
+	}
+	for _, objectiveToInitialize := range replay.Objectives {
+		namedSquaddieIDs = append(namedSquaddieIDs, objectiveToInitialize.SquaddieID)
+	}
+	for _, triggerToInitialize := range replay.Triggers {
+		namedSquaddieIDs = append(namedSquaddieIDs, triggerToInitialize.Condition.SquaddieID)
+		for _, effect := range triggerToInitialize.Effects {
+			namedSquaddieIDs = append(namedSquaddieIDs, effect.SquaddieID)
 		}
 	}
+
+	squaddiesFound := map[string]bool{}
+	squaddieIDs := []string{}
+	for _, squaddieID := range namedSquaddieIDs {
+		if squaddieID == "" || repositories.SquaddieRepo.GetOriginalSquaddieByID(squaddieID) == nil {
+			continue
+		}
+		if squaddiesFound[squaddieID] != true {
+			g.loadAndInitializeSquaddie(squaddieID, repositories)
+			squaddiesFound[squaddieID] = true
+			squaddieIDs = append(squaddieIDs, squaddieID)
+		}
+	}
+	return squaddieIDs
+}
+
+func (g *GameRules) initializeTeamInventory(replay *replay.ChapterReplay, repositories *repositories.RepositoryCollection) {
+	repositories.TeamInventory = item.NewTeamInventory()
+	for _, stack := range replay.TeamItems {
+		repositories.TeamInventory.AddItem(stack.Team, stack.ItemID, stack.Count)
+	}
+}
+
+func (g *GameRules) initializeFactions(replay *replay.ChapterReplay, repositories *repositories.RepositoryCollection) error {
+	repositories.FactionRepo = faction.NewRepository()
+	repositories.FactionRepo.AddFactions(replay.Factions)
+	_, err := repositories.FactionRepo.AddRelationships(replay.Relationships)
+	return err
+}
+
+// initializeGrid places the squaddies on the battle grid. Battles without positions have no grid.
+func (g *GameRules) initializeGrid(replay *replay.ChapterReplay, repositories *repositories.RepositoryCollection) error {
+	if len(replay.Positions) == 0 {
+		return nil
+	}
+	repositories.Grid = battlegrid.NewGrid()
+	return repositories.Grid.AddPlacements(replay.Positions)
 }
 
 func (g *GameRules) loadAndInitializeSquaddie(squaddieID string, repositories *repositories.RepositoryCollection) {
@@ -189,6 +592,60 @@ func (g *GameRules) createPowerRepo(input io.Reader) (*powerrepository.Repositor
 	return repo, nil
 }
 
+func (g *GameRules) createLevelRepo(input io.Reader) (*levelupbenefit.Repository, error) {
+	if input == nil || reflect.ValueOf(input).IsNil() {
+		return nil, nil
+	}
+
+	levelData, levelErr := ioutil.ReadAll(input)
+	if levelErr != nil {
+		return nil, levelErr
+	}
+
+	repo := levelupbenefit.NewLevelUpBenefitRepository()
+	loadErr := repo.AddYAML(levelData)
+	if loadErr != nil {
+		return nil, errors.New("level data is invalid")
+	}
+	return repo, nil
+}
+
+func (g *GameRules) createClassRepo(input io.Reader) (*squaddieclass.Repository, error) {
+	if input == nil || reflect.ValueOf(input).IsNil() {
+		return nil, nil
+	}
+
+	classData, classErr := ioutil.ReadAll(input)
+	if classErr != nil {
+		return nil, classErr
+	}
+
+	repo := squaddieclass.NewRepository()
+	_, loadErr := repo.AddYAMLSource(classData)
+	if loadErr != nil {
+		return nil, errors.New("class data is invalid")
+	}
+	return repo, nil
+}
+
+func (g *GameRules) createItemRepo(input io.Reader) (*item.Repository, error) {
+	if input == nil || reflect.ValueOf(input).IsNil() {
+		return nil, nil
+	}
+
+	itemData, itemErr := ioutil.ReadAll(input)
+	if itemErr != nil {
+		return nil, itemErr
+	}
+
+	repo := item.NewRepository()
+	_, loadErr := repo.AddYAMLSource(itemData)
+	if loadErr != nil {
+		return nil, fmt.Errorf("item data is invalid: %w", loadErr)
+	}
+	return repo, nil
+}
+
 func (g *GameRules) createChapterReplay(input io.Reader) (*replay.ChapterReplay, error) {
 	if input == nil || reflect.ValueOf(input).IsNil() {
 		return nil, errors.New("no script data found")
@@ -201,7 +658,7 @@ func (g *GameRules) createChapterReplay(input io.Reader) (*replay.ChapterReplay,
 
 	chapterReplay, replayErr := replay.NewCreateMapReplayFromYAML(scriptData)
 	if replayErr != nil {
-		return nil, errors.New("script data is invalid")
+		return nil, fmt.Errorf("script data is invalid: %s", replayErr.Error())
 	}
 
 	return chapterReplay, nil
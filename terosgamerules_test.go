@@ -3,6 +3,7 @@ package terosgamerules_test
 import (
 	"bytes"
 	"github.com/chadius/terosgamerules"
+	"github.com/chadius/terosgamerules/entity/objective"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"strings"
@@ -106,7 +107,7 @@ func (suite *ReplayScriptExpectedOutput) TestWhenCustomerUsesValidDataIsUsed_Get
 	gameRunner := terosgamerules.GameRules{}
 
 	// Run
-	err := gameRunner.ReplayBattleScript(
+	_, err := gameRunner.ReplayBattleScript(
 		useValidScriptData(),
 		useValidSquaddieData(),
 		useValidPowerData(),
@@ -117,7 +118,7 @@ func (suite *ReplayScriptExpectedOutput) TestWhenCustomerUsesValidDataIsUsed_Get
 	require := require.New(suite.T())
 	require.Nil(err, "no errors should have been found")
 
-	expectedOutput := "Teros (Spear) vs Bandit: +2 (30/36), for 3 damage\n crit: 3/36, FATAL\nBandit (Axe) counters Teros: -5 (1/36) for NO DAMAGE + 2 barrier burn\nTeros (Spear) hits Bandit, for 3 damage\n   Bandit: 2/5 HP\nBandit (Axe) misses Teros\n   Teros: 5/5 HP, 3 barrier\n---\nBandit (Axe) vs Teros: -3 (6/36) for NO DAMAGE + 2 barrier burn\nTeros (Spear) counters Bandit: +0 (21/36), FATAL\nBandit (Axe) misses Teros\n   Teros: 5/5 HP, 3 barrier\nTeros (Spear) counters Bandit, felling\n   Bandit: 0/5 HP\n---\n"
+	expectedOutput := "Teros (Spear) vs Bandit: +2 (30/36), for 3 damage\n crit: 3/36, FATAL\nBandit (Axe) counters Teros: -5 (1/36) for NO DAMAGE + 2 barrier burn\nTeros (Spear) hits Bandit, for 3 damage\n   Bandit: 2/5 HP\nBandit (Axe) misses Teros\n   Teros: 5/5 HP, 3 barrier\n   Teros gains 10 XP\n   Bandit gains 1 XP\n---\nBandit (Axe) vs Teros: -3 (6/36) for NO DAMAGE + 2 barrier burn\nTeros (Spear) counters Bandit: +0 (21/36), FATAL\nBandit (Axe) hits Teros, for 0 damage + 2 barrier burn\n   Teros: 5/5 HP, 1 barrier\nTeros (Spear) misses Bandit\n   Bandit: 2/5 HP\n   Bandit gains 10 XP\n   Teros gains 1 XP\n---\n"
 	require.Equal(expectedOutput, output.String())
 }
 
@@ -138,7 +139,7 @@ func (suite *ReplayScriptErrorsSuite) TestWhenAllDataExists_ThenNoErrors() {
 	scriptDataBuffer := useValidScriptData()
 
 	// Run
-	err := suite.gameRunner.ReplayBattleScript(
+	_, err := suite.gameRunner.ReplayBattleScript(
 		scriptDataBuffer,
 		squaddieDataBuffer,
 		powerDataBuffer,
@@ -155,7 +156,7 @@ func (suite *ReplayScriptErrorsSuite) TestWhenSquaddieDataIsMissing_ThenSquaddie
 	scriptDataBuffer := useValidScriptData()
 
 	// Run
-	err := suite.gameRunner.ReplayBattleScript(
+	_, err := suite.gameRunner.ReplayBattleScript(
 		scriptDataBuffer,
 		nil,
 		powerDataBuffer,
@@ -175,7 +176,7 @@ func (suite *ReplayScriptErrorsSuite) TestWhenSquaddieDataIsInvalid_ThenReportNo
 	scriptDataBuffer := useValidScriptData()
 
 	// Run
-	err := suite.gameRunner.ReplayBattleScript(
+	_, err := suite.gameRunner.ReplayBattleScript(
 		scriptDataBuffer,
 		squaddieDataBuffer,
 		powerDataBuffer,
@@ -193,7 +194,7 @@ func (suite *ReplayScriptErrorsSuite) TestWhenPowerDataIsMissing_ThenPowerDataEr
 	scriptDataBuffer := useValidScriptData()
 
 	// Run
-	err := suite.gameRunner.ReplayBattleScript(
+	_, err := suite.gameRunner.ReplayBattleScript(
 		scriptDataBuffer,
 		squaddieDataBuffer,
 		nil,
@@ -213,7 +214,7 @@ func (suite *ReplayScriptErrorsSuite) TestWhenPowerDataIsInvalid_ThenReportNoPow
 	scriptDataBuffer := useValidScriptData()
 
 	// Run
-	err := suite.gameRunner.ReplayBattleScript(
+	_, err := suite.gameRunner.ReplayBattleScript(
 		scriptDataBuffer,
 		squaddieDataBuffer,
 		powerDataBuffer,
@@ -231,7 +232,7 @@ func (suite *ReplayScriptErrorsSuite) TestWhenScriptDataIsMissing_ThenScriptData
 	powerDataBuffer := useValidPowerData()
 
 	// Run
-	err := suite.gameRunner.ReplayBattleScript(
+	_, err := suite.gameRunner.ReplayBattleScript(
 		nil,
 		squaddieDataBuffer,
 		powerDataBuffer,
@@ -251,7 +252,7 @@ func (suite *ReplayScriptErrorsSuite) TestWhenScriptDataIsInvalid_ThenReportNoSc
 	powerDataBuffer := useValidPowerData()
 
 	// Run
-	err := suite.gameRunner.ReplayBattleScript(
+	_, err := suite.gameRunner.ReplayBattleScript(
 		scriptDataBuffer,
 		squaddieDataBuffer,
 		powerDataBuffer,
@@ -263,3 +264,2447 @@ func (suite *ReplayScriptErrorsSuite) TestWhenScriptDataIsInvalid_ThenReportNoSc
 	require.Error(err, "Did not report script data error")
 	require.Containsf(err.Error(), "script data is invalid", "Error message does not match.")
 }
+
+func (suite *ReplayScriptErrorsSuite) TestWhenScriptUsesAnUnknownPower_ThenReportTheError() {
+	// Setup
+	var output strings.Builder
+	scriptDataBuffer := bytes.NewBuffer([]byte(`---
+version: 0.1F
+actions:
+  -
+    user_id: squaddieTeros
+    power_id: powerDoesNotExist
+`))
+
+	// Run
+	_, err := suite.gameRunner.ReplayBattleScript(
+		scriptDataBuffer,
+		useValidSquaddieData(),
+		useValidPowerData(),
+		&output,
+	)
+
+	// Require
+	require := require.New(suite.T())
+	require.Nil(err, "no errors should have been found")
+	require.Equal("Power does not exist\n  Teros[squaddieTeros] tried to use unknown power powerDoesNotExist\n", output.String())
+}
+
+func (suite *ReplayScriptErrorsSuite) TestWhenScriptHasAnUnknownActionKind_ThenScriptDataErrors() {
+	scriptDataBuffer := bytes.NewBuffer([]byte(`---
+version: 0.1F
+actions:
+  -
+    kind: end_trun
+    user_id: squaddieTeros
+`))
+
+	// Run
+	_, err := suite.gameRunner.ReplayBattleScript(
+		scriptDataBuffer,
+		useValidSquaddieData(),
+		useValidPowerData(),
+		&suite.byteOutput,
+	)
+
+	// Require
+	require := require.New(suite.T())
+	require.Error(err, "Did not report script data error")
+	require.Equal(`script data is invalid: unknown action kind "end_trun"`, err.Error())
+}
+
+func useProgressionPowerData() *bytes.Buffer {
+	powerData := useValidPowerData()
+	powerData.WriteString(`
+-
+  name: Blot
+  id: powerBlot
+  power_type: spell
+  target_foe: true
+  can_attack: true
+  damage_bonus: 3
+  can_be_equipped: true
+`)
+	return powerData
+}
+
+func useProgressionLevelData() *bytes.Buffer {
+	levelData := []byte(`
+-
+  id: mageBigBlot
+  class_id: classMage
+  is_a_big_level: true
+  powers_gained:
+    -
+      name: Blot
+      id: powerBlot
+-
+  id: mageSmall0
+  class_id: classMage
+  aim: 1
+`)
+	return bytes.NewBuffer(levelData)
+}
+
+func useProgressionClassData() *bytes.Buffer {
+	classData := []byte(`
+-
+  id: classMage
+  name: Mage
+`)
+	return bytes.NewBuffer(classData)
+}
+
+func useProgressionScriptData() *bytes.Buffer {
+	scriptData := []byte(`---
+version: 0.1F
+actions:
+  -
+    kind: change_class
+    user_id: squaddieTeros
+    class_id: classMage
+  -
+    kind: level_up
+    user_id: squaddieTeros
+    big_level_id: mageBigBlot
+  -
+    kind: equip_power
+    user_id: squaddieTeros
+    power_id: powerBlot
+`)
+	return bytes.NewBuffer(scriptData)
+}
+
+func TestReplayScriptProgressionSuite(t *testing.T) {
+	suite.Run(t, new(ReplayScriptProgressionSuite))
+}
+
+type ReplayScriptProgressionSuite struct {
+	suite.Suite
+}
+
+func (suite *ReplayScriptProgressionSuite) TestWhenScriptChangesClassAndLevelsUp_ThenReportProgression() {
+	// Setup
+	var output strings.Builder
+	gameRunner := terosgamerules.GameRules{}
+
+	// Run
+	_, err := gameRunner.ReplayBattleScriptWithOptions(
+		&terosgamerules.ReplayOptions{
+			ScriptFileHandle:   useProgressionScriptData(),
+			SquaddieFileHandle: useValidSquaddieData(),
+			PowerFileHandle:    useProgressionPowerData(),
+			LevelFileHandle:    useProgressionLevelData(),
+			ClassFileHandle:    useProgressionClassData(),
+		},
+		&output,
+	)
+
+	// Require
+	require := require.New(suite.T())
+	require.Nil(err, "no errors should have been found")
+
+	expectedOutput := "Teros switches class to Mage\n---\nTeros levels up: learns Blot, +1 Aim\n---\nTeros equips Blot\n---\n"
+	require.Equal(expectedOutput, output.String())
+}
+
+func (suite *ReplayScriptProgressionSuite) TestWhenAttacksEarnLevelUps_ThenTheRandomSeedPicksTheSmallLevel() {
+	// Setup
+	squaddieData := func() *bytes.Buffer {
+		return bytes.NewBuffer([]byte(`
+-
+  name: Teros
+  id: squaddieTeros
+  affiliation: player
+  aim: 2
+  strength: 1
+  max_hit_points: 5
+  experience_points: 95
+  powers:
+    -
+      name: Spear
+      id: powerSpear
+-
+  name: Bandit
+  id: squaddieBandit0
+  affiliation: enemy
+  max_hit_points: 5
+  powers:
+    -
+      name: Axe
+      id: powerAxe
+`))
+	}
+	levelData := func() *bytes.Buffer {
+		return bytes.NewBuffer([]byte(`
+-
+  id: mageSmallAim
+  class_id: classMage
+  aim: 1
+-
+  id: mageSmallStrength
+  class_id: classMage
+  strength: 1
+-
+  id: mageSmallArmor
+  class_id: classMage
+  armor: 1
+`))
+	}
+	scriptData := func() *bytes.Buffer {
+		return bytes.NewBuffer([]byte(`---
+version: 0.1F
+actions:
+  -
+    kind: change_class
+    user_id: squaddieTeros
+    class_id: classMage
+  -
+    random_seed: 1000
+    user_id: squaddieTeros
+    power_id: powerSpear
+    target_ids:
+      - squaddieBandit0
+`))
+	}
+	replay := func() string {
+		var output strings.Builder
+		gameRunner := terosgamerules.GameRules{}
+		_, err := gameRunner.ReplayBattleScriptWithOptions(
+			&terosgamerules.ReplayOptions{
+				ScriptFileHandle:   scriptData(),
+				SquaddieFileHandle: squaddieData(),
+				PowerFileHandle:    useValidPowerData(),
+				LevelFileHandle:    levelData(),
+				ClassFileHandle:    useProgressionClassData(),
+			},
+			&output,
+		)
+		suite.Require().Nil(err, "no errors should have been found")
+		return output.String()
+	}
+
+	// Run
+	firstOutput := replay()
+	secondOutput := replay()
+
+	// Require
+	require := require.New(suite.T())
+	expectedOutput := "Teros switches class to Mage\n---\nTeros (Spear) vs Bandit: +2 (30/36), for 3 damage\n crit: 3/36, FATAL\nBandit (Axe) counters Teros: -2 (10/36), for 1 damage\nTeros (Spear) hits Bandit, for 3 damage\n   Bandit: 2/5 HP\nBandit (Axe) misses Teros\n   Teros: 5/5 HP\n   Teros gains 10 XP\n   Teros is ready to level up\n   Bandit gains 1 XP\n   Teros levels up: +1 Strength\n---\n"
+	require.Equal(expectedOutput, firstOutput)
+	require.Equal(firstOutput, secondOutput)
+}
+
+func (suite *ReplayScriptProgressionSuite) TestWhenLevelDataIsInvalid_ThenReportNoLevelInformation() {
+	var output strings.Builder
+	gameRunner := terosgamerules.GameRules{}
+
+	// Run
+	_, err := gameRunner.ReplayBattleScriptWithOptions(
+		&terosgamerules.ReplayOptions{
+			ScriptFileHandle:   useProgressionScriptData(),
+			SquaddieFileHandle: useValidSquaddieData(),
+			PowerFileHandle:    useProgressionPowerData(),
+			LevelFileHandle:    bytes.NewBuffer([]byte(`Not Valid JSON/YAML`)),
+			ClassFileHandle:    useProgressionClassData(),
+		},
+		&output,
+	)
+
+	// Require
+	require := require.New(suite.T())
+	require.Error(err, "Did not report level data error")
+	require.Containsf(err.Error(), "level data is invalid", "Error message does not match.")
+}
+
+func (suite *ReplayScriptProgressionSuite) TestWhenClassDataIsInvalid_ThenReportNoClassInformation() {
+	var output strings.Builder
+	gameRunner := terosgamerules.GameRules{}
+
+	// Run
+	_, err := gameRunner.ReplayBattleScriptWithOptions(
+		&terosgamerules.ReplayOptions{
+			ScriptFileHandle:   useProgressionScriptData(),
+			SquaddieFileHandle: useValidSquaddieData(),
+			PowerFileHandle:    useProgressionPowerData(),
+			LevelFileHandle:    useProgressionLevelData(),
+			ClassFileHandle:    bytes.NewBuffer([]byte(`Not Valid JSON/YAML`)),
+		},
+		&output,
+	)
+
+	// Require
+	require := require.New(suite.T())
+	require.Error(err, "Did not report class data error")
+	require.Containsf(err.Error(), "class data is invalid", "Error message does not match.")
+}
+
+func (suite *ReplayScriptProgressionSuite) TestWhenScriptNamesAnUnknownSquaddie_ThenReportTheError() {
+	for _, kind := range []string{"change_class", "level_up", "equip_power"} {
+		// Setup
+		var output strings.Builder
+		gameRunner := terosgamerules.GameRules{}
+		scriptData := bytes.NewBuffer([]byte(`---
+version: 0.1F
+actions:
+  -
+    kind: ` + kind + `
+    user_id: squaddieGhost
+    class_id: classMage
+    power_id: powerBlot
+`))
+
+		// Run
+		_, err := gameRunner.ReplayBattleScriptWithOptions(
+			&terosgamerules.ReplayOptions{
+				ScriptFileHandle:   scriptData,
+				SquaddieFileHandle: useValidSquaddieData(),
+				PowerFileHandle:    useProgressionPowerData(),
+				LevelFileHandle:    useProgressionLevelData(),
+				ClassFileHandle:    useProgressionClassData(),
+			},
+			&output,
+		)
+
+		// Require
+		require := require.New(suite.T())
+		require.Nil(err, "no errors should have been found")
+		require.Equal("squaddie \"squaddieGhost\" does not exist\n", output.String(), kind)
+	}
+}
+
+func useEquipmentSquaddieData() *bytes.Buffer {
+	squaddieData := []byte(`
+-
+  name: Teros
+  id: squaddieTeros
+  affiliation: player
+  aim: 2
+  strength: 1
+  max_hit_points: 5
+  powers:
+    -
+      name: Spear
+      id: powerSpear
+  items:
+    - itemAxe
+    - itemLeather
+`)
+	return bytes.NewBuffer(squaddieData)
+}
+
+func useEquipmentItemData() *bytes.Buffer {
+	itemData := []byte(`
+-
+  id: itemAxe
+  name: Great Axe
+  slot: weapon
+  strength: 1
+  powers:
+    -
+      name: Axe
+      id: powerAxe
+-
+  id: itemLeather
+  name: Leather Armor
+  slot: armor
+  armor: 1
+`)
+	return bytes.NewBuffer(itemData)
+}
+
+func useEquipmentScriptData() *bytes.Buffer {
+	scriptData := []byte(`---
+version: 0.1F
+actions:
+  -
+    kind: equip_item
+    user_id: squaddieTeros
+    item_id: itemAxe
+  -
+    kind: equip_item
+    user_id: squaddieTeros
+    item_id: itemLeather
+  -
+    kind: unequip_item
+    user_id: squaddieTeros
+    slot: weapon
+`)
+	return bytes.NewBuffer(scriptData)
+}
+
+func TestReplayScriptEquipmentSuite(t *testing.T) {
+	suite.Run(t, new(ReplayScriptEquipmentSuite))
+}
+
+type ReplayScriptEquipmentSuite struct {
+	suite.Suite
+}
+
+func (suite *ReplayScriptEquipmentSuite) TestWhenScriptEquipsItems_ThenReportEquipment() {
+	// Setup
+	var output strings.Builder
+	gameRunner := terosgamerules.GameRules{}
+
+	// Run
+	_, err := gameRunner.ReplayBattleScriptWithOptions(
+		&terosgamerules.ReplayOptions{
+			ScriptFileHandle:   useEquipmentScriptData(),
+			SquaddieFileHandle: useEquipmentSquaddieData(),
+			PowerFileHandle:    useValidPowerData(),
+			ItemFileHandle:     useEquipmentItemData(),
+		},
+		&output,
+	)
+
+	// Require
+	require := require.New(suite.T())
+	require.Nil(err, "no errors should have been found")
+
+	expectedOutput := "Teros equips Great Axe, wielding Axe\n---\nTeros equips Leather Armor, wielding Axe\n---\nTeros unequips Great Axe, wielding Spear\n---\n"
+	require.Equal(expectedOutput, output.String())
+}
+
+func (suite *ReplayScriptEquipmentSuite) TestWhenItemDataIsInvalid_ThenReportNoItemInformation() {
+	var output strings.Builder
+	gameRunner := terosgamerules.GameRules{}
+
+	// Run
+	_, err := gameRunner.ReplayBattleScriptWithOptions(
+		&terosgamerules.ReplayOptions{
+			ScriptFileHandle:   useEquipmentScriptData(),
+			SquaddieFileHandle: useEquipmentSquaddieData(),
+			PowerFileHandle:    useValidPowerData(),
+			ItemFileHandle:     bytes.NewBuffer([]byte(`Not Valid JSON/YAML`)),
+		},
+		&output,
+	)
+
+	// Require
+	require := require.New(suite.T())
+	require.Error(err, "Did not report item data error")
+	require.Containsf(err.Error(), "item data is invalid", "Error message does not match.")
+	require.Containsf(err.Error(), "cannot unmarshal", "Error message does not include the cause.")
+}
+
+func (suite *ReplayScriptEquipmentSuite) TestWhenScriptNamesAnUnknownSquaddie_ThenReportTheError() {
+	for _, kind := range []string{"equip_item", "unequip_item"} {
+		// Setup
+		var output strings.Builder
+		gameRunner := terosgamerules.GameRules{}
+		scriptData := bytes.NewBuffer([]byte(`---
+version: 0.1F
+actions:
+  -
+    kind: ` + kind + `
+    user_id: squaddieGhost
+    item_id: itemAxe
+    slot: weapon
+`))
+
+		// Run
+		_, err := gameRunner.ReplayBattleScriptWithOptions(
+			&terosgamerules.ReplayOptions{
+				ScriptFileHandle:   scriptData,
+				SquaddieFileHandle: useEquipmentSquaddieData(),
+				PowerFileHandle:    useValidPowerData(),
+				ItemFileHandle:     useEquipmentItemData(),
+			},
+			&output,
+		)
+
+		// Require
+		require := require.New(suite.T())
+		require.Nil(err, "no errors should have been found")
+		require.Equal("squaddie \"squaddieGhost\" does not exist\n", output.String(), kind)
+	}
+}
+
+func useItemUseSquaddieData() *bytes.Buffer {
+	squaddieData := []byte(`
+-
+  name: Teros
+  id: squaddieTeros
+  affiliation: player
+  max_hit_points: 10
+  powers:
+    -
+      name: Spear
+      id: powerSpear
+  items:
+    - itemBomb
+-
+  name: Bandit
+  id: squaddieBandit0
+  affiliation: enemy
+  max_hit_points: 5
+`)
+	return bytes.NewBuffer(squaddieData)
+}
+
+func useItemUsePowerData() *bytes.Buffer {
+	powerData := []byte(`
+-
+  name: Spear
+  id: powerSpear
+  power_type: physical
+  target_foe: true
+  can_attack: true
+  damage_bonus: 2
+  can_be_equipped: true
+-
+  name: Drink Potion
+  id: powerPotion
+  power_type: spell
+  target_self: true
+  target_friend: true
+  hit_points_healed: 8
+  healing_logic: zero
+-
+  name: Explode
+  id: powerBomb
+  power_type: physical
+  target_foe: true
+  can_attack: true
+  damage_bonus: 2
+  to_hit_bonus: 20
+`)
+	return bytes.NewBuffer(powerData)
+}
+
+func useItemUseItemData() *bytes.Buffer {
+	itemData := []byte(`
+-
+  id: itemPotion
+  name: Potion
+  use_power:
+    name: Drink Potion
+    id: powerPotion
+-
+  id: itemBomb
+  name: Bomb
+  use_power:
+    name: Explode
+    id: powerBomb
+`)
+	return bytes.NewBuffer(itemData)
+}
+
+func useItemUseScriptData() *bytes.Buffer {
+	scriptData := []byte(`---
+version: 0.1F
+team_items:
+  -
+    team: enemy
+    item_id: itemPotion
+    count: 1
+actions:
+  -
+    kind: use_item
+    random_seed: 1
+    user_id: squaddieTeros
+    item_id: itemBomb
+    target_ids:
+      - squaddieBandit0
+  -
+    kind: use_item
+    user_id: squaddieBandit0
+    item_id: itemPotion
+    target_ids:
+      - squaddieBandit0
+  -
+    kind: use_item
+    user_id: squaddieTeros
+    item_id: itemPotion
+    target_ids:
+      - squaddieTeros
+`)
+	return bytes.NewBuffer(scriptData)
+}
+
+func (suite *ReplayScriptEquipmentSuite) TestWhenScriptUsesItems_ThenItemsAreUsedUp() {
+	// Setup
+	var output strings.Builder
+	gameRunner := terosgamerules.GameRules{}
+
+	// Run
+	_, err := gameRunner.ReplayBattleScriptWithOptions(
+		&terosgamerules.ReplayOptions{
+			ScriptFileHandle:   useItemUseScriptData(),
+			SquaddieFileHandle: useItemUseSquaddieData(),
+			PowerFileHandle:    useItemUsePowerData(),
+			ItemFileHandle:     useItemUseItemData(),
+		},
+		&output,
+	)
+
+	// Require
+	require := require.New(suite.T())
+	require.Nil(err, "no errors should have been found")
+
+	expectedOutput := "Teros (Explode) vs Bandit: +20 (36/36), for 2 damage\nTeros uses Bomb on Bandit, for 2 damage\n   Bandit: 3/5 HP\n---\nBandit (Drink Potion) heals Bandit, for 2 healing\nBandit uses Potion on Bandit, restoring 2 HP\n   Bandit: 5/5 HP\n---\nsquaddie \"Teros\" has no \"Potion\" left\n"
+	require.Equal(expectedOutput, output.String())
+}
+
+func useFactionScriptData() *bytes.Buffer {
+	scriptData := []byte(`---
+version: 0.1F
+factions:
+  -
+    id: player
+    name: Teros's Party
+  -
+    id: enemy
+    name: Bandit Gang
+relationships:
+  -
+    faction_id: player
+    other_faction_id: enemy
+    relationship: neutral
+actions:
+  -
+    kind: change_relationship
+    faction_id: enemy
+    other_faction_id: player
+    relationship: foe
+  -
+    random_seed: 1000
+    user_id: squaddieTeros
+    power_id: powerSpear
+    target_ids:
+      - squaddieBandit0
+  -
+    kind: change_relationship
+    faction_id: player
+    other_faction_id: enemy
+    relationship: friend
+  -
+    random_seed: 1000
+    user_id: squaddieTeros
+    power_id: powerSpear
+    target_ids:
+      - squaddieBandit0
+`)
+	return bytes.NewBuffer(scriptData)
+}
+
+func TestReplayScriptFactionSuite(t *testing.T) {
+	suite.Run(t, new(ReplayScriptFactionSuite))
+}
+
+type ReplayScriptFactionSuite struct {
+	suite.Suite
+}
+
+func (suite *ReplayScriptFactionSuite) TestWhenScriptChangesRelationships_ThenTargetingFollowsTheRelationships() {
+	// Setup
+	var output strings.Builder
+	gameRunner := terosgamerules.GameRules{}
+
+	// Run
+	_, err := gameRunner.ReplayBattleScript(
+		useFactionScriptData(),
+		useValidSquaddieData(),
+		useValidPowerData(),
+		&output,
+	)
+
+	// Require
+	require := require.New(suite.T())
+	require.Nil(err, "no errors should have been found")
+
+	expectedOutput := "Bandit Gang and Teros's Party are now foes\n---\nTeros (Spear) vs Bandit: +2 (30/36), for 3 damage\n crit: 3/36, FATAL\nBandit (Axe) counters Teros: -5 (1/36) for NO DAMAGE + 2 barrier burn\nTeros (Spear) hits Bandit, for 3 damage\n   Bandit: 2/5 HP\nBandit (Axe) misses Teros\n   Teros: 5/5 HP, 3 barrier\n   Teros gains 10 XP\n   Bandit gains 1 XP\n---\nTeros's Party and Bandit Gang are now friends\n---\nTarget is not compatible with affiliation\n"
+	require.True(strings.HasPrefix(output.String(), expectedOutput), output.String())
+}
+
+func useCharmSquaddieData() *bytes.Buffer {
+	squaddieData := []byte(`
+-
+  name: Teros
+  id: squaddieTeros
+  affiliation: player
+  max_hit_points: 5
+  powers:
+    -
+      name: Beguile
+      id: powerBeguile
+-
+  name: Bandit
+  id: squaddieBandit0
+  affiliation: enemy
+  max_hit_points: 5
+  powers:
+    -
+      name: Axe
+      id: powerAxe
+`)
+	return bytes.NewBuffer(squaddieData)
+}
+
+func useCharmPowerData() *bytes.Buffer {
+	powerData := []byte(`
+-
+  name: Beguile
+  id: powerBeguile
+  power_type: spell
+  target_foe: true
+  can_attack: true
+  to_hit_bonus: 20
+  charm_turns: 1
+-
+  name: Axe
+  id: powerAxe
+  power_type: physical
+  target_foe: true
+  can_attack: true
+  damage_bonus: 1
+  can_be_equipped: true
+  can_counter_attack: true
+`)
+	return bytes.NewBuffer(powerData)
+}
+
+func useCharmScriptData() *bytes.Buffer {
+	scriptData := []byte(`---
+version: 0.1F
+actions:
+  -
+    random_seed: 1
+    user_id: squaddieTeros
+    power_id: powerBeguile
+    target_ids:
+      - squaddieBandit0
+  -
+    kind: end_turn
+    user_id: squaddieBandit0
+  -
+    random_seed: 1
+    user_id: squaddieBandit0
+    power_id: powerAxe
+    target_ids:
+      - squaddieTeros
+`)
+	return bytes.NewBuffer(scriptData)
+}
+
+func TestReplayScriptCharmSuite(t *testing.T) {
+	suite.Run(t, new(ReplayScriptCharmSuite))
+}
+
+type ReplayScriptCharmSuite struct {
+	suite.Suite
+}
+
+func (suite *ReplayScriptCharmSuite) TestWhenScriptCharmsSquaddies_ThenTheyChangeSidesUntilTheirTurnEnds() {
+	// Setup
+	var output strings.Builder
+	gameRunner := terosgamerules.GameRules{}
+
+	// Run
+	_, err := gameRunner.ReplayBattleScript(
+		useCharmScriptData(),
+		useCharmSquaddieData(),
+		useCharmPowerData(),
+		&output,
+	)
+
+	// Require
+	require := require.New(suite.T())
+	require.Nil(err, "no errors should have been found")
+
+	expectedOutput := "Teros (Beguile) vs Bandit: +20 (36/36) for NO DAMAGE\nBandit (Axe) counters Teros: -2 (10/36), for 1 damage\nTeros (Beguile) hits Bandit, for 0 damage, charming for 1 turn\n   Bandit: 5/5 HP, player for 1 turn\n   Teros gains 10 XP\n---\nBandit returns to the enemy side\n---\nBandit (Axe) vs Teros: +0 (21/36), for 1 damage\nBandit (Axe) hits Teros, for 1 damage\n   Teros: 4/5 HP\n   Bandit gains 10 XP\n---\n"
+	require.Equal(expectedOutput, output.String())
+}
+
+func (suite *ReplayScriptCharmSuite) TestWhenTheNextTurnStarts_ThenCharmedSquaddiesReturnToTheirSide() {
+	// Setup
+	var output strings.Builder
+	gameRunner := terosgamerules.GameRules{}
+	scriptData := bytes.NewBuffer([]byte(`---
+version: 0.1F
+actions:
+  -
+    random_seed: 1
+    user_id: squaddieTeros
+    power_id: powerBeguile
+    target_ids:
+      - squaddieBandit0
+  -
+    kind: next_turn
+  -
+    random_seed: 1
+    user_id: squaddieBandit0
+    power_id: powerAxe
+    target_ids:
+      - squaddieTeros
+`))
+
+	// Run
+	_, err := gameRunner.ReplayBattleScript(
+		scriptData,
+		useCharmSquaddieData(),
+		useCharmPowerData(),
+		&output,
+	)
+
+	// Require
+	require := require.New(suite.T())
+	require.Nil(err, "no errors should have been found")
+	expectedOutput := `Teros (Beguile) vs Bandit: +20 (36/36) for NO DAMAGE
+Bandit (Axe) counters Teros: -2 (10/36), for 1 damage
+Teros (Beguile) hits Bandit, for 0 damage, charming for 1 turn
+   Bandit: 5/5 HP, player for 1 turn
+   Teros gains 10 XP
+---
+Bandit returns to the enemy side
+---
+Turn 2 begins
+---
+Bandit (Axe) vs Teros: +0 (21/36), for 1 damage
+Bandit (Axe) hits Teros, for 1 damage
+   Teros: 4/5 HP
+   Bandit gains 10 XP
+---
+`
+	require.Equal(expectedOutput, output.String())
+}
+
+func (suite *ReplayScriptCharmSuite) TestWhenCharmedSquaddiesAreCleansed_ThenTheyReturnToTheirSide() {
+	// Setup
+	var output strings.Builder
+	gameRunner := terosgamerules.GameRules{}
+	squaddieData := useCharmSquaddieData()
+	squaddieData.WriteString(`
+-
+  name: Witch
+  id: squaddieWitch
+  affiliation: enemy
+  max_hit_points: 5
+  powers:
+    -
+      name: Purify
+      id: powerPurify
+`)
+	powerData := useCharmPowerData()
+	powerData.WriteString(`
+-
+  name: Purify
+  id: powerPurify
+  power_type: spell
+  target_friend: true
+  cleanses: true
+`)
+	scriptData := bytes.NewBuffer([]byte(`---
+version: 0.1F
+actions:
+  -
+    random_seed: 1
+    user_id: squaddieTeros
+    power_id: powerBeguile
+    target_ids:
+      - squaddieBandit0
+  -
+    random_seed: 1
+    user_id: squaddieWitch
+    power_id: powerPurify
+    target_ids:
+      - squaddieBandit0
+  -
+    kind: end_turn
+    user_id: squaddieBandit0
+`))
+
+	// Run
+	_, err := gameRunner.ReplayBattleScript(
+		scriptData,
+		squaddieData,
+		powerData,
+		&output,
+	)
+
+	// Require
+	require := require.New(suite.T())
+	require.Nil(err, "no errors should have been found")
+	expectedOutput := `Teros (Beguile) vs Bandit: +20 (36/36) for NO DAMAGE
+Bandit (Axe) counters Teros: -2 (10/36), for 1 damage
+Teros (Beguile) hits Bandit, for 0 damage, charming for 1 turn
+   Bandit: 5/5 HP, player for 1 turn
+   Teros gains 10 XP
+---
+Witch (Purify) heals Bandit for NO HEALING, cleansing 1 effect
+Witch (Purify) heals Bandit for NO HEALING, cleansing 1 effect
+   Bandit: 5/5 HP
+   Witch gains 1 XP
+---
+`
+	require.Equal(expectedOutput, output.String())
+}
+
+func (suite *ReplayScriptCharmSuite) TestWhenAnUnknownSquaddieEndsItsTurn_ThenReportTheError() {
+	// Setup
+	var output strings.Builder
+	gameRunner := terosgamerules.GameRules{}
+	scriptData := bytes.NewBuffer([]byte(`---
+version: 0.1F
+actions:
+  -
+    kind: end_turn
+    user_id: squaddieGhost
+`))
+
+	// Run
+	_, err := gameRunner.ReplayBattleScript(
+		scriptData,
+		useCharmSquaddieData(),
+		useCharmPowerData(),
+		&output,
+	)
+
+	// Require
+	require := require.New(suite.T())
+	require.Nil(err, "no errors should have been found")
+	require.Equal("squaddie \"squaddieGhost\" does not exist\n", output.String())
+}
+
+func useObjectiveScriptData() *bytes.Buffer {
+	scriptData := []byte(`---
+version: 0.1F
+objectives:
+  -
+    kind: protect_squaddie
+    squaddie_id: squaddieTeros
+  -
+    kind: defeat_boss
+    squaddie_id: squaddieBandit0
+actions:
+  -
+    random_seed: 1000
+    user_id: squaddieTeros
+    power_id: powerSpear
+    target_ids:
+      - squaddieBandit0
+  -
+    kind: next_turn
+  -
+    random_seed: 1000
+    user_id: squaddieTeros
+    power_id: powerSpear
+    target_ids:
+      - squaddieBandit0
+  -
+    random_seed: 2
+    user_id: squaddieBandit0
+    power_id: powerAxe
+    target_ids:
+      - squaddieTeros
+`)
+	return bytes.NewBuffer(scriptData)
+}
+
+func useSurviveScriptData() *bytes.Buffer {
+	scriptData := []byte(`---
+version: 0.1F
+objectives:
+  -
+    kind: protect_squaddie
+    squaddie_id: squaddieTeros
+  -
+    kind: survive_turns
+    turns: 2
+actions:
+  -
+    kind: next_turn
+  -
+    kind: next_turn
+  -
+    kind: next_turn
+`)
+	return bytes.NewBuffer(scriptData)
+}
+
+func useUnknownObjectiveScriptData() *bytes.Buffer {
+	scriptData := []byte(`---
+version: 0.1F
+objectives:
+  -
+    kind: defeat_boss
+    squaddie_id: squaddieNobody
+actions:
+  -
+    kind: next_turn
+`)
+	return bytes.NewBuffer(scriptData)
+}
+
+func TestReplayScriptObjectiveSuite(t *testing.T) {
+	suite.Run(t, new(ReplayScriptObjectiveSuite))
+}
+
+type ReplayScriptObjectiveSuite struct {
+	suite.Suite
+}
+
+func (suite *ReplayScriptObjectiveSuite) TestWhenTheBossFalls_ThenTheBattleIsWon() {
+	// Setup
+	var output strings.Builder
+	gameRunner := terosgamerules.GameRules{}
+
+	// Run
+	outcome, err := gameRunner.ReplayBattleScript(
+		useObjectiveScriptData(),
+		useValidSquaddieData(),
+		useValidPowerData(),
+		&output,
+	)
+
+	// Require
+	require := require.New(suite.T())
+	require.Nil(err, "no errors should have been found")
+	require.True(outcome.IsVictory())
+	require.Equal(objective.DefeatBoss, outcome.Objective.Kind)
+	expectedOutput := "Teros (Spear) vs Bandit: +2 (30/36), for 3 damage\n crit: 3/36, FATAL\nBandit (Axe) counters Teros: -5 (1/36) for NO DAMAGE + 2 barrier burn\nTeros (Spear) hits Bandit, for 3 damage\n   Bandit: 2/5 HP\nBandit (Axe) misses Teros\n   Teros: 5/5 HP, 3 barrier\n   Teros gains 10 XP\n   Bandit gains 1 XP\n---\nTurn 2 begins\n---\nTeros (Spear) vs Bandit: +2 (30/36), FATAL\n crit: 3/36, FATAL\nBandit (Axe) counters Teros: -5 (1/36) for NO DAMAGE + 2 barrier burn\nTeros (Spear) hits Bandit, felling\n   Bandit: 0/5 HP\n   Teros gains 30 XP\n---\nVictory: Bandit has fallen\n"
+	require.Equal(expectedOutput, output.String())
+}
+
+func (suite *ReplayScriptObjectiveSuite) TestWhenTheTurnsPass_ThenTheBattleIsWon() {
+	// Setup
+	var output strings.Builder
+	gameRunner := terosgamerules.GameRules{}
+
+	// Run
+	outcome, err := gameRunner.ReplayBattleScript(
+		useSurviveScriptData(),
+		useValidSquaddieData(),
+		useValidPowerData(),
+		&output,
+	)
+
+	// Require
+	require := require.New(suite.T())
+	require.Nil(err, "no errors should have been found")
+	require.True(outcome.IsVictory())
+	require.Equal(objective.SurviveTurns, outcome.Objective.Kind)
+	require.Equal("Turn 2 begins\n---\nTurn 3 begins\n---\nVictory: survived 2 turns\n", output.String())
+}
+
+func (suite *ReplayScriptObjectiveSuite) TestWhenTheBattleIsUndecided_ThenThereIsNoOutcome() {
+	// Setup
+	var output strings.Builder
+	gameRunner := terosgamerules.GameRules{}
+	scriptData := bytes.NewBuffer([]byte(`---
+version: 0.1F
+objectives:
+  -
+    kind: protect_squaddie
+    squaddie_id: squaddieTeros
+  -
+    kind: survive_turns
+    turns: 2
+actions:
+  -
+    kind: next_turn
+`))
+
+	// Run
+	outcome, err := gameRunner.ReplayBattleScript(
+		scriptData,
+		useValidSquaddieData(),
+		useValidPowerData(),
+		&output,
+	)
+
+	// Require
+	require := require.New(suite.T())
+	require.Nil(err, "no errors should have been found")
+	require.Nil(outcome)
+	require.Equal("Turn 2 begins\n---\nThe battle is undecided\n", output.String())
+}
+
+func (suite *ReplayScriptObjectiveSuite) TestWhenTheChapterNamesThePlayerSide_ThenObjectivesAreWrittenForThatSide() {
+	// Setup
+	var output strings.Builder
+	gameRunner := terosgamerules.GameRules{}
+	scriptData := bytes.NewBuffer([]byte(`---
+version: 0.1F
+player_affiliation: enemy
+positions:
+  - squaddie_id: squaddieTeros
+    row: 0
+    column: 0
+  - squaddie_id: squaddieBandit0
+    row: 0
+    column: 5
+objectives:
+  -
+    kind: reach_tile
+    squaddie_id: squaddieBandit0
+    tile:
+      row: 0
+      column: 3
+actions:
+  -
+    kind: move
+    user_id: squaddieBandit0
+    destination:
+      row: 0
+      column: 4
+  -
+    kind: move
+    user_id: squaddieBandit0
+    destination:
+      row: 0
+      column: 3
+  -
+    kind: move
+    user_id: squaddieBandit0
+    destination:
+      row: 0
+      column: 2
+`))
+
+	// Run
+	outcome, err := gameRunner.ReplayBattleScript(
+		scriptData,
+		useOverwatchSquaddieData(),
+		useOverwatchPowerData(),
+		&output,
+	)
+
+	// Require
+	require := require.New(suite.T())
+	require.Nil(err, "no errors should have been found")
+	require.True(outcome.IsVictory())
+	require.Equal(objective.ReachTile, outcome.Objective.Kind)
+	require.Equal("Bandit moves to (0, 4)\n---\nBandit moves to (0, 3)\n---\nVictory: Bandit reached (0, 3)\n", output.String())
+}
+
+func (suite *ReplayScriptObjectiveSuite) TestWhenAnObjectiveNamesAnUnknownSquaddie_ThenReportTheError() {
+	// Setup
+	var output strings.Builder
+	gameRunner := terosgamerules.GameRules{}
+
+	// Run
+	_, err := gameRunner.ReplayBattleScript(
+		useUnknownObjectiveScriptData(),
+		useValidSquaddieData(),
+		useValidPowerData(),
+		&output,
+	)
+
+	// Require
+	require := require.New(suite.T())
+	require.Nil(err, "no errors should have been found")
+	require.Equal("objective \"defeat_boss\" has unknown squaddie \"squaddieNobody\"\n", output.String())
+}
+
+func useTriggerSquaddieData() *bytes.Buffer {
+	squaddieData := []byte(`
+-
+  name: Teros
+  id: squaddieTeros
+  affiliation: player
+  aim: 2
+  strength: 1
+  max_hit_points: 5
+  max_barrier: 3
+  armor: 2
+  dodge: 3
+  deflect: 4
+  powers:
+    -
+      name: Spear
+      id: powerSpear
+-
+  name: Bandit
+  id: squaddieBandit0
+  affiliation: enemy
+  strength: 1
+  max_hit_points: 5
+  powers:
+    -
+      name: Axe
+      id: powerAxe
+-
+  name: Guard
+  id: templateGuard
+  affiliation: enemy
+  max_hit_points: 3
+  powers:
+    -
+      name: Axe
+      id: powerAxe
+`)
+	return bytes.NewBuffer(squaddieData)
+}
+
+func useTriggerPowerData() *bytes.Buffer {
+	powerData := []byte(`
+-
+  name: Spear
+  id: powerSpear
+  power_type: physical
+  target_foe: true
+  can_attack: true
+  damage_bonus: 2
+  can_be_equipped: true
+  can_counter_attack: true
+  can_critical: true
+  critical_damage: 2
+-
+  name: Axe
+  id: powerAxe
+  power_type: physical
+  target_foe: true
+  can_attack: true
+  damage_bonus: 1
+  can_be_equipped: true
+  can_counter_attack: true
+-
+  name: Rage
+  id: powerRage
+  power_type: physical
+  target_foe: true
+  can_attack: true
+  damage_bonus: 3
+  can_be_equipped: true
+`)
+	return bytes.NewBuffer(powerData)
+}
+
+func useTriggerScriptData() *bytes.Buffer {
+	scriptData := []byte(`---
+version: 0.1F
+objectives:
+  -
+    kind: rout_foes
+triggers:
+  -
+    id: banditEnrages
+    condition:
+      kind: hit_points_below
+      squaddie_id: squaddieBandit0
+      percent: 50
+    effects:
+      -
+        kind: show_message
+        message: The bandit calls for help!
+      -
+        kind: add_squaddies
+        template_id: templateGuard
+        squaddie_ids:
+          - squaddieGuard0
+      -
+        kind: lose_powers
+        squaddie_id: squaddieBandit0
+        powers:
+          -
+            name: Axe
+            id: powerAxe
+      -
+        kind: gain_powers
+        squaddie_id: squaddieBandit0
+        powers:
+          -
+            name: Rage
+            id: powerRage
+  -
+    id: guardDeserts
+    condition:
+      kind: turn_reached
+      turn: 2
+    effects:
+      -
+        kind: change_affiliation
+        squaddie_id: squaddieGuard0
+        affiliation: neutral
+actions:
+  -
+    random_seed: 1000
+    user_id: squaddieTeros
+    power_id: powerSpear
+    target_ids:
+      - squaddieBandit0
+  -
+    random_seed: 1000
+    user_id: squaddieTeros
+    power_id: powerSpear
+    target_ids:
+      - squaddieBandit0
+  -
+    kind: next_turn
+  -
+    kind: next_turn
+`)
+	return bytes.NewBuffer(scriptData)
+}
+
+func TestReplayScriptTriggerSuite(t *testing.T) {
+	suite.Run(t, new(ReplayScriptTriggerSuite))
+}
+
+type ReplayScriptTriggerSuite struct {
+	suite.Suite
+}
+
+func (suite *ReplayScriptTriggerSuite) TestWhenTriggerConditionsAreMet_ThenTheirEffectsChangeTheBattle() {
+	// Setup
+	var output strings.Builder
+	gameRunner := terosgamerules.GameRules{}
+
+	// Run
+	_, err := gameRunner.ReplayBattleScript(
+		useTriggerScriptData(),
+		useTriggerSquaddieData(),
+		useTriggerPowerData(),
+		&output,
+	)
+
+	// Require
+	require := require.New(suite.T())
+	require.Nil(err, "no errors should have been found")
+	expectedOutput := `Teros (Spear) vs Bandit: +2 (30/36), for 3 damage
+ crit: 3/36, FATAL
+Bandit (Axe) counters Teros: -5 (1/36) for NO DAMAGE + 2 barrier burn
+Teros (Spear) hits Bandit, for 3 damage
+   Bandit: 2/5 HP
+Bandit (Axe) misses Teros
+   Teros: 5/5 HP, 3 barrier
+   Teros gains 10 XP
+   Bandit gains 1 XP
+---
+The bandit calls for help!
+Guard joins the battle
+Bandit forgets Axe
+Bandit learns Rage
+---
+Teros (Spear) vs Bandit: +2 (30/36), FATAL
+ crit: 3/36, FATAL
+Bandit (Rage) counters Teros: -5 (1/36) for NO DAMAGE + 3 barrier burn
+Teros (Spear) hits Bandit, felling
+   Bandit: 0/5 HP
+   Teros gains 30 XP
+---
+Turn 2 begins
+---
+Guard switches to neutral
+---
+Turn 3 begins
+---
+The battle is undecided
+`
+	require.Equal(expectedOutput, output.String())
+}
+
+func useSummonSquaddieData() *bytes.Buffer {
+	squaddieData := []byte(`
+-
+  name: Teros
+  id: squaddieTeros
+  affiliation: player
+  aim: 2
+  max_hit_points: 5
+  powers:
+    -
+      name: Call Wolf
+      id: powerCallWolf
+-
+  name: Bandit
+  id: squaddieBandit0
+  affiliation: enemy
+  max_hit_points: 5
+-
+  name: Wolf
+  id: templateWolf
+  affiliation: enemy
+  aim: 2
+  max_hit_points: 3
+  powers:
+    -
+      name: Bite
+      id: powerBite
+`)
+	return bytes.NewBuffer(squaddieData)
+}
+
+func useSummonPowerData() *bytes.Buffer {
+	powerData := []byte(`
+-
+  name: Call Wolf
+  id: powerCallWolf
+  power_type: spell
+  target_self: true
+  summon_template_id: templateWolf
+  summon_turns: 1
+  summon_limit: 1
+-
+  name: Bite
+  id: powerBite
+  power_type: physical
+  target_foe: true
+  can_attack: true
+  damage_bonus: 1
+  can_be_equipped: true
+`)
+	return bytes.NewBuffer(powerData)
+}
+
+func useSummonScriptData() *bytes.Buffer {
+	scriptData := []byte(`---
+version: 0.1F
+actions:
+  -
+    user_id: squaddieTeros
+    power_id: powerCallWolf
+    target_ids:
+      - squaddieTeros
+  -
+    random_seed: 1000
+    user_id: squaddieTeros_templateWolf_1
+    power_id: powerBite
+    target_ids:
+      - squaddieBandit0
+  -
+    kind: end_turn
+    user_id: squaddieTeros_templateWolf_1
+  -
+    user_id: squaddieTeros
+    power_id: powerCallWolf
+    target_ids:
+      - squaddieTeros
+  -
+    user_id: squaddieTeros
+    power_id: powerCallWolf
+    target_ids:
+      - squaddieTeros
+`)
+	return bytes.NewBuffer(scriptData)
+}
+
+func TestReplayScriptSummonSuite(t *testing.T) {
+	suite.Run(t, new(ReplayScriptSummonSuite))
+}
+
+type ReplayScriptSummonSuite struct {
+	suite.Suite
+}
+
+func (suite *ReplayScriptSummonSuite) TestWhenSquaddiesSummon_ThenSummonsFightUntilTheyVanish() {
+	// Setup
+	var output strings.Builder
+	gameRunner := terosgamerules.GameRules{}
+
+	// Run
+	_, err := gameRunner.ReplayBattleScript(
+		useSummonScriptData(),
+		useSummonSquaddieData(),
+		useSummonPowerData(),
+		&output,
+	)
+
+	// Require
+	require := require.New(suite.T())
+	require.Nil(err, "no errors should have been found")
+	expectedOutput := `Teros (Call Wolf) summons Wolf for 1 turn
+Teros (Call Wolf) summons: 0/1 in battle
+Teros summons Wolf for 1 turn
+---
+Wolf (Bite) vs Bandit: +2 (30/36), for 1 damage
+Wolf (Bite) hits Bandit, for 1 damage
+   Bandit: 4/5 HP
+   Wolf gains 10 XP
+---
+Wolf vanishes
+---
+Teros (Call Wolf) summons Wolf for 1 turn
+Teros (Call Wolf) summons: 0/1 in battle
+Teros summons Wolf for 1 turn
+---
+Summon limit reached
+  Teros[squaddieTeros] already has 1 summons in battle
+    Call Wolf[powerCallWolf] allows 1
+`
+	require.Equal(expectedOutput, output.String())
+}
+
+func (suite *ReplayScriptSummonSuite) TestWhenTheNextTurnStarts_ThenSummonsWhoseTimeRanOutVanish() {
+	// Setup
+	var output strings.Builder
+	gameRunner := terosgamerules.GameRules{}
+	scriptData := bytes.NewBuffer([]byte(`---
+version: 0.1F
+actions:
+  -
+    user_id: squaddieTeros
+    power_id: powerCallWolf
+  -
+    kind: next_turn
+  -
+    user_id: squaddieTeros
+    power_id: powerCallWolf
+`))
+
+	// Run
+	_, err := gameRunner.ReplayBattleScript(
+		scriptData,
+		useSummonSquaddieData(),
+		useSummonPowerData(),
+		&output,
+	)
+
+	// Require
+	require := require.New(suite.T())
+	require.Nil(err, "no errors should have been found")
+	expectedOutput := `Teros summons Wolf for 1 turn
+---
+Wolf vanishes
+---
+Turn 2 begins
+---
+Teros summons Wolf for 1 turn
+---
+`
+	require.Equal(expectedOutput, output.String())
+}
+
+func (suite *ReplayScriptSummonSuite) TestWhenSquaddiesSummonWithoutTargets_ThenTheSummonLimitStillApplies() {
+	// Setup
+	var output strings.Builder
+	gameRunner := terosgamerules.GameRules{}
+	scriptData := bytes.NewBuffer([]byte(`---
+version: 0.1F
+actions:
+  -
+    user_id: squaddieTeros
+    power_id: powerCallWolf
+  -
+    user_id: squaddieTeros
+    power_id: powerCallWolf
+`))
+
+	// Run
+	_, err := gameRunner.ReplayBattleScript(
+		scriptData,
+		useSummonSquaddieData(),
+		useSummonPowerData(),
+		&output,
+	)
+
+	// Require
+	require := require.New(suite.T())
+	require.Nil(err, "no errors should have been found")
+	expectedOutput := `Teros summons Wolf for 1 turn
+---
+Summon limit reached
+  Teros[squaddieTeros] already has 1 summons in battle
+    Call Wolf[powerCallWolf] allows 1
+`
+	require.Equal(expectedOutput, output.String())
+}
+
+func (suite *ReplayScriptSummonSuite) TestWhenAttacksHaveNoTargets_ThenReportTheError() {
+	// Setup
+	var output strings.Builder
+	gameRunner := terosgamerules.GameRules{}
+	scriptData := bytes.NewBuffer([]byte(`---
+version: 0.1F
+actions:
+  -
+    user_id: squaddieTeros
+    power_id: powerCallWolf
+  -
+    random_seed: 1000
+    user_id: squaddieTeros_templateWolf_1
+    power_id: powerBite
+`))
+
+	// Run
+	_, err := gameRunner.ReplayBattleScript(
+		scriptData,
+		useSummonSquaddieData(),
+		useSummonPowerData(),
+		&output,
+	)
+
+	// Require
+	require := require.New(suite.T())
+	require.Nil(err, "no errors should have been found")
+	expectedOutput := `Teros summons Wolf for 1 turn
+---
+Power needs a target
+  Wolf[squaddieTeros_templateWolf_1] used Bite[powerBite] without choosing a target
+`
+	require.Equal(expectedOutput, output.String())
+}
+
+func useCounterAttackSquaddieData() *bytes.Buffer {
+	squaddieData := []byte(`
+-
+  name: Teros
+  id: squaddieTeros
+  affiliation: player
+  aim: 2
+  max_hit_points: 10
+  powers:
+    -
+      name: Rapier
+      id: powerRapier
+-
+  name: Bandit
+  id: squaddieBandit0
+  affiliation: enemy
+  aim: 2
+  max_hit_points: 10
+  powers:
+    -
+      name: Pike
+      id: powerPike
+`)
+	return bytes.NewBuffer(squaddieData)
+}
+
+func useCounterAttackPowerData() *bytes.Buffer {
+	powerData := []byte(`
+-
+  name: Rapier
+  id: powerRapier
+  power_type: physical
+  target_foe: true
+  can_attack: true
+  damage_bonus: 1
+  can_be_equipped: true
+  riposte: true
+-
+  name: Pike
+  id: powerPike
+  power_type: physical
+  target_foe: true
+  can_attack: true
+  damage_bonus: 1
+  can_be_equipped: true
+  first_strike: true
+  counter_attacks_per_turn: 1
+`)
+	return bytes.NewBuffer(powerData)
+}
+
+func useCounterAttackScriptData() *bytes.Buffer {
+	scriptData := []byte(`---
+version: 0.1F
+actions:
+  -
+    random_seed: 16
+    user_id: squaddieTeros
+    power_id: powerRapier
+    target_ids:
+      - squaddieBandit0
+  -
+    random_seed: 16
+    user_id: squaddieTeros
+    power_id: powerRapier
+    target_ids:
+      - squaddieBandit0
+  -
+    kind: next_turn
+  -
+    random_seed: 16
+    user_id: squaddieTeros
+    power_id: powerRapier
+    target_ids:
+      - squaddieBandit0
+`)
+	return bytes.NewBuffer(scriptData)
+}
+
+func TestReplayScriptCounterAttackSuite(t *testing.T) {
+	suite.Run(t, new(ReplayScriptCounterAttackSuite))
+}
+
+type ReplayScriptCounterAttackSuite struct {
+	suite.Suite
+}
+
+func (suite *ReplayScriptCounterAttackSuite) TestWhenWeaponsStrikeFirstOrRiposte_ThenCountersFollowTheirOrder() {
+	// Setup
+	var output strings.Builder
+	gameRunner := terosgamerules.GameRules{}
+
+	// Run
+	_, err := gameRunner.ReplayBattleScript(
+		useCounterAttackScriptData(),
+		useCounterAttackSquaddieData(),
+		useCounterAttackPowerData(),
+		&output,
+	)
+
+	// Require
+	require := require.New(suite.T())
+	require.Nil(err, "no errors should have been found")
+	expectedOutput := `Bandit (Pike) strikes first against Teros: +0 (21/36), for 1 damage
+Teros (Rapier) vs Bandit: +2 (30/36), for 1 damage
+Teros (Rapier) ripostes Bandit: +0 (21/36), for 1 damage
+Bandit (Pike) misses Teros
+   Teros: 10/10 HP
+Teros (Rapier) misses Bandit
+   Bandit: 9/10 HP
+Teros (Rapier) counters Bandit, for 1 damage
+   Bandit: 9/10 HP
+   Bandit gains 1 XP
+   Teros gains 11 XP
+---
+Teros (Rapier) vs Bandit: +2 (30/36), for 1 damage
+Teros (Rapier) hits Bandit, for 1 damage
+   Bandit: 8/10 HP
+   Teros gains 10 XP
+---
+Turn 2 begins
+---
+Bandit (Pike) strikes first against Teros: +0 (21/36), for 1 damage
+Teros (Rapier) vs Bandit: +2 (30/36), for 1 damage
+Teros (Rapier) ripostes Bandit: +0 (21/36), for 1 damage
+Bandit (Pike) misses Teros
+   Teros: 10/10 HP
+Teros (Rapier) misses Bandit
+   Bandit: 7/10 HP
+Teros (Rapier) counters Bandit, for 1 damage
+   Bandit: 7/10 HP
+   Bandit gains 1 XP
+   Teros gains 11 XP
+---
+`
+	require.Equal(expectedOutput, output.String())
+}
+
+func TestReplayScriptGuardSuite(t *testing.T) {
+	suite.Run(t, new(ReplayScriptGuardSuite))
+}
+
+type ReplayScriptGuardSuite struct {
+	suite.Suite
+}
+
+func (suite *ReplayScriptGuardSuite) TestWhenSquaddieGuardsAnAlly_ThenItTakesTheAttacksUntilTheNextTurn() {
+	// Setup
+	var output strings.Builder
+	gameRunner := terosgamerules.GameRules{}
+
+	// Run
+	_, err := gameRunner.ReplayBattleScript(
+		useGuardScriptData(),
+		useGuardSquaddieData(),
+		useGuardPowerData(),
+		&output,
+	)
+
+	// Require
+	require := require.New(suite.T())
+	require.Nil(err, "no errors should have been found")
+	expectedOutput := `Teros starts guarding Lini
+---
+Teros guards Lini
+Bandit (Axe) vs Teros: +2 (30/36), for 3 damage
+Bandit (Axe) hits Teros, for 3 damage
+   Teros: 7/10 HP
+   Bandit gains 10 XP
+---
+Turn 2 begins
+---
+Bandit (Axe) vs Lini: +2 (30/36), for 3 damage
+Bandit (Axe) hits Lini, for 3 damage
+   Lini: 7/10 HP
+   Bandit gains 10 XP
+---
+squaddie "Teros" cannot guard "Bandit", they are not friends
+`
+	require.Equal(expectedOutput, output.String())
+}
+
+func useGuardSquaddieData() *bytes.Buffer {
+	squaddieData := []byte(`
+-
+  name: Teros
+  id: squaddieTeros
+  affiliation: player
+  max_hit_points: 10
+-
+  name: Lini
+  id: squaddieLini
+  affiliation: player
+  max_hit_points: 10
+-
+  name: Bandit
+  id: squaddieBandit0
+  affiliation: enemy
+  aim: 2
+  max_hit_points: 10
+  powers:
+    -
+      name: Axe
+      id: powerAxe
+`)
+	return bytes.NewBuffer(squaddieData)
+}
+
+func useGuardPowerData() *bytes.Buffer {
+	powerData := []byte(`
+-
+  name: Axe
+  id: powerAxe
+  power_type: physical
+  target_foe: true
+  can_attack: true
+  damage_bonus: 3
+`)
+	return bytes.NewBuffer(powerData)
+}
+
+func useGuardScriptData() *bytes.Buffer {
+	scriptData := []byte(`---
+version: 0.1F
+actions:
+  -
+    kind: guard
+    user_id: squaddieTeros
+    target_ids:
+      - squaddieLini
+  -
+    random_seed: 1000
+    user_id: squaddieBandit0
+    power_id: powerAxe
+    target_ids:
+      - squaddieLini
+  -
+    kind: next_turn
+  -
+    random_seed: 1000
+    user_id: squaddieBandit0
+    power_id: powerAxe
+    target_ids:
+      - squaddieLini
+  -
+    kind: guard
+    user_id: squaddieTeros
+    target_ids:
+      - squaddieBandit0
+`)
+	return bytes.NewBuffer(scriptData)
+}
+
+func TestReplayScriptOverwatchSuite(t *testing.T) {
+	suite.Run(t, new(ReplayScriptOverwatchSuite))
+}
+
+type ReplayScriptOverwatchSuite struct {
+	suite.Suite
+}
+
+func (suite *ReplayScriptOverwatchSuite) TestWhenFoesMoveIntoRange_ThenOverwatchersAttackOnce() {
+	// Setup
+	var output strings.Builder
+	gameRunner := terosgamerules.GameRules{}
+
+	// Run
+	_, err := gameRunner.ReplayBattleScript(
+		useOverwatchScriptData(),
+		useOverwatchSquaddieData(),
+		useOverwatchPowerData(),
+		&output,
+	)
+
+	// Require
+	require := require.New(suite.T())
+	require.Nil(err, "no errors should have been found")
+	expectedOutput := `Teros goes on overwatch with Bow
+---
+Bandit moves to (0, 3)
+---
+Bandit moves to (0, 2)
+Teros reacts to Bandit moving into range
+---
+Teros (Bow) vs Bandit: +2 (30/36), for 2 damage
+Teros (Bow) hits Bandit, for 2 damage
+   Bandit: 8/10 HP
+   Teros gains 10 XP
+---
+Bandit moves to (0, 1)
+---
+Target is out of range
+  Lini[squaddieLini] is 3 steps away from Bandit[squaddieBandit0]
+    Axe[powerAxe] has a range of 1
+`
+	require.Equal(expectedOutput, output.String())
+}
+
+func (suite *ReplayScriptOverwatchSuite) TestWhenOverwatchersSummon_ThenTheSummonsVanishWhenTheirTimeRunsOut() {
+	// Setup
+	var output strings.Builder
+	gameRunner := terosgamerules.GameRules{}
+	scriptData := bytes.NewBuffer([]byte(`---
+version: 0.1F
+positions:
+  - squaddie_id: squaddieTeros
+    row: 0
+    column: 0
+  - squaddie_id: squaddieBandit0
+    row: 0
+    column: 4
+actions:
+  -
+    kind: overwatch
+    user_id: squaddieTeros
+    power_id: powerHowlingBow
+  -
+    kind: move
+    random_seed: 1000
+    user_id: squaddieBandit0
+    destination:
+      row: 0
+      column: 2
+  -
+    kind: next_turn
+`))
+	squaddieData := bytes.NewBuffer([]byte(`
+-
+  name: Teros
+  id: squaddieTeros
+  affiliation: player
+  aim: 2
+  max_hit_points: 10
+  powers:
+    -
+      name: Howling Bow
+      id: powerHowlingBow
+-
+  name: Bandit
+  id: squaddieBandit0
+  affiliation: enemy
+  max_hit_points: 10
+  movement_distance: 2
+-
+  name: Wolf
+  id: templateWolf
+  affiliation: player
+  max_hit_points: 3
+`))
+	powerData := bytes.NewBuffer([]byte(`
+-
+  name: Howling Bow
+  id: powerHowlingBow
+  power_type: physical
+  target_foe: true
+  can_attack: true
+  damage_bonus: 2
+  range: 2
+  summon_template_id: templateWolf
+  summon_turns: 1
+`))
+
+	// Run
+	_, err := gameRunner.ReplayBattleScript(
+		scriptData,
+		squaddieData,
+		powerData,
+		&output,
+	)
+
+	// Require
+	require := require.New(suite.T())
+	require.Nil(err, "no errors should have been found")
+	expectedOutput := `Teros goes on overwatch with Howling Bow
+---
+Bandit moves to (0, 2)
+Teros reacts to Bandit moving into range
+---
+Teros (Howling Bow) vs Bandit: +2 (30/36), for 2 damage
+Teros (Howling Bow) summons Wolf for 1 turn
+Teros (Howling Bow) hits Bandit, for 2 damage
+   Bandit: 8/10 HP
+Teros summons Wolf for 1 turn
+   Teros gains 10 XP
+---
+Wolf vanishes
+---
+Turn 2 begins
+---
+`
+	require.Equal(expectedOutput, output.String())
+}
+
+func (suite *ReplayScriptOverwatchSuite) TestWhenSquaddiesCannotMove_ThenReportTheError() {
+	positions := `
+positions:
+  - squaddie_id: squaddieBandit0
+    row: 0
+    column: 5
+  - squaddie_id: squaddieTeros
+    row: 0
+    column: 3
+`
+	moves := map[string]string{
+		"too far": positions + `
+actions:
+  -
+    kind: move
+    user_id: squaddieBandit0
+    destination:
+      row: 3
+      column: 5
+`,
+		"taken": positions + `
+actions:
+  -
+    kind: move
+    user_id: squaddieBandit0
+    destination:
+      row: 0
+      column: 3
+`,
+		"no grid": `
+actions:
+  -
+    kind: move
+    user_id: squaddieBandit0
+    destination:
+      row: 0
+      column: 3
+`,
+	}
+	expectedErrors := map[string]string{
+		"too far": "squaddie \"Bandit\" cannot move 3 steps, it moves up to 2\n",
+		"taken":   "squaddie \"squaddieBandit0\" cannot stand at (0, 3), \"squaddieTeros\" is already there\n",
+		"no grid": "squaddie \"Bandit\" cannot move, the battle has no grid\n",
+	}
+
+	for name, script := range moves {
+		// Setup
+		var output strings.Builder
+		gameRunner := terosgamerules.GameRules{}
+
+		// Run
+		_, err := gameRunner.ReplayBattleScript(
+			bytes.NewBuffer([]byte("---\nversion: 0.1F\n"+script)),
+			useOverwatchSquaddieData(),
+			useOverwatchPowerData(),
+			&output,
+		)
+
+		// Require
+		require := require.New(suite.T())
+		require.Nil(err, "no errors should have been found")
+		require.Equal(expectedErrors[name], output.String(), name)
+	}
+}
+
+func useOverwatchSquaddieData() *bytes.Buffer {
+	squaddieData := []byte(`
+-
+  name: Teros
+  id: squaddieTeros
+  affiliation: player
+  aim: 2
+  max_hit_points: 10
+  powers:
+    -
+      name: Bow
+      id: powerBow
+-
+  name: Lini
+  id: squaddieLini
+  affiliation: player
+  max_hit_points: 10
+-
+  name: Bandit
+  id: squaddieBandit0
+  affiliation: enemy
+  aim: 2
+  max_hit_points: 10
+  movement_distance: 2
+  powers:
+    -
+      name: Axe
+      id: powerAxe
+`)
+	return bytes.NewBuffer(squaddieData)
+}
+
+func useOverwatchPowerData() *bytes.Buffer {
+	powerData := []byte(`
+-
+  name: Bow
+  id: powerBow
+  power_type: physical
+  target_foe: true
+  can_attack: true
+  can_counter_attack: true
+  damage_bonus: 2
+  range: 2
+-
+  name: Axe
+  id: powerAxe
+  power_type: physical
+  target_foe: true
+  can_attack: true
+  can_counter_attack: true
+  damage_bonus: 3
+`)
+	return bytes.NewBuffer(powerData)
+}
+
+func useOverwatchScriptData() *bytes.Buffer {
+	scriptData := []byte(`---
+version: 0.1F
+positions:
+  - squaddie_id: squaddieTeros
+    row: 0
+    column: 0
+  - squaddie_id: squaddieLini
+    row: 2
+    column: 0
+  - squaddie_id: squaddieBandit0
+    row: 0
+    column: 5
+actions:
+  -
+    kind: overwatch
+    user_id: squaddieTeros
+    power_id: powerBow
+  -
+    kind: move
+    user_id: squaddieBandit0
+    destination:
+      row: 0
+      column: 3
+  -
+    kind: move
+    random_seed: 1000
+    user_id: squaddieBandit0
+    destination:
+      row: 0
+      column: 2
+  -
+    kind: move
+    user_id: squaddieBandit0
+    destination:
+      row: 0
+      column: 1
+  -
+    random_seed: 1000
+    user_id: squaddieBandit0
+    power_id: powerAxe
+    target_ids:
+      - squaddieLini
+`)
+	return bytes.NewBuffer(scriptData)
+}
+
+func TestReplayScriptTurnStartSuite(t *testing.T) {
+	suite.Run(t, new(ReplayScriptTurnStartSuite))
+}
+
+type ReplayScriptTurnStartSuite struct {
+	suite.Suite
+}
+
+func (suite *ReplayScriptTurnStartSuite) TestWhenNextTurnStarts_ThenPowerCooldownsCountDown() {
+	// Setup
+	var output strings.Builder
+	gameRunner := terosgamerules.GameRules{}
+
+	// Run
+	_, err := gameRunner.ReplayBattleScript(
+		useTurnStartScriptData(),
+		useTurnStartSquaddieData(),
+		useTurnStartPowerData(),
+		&output,
+	)
+
+	// Require
+	require := require.New(suite.T())
+	require.Nil(err, "no errors should have been found")
+	expectedOutput := `Teros (Lance) vs Bandit: +2 (30/36), for 1 damage
+Teros (Lance) cooldown: 0 turns remaining, 1 after use
+Teros (Lance) hits Bandit, for 1 damage
+   Bandit: 9/10 HP
+   Teros gains 10 XP
+---
+Turn 2 begins
+---
+Teros (Lance) vs Bandit: +2 (30/36), for 1 damage
+Teros (Lance) cooldown: 0 turns remaining, 1 after use
+Teros (Lance) hits Bandit, for 1 damage
+   Bandit: 8/10 HP
+   Teros gains 10 XP
+---
+`
+	require.Equal(expectedOutput, output.String())
+}
+
+func (suite *ReplayScriptTurnStartSuite) TestWhenNextTurnStarts_ThenSquaddiesRegenerateMana() {
+	// Setup
+	var output strings.Builder
+	gameRunner := terosgamerules.GameRules{}
+
+	// Run
+	_, err := gameRunner.ReplayBattleScript(
+		useManaRegenerationScriptData(),
+		useTurnStartSquaddieData(),
+		useTurnStartPowerData(),
+		&output,
+	)
+
+	// Require
+	require := require.New(suite.T())
+	require.Nil(err, "no errors should have been found")
+	expectedOutput := `Teros (Spark) vs Bandit: +2 (30/36), for 1 damage
+Teros (Spark) hits Bandit, for 1 damage
+   Bandit: 9/10 HP
+   Teros gains 10 XP
+---
+Turn 2 begins
+---
+Teros (Spark) vs Bandit: +2 (30/36), for 1 damage
+Teros (Spark) hits Bandit, for 1 damage
+   Bandit: 8/10 HP
+   Teros gains 10 XP
+---
+User cannot afford power
+  Teros[squaddieTeros] has 1 mana
+    Spark[powerSpark] costs 3 mana
+`
+	require.Equal(expectedOutput, output.String())
+}
+
+func (suite *ReplayScriptTurnStartSuite) TestWhenNextTurnStarts_ThenLingeringEffectsTick() {
+	// Setup
+	var output strings.Builder
+	gameRunner := terosgamerules.GameRules{}
+
+	// Run
+	_, err := gameRunner.ReplayBattleScript(
+		useLingeringEffectScriptData(),
+		useLingeringEffectSquaddieData(),
+		useLingeringEffectPowerData(),
+		&output,
+	)
+
+	// Require
+	require := require.New(suite.T())
+	require.Nil(err, "no errors should have been found")
+	expectedOutput := `Teros (Poison Dart) vs Bandit: +2 (30/36), for 1 damage
+Teros (Poison Dart) hits Bandit, for 1 damage, then 2 damage per turn for 2 turns
+   Bandit: 9/10 HP
+   Teros gains 10 XP
+---
+Bandit (Poison Dart) vs Teros: +2 (30/36), for 1 damage
+Bandit (Poison Dart) hits Teros, for 1 damage, then 2 damage per turn for 2 turns
+   Teros: 9/10 HP
+   Bandit gains 10 XP
+---
+Lini (Purify) heals Teros, for 1 healing per turn for 2 turns, cleansing 1 effect
+Lini (Purify) heals Teros, for 1 healing per turn for 2 turns, cleansing 1 effect
+   Teros: 9/10 HP
+   Lini gains 1 XP
+---
+Turn 2 begins
+---
+Teros heals 1 HP from lingering effects
+   Teros: 10/10 HP
+Bandit takes 2 damage from lingering effects
+   Bandit: 7/10 HP
+---
+Turn 3 begins
+---
+Bandit takes 2 damage from lingering effects
+   Bandit: 5/10 HP
+---
+Turn 4 begins
+---
+`
+	require.Equal(expectedOutput, output.String())
+}
+
+func useTurnStartSquaddieData() *bytes.Buffer {
+	squaddieData := []byte(`
+-
+  name: Teros
+  id: squaddieTeros
+  affiliation: player
+  aim: 2
+  max_hit_points: 10
+  max_mana: 5
+  mana_regeneration: 2
+  powers:
+    -
+      name: Lance
+      id: powerLance
+    -
+      name: Spark
+      id: powerSpark
+-
+  name: Bandit
+  id: squaddieBandit0
+  affiliation: enemy
+  max_hit_points: 10
+`)
+	return bytes.NewBuffer(squaddieData)
+}
+
+func useTurnStartPowerData() *bytes.Buffer {
+	powerData := []byte(`
+-
+  name: Lance
+  id: powerLance
+  power_type: physical
+  target_foe: true
+  can_attack: true
+  damage_bonus: 1
+  cooldown: 1
+-
+  name: Spark
+  id: powerSpark
+  power_type: spell
+  target_foe: true
+  can_attack: true
+  damage_bonus: 1
+  mana_cost: 3
+`)
+	return bytes.NewBuffer(powerData)
+}
+
+func useTurnStartScriptData() *bytes.Buffer {
+	scriptData := []byte(`---
+version: 0.1F
+actions:
+  -
+    random_seed: 1000
+    user_id: squaddieTeros
+    power_id: powerLance
+    target_ids:
+      - squaddieBandit0
+  -
+    kind: next_turn
+  -
+    random_seed: 1000
+    user_id: squaddieTeros
+    power_id: powerLance
+    target_ids:
+      - squaddieBandit0
+`)
+	return bytes.NewBuffer(scriptData)
+}
+
+func useManaRegenerationScriptData() *bytes.Buffer {
+	scriptData := []byte(`---
+version: 0.1F
+actions:
+  -
+    random_seed: 1000
+    user_id: squaddieTeros
+    power_id: powerSpark
+    target_ids:
+      - squaddieBandit0
+  -
+    kind: next_turn
+  -
+    random_seed: 1000
+    user_id: squaddieTeros
+    power_id: powerSpark
+    target_ids:
+      - squaddieBandit0
+  -
+    random_seed: 1000
+    user_id: squaddieTeros
+    power_id: powerSpark
+    target_ids:
+      - squaddieBandit0
+`)
+	return bytes.NewBuffer(scriptData)
+}
+
+func useLingeringEffectSquaddieData() *bytes.Buffer {
+	squaddieData := []byte(`
+-
+  name: Teros
+  id: squaddieTeros
+  affiliation: player
+  aim: 2
+  max_hit_points: 10
+  powers:
+    -
+      name: Poison Dart
+      id: powerPoisonDart
+-
+  name: Lini
+  id: squaddieLini
+  affiliation: player
+  max_hit_points: 10
+  powers:
+    -
+      name: Purify
+      id: powerPurify
+-
+  name: Bandit
+  id: squaddieBandit0
+  affiliation: enemy
+  aim: 2
+  max_hit_points: 10
+  powers:
+    -
+      name: Poison Dart
+      id: powerPoisonDart
+`)
+	return bytes.NewBuffer(squaddieData)
+}
+
+func useLingeringEffectPowerData() *bytes.Buffer {
+	powerData := []byte(`
+-
+  name: Poison Dart
+  id: powerPoisonDart
+  power_type: physical
+  target_foe: true
+  can_attack: true
+  damage_bonus: 1
+  damage_per_turn: 2
+  damage_over_time_turns: 2
+-
+  name: Purify
+  id: powerPurify
+  power_type: spell
+  target_friend: true
+  hit_points_healed_per_turn: 1
+  heal_over_time_turns: 2
+  cleanses: true
+`)
+	return bytes.NewBuffer(powerData)
+}
+
+func useLingeringEffectScriptData() *bytes.Buffer {
+	scriptData := []byte(`---
+version: 0.1F
+actions:
+  -
+    random_seed: 1000
+    user_id: squaddieTeros
+    power_id: powerPoisonDart
+    target_ids:
+      - squaddieBandit0
+  -
+    random_seed: 1000
+    user_id: squaddieBandit0
+    power_id: powerPoisonDart
+    target_ids:
+      - squaddieTeros
+  -
+    user_id: squaddieLini
+    power_id: powerPurify
+    target_ids:
+      - squaddieTeros
+  -
+    kind: next_turn
+  -
+    kind: next_turn
+  -
+    kind: next_turn
+`)
+	return bytes.NewBuffer(scriptData)
+}
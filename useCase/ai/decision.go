@@ -0,0 +1,199 @@
+package ai
+
+import (
+	"fmt"
+	"github.com/chadius/terosgamerules/entity/damagedistribution"
+	"github.com/chadius/terosgamerules/entity/powerusagescenario"
+	"github.com/chadius/terosgamerules/usecase/powerattackforecast"
+	"github.com/chadius/terosgamerules/usecase/powercantarget"
+	"github.com/chadius/terosgamerules/usecase/repositories"
+	"github.com/chadius/terosgamerules/usecase/squaddiestats"
+	"github.com/chadius/terosgamerules/utility"
+)
+
+// KillBonus is how much a guaranteed kill is worth, measured in points of damage.
+const KillBonus = 10
+
+// DecisionStrategy describes objects that choose what a squaddie does on its turn.
+type DecisionStrategy interface {
+	ChooseAction(userID string, repos *repositories.RepositoryCollection) (*powerusagescenario.Setup, error)
+}
+
+// ActionScore uses the forecast to describe what will probably happen if the squaddie takes the action.
+//   Chances range from 0 to 1, and expected values are weighted by the chance to hit.
+type ActionScore struct {
+	Setup             *powerusagescenario.Setup
+	ChanceToHit       float64
+	ExpectedDamage    float64
+	KillChance        float64
+	CounterAttackRisk float64
+	ExpectedHealing   float64
+	ExpectedMana      float64
+}
+
+// Value is a general measure of how good the action is. Higher values are better.
+func (a *ActionScore) Value() float64 {
+	return a.ExpectedDamage + a.KillChance*KillBonus + a.ExpectedHealing + a.ExpectedMana - a.CounterAttackRisk
+}
+
+// ScoreLegalActions scores every power and target the squaddie can legally use.
+//   Powers are checked in the order the squaddie learned them, and targets are checked by ID.
+func ScoreLegalActions(userID string, repos *repositories.RepositoryCollection) []*ActionScore {
+	scores := []*ActionScore{}
+	user := repos.SquaddieRepo.GetOriginalSquaddieByID(userID)
+	if user == nil {
+		return scores
+	}
+
+	targetChecker := powercantarget.ValidTargetChecker{}
+	for _, reference := range user.GetCopyOfPowerReferences() {
+		if repos.PowerRepo.GetPowerByID(reference.PowerID) == nil {
+			continue
+		}
+
+		for _, targetID := range repos.SquaddieRepo.GetAllSquaddieIDs() {
+			isValid, _ := targetChecker.IsValidTarget(userID, reference.PowerID, targetID, repos)
+			if isValid == false {
+				continue
+			}
+
+			scores = append(scores, ScoreAction(&powerusagescenario.Setup{
+				UserID:          userID,
+				PowerID:         reference.PowerID,
+				Targets:         []string{targetID},
+				IsCounterAttack: false,
+			}, repos))
+		}
+	}
+	return scores
+}
+
+// ScoreAction forecasts the setup against its first target and summarizes the results.
+func ScoreAction(setup *powerusagescenario.Setup, repos *repositories.RepositoryCollection) *ActionScore {
+	score := &ActionScore{Setup: setup}
+
+	forecast := powerattackforecast.NewForecastBuilder().
+		Setup(setup).
+		Repositories(repos).
+		OffenseStrategy(&squaddiestats.CalculateSquaddieOffenseStats{}).
+		Build()
+	forecast.CalculateForecast()
+
+	calculations := forecast.ForecastedResultPerTarget()
+	if len(calculations) == 0 {
+		return score
+	}
+	calculation := calculations[0]
+
+	if calculation.Attack() != nil {
+		versusContext := calculation.Attack().VersusContext
+		score.ChanceToHit = getChanceToHit(versusContext.ToHit().ToHitBonus)
+		score.ExpectedDamage = getExpectedDamageAcrossStrikes(calculation.Attack(), score.ChanceToHit)
+		score.KillChance = getKillChance(calculation.Attack(), score.ChanceToHit)
+	}
+
+	if calculation.CounterAttack() != nil {
+		counterVersusContext := calculation.CounterAttack().VersusContext
+		counterChanceToHit := getChanceToHit(counterVersusContext.ToHit().ToHitBonus)
+		score.CounterAttackRisk = (1.0 - score.KillChance) * counterChanceToHit * getDamageTaken(counterVersusContext.NormalDamage(), calculation.CounterAttack().DefenderContext)
+	}
+
+	if calculation.HealingForecast() != nil {
+		target := repos.SquaddieRepo.GetOriginalSquaddieByID(calculation.HealingForecast().TargetID)
+		hitPointsMissing := target.MaxHitPoints() - target.CurrentHitPoints()
+		if calculation.HealingForecast().RawHitPointsRestored < hitPointsMissing {
+			hitPointsMissing = calculation.HealingForecast().RawHitPointsRestored
+		}
+		score.ExpectedHealing = float64(hitPointsMissing)
+
+		manaMissing := target.MaxMana() - target.CurrentMana()
+		if calculation.HealingForecast().RawManaRestored < manaMissing {
+			manaMissing = calculation.HealingForecast().RawManaRestored
+		}
+		score.ExpectedMana = float64(manaMissing)
+	}
+	return score
+}
+
+func getChanceToHit(toHitBonus int) float64 {
+	return float64(damagedistribution.ChanceToHitOutOf36(toHitBonus)) / 36.0
+}
+
+// getDamageTaken returns the hit points the defender will lose, which cannot exceed their remaining hit points.
+func getDamageTaken(distribution *damagedistribution.DamageDistribution, defenderContext powerattackforecast.DefenderContext) float64 {
+	if distribution.RawDamageDealt > defenderContext.HitPoints() {
+		return float64(defenderContext.HitPoints())
+	}
+	return float64(distribution.RawDamageDealt)
+}
+
+// getExpectedDamageAcrossStrikes adds up the expected damage of every strike, which cannot exceed the defender's remaining hit points.
+func getExpectedDamageAcrossStrikes(attack *powerattackforecast.AttackForecast, chanceToHit float64) float64 {
+	expectedDamage := chanceToHit * getDamageTaken(attack.VersusContext.NormalDamage(), attack.DefenderContext) * float64(attack.AttackerContext.HitsPerUse())
+	if expectedDamage > float64(attack.DefenderContext.HitPoints()) {
+		return float64(attack.DefenderContext.HitPoints())
+	}
+	return expectedDamage
+}
+
+// getKillChance returns the chance the attack's strikes add up to enough damage to fell the defender.
+//   Every strike is assumed to deal the forecasted damage.
+func getKillChance(attack *powerattackforecast.AttackForecast, chanceToHit float64) float64 {
+	versusContext := attack.VersusContext
+	hitPoints := attack.DefenderContext.HitPoints()
+	if hitPoints < 0 {
+		hitPoints = 0
+	}
+
+	chanceToCritical := 0.0
+	criticalDamage := 0
+	if versusContext.CanCritical() {
+		chanceToCritical = getChanceToHit(versusContext.ToHit().ToHitBonus - versusContext.CriticalHitThreshold())
+		criticalDamage = versusContext.CriticalHitDamage().RawDamageDealt
+	}
+	chanceToHitNormally := chanceToHit - chanceToCritical
+	if chanceToHitNormally < 0 {
+		chanceToHitNormally = 0
+	}
+
+	chanceByDamageDealt := make([]float64, hitPoints+1)
+	chanceByDamageDealt[0] = 1.0
+	addDamage := func(damageDealt, damage int) int {
+		if damageDealt+damage > hitPoints {
+			return hitPoints
+		}
+		return damageDealt + damage
+	}
+	for strike := 0; strike < attack.AttackerContext.HitsPerUse(); strike++ {
+		nextChanceByDamageDealt := make([]float64, hitPoints+1)
+		nextChanceByDamageDealt[hitPoints] = chanceByDamageDealt[hitPoints]
+		for damageDealt, chance := range chanceByDamageDealt[:hitPoints] {
+			nextChanceByDamageDealt[damageDealt] += chance * (1.0 - chanceToHitNormally - chanceToCritical)
+			nextChanceByDamageDealt[addDamage(damageDealt, versusContext.NormalDamage().RawDamageDealt)] += chance * chanceToHitNormally
+			nextChanceByDamageDealt[addDamage(damageDealt, criticalDamage)] += chance * chanceToCritical
+		}
+		chanceByDamageDealt = nextChanceByDamageDealt
+	}
+	return chanceByDamageDealt[hitPoints]
+}
+
+// chooseHighestScore returns the setup with the highest value.
+//   Ties go to the earliest action.
+func chooseHighestScore(userID string, scores []*ActionScore, repos *repositories.RepositoryCollection, value func(score *ActionScore) float64) (*powerusagescenario.Setup, error) {
+	if len(scores) == 0 {
+		user := repos.SquaddieRepo.GetOriginalSquaddieByID(userID)
+		newError := fmt.Errorf(`squaddie "%s" has no legal actions`, user.Name())
+		utility.Log(newError.Error(), 0, utility.Error)
+		return nil, newError
+	}
+
+	bestScore := scores[0]
+	bestValue := value(bestScore)
+	for _, score := range scores[1:] {
+		if value(score) > bestValue {
+			bestScore = score
+			bestValue = value(score)
+		}
+	}
+	return bestScore.Setup, nil
+}
@@ -0,0 +1,168 @@
+package ai_test
+
+import (
+	"github.com/chadius/terosgamerules/entity/power"
+	"github.com/chadius/terosgamerules/entity/powerinterface"
+	"github.com/chadius/terosgamerules/entity/powerreference"
+	"github.com/chadius/terosgamerules/entity/powerrepository"
+	"github.com/chadius/terosgamerules/entity/powerusagescenario"
+	"github.com/chadius/terosgamerules/entity/squaddie"
+	"github.com/chadius/terosgamerules/entity/squaddieinterface"
+	"github.com/chadius/terosgamerules/usecase/ai"
+	"github.com/chadius/terosgamerules/usecase/powerequip"
+	"github.com/chadius/terosgamerules/usecase/repositories"
+	. "gopkg.in/check.v1"
+	"math"
+	"testing"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type DecisionSuite struct {
+	teros  squaddieinterface.Interface
+	lini   squaddieinterface.Interface
+	bandit squaddieinterface.Interface
+	priest squaddieinterface.Interface
+	mute   squaddieinterface.Interface
+
+	axe          powerinterface.Interface
+	healingStaff powerinterface.Interface
+
+	repos *repositories.RepositoryCollection
+}
+
+var _ = Suite(&DecisionSuite{})
+
+func (suite *DecisionSuite) SetUpTest(checker *C) {
+	suite.teros = squaddie.NewSquaddieBuilder().Teros().HitPoints(5).Strength(1).Build()
+	suite.lini = squaddie.NewSquaddieBuilder().Lini().HitPoints(10).Build()
+	suite.bandit = squaddie.NewSquaddieBuilder().Bandit().Strength(2).Build()
+	suite.priest = squaddie.NewSquaddieBuilder().WithID("squaddiePriest").WithName("Priest").AsEnemy().Build()
+	suite.mute = squaddie.NewSquaddieBuilder().WithID("squaddieMute").WithName("Mute").AsEnemy().Build()
+
+	suite.axe = power.NewPowerBuilder().Axe().Build()
+	suite.healingStaff = power.NewPowerBuilder().HealingStaff().Build()
+
+	squaddieRepo := squaddie.NewSquaddieRepository()
+	squaddieRepo.AddSquaddies([]squaddieinterface.Interface{
+		suite.teros,
+		suite.lini,
+		suite.bandit,
+		suite.priest,
+		suite.mute,
+	})
+
+	powerRepo := powerrepository.NewPowerRepository()
+	powerRepo.AddSlicePowerSource([]powerinterface.Interface{
+		suite.axe,
+		suite.healingStaff,
+	})
+
+	suite.repos = &repositories.RepositoryCollection{
+		SquaddieRepo: squaddieRepo,
+		PowerRepo:    powerRepo,
+	}
+
+	checkEquip := powerequip.CheckRepositories{}
+	for _, squaddieWithAxe := range []squaddieinterface.Interface{suite.teros, suite.bandit} {
+		checkEquip.LoadAllOfSquaddieInnatePowers(squaddieWithAxe, []*powerreference.Reference{suite.axe.GetReference()}, suite.repos)
+		checkEquip.EquipDefaultPower(squaddieWithAxe, suite.repos)
+	}
+	for _, healer := range []squaddieinterface.Interface{suite.lini, suite.priest} {
+		checkEquip.LoadAllOfSquaddieInnatePowers(healer, []*powerreference.Reference{suite.healingStaff.GetReference()}, suite.repos)
+	}
+}
+
+func (suite *DecisionSuite) TestScoreUsesTheForecast(checker *C) {
+	score := ai.ScoreAction(&powerusagescenario.Setup{
+		UserID:  suite.bandit.ID(),
+		PowerID: suite.axe.ID(),
+		Targets: []string{suite.teros.ID()},
+	}, suite.repos)
+
+	checker.Assert(score.ChanceToHit, Equals, 26.0/36.0)
+	checker.Assert(score.ExpectedDamage, Equals, 3*26.0/36.0)
+	checker.Assert(score.KillChance, Equals, 0.0)
+	checker.Assert(score.CounterAttackRisk, Equals, 2*15.0/36.0)
+	checker.Assert(score.ExpectedHealing, Equals, 0.0)
+}
+
+func (suite *DecisionSuite) TestScoreIncludesKillChance(checker *C) {
+	suite.teros.ReduceHitPoints(2)
+	score := ai.ScoreAction(&powerusagescenario.Setup{
+		UserID:  suite.bandit.ID(),
+		PowerID: suite.axe.ID(),
+		Targets: []string{suite.teros.ID()},
+	}, suite.repos)
+
+	checker.Assert(score.KillChance, Equals, 26.0/36.0)
+	checker.Assert(math.Abs(score.CounterAttackRisk-(10.0/36.0)*2*15.0/36.0) < 0.0001, Equals, true)
+}
+
+func (suite *DecisionSuite) TestKillChanceAddsUpEveryStrike(checker *C) {
+	doubleAxe := power.NewPowerBuilder().Axe().WithID("powerDoubleAxe").HitsPerUse(2).Build()
+	suite.repos.PowerRepo.AddSlicePowerSource([]powerinterface.Interface{doubleAxe})
+	checkEquip := powerequip.CheckRepositories{}
+	checkEquip.LoadAllOfSquaddieInnatePowers(suite.bandit, []*powerreference.Reference{doubleAxe.GetReference()}, suite.repos)
+
+	score := ai.ScoreAction(&powerusagescenario.Setup{
+		UserID:  suite.bandit.ID(),
+		PowerID: doubleAxe.ID(),
+		Targets: []string{suite.teros.ID()},
+	}, suite.repos)
+
+	checker.Assert(math.Abs(score.KillChance-(26.0/36.0)*(26.0/36.0)) < 0.0001, Equals, true)
+}
+
+func (suite *DecisionSuite) TestOnlyLegalActionsAreScored(checker *C) {
+	scores := ai.ScoreLegalActions(suite.bandit.ID(), suite.repos)
+	checker.Assert(scores, HasLen, 2)
+	checker.Assert(scores[0].Setup.Targets, DeepEquals, []string{suite.lini.ID()})
+	checker.Assert(scores[1].Setup.Targets, DeepEquals, []string{suite.teros.ID()})
+}
+
+func (suite *DecisionSuite) TestRaisesAnErrorWithoutLegalActions(checker *C) {
+	_, err := (&ai.Greedy{}).ChooseAction(suite.mute.ID(), suite.repos)
+	checker.Assert(err, ErrorMatches, `squaddie "Mute" has no legal actions`)
+}
+
+func (suite *DecisionSuite) TestGreedyAvoidsCounterAttacks(checker *C) {
+	setup, err := (&ai.Greedy{}).ChooseAction(suite.bandit.ID(), suite.repos)
+	checker.Assert(err, IsNil)
+	checker.Assert(setup.PowerID, Equals, suite.axe.ID())
+	checker.Assert(setup.Targets, DeepEquals, []string{suite.lini.ID()})
+}
+
+func (suite *DecisionSuite) TestGreedyGoesForTheKill(checker *C) {
+	suite.teros.ReduceHitPoints(2)
+	setup, err := (&ai.Greedy{}).ChooseAction(suite.bandit.ID(), suite.repos)
+	checker.Assert(err, IsNil)
+	checker.Assert(setup.Targets, DeepEquals, []string{suite.teros.ID()})
+}
+
+func (suite *DecisionSuite) TestFocusTheWeakestAttacksTheLowestHitPoints(checker *C) {
+	setup, err := (&ai.FocusTheWeakest{}).ChooseAction(suite.bandit.ID(), suite.repos)
+	checker.Assert(err, IsNil)
+	checker.Assert(setup.Targets, DeepEquals, []string{suite.teros.ID()})
+}
+
+func (suite *DecisionSuite) TestProtectTheHealerAttacksTheHealersThreat(checker *C) {
+	setup, err := (&ai.ProtectTheHealer{}).ChooseAction(suite.bandit.ID(), suite.repos)
+	checker.Assert(err, IsNil)
+	checker.Assert(setup.Targets, DeepEquals, []string{suite.teros.ID()})
+}
+
+func (suite *DecisionSuite) TestProtectTheHealerHealsInjuredHealers(checker *C) {
+	checkEquip := powerequip.CheckRepositories{}
+	checkEquip.LoadAllOfSquaddieInnatePowers(suite.priest, []*powerreference.Reference{
+		suite.healingStaff.GetReference(),
+		suite.axe.GetReference(),
+	}, suite.repos)
+	suite.priest.ReduceHitPoints(1)
+	suite.bandit.ReduceHitPoints(1)
+
+	setup, err := (&ai.ProtectTheHealer{}).ChooseAction(suite.priest.ID(), suite.repos)
+	checker.Assert(err, IsNil)
+	checker.Assert(setup.PowerID, Equals, suite.healingStaff.ID())
+	checker.Assert(setup.Targets, DeepEquals, []string{suite.priest.ID()})
+}
@@ -0,0 +1,129 @@
+package ai
+
+import (
+	"github.com/chadius/terosgamerules/entity/powerusagescenario"
+	"github.com/chadius/terosgamerules/usecase/powercantarget"
+	"github.com/chadius/terosgamerules/usecase/repositories"
+)
+
+// Greedy takes the action with the highest overall value,
+//   favoring damage and kills while avoiding dangerous counterattacks.
+type Greedy struct{}
+
+// ChooseAction picks the action with the highest value.
+func (g *Greedy) ChooseAction(userID string, repos *repositories.RepositoryCollection) (*powerusagescenario.Setup, error) {
+	return chooseHighestScore(userID, ScoreLegalActions(userID, repos), repos, func(score *ActionScore) float64 {
+		return score.Value()
+	})
+}
+
+// FocusTheWeakest attacks whichever foe has the fewest hit points and barrier left.
+//   If the squaddie cannot damage anyone, it acts like Greedy.
+type FocusTheWeakest struct{}
+
+// ChooseAction picks the attack against the weakest target.
+func (f *FocusTheWeakest) ChooseAction(userID string, repos *repositories.RepositoryCollection) (*powerusagescenario.Setup, error) {
+	scores := ScoreLegalActions(userID, repos)
+	attackScores := []*ActionScore{}
+	for _, score := range scores {
+		if score.ExpectedDamage > 0 {
+			attackScores = append(attackScores, score)
+		}
+	}
+
+	if len(attackScores) == 0 {
+		return chooseHighestScore(userID, scores, repos, func(score *ActionScore) float64 {
+			return score.Value()
+		})
+	}
+
+	return chooseHighestScore(userID, attackScores, repos, func(score *ActionScore) float64 {
+		target := repos.SquaddieRepo.GetOriginalSquaddieByID(score.Setup.Targets[0])
+		remainingToughness := target.CurrentHitPoints() + target.CurrentBarrier()
+		return score.Value() - float64(remainingToughness*KillBonus)
+	})
+}
+
+// ProtectTheHealer keeps the squaddie's healers alive.
+//   It prefers healing injured healers and attacking foes who threaten them.
+//   Otherwise it acts like Greedy.
+type ProtectTheHealer struct{}
+
+// ChooseAction picks the action that best protects allied healers.
+func (p *ProtectTheHealer) ChooseAction(userID string, repos *repositories.RepositoryCollection) (*powerusagescenario.Setup, error) {
+	healerIDs := p.getAlliedHealerIDs(userID, repos)
+	threatByFoeID := map[string]float64{}
+
+	return chooseHighestScore(userID, ScoreLegalActions(userID, repos), repos, func(score *ActionScore) float64 {
+		targetID := score.Setup.Targets[0]
+		value := score.Value()
+		if score.ExpectedHealing > 0 && isIDInList(targetID, healerIDs) {
+			value += score.ExpectedHealing
+		}
+
+		if score.ExpectedDamage > 0 {
+			threat, alreadyCalculated := threatByFoeID[targetID]
+			if !alreadyCalculated {
+				threat = p.getThreatToHealers(targetID, healerIDs, repos)
+				threatByFoeID[targetID] = threat
+			}
+			value += threat * (score.ChanceToHit + score.KillChance)
+		}
+		return value
+	})
+}
+
+func (p *ProtectTheHealer) getAlliedHealerIDs(userID string, repos *repositories.RepositoryCollection) []string {
+	user := repos.SquaddieRepo.GetOriginalSquaddieByID(userID)
+	healerIDs := []string{}
+	for _, squaddieID := range repos.SquaddieRepo.GetAllSquaddieIDs() {
+		ally := repos.SquaddieRepo.GetOriginalSquaddieByID(squaddieID)
+		if ally.IsDead() || (squaddieID != userID && powercantarget.AreFriends(user, ally, repos) == false) {
+			continue
+		}
+
+		for _, reference := range ally.GetCopyOfPowerReferences() {
+			powerToCheck := repos.PowerRepo.GetPowerByID(reference.PowerID)
+			if powerToCheck != nil && powerToCheck.CanHeal() {
+				healerIDs = append(healerIDs, squaddieID)
+				break
+			}
+		}
+	}
+	return healerIDs
+}
+
+// getThreatToHealers forecasts how much damage the foe's equipped power will deal to the healers.
+func (p *ProtectTheHealer) getThreatToHealers(foeID string, healerIDs []string, repos *repositories.RepositoryCollection) float64 {
+	foe := repos.SquaddieRepo.GetOriginalSquaddieByID(foeID)
+	powerID := foe.GetEquippedPowerID()
+	if powerID == "" || repos.PowerRepo.GetPowerByID(powerID) == nil {
+		return 0
+	}
+
+	threat := 0.0
+	targetChecker := powercantarget.ValidTargetChecker{}
+	for _, healerID := range healerIDs {
+		isValid, _ := targetChecker.IsValidTarget(foeID, powerID, healerID, repos)
+		if isValid == false {
+			continue
+		}
+
+		threat += ScoreAction(&powerusagescenario.Setup{
+			UserID:          foeID,
+			PowerID:         powerID,
+			Targets:         []string{healerID},
+			IsCounterAttack: false,
+		}, repos).ExpectedDamage
+	}
+	return threat
+}
+
+func isIDInList(id string, ids []string) bool {
+	for _, otherID := range ids {
+		if id == otherID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,141 @@
+package battleoutcome
+
+import (
+	"fmt"
+	"github.com/chadius/terosgamerules/entity/battlegrid"
+	"github.com/chadius/terosgamerules/entity/objective"
+	"github.com/chadius/terosgamerules/entity/squaddieinterface"
+	"github.com/chadius/terosgamerules/usecase/powercantarget"
+	"github.com/chadius/terosgamerules/usecase/repositories"
+	"github.com/chadius/terosgamerules/utility"
+)
+
+// DefaultPlayerAffiliation is the affiliation of the squaddies the objectives are written for,
+//   unless the battle names another affiliation or faction.
+const DefaultPlayerAffiliation = "player"
+
+// Strategy decides if a battle has been won or lost.
+type Strategy interface {
+	CheckObjectives(objectives []*objective.Objective, repos *repositories.RepositoryCollection) error
+	CheckOutcome(objectives []*objective.Objective, squaddieIDs []string, turnsSurvived int, repos *repositories.RepositoryCollection) *objective.Outcome
+}
+
+// CheckRepositories uses the repositories to see which squaddies have fallen.
+//   The objectives are written for the squaddies with the player affiliation.
+type CheckRepositories struct {
+	playerAffiliation string
+}
+
+// NewCheckRepositories returns a CheckRepositories that writes the objectives for the squaddies
+//   with the given affiliation or faction. Uses DefaultPlayerAffiliation if playerAffiliation is empty.
+func NewCheckRepositories(playerAffiliation string) *CheckRepositories {
+	return &CheckRepositories{playerAffiliation: playerAffiliation}
+}
+
+// PlayerAffiliation returns the affiliation or faction of the squaddies the objectives are written for.
+func (c *CheckRepositories) PlayerAffiliation() string {
+	if c.playerAffiliation == "" {
+		return DefaultPlayerAffiliation
+	}
+	return c.playerAffiliation
+}
+
+// CheckObjectives makes sure every objective is valid and its squaddie exists.
+//   Objectives that need squaddie positions raise an error if the battle has no grid.
+func (c *CheckRepositories) CheckObjectives(objectives []*objective.Objective, repos *repositories.RepositoryCollection) error {
+	for _, objectiveToCheck := range objectives {
+		err := objectiveToCheck.CheckForErrors()
+		if err != nil {
+			return err
+		}
+
+		if objectiveToCheck.SquaddieID != "" && repos.SquaddieRepo.GetOriginalSquaddieByID(objectiveToCheck.SquaddieID) == nil {
+			newError := fmt.Errorf(`objective "%s" has unknown squaddie "%s"`, objectiveToCheck.Kind, objectiveToCheck.SquaddieID)
+			utility.Log(newError.Error(), 0, utility.Error)
+			return newError
+		}
+
+		if objectiveToCheck.Kind == objective.ReachTile && repos.Grid == nil {
+			newError := fmt.Errorf(`objective "%s" needs squaddie positions`, objectiveToCheck.Kind)
+			utility.Log(newError.Error(), 0, utility.Error)
+			return newError
+		}
+	}
+	return nil
+}
+
+// CheckOutcome returns the outcome of the battle fought by the squaddies, or nil if the battle continues.
+//   Defeat is checked first: the battle is lost if a protected squaddie falls or every player squaddie falls.
+//   Otherwise the battle is won as soon as any victory objective is complete.
+func (c *CheckRepositories) CheckOutcome(objectives []*objective.Objective, squaddieIDs []string, turnsSurvived int, repos *repositories.RepositoryCollection) *objective.Outcome {
+	for _, objectiveToCheck := range objectives {
+		if objectiveToCheck.Kind == objective.ProtectSquaddie && hasSquaddieFallen(objectiveToCheck.SquaddieID, repos) {
+			return &objective.Outcome{Result: objective.Defeat, Objective: objectiveToCheck}
+		}
+	}
+
+	players := getLivingPlayers(squaddieIDs, c.PlayerAffiliation(), repos)
+	if len(players) == 0 {
+		return &objective.Outcome{Result: objective.Defeat}
+	}
+
+	for _, objectiveToCheck := range objectives {
+		if isVictoryObjectiveComplete(objectiveToCheck, players, squaddieIDs, turnsSurvived, repos) {
+			return &objective.Outcome{Result: objective.Victory, Objective: objectiveToCheck}
+		}
+	}
+	return nil
+}
+
+func isVictoryObjectiveComplete(objectiveToCheck *objective.Objective, players []squaddieinterface.Interface, squaddieIDs []string, turnsSurvived int, repos *repositories.RepositoryCollection) bool {
+	switch objectiveToCheck.Kind {
+	case objective.RoutFoes:
+		return haveAllFoesFallen(players, squaddieIDs, repos)
+	case objective.DefeatBoss:
+		return hasSquaddieFallen(objectiveToCheck.SquaddieID, repos)
+	case objective.SurviveTurns:
+		return turnsSurvived >= objectiveToCheck.Turns
+	case objective.ReachTile:
+		return hasSquaddieReachedTile(objectiveToCheck.SquaddieID, *objectiveToCheck.Tile, repos)
+	}
+	return false
+}
+
+func hasSquaddieFallen(squaddieID string, repos *repositories.RepositoryCollection) bool {
+	squaddieToCheck := repos.SquaddieRepo.GetOriginalSquaddieByID(squaddieID)
+	return squaddieToCheck != nil && squaddieToCheck.IsDead()
+}
+
+func hasSquaddieReachedTile(squaddieID string, tile battlegrid.Coordinate, repos *repositories.RepositoryCollection) bool {
+	squaddieToCheck := repos.SquaddieRepo.GetOriginalSquaddieByID(squaddieID)
+	if squaddieToCheck == nil || squaddieToCheck.IsDead() || repos.Grid == nil {
+		return false
+	}
+	return repos.Grid.SquaddieAt(tile) == squaddieID
+}
+
+func getLivingPlayers(squaddieIDs []string, playerAffiliation string, repos *repositories.RepositoryCollection) []squaddieinterface.Interface {
+	players := []squaddieinterface.Interface{}
+	for _, squaddieID := range squaddieIDs {
+		squaddieToCheck := repos.SquaddieRepo.GetOriginalSquaddieByID(squaddieID)
+		if squaddieToCheck.IsDead() == false && squaddieToCheck.AffiliationLogic().Name() == playerAffiliation {
+			players = append(players, squaddieToCheck)
+		}
+	}
+	return players
+}
+
+func haveAllFoesFallen(players []squaddieinterface.Interface, squaddieIDs []string, repos *repositories.RepositoryCollection) bool {
+	for _, squaddieID := range squaddieIDs {
+		squaddieToCheck := repos.SquaddieRepo.GetOriginalSquaddieByID(squaddieID)
+		if squaddieToCheck.IsDead() {
+			continue
+		}
+		for _, player := range players {
+			if powercantarget.AreFoes(player, squaddieToCheck, repos) {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,182 @@
+package battleoutcome_test
+
+import (
+	"github.com/chadius/terosgamerules/entity/battlegrid"
+	"github.com/chadius/terosgamerules/entity/faction"
+	"github.com/chadius/terosgamerules/entity/objective"
+	"github.com/chadius/terosgamerules/entity/squaddie"
+	"github.com/chadius/terosgamerules/entity/squaddieinterface"
+	"github.com/chadius/terosgamerules/usecase/battleoutcome"
+	"github.com/chadius/terosgamerules/usecase/repositories"
+	. "gopkg.in/check.v1"
+	"testing"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type BattleOutcomeSuite struct {
+	teros       squaddieinterface.Interface
+	lini        squaddieinterface.Interface
+	bandit      squaddieinterface.Interface
+	banditBoss  squaddieinterface.Interface
+	squaddieIDs []string
+
+	repos        *repositories.RepositoryCollection
+	outcomeCheck battleoutcome.Strategy
+}
+
+var _ = Suite(&BattleOutcomeSuite{})
+
+func (suite *BattleOutcomeSuite) SetUpTest(checker *C) {
+	suite.teros = squaddie.NewSquaddieBuilder().Teros().HitPoints(5).Build()
+	suite.lini = squaddie.NewSquaddieBuilder().Lini().HitPoints(5).Build()
+	suite.bandit = squaddie.NewSquaddieBuilder().Bandit().HitPoints(5).Build()
+	suite.banditBoss = squaddie.NewSquaddieBuilder().Bandit().WithID("squaddieBanditBoss").WithName("Bandit Boss").HitPoints(5).Build()
+
+	squaddieRepo := squaddie.NewSquaddieRepository()
+	suite.squaddieIDs = []string{}
+	for _, squaddieToAdd := range []squaddieinterface.Interface{suite.teros, suite.lini, suite.bandit, suite.banditBoss} {
+		squaddieToAdd.SetHPToMax()
+		squaddieRepo.AddSquaddie(squaddieToAdd)
+		suite.squaddieIDs = append(suite.squaddieIDs, squaddieToAdd.ID())
+	}
+
+	suite.repos = &repositories.RepositoryCollection{
+		SquaddieRepo: squaddieRepo,
+	}
+	suite.outcomeCheck = &battleoutcome.CheckRepositories{}
+}
+
+func (suite *BattleOutcomeSuite) defeat(squaddieToDefeat squaddieinterface.Interface) {
+	squaddieToDefeat.ReduceHitPoints(squaddieToDefeat.MaxHitPoints())
+}
+
+func (suite *BattleOutcomeSuite) TestBattleContinuesUntilAnObjectiveIsComplete(checker *C) {
+	objectives := []*objective.Objective{
+		{Kind: objective.RoutFoes},
+		{Kind: objective.SurviveTurns, Turns: 2},
+	}
+	suite.defeat(suite.bandit)
+	checker.Assert(suite.outcomeCheck.CheckOutcome(objectives, suite.squaddieIDs, 1, suite.repos), IsNil)
+}
+
+func (suite *BattleOutcomeSuite) TestWinByRoutingFoes(checker *C) {
+	objectives := []*objective.Objective{{Kind: objective.RoutFoes}}
+	suite.defeat(suite.bandit)
+	suite.defeat(suite.banditBoss)
+
+	outcome := suite.outcomeCheck.CheckOutcome(objectives, suite.squaddieIDs, 0, suite.repos)
+	checker.Assert(outcome.IsVictory(), Equals, true)
+	checker.Assert(outcome.Objective, Equals, objectives[0])
+}
+
+func (suite *BattleOutcomeSuite) TestRoutingFollowsFactionRelationships(checker *C) {
+	objectives := []*objective.Objective{{Kind: objective.RoutFoes}}
+	suite.defeat(suite.banditBoss)
+	checker.Assert(suite.outcomeCheck.CheckOutcome(objectives, suite.squaddieIDs, 0, suite.repos), IsNil)
+
+	suite.repos.FactionRepo = faction.NewRepository()
+	suite.repos.FactionRepo.SetRelationship("player", "enemy", faction.Neutral)
+	outcome := suite.outcomeCheck.CheckOutcome(objectives, suite.squaddieIDs, 0, suite.repos)
+	checker.Assert(outcome.IsVictory(), Equals, true)
+}
+
+func (suite *BattleOutcomeSuite) TestWinByDefeatingTheBoss(checker *C) {
+	objectives := []*objective.Objective{{Kind: objective.DefeatBoss, SquaddieID: suite.banditBoss.ID()}}
+	suite.defeat(suite.bandit)
+	checker.Assert(suite.outcomeCheck.CheckOutcome(objectives, suite.squaddieIDs, 0, suite.repos), IsNil)
+
+	suite.defeat(suite.banditBoss)
+	outcome := suite.outcomeCheck.CheckOutcome(objectives, suite.squaddieIDs, 0, suite.repos)
+	checker.Assert(outcome.IsVictory(), Equals, true)
+}
+
+func (suite *BattleOutcomeSuite) TestWinBySurvivingTurns(checker *C) {
+	objectives := []*objective.Objective{{Kind: objective.SurviveTurns, Turns: 3}}
+	checker.Assert(suite.outcomeCheck.CheckOutcome(objectives, suite.squaddieIDs, 2, suite.repos), IsNil)
+
+	outcome := suite.outcomeCheck.CheckOutcome(objectives, suite.squaddieIDs, 3, suite.repos)
+	checker.Assert(outcome.IsVictory(), Equals, true)
+}
+
+func (suite *BattleOutcomeSuite) TestWinByReachingATile(checker *C) {
+	objectives := []*objective.Objective{{Kind: objective.ReachTile, SquaddieID: suite.lini.ID(), Tile: &battlegrid.Coordinate{Row: 0, Column: 3}}}
+	suite.repos.Grid = battlegrid.NewGrid()
+	suite.repos.Grid.PlaceSquaddie(suite.lini.ID(), battlegrid.Coordinate{Row: 0, Column: 1})
+	suite.repos.Grid.PlaceSquaddie(suite.teros.ID(), battlegrid.Coordinate{Row: 0, Column: 3})
+	checker.Assert(suite.outcomeCheck.CheckObjectives(objectives, suite.repos), IsNil)
+	checker.Assert(suite.outcomeCheck.CheckOutcome(objectives, suite.squaddieIDs, 0, suite.repos), IsNil)
+
+	suite.repos.Grid.PlaceSquaddie(suite.teros.ID(), battlegrid.Coordinate{Row: 1, Column: 3})
+	suite.repos.Grid.PlaceSquaddie(suite.lini.ID(), battlegrid.Coordinate{Row: 0, Column: 3})
+	outcome := suite.outcomeCheck.CheckOutcome(objectives, suite.squaddieIDs, 0, suite.repos)
+	checker.Assert(outcome.IsVictory(), Equals, true)
+	checker.Assert(outcome.Objective, Equals, objectives[0])
+}
+
+func (suite *BattleOutcomeSuite) TestReachingATileNeedsPositions(checker *C) {
+	err := suite.outcomeCheck.CheckObjectives([]*objective.Objective{
+		{Kind: objective.ReachTile, SquaddieID: suite.lini.ID(), Tile: &battlegrid.Coordinate{Row: 0, Column: 3}},
+	}, suite.repos)
+	checker.Assert(err, ErrorMatches, `objective "reach_tile" needs squaddie positions`)
+}
+
+func (suite *BattleOutcomeSuite) TestObjectivesCanBeWrittenForAnotherSide(checker *C) {
+	objectives := []*objective.Objective{{Kind: objective.RoutFoes}}
+	suite.defeat(suite.teros)
+	suite.defeat(suite.lini)
+
+	checker.Assert(suite.outcomeCheck.CheckOutcome(objectives, suite.squaddieIDs, 0, suite.repos).IsVictory(), Equals, false)
+
+	banditCheck := battleoutcome.NewCheckRepositories("enemy")
+	checker.Assert(banditCheck.PlayerAffiliation(), Equals, "enemy")
+	outcome := banditCheck.CheckOutcome(objectives, suite.squaddieIDs, 0, suite.repos)
+	checker.Assert(outcome.IsVictory(), Equals, true)
+	checker.Assert(outcome.Objective, Equals, objectives[0])
+}
+
+func (suite *BattleOutcomeSuite) TestObjectivesAreWrittenForPlayersByDefault(checker *C) {
+	checker.Assert(battleoutcome.NewCheckRepositories("").PlayerAffiliation(), Equals, battleoutcome.DefaultPlayerAffiliation)
+}
+
+func (suite *BattleOutcomeSuite) TestLoseIfTheProtectedSquaddieFalls(checker *C) {
+	objectives := []*objective.Objective{
+		{Kind: objective.DefeatBoss, SquaddieID: suite.banditBoss.ID()},
+		{Kind: objective.ProtectSquaddie, SquaddieID: suite.lini.ID()},
+	}
+	suite.defeat(suite.lini)
+	suite.defeat(suite.banditBoss)
+
+	outcome := suite.outcomeCheck.CheckOutcome(objectives, suite.squaddieIDs, 0, suite.repos)
+	checker.Assert(outcome.IsVictory(), Equals, false)
+	checker.Assert(outcome.Objective, Equals, objectives[1])
+}
+
+func (suite *BattleOutcomeSuite) TestLoseIfEveryPlayerFalls(checker *C) {
+	objectives := []*objective.Objective{{Kind: objective.SurviveTurns, Turns: 3}}
+	suite.defeat(suite.teros)
+	suite.defeat(suite.lini)
+
+	outcome := suite.outcomeCheck.CheckOutcome(objectives, suite.squaddieIDs, 3, suite.repos)
+	checker.Assert(outcome.Result, Equals, objective.Defeat)
+	checker.Assert(outcome.Objective, IsNil)
+}
+
+func (suite *BattleOutcomeSuite) TestCharmedFoesFightForThePlayers(checker *C) {
+	objectives := []*objective.Objective{{Kind: objective.RoutFoes}}
+	suite.defeat(suite.banditBoss)
+	suite.bandit.OverrideAffiliation(suite.teros.AffiliationLogic(), 1)
+
+	outcome := suite.outcomeCheck.CheckOutcome(objectives, suite.squaddieIDs, 0, suite.repos)
+	checker.Assert(outcome.IsVictory(), Equals, true)
+}
+
+func (suite *BattleOutcomeSuite) TestRaisesErrorForObjectivesWithUnknownSquaddies(checker *C) {
+	err := suite.outcomeCheck.CheckObjectives([]*objective.Objective{
+		{Kind: objective.ProtectSquaddie, SquaddieID: "squaddieNobody"},
+	}, suite.repos)
+	checker.Assert(err, ErrorMatches, `objective "protect_squaddie" has unknown squaddie "squaddieNobody"`)
+
+	err = suite.outcomeCheck.CheckObjectives([]*objective.Objective{{Kind: objective.SurviveTurns}}, suite.repos)
+	checker.Assert(err, NotNil)
+}
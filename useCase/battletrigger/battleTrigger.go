@@ -0,0 +1,252 @@
+package battletrigger
+
+import (
+	"fmt"
+	"github.com/chadius/terosgamerules/entity/squaddieinterface"
+	"github.com/chadius/terosgamerules/entity/trigger"
+	"github.com/chadius/terosgamerules/usecase/powerequip"
+	"github.com/chadius/terosgamerules/usecase/repositories"
+	"github.com/chadius/terosgamerules/utility"
+)
+
+// Strategy decides which triggers fire and applies their effects.
+type Strategy interface {
+	CheckTriggers(triggers []*trigger.Trigger, repos *repositories.RepositoryCollection) error
+	GetTriggersToFire(triggers []*trigger.Trigger, firedTriggerIDs map[string]bool, progress *trigger.BattleProgress, repos *repositories.RepositoryCollection) []*trigger.Trigger
+	ApplyEffects(triggerToFire *trigger.Trigger, repos *repositories.RepositoryCollection) ([]string, error)
+}
+
+// CheckRepositories uses the repositories to find the squaddies and powers the triggers name.
+type CheckRepositories struct{}
+
+// CheckTriggers makes sure every trigger is valid, has a unique ID,
+//   and names squaddies, templates and powers that exist.
+//   Squaddies added by a trigger may be named by any trigger.
+func (c *CheckRepositories) CheckTriggers(triggers []*trigger.Trigger, repos *repositories.RepositoryCollection) error {
+	triggerIDsFound := map[string]bool{}
+	addedSquaddieIDs := map[string]bool{}
+	for _, triggerToCheck := range triggers {
+		err := triggerToCheck.CheckForErrors()
+		if err != nil {
+			return err
+		}
+
+		if triggerIDsFound[triggerToCheck.ID] {
+			newError := fmt.Errorf(`trigger "%s" is declared more than once`, triggerToCheck.ID)
+			utility.Log(newError.Error(), 0, utility.Error)
+			return newError
+		}
+		triggerIDsFound[triggerToCheck.ID] = true
+
+		for _, effect := range triggerToCheck.Effects {
+			for _, newSquaddieID := range effect.SquaddieIDs {
+				if addedSquaddieIDs[newSquaddieID] || repos.SquaddieRepo.GetOriginalSquaddieByID(newSquaddieID) != nil {
+					newError := fmt.Errorf(`trigger "%s" cannot add squaddie "%s", the ID is already used`, triggerToCheck.ID, newSquaddieID)
+					utility.Log(newError.Error(), 0, utility.Error)
+					return newError
+				}
+				addedSquaddieIDs[newSquaddieID] = true
+			}
+		}
+	}
+
+	for _, triggerToCheck := range triggers {
+		err := checkTriggerReferences(triggerToCheck, addedSquaddieIDs, repos)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkTriggerReferences(triggerToCheck *trigger.Trigger, addedSquaddieIDs map[string]bool, repos *repositories.RepositoryCollection) error {
+	squaddieIDsToCheck := []string{triggerToCheck.Condition.SquaddieID}
+	for _, effect := range triggerToCheck.Effects {
+		squaddieIDsToCheck = append(squaddieIDsToCheck, effect.SquaddieID)
+
+		if effect.TemplateID != "" && repos.SquaddieRepo.GetOriginalSquaddieByID(effect.TemplateID) == nil {
+			newError := fmt.Errorf(`trigger "%s" has unknown template "%s"`, triggerToCheck.ID, effect.TemplateID)
+			utility.Log(newError.Error(), 0, utility.Error)
+			return newError
+		}
+
+		if effect.Kind != trigger.GainPowers {
+			continue
+		}
+		for _, reference := range effect.Powers {
+			if repos.PowerRepo.GetPowerByID(reference.PowerID) == nil {
+				newError := fmt.Errorf(`trigger "%s" has unknown power "%s"`, triggerToCheck.ID, reference.PowerID)
+				utility.Log(newError.Error(), 0, utility.Error)
+				return newError
+			}
+		}
+	}
+
+	for _, squaddieID := range squaddieIDsToCheck {
+		if squaddieID == "" || addedSquaddieIDs[squaddieID] {
+			continue
+		}
+		if repos.SquaddieRepo.GetOriginalSquaddieByID(squaddieID) == nil {
+			newError := fmt.Errorf(`trigger "%s" has unknown squaddie "%s"`, triggerToCheck.ID, squaddieID)
+			utility.Log(newError.Error(), 0, utility.Error)
+			return newError
+		}
+	}
+	return nil
+}
+
+// GetTriggersToFire returns the triggers whose conditions are met, in the order they were declared.
+//   Triggers in firedTriggerIDs have already fired and are skipped.
+func (c *CheckRepositories) GetTriggersToFire(triggers []*trigger.Trigger, firedTriggerIDs map[string]bool, progress *trigger.BattleProgress, repos *repositories.RepositoryCollection) []*trigger.Trigger {
+	triggersToFire := []*trigger.Trigger{}
+	for _, triggerToCheck := range triggers {
+		if firedTriggerIDs[triggerToCheck.ID] {
+			continue
+		}
+
+		var conditionSquaddie squaddieinterface.Interface
+		if triggerToCheck.Condition.SquaddieID != "" {
+			conditionSquaddie = repos.SquaddieRepo.GetOriginalSquaddieByID(triggerToCheck.Condition.SquaddieID)
+		}
+		if triggerToCheck.Condition.IsMet(progress, conditionSquaddie) {
+			triggersToFire = append(triggersToFire, triggerToCheck)
+		}
+	}
+	return triggersToFire
+}
+
+// ApplyEffects applies the trigger's effects in order.
+//   Returns the IDs of the squaddies the trigger added to the battle.
+//   Raises an error if an effect names a squaddie, template or power that does not exist,
+//   or adds a squaddie whose ID is already used.
+func (c *CheckRepositories) ApplyEffects(triggerToFire *trigger.Trigger, repos *repositories.RepositoryCollection) ([]string, error) {
+	addedSquaddieIDs := []string{}
+	for _, effect := range triggerToFire.Effects {
+		var err error
+		switch effect.Kind {
+		case trigger.AddSquaddies:
+			var newSquaddieIDs []string
+			newSquaddieIDs, err = addSquaddies(triggerToFire, effect, repos)
+			addedSquaddieIDs = append(addedSquaddieIDs, newSquaddieIDs...)
+		case trigger.GainPowers:
+			err = gainPowers(triggerToFire, effect, repos)
+		case trigger.LosePowers:
+			err = losePowers(triggerToFire, effect, repos)
+		case trigger.ChangeAffiliation:
+			err = changeAffiliation(triggerToFire, effect, repos)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+	return addedSquaddieIDs, nil
+}
+
+// addSquaddies clones the template for every new squaddie, ready for battle with full health and its innate powers.
+//   Squaddies may have joined the battle since the triggers were checked, so no squaddie is added
+//   if any new ID is already used.
+func addSquaddies(triggerToFire *trigger.Trigger, effect *trigger.Effect, repos *repositories.RepositoryCollection) ([]string, error) {
+	template := repos.SquaddieRepo.GetOriginalSquaddieByID(effect.TemplateID)
+	if template == nil {
+		newError := fmt.Errorf(`trigger "%s" has unknown template "%s"`, triggerToFire.ID, effect.TemplateID)
+		utility.Log(newError.Error(), 0, utility.Error)
+		return nil, newError
+	}
+
+	for _, newSquaddieID := range effect.SquaddieIDs {
+		if repos.SquaddieRepo.GetOriginalSquaddieByID(newSquaddieID) != nil {
+			newError := fmt.Errorf(`trigger "%s" cannot add squaddie "%s", the ID is already used`, triggerToFire.ID, newSquaddieID)
+			utility.Log(newError.Error(), 0, utility.Error)
+			return nil, newError
+		}
+	}
+
+	equipCheck := powerequip.CheckRepositories{}
+	for _, newSquaddieID := range effect.SquaddieIDs {
+		newSquaddie, err := repos.SquaddieRepo.CloneSquaddieWithNewID(template, newSquaddieID)
+		if err != nil {
+			return nil, err
+		}
+		newSquaddie.SetHPToMax()
+		newSquaddie.SetBarrierToMax()
+		newSquaddie.SetManaToMax()
+
+		err = equipCheck.LoadAllOfSquaddieInnatePowers(newSquaddie, newSquaddie.GetCopyOfPowerReferences(), repos)
+		if err != nil {
+			return nil, err
+		}
+		equipCheck.EquipDefaultPower(newSquaddie, repos)
+		repos.SquaddieRepo.AddSquaddie(newSquaddie)
+	}
+	return effect.SquaddieIDs, nil
+}
+
+// gainPowers adds the powers the squaddie does not already have.
+//   Squaddies without an equipped power equip their default power.
+func gainPowers(triggerToFire *trigger.Trigger, effect *trigger.Effect, repos *repositories.RepositoryCollection) error {
+	squaddieToChange, err := getEffectSquaddie(triggerToFire, effect, repos)
+	if err != nil {
+		return err
+	}
+
+	for _, reference := range effect.Powers {
+		if repos.PowerRepo.GetPowerByID(reference.PowerID) == nil {
+			newError := fmt.Errorf(`trigger "%s" has unknown power "%s"`, triggerToFire.ID, reference.PowerID)
+			utility.Log(newError.Error(), 0, utility.Error)
+			return newError
+		}
+		if !squaddieToChange.HasPowerWithID(reference.PowerID) {
+			squaddieToChange.AddPowerReference(reference)
+		}
+	}
+
+	if !squaddieToChange.HasEquippedPower() {
+		equipCheck := powerequip.CheckRepositories{}
+		equipCheck.EquipDefaultPower(squaddieToChange, repos)
+	}
+	return nil
+}
+
+// losePowers removes the powers. If the squaddie loses its equipped power, it equips its default power.
+func losePowers(triggerToFire *trigger.Trigger, effect *trigger.Effect, repos *repositories.RepositoryCollection) error {
+	squaddieToChange, err := getEffectSquaddie(triggerToFire, effect, repos)
+	if err != nil {
+		return err
+	}
+
+	for _, reference := range effect.Powers {
+		squaddieToChange.RemovePowerReferenceByPowerID(reference.PowerID)
+	}
+
+	if !squaddieToChange.HasPowerWithID(squaddieToChange.GetEquippedPowerID()) {
+		squaddieToChange.EquipPower("")
+		equipCheck := powerequip.CheckRepositories{}
+		equipCheck.EquipDefaultPower(squaddieToChange, repos)
+	}
+	return nil
+}
+
+func changeAffiliation(triggerToFire *trigger.Trigger, effect *trigger.Effect, repos *repositories.RepositoryCollection) error {
+	squaddieToChange, err := getEffectSquaddie(triggerToFire, effect, repos)
+	if err != nil {
+		return err
+	}
+
+	newAffiliation, err := effect.NewAffiliationLogic()
+	if err != nil {
+		return err
+	}
+	squaddieToChange.ChangeBaseAffiliation(newAffiliation)
+	return nil
+}
+
+func getEffectSquaddie(triggerToFire *trigger.Trigger, effect *trigger.Effect, repos *repositories.RepositoryCollection) (squaddieinterface.Interface, error) {
+	squaddieToChange := repos.SquaddieRepo.GetOriginalSquaddieByID(effect.SquaddieID)
+	if squaddieToChange == nil {
+		newError := fmt.Errorf(`trigger "%s" has unknown squaddie "%s"`, triggerToFire.ID, effect.SquaddieID)
+		utility.Log(newError.Error(), 0, utility.Error)
+		return nil, newError
+	}
+	return squaddieToChange, nil
+}
@@ -0,0 +1,160 @@
+package battletrigger_test
+
+import (
+	"github.com/chadius/terosgamerules/entity/power"
+	"github.com/chadius/terosgamerules/entity/powerinterface"
+	"github.com/chadius/terosgamerules/entity/powerreference"
+	"github.com/chadius/terosgamerules/entity/powerrepository"
+	"github.com/chadius/terosgamerules/entity/squaddie"
+	"github.com/chadius/terosgamerules/entity/squaddieinterface"
+	"github.com/chadius/terosgamerules/entity/trigger"
+	"github.com/chadius/terosgamerules/usecase/battletrigger"
+	"github.com/chadius/terosgamerules/usecase/repositories"
+	"github.com/chadius/terosgamerules/utility/testutility"
+	. "gopkg.in/check.v1"
+	"testing"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type BattleTriggerSuite struct {
+	boss  squaddieinterface.Interface
+	guard squaddieinterface.Interface
+
+	axe  powerinterface.Interface
+	rage powerinterface.Interface
+
+	bossEnrages *trigger.Trigger
+	guardsFlee  *trigger.Trigger
+
+	repos        *repositories.RepositoryCollection
+	triggerCheck battletrigger.Strategy
+}
+
+var _ = Suite(&BattleTriggerSuite{})
+
+func (suite *BattleTriggerSuite) SetUpTest(checker *C) {
+	suite.boss = squaddie.NewSquaddieBuilder().Bandit().WithID("squaddieBoss").WithName("Bandit Boss").HitPoints(10).Build()
+	suite.guard = squaddie.NewSquaddieBuilder().Bandit().WithID("squaddieGuard").WithName("Guard").HitPoints(3).Barrier(1).Build()
+
+	suite.axe = power.NewPowerBuilder().Axe().WithName("Axe").Build()
+	suite.rage = power.NewPowerBuilder().WithName("Rage").WithID("powerRage").TargetsFoe().CanBeEquipped().DealsDamage(3).Build()
+
+	suite.repos = &repositories.RepositoryCollection{
+		SquaddieRepo: squaddie.NewSquaddieRepository(),
+		PowerRepo:    powerrepository.NewPowerRepository(),
+	}
+	suite.repos.PowerRepo.AddSlicePowerSource([]powerinterface.Interface{suite.rage})
+	testutility.AddSquaddieWithInnatePowersToRepos(suite.boss, suite.axe, suite.repos, true)
+	testutility.AddSquaddieWithInnatePowersToRepos(suite.guard, suite.axe, suite.repos, false)
+	suite.boss.SetHPToMax()
+
+	suite.bossEnrages = &trigger.Trigger{
+		ID:        "bossEnrages",
+		Condition: trigger.Condition{Kind: trigger.HitPointsBelow, SquaddieID: suite.boss.ID(), Percent: 50},
+		Effects: []*trigger.Effect{
+			{Kind: trigger.AddSquaddies, TemplateID: suite.guard.ID(), SquaddieIDs: []string{"guard0", "guard1"}},
+			{Kind: trigger.LosePowers, SquaddieID: suite.boss.ID(), Powers: []*powerreference.Reference{suite.axe.GetReference()}},
+			{Kind: trigger.GainPowers, SquaddieID: suite.boss.ID(), Powers: []*powerreference.Reference{suite.rage.GetReference()}},
+		},
+	}
+	suite.guardsFlee = &trigger.Trigger{
+		ID:        "guardsFlee",
+		Condition: trigger.Condition{Kind: trigger.SquaddieFelled, SquaddieID: "guard0"},
+		Effects: []*trigger.Effect{
+			{Kind: trigger.ChangeAffiliation, SquaddieID: "guard1", Affiliation: "neutral"},
+		},
+	}
+
+	suite.triggerCheck = &battletrigger.CheckRepositories{}
+}
+
+func (suite *BattleTriggerSuite) TestTriggersCanNameSquaddiesAddedByOtherTriggers(checker *C) {
+	err := suite.triggerCheck.CheckTriggers([]*trigger.Trigger{suite.guardsFlee, suite.bossEnrages}, suite.repos)
+	checker.Assert(err, IsNil)
+}
+
+func (suite *BattleTriggerSuite) TestRaisesErrorForDuplicateTriggers(checker *C) {
+	err := suite.triggerCheck.CheckTriggers([]*trigger.Trigger{suite.bossEnrages, suite.bossEnrages}, suite.repos)
+	checker.Assert(err, ErrorMatches, `trigger "bossEnrages" is declared more than once`)
+}
+
+func (suite *BattleTriggerSuite) TestRaisesErrorForUnknownSquaddies(checker *C) {
+	err := suite.triggerCheck.CheckTriggers([]*trigger.Trigger{suite.guardsFlee}, suite.repos)
+	checker.Assert(err, ErrorMatches, `trigger "guardsFlee" has unknown squaddie "guard0"`)
+}
+
+func (suite *BattleTriggerSuite) TestRaisesErrorIfNewSquaddiesReuseIDs(checker *C) {
+	suite.bossEnrages.Effects[0].SquaddieIDs = []string{suite.boss.ID()}
+	err := suite.triggerCheck.CheckTriggers([]*trigger.Trigger{suite.bossEnrages}, suite.repos)
+	checker.Assert(err, ErrorMatches, `trigger "bossEnrages" cannot add squaddie "squaddieBoss", the ID is already used`)
+}
+
+func (suite *BattleTriggerSuite) TestRaisesErrorForUnknownTemplatesAndPowers(checker *C) {
+	suite.bossEnrages.Effects[0].TemplateID = "squaddieNobody"
+	err := suite.triggerCheck.CheckTriggers([]*trigger.Trigger{suite.bossEnrages}, suite.repos)
+	checker.Assert(err, ErrorMatches, `trigger "bossEnrages" has unknown template "squaddieNobody"`)
+
+	suite.bossEnrages.Effects[0].TemplateID = suite.guard.ID()
+	suite.bossEnrages.Effects[2].Powers = []*powerreference.Reference{{Name: "Fireball", PowerID: "powerFireball"}}
+	err = suite.triggerCheck.CheckTriggers([]*trigger.Trigger{suite.bossEnrages}, suite.repos)
+	checker.Assert(err, ErrorMatches, `trigger "bossEnrages" has unknown power "powerFireball"`)
+}
+
+func (suite *BattleTriggerSuite) TestFiresTriggersInOrderOnce(checker *C) {
+	turnTwo := &trigger.Trigger{
+		ID:        "turnTwo",
+		Condition: trigger.Condition{Kind: trigger.TurnReached, Turn: 2},
+		Effects:   []*trigger.Effect{{Kind: trigger.ShowMessage, Message: "Turn 2"}},
+	}
+	triggers := []*trigger.Trigger{suite.bossEnrages, turnTwo}
+	firedTriggerIDs := map[string]bool{}
+	progress := &trigger.BattleProgress{Turn: 1, ActionsPlayed: 1}
+
+	checker.Assert(suite.triggerCheck.GetTriggersToFire(triggers, firedTriggerIDs, progress, suite.repos), HasLen, 0)
+
+	suite.boss.ReduceHitPoints(6)
+	progress.Turn = 2
+	checker.Assert(suite.triggerCheck.GetTriggersToFire(triggers, firedTriggerIDs, progress, suite.repos), DeepEquals, triggers)
+
+	firedTriggerIDs[suite.bossEnrages.ID] = true
+	checker.Assert(suite.triggerCheck.GetTriggersToFire(triggers, firedTriggerIDs, progress, suite.repos), DeepEquals, []*trigger.Trigger{turnTwo})
+}
+
+func (suite *BattleTriggerSuite) TestAddsReinforcementsAndSwitchesPowers(checker *C) {
+	addedSquaddieIDs, err := suite.triggerCheck.ApplyEffects(suite.bossEnrages, suite.repos)
+	checker.Assert(err, IsNil)
+	checker.Assert(addedSquaddieIDs, DeepEquals, []string{"guard0", "guard1"})
+
+	for _, guardID := range addedSquaddieIDs {
+		newGuard := suite.repos.SquaddieRepo.GetOriginalSquaddieByID(guardID)
+		checker.Assert(newGuard.Name(), Equals, "Guard")
+		checker.Assert(newGuard.CurrentHitPoints(), Equals, 3)
+		checker.Assert(newGuard.CurrentBarrier(), Equals, 1)
+		checker.Assert(newGuard.GetEquippedPowerID(), Equals, suite.axe.ID())
+	}
+
+	checker.Assert(suite.boss.HasPowerWithID(suite.axe.ID()), Equals, false)
+	checker.Assert(suite.boss.HasPowerWithID(suite.rage.ID()), Equals, true)
+	checker.Assert(suite.boss.GetEquippedPowerID(), Equals, suite.rage.ID())
+}
+
+func (suite *BattleTriggerSuite) TestRaisesErrorIfNewSquaddieIDWasTakenBeforeTheTriggerFired(checker *C) {
+	err := suite.triggerCheck.CheckTriggers([]*trigger.Trigger{suite.bossEnrages}, suite.repos)
+	checker.Assert(err, IsNil)
+
+	summonedGuard := squaddie.NewSquaddieBuilder().Bandit().WithID("guard1").WithName("Summoned Guard").Build()
+	suite.repos.SquaddieRepo.AddSquaddie(summonedGuard)
+
+	_, err = suite.triggerCheck.ApplyEffects(suite.bossEnrages, suite.repos)
+	checker.Assert(err, ErrorMatches, `trigger "bossEnrages" cannot add squaddie "guard1", the ID is already used`)
+	checker.Assert(suite.repos.SquaddieRepo.GetOriginalSquaddieByID("guard0"), IsNil)
+	checker.Assert(suite.repos.SquaddieRepo.GetOriginalSquaddieByID("guard1").Name(), Equals, "Summoned Guard")
+}
+
+func (suite *BattleTriggerSuite) TestChangesAffiliation(checker *C) {
+	suite.triggerCheck.ApplyEffects(suite.bossEnrages, suite.repos)
+	_, err := suite.triggerCheck.ApplyEffects(suite.guardsFlee, suite.repos)
+	checker.Assert(err, IsNil)
+	checker.Assert(suite.repos.SquaddieRepo.GetOriginalSquaddieByID("guard1").AffiliationLogic().Name(), Equals, "neutral")
+}
@@ -0,0 +1,81 @@
+package battleturn
+
+import (
+	"github.com/chadius/terosgamerules/entity/squaddieinterface"
+	"github.com/chadius/terosgamerules/usecase/repositories"
+)
+
+// TurnEnd describes what happened to the squaddie when its turn ended.
+//   AffiliationRestored is true if the squaddie returned to its own side.
+//   SummonVanished is true if the squaddie was summoned and its time ran out.
+type TurnEnd struct {
+	SquaddieID          string
+	AffiliationRestored bool
+	SummonVanished      bool
+}
+
+// TurnStart describes what happened to the squaddies when a new turn started.
+//   TurnEnds describes the squaddies whose turn ended because the new turn started.
+//   HitPointChangeBySquaddieID has the hit points each squaddie gained from its lingering effects, or lost if negative.
+//   Squaddies whose hit points did not change are left out.
+type TurnStart struct {
+	TurnEnds                   []*TurnEnd
+	HitPointChangeBySquaddieID map[string]int
+}
+
+// EndSquaddieTurn ends the squaddie's turn, using up a turn of any override to its affiliation
+//   and of its summon's duration. Summons whose time ran out vanish from the battle.
+//   Squaddies only end their turn once per turn, so ending it again does nothing.
+func EndSquaddieTurn(squaddieToEnd squaddieinterface.Interface) *TurnEnd {
+	turnEnd := &TurnEnd{SquaddieID: squaddieToEnd.ID()}
+	if squaddieToEnd.TurnEnded() {
+		return turnEnd
+	}
+	squaddieToEnd.EndTurn()
+	turnEnd.AffiliationRestored = squaddieToEnd.ReduceAffiliationOverrideDuration()
+
+	if squaddieToEnd.IsDead() {
+		return turnEnd
+	}
+	if squaddieToEnd.ReduceSummonDuration() {
+		squaddieToEnd.ReduceHitPoints(squaddieToEnd.CurrentHitPoints())
+		turnEnd.SummonVanished = true
+	}
+	return turnEnd
+}
+
+// StartNewTurn ends the turn of every squaddie who has not ended it yet, then starts a new turn.
+//   Every squaddie can counterattack again and stops guarding and watching over the battlefield.
+//   Living squaddies apply their lingering effects, then regenerate mana and count down their power cooldowns.
+//   Squaddies felled by their lingering effects do nothing else.
+func StartNewTurn(squaddieIDs []string, repos *repositories.RepositoryCollection) *TurnStart {
+	turnStart := &TurnStart{
+		TurnEnds:                   []*TurnEnd{},
+		HitPointChangeBySquaddieID: map[string]int{},
+	}
+
+	for _, squaddieID := range squaddieIDs {
+		squaddieToUpdate := repos.SquaddieRepo.GetOriginalSquaddieByID(squaddieID)
+		if !squaddieToUpdate.TurnEnded() {
+			turnStart.TurnEnds = append(turnStart.TurnEnds, EndSquaddieTurn(squaddieToUpdate))
+		}
+		squaddieToUpdate.ResetTurnState()
+	}
+
+	for _, squaddieID := range squaddieIDs {
+		squaddieToUpdate := repos.SquaddieRepo.GetOriginalSquaddieByID(squaddieID)
+		if squaddieToUpdate.IsDead() {
+			continue
+		}
+		hitPointChange := squaddieToUpdate.ApplyLingeringEffects()
+		if hitPointChange != 0 {
+			turnStart.HitPointChangeBySquaddieID[squaddieID] = hitPointChange
+		}
+		if squaddieToUpdate.IsDead() {
+			continue
+		}
+		squaddieToUpdate.RegenerateMana()
+		squaddieToUpdate.ReducePowerCooldowns()
+	}
+	return turnStart
+}
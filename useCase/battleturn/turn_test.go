@@ -0,0 +1,99 @@
+package battleturn_test
+
+import (
+	"github.com/chadius/terosgamerules/entity/lingeringeffect"
+	"github.com/chadius/terosgamerules/entity/squaddie"
+	"github.com/chadius/terosgamerules/entity/squaddieinterface"
+	"github.com/chadius/terosgamerules/usecase/battleturn"
+	"github.com/chadius/terosgamerules/usecase/repositories"
+	. "gopkg.in/check.v1"
+	"testing"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type BattleTurnSuite struct {
+	teros  squaddieinterface.Interface
+	bandit squaddieinterface.Interface
+
+	repos *repositories.RepositoryCollection
+}
+
+var _ = Suite(&BattleTurnSuite{})
+
+func (suite *BattleTurnSuite) SetUpTest(checker *C) {
+	suite.teros = squaddie.NewSquaddieBuilder().Teros().Build()
+	suite.bandit = squaddie.NewSquaddieBuilder().Bandit().Build()
+
+	squaddieRepo := squaddie.NewSquaddieRepository()
+	squaddieRepo.AddSquaddies([]squaddieinterface.Interface{suite.teros, suite.bandit})
+
+	suite.repos = &repositories.RepositoryCollection{
+		SquaddieRepo: squaddieRepo,
+	}
+}
+
+func (suite *BattleTurnSuite) TestEndingTheTurnCountsDownTheAffiliationOverride(checker *C) {
+	suite.bandit.OverrideAffiliation(suite.teros.AffiliationLogic(), 1)
+
+	checker.Assert(battleturn.EndSquaddieTurn(suite.bandit).AffiliationRestored, Equals, true)
+	checker.Assert(suite.bandit.HasAffiliationOverride(), Equals, false)
+	checker.Assert(suite.bandit.TurnEnded(), Equals, true)
+}
+
+func (suite *BattleTurnSuite) TestEndingTheTurnTwiceOnlyCountsDownOnce(checker *C) {
+	suite.bandit.OverrideAffiliation(suite.teros.AffiliationLogic(), 2)
+
+	battleturn.EndSquaddieTurn(suite.bandit)
+	checker.Assert(battleturn.EndSquaddieTurn(suite.bandit).AffiliationRestored, Equals, false)
+	checker.Assert(suite.bandit.AffiliationOverrideTurnsRemaining(), Equals, 1)
+}
+
+func (suite *BattleTurnSuite) TestNewTurnEndsTheTurnOfSquaddiesWhoDidNotEndIt(checker *C) {
+	suite.bandit.OverrideAffiliation(suite.teros.AffiliationLogic(), 1)
+
+	turnStart := battleturn.StartNewTurn([]string{suite.teros.ID(), suite.bandit.ID()}, suite.repos)
+	checker.Assert(turnStart.TurnEnds, DeepEquals, []*battleturn.TurnEnd{
+		{SquaddieID: suite.teros.ID()},
+		{SquaddieID: suite.bandit.ID(), AffiliationRestored: true},
+	})
+	checker.Assert(suite.bandit.HasAffiliationOverride(), Equals, false)
+}
+
+func (suite *BattleTurnSuite) TestNewTurnDoesNotCountDownSquaddiesWhoEndedTheirTurn(checker *C) {
+	suite.bandit.OverrideAffiliation(suite.teros.AffiliationLogic(), 2)
+	battleturn.EndSquaddieTurn(suite.bandit)
+
+	turnStart := battleturn.StartNewTurn([]string{suite.teros.ID(), suite.bandit.ID()}, suite.repos)
+	checker.Assert(turnStart.TurnEnds, DeepEquals, []*battleturn.TurnEnd{{SquaddieID: suite.teros.ID()}})
+	checker.Assert(suite.bandit.AffiliationOverrideTurnsRemaining(), Equals, 1)
+	checker.Assert(suite.bandit.TurnEnded(), Equals, false)
+}
+
+func (suite *BattleTurnSuite) TestEndingTheTurnCountsDownTheSummonDuration(checker *C) {
+	suite.bandit.MarkAsSummon(suite.teros.ID(), 2)
+
+	checker.Assert(battleturn.EndSquaddieTurn(suite.bandit).SummonVanished, Equals, false)
+	battleturn.StartNewTurn([]string{suite.teros.ID(), suite.bandit.ID()}, suite.repos)
+	checker.Assert(suite.bandit.IsDead(), Equals, false)
+
+	turnStart := battleturn.StartNewTurn([]string{suite.teros.ID(), suite.bandit.ID()}, suite.repos)
+	checker.Assert(turnStart.TurnEnds[1].SummonVanished, Equals, true)
+	checker.Assert(suite.bandit.IsDead(), Equals, true)
+}
+
+func (suite *BattleTurnSuite) TestNewTurnResetsTheTurnState(checker *C) {
+	suite.teros.Guard(suite.bandit.ID())
+	suite.teros.MarkCounterAttackMade()
+
+	battleturn.StartNewTurn([]string{suite.teros.ID(), suite.bandit.ID()}, suite.repos)
+	checker.Assert(suite.teros.GuardedSquaddieID(), Equals, "")
+	checker.Assert(suite.teros.CounterAttacksThisTurn(), Equals, 0)
+}
+
+func (suite *BattleTurnSuite) TestNewTurnAppliesLingeringEffects(checker *C) {
+	suite.teros.AddLingeringEffect(&lingeringeffect.LingeringEffect{Name: "Poison", HitPointsPerTurn: -1, TurnsRemaining: 2})
+
+	turnStart := battleturn.StartNewTurn([]string{suite.teros.ID(), suite.bandit.ID()}, suite.repos)
+	checker.Assert(turnStart.HitPointChangeBySquaddieID, DeepEquals, map[string]int{suite.teros.ID(): -1})
+}
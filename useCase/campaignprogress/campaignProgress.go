@@ -0,0 +1,169 @@
+package campaignprogress
+
+import (
+	"fmt"
+	"github.com/chadius/terosgamerules/entity/campaign"
+	"github.com/chadius/terosgamerules/entity/powerreference"
+	"github.com/chadius/terosgamerules/entity/squaddie"
+	"github.com/chadius/terosgamerules/entity/squaddieinterface"
+	"github.com/chadius/terosgamerules/usecase/itemequip"
+	"github.com/chadius/terosgamerules/usecase/powerequip"
+	"github.com/chadius/terosgamerules/usecase/repositories"
+	"github.com/chadius/terosgamerules/utility"
+)
+
+// Strategy moves a campaign's roster in and out of its chapters.
+type Strategy interface {
+	StartCampaign(campaignToStart *campaign.Campaign, squaddieIDs []string, repos *repositories.RepositoryCollection) (*campaign.SaveFile, error)
+	DeployRoster(save *campaign.SaveFile, repos *repositories.RepositoryCollection) ([]string, error)
+	CompleteChapter(campaignInProgress *campaign.Campaign, save *campaign.SaveFile, repos *repositories.RepositoryCollection) error
+}
+
+// CheckRepositories uses the repositories to save and restore the roster.
+type CheckRepositories struct{}
+
+// StartCampaign creates a save file at the first chapter whose roster holds the squaddies.
+//   Raises an error if one of the squaddies does not exist.
+func (c *CheckRepositories) StartCampaign(campaignToStart *campaign.Campaign, squaddieIDs []string, repos *repositories.RepositoryCollection) (*campaign.SaveFile, error) {
+	save := &campaign.SaveFile{
+		CampaignID:   campaignToStart.ID,
+		ChapterIndex: 0,
+		Roster:       []*campaign.RosterEntry{},
+	}
+
+	for _, squaddieID := range squaddieIDs {
+		recruit := repos.SquaddieRepo.GetOriginalSquaddieByID(squaddieID)
+		if recruit == nil {
+			newError := fmt.Errorf(`campaign "%s" cannot recruit unknown squaddie "%s"`, campaignToStart.ID, squaddieID)
+			utility.Log(newError.Error(), 0, utility.Error)
+			return nil, newError
+		}
+
+		entry := &campaign.RosterEntry{}
+		recordSquaddie(entry, recruit)
+		save.Roster = append(save.Roster, entry)
+	}
+	return save, nil
+}
+
+// DeployRoster rebuilds every available squaddie on the roster and adds them to the squaddie repository,
+//   replacing any squaddie with the same ID. Deployed squaddies keep their hit points and equipment,
+//   but start the chapter with full barrier and mana.
+//   Returns the IDs of the deployed squaddies.
+//   Raises an error if a squaddie's powers or items no longer exist.
+func (c *CheckRepositories) DeployRoster(save *campaign.SaveFile, repos *repositories.RepositoryCollection) ([]string, error) {
+	deployedSquaddieIDs := []string{}
+	for _, entry := range save.Roster {
+		if !entry.IsAvailable() {
+			continue
+		}
+
+		deployedSquaddie, err := restoreSquaddie(entry, repos)
+		if err != nil {
+			return nil, err
+		}
+		repos.SquaddieRepo.AddSquaddie(deployedSquaddie)
+		deployedSquaddieIDs = append(deployedSquaddieIDs, deployedSquaddie.ID())
+	}
+	return deployedSquaddieIDs, nil
+}
+
+// CompleteChapter records the state of every deployed squaddie and moves the save file to the next chapter.
+//   Fallen squaddies die or are injured based on the campaign's casualty policy.
+//   Squaddies who sat out the chapter recover from their injuries.
+//   Raises an error if the campaign is already complete.
+func (c *CheckRepositories) CompleteChapter(campaignInProgress *campaign.Campaign, save *campaign.SaveFile, repos *repositories.RepositoryCollection) error {
+	if save.IsCampaignComplete(campaignInProgress) {
+		newError := fmt.Errorf(`campaign "%s" is already complete`, campaignInProgress.ID)
+		utility.Log(newError.Error(), 0, utility.Error)
+		return newError
+	}
+
+	for _, entry := range save.Roster {
+		if !entry.IsAvailable() {
+			if entry.InjuredChaptersRemaining > 0 {
+				entry.InjuredChaptersRemaining--
+			}
+			continue
+		}
+
+		deployedSquaddie := repos.SquaddieRepo.GetOriginalSquaddieByID(entry.SquaddieID())
+		if deployedSquaddie == nil {
+			continue
+		}
+
+		recordSquaddie(entry, deployedSquaddie)
+		if deployedSquaddie.IsDead() {
+			applyCasualtyPolicy(campaignInProgress, entry)
+		}
+	}
+
+	save.ChapterIndex++
+	return nil
+}
+
+func applyCasualtyPolicy(campaignInProgress *campaign.Campaign, entry *campaign.RosterEntry) {
+	if campaignInProgress.CasualtyPolicy == campaign.Injured {
+		entry.InjuredChaptersRemaining = campaignInProgress.InjuredChapters
+		entry.CurrentHitPoints = entry.Squaddie.MaxHitPoints
+		return
+	}
+	entry.IsDead = true
+}
+
+// recordSquaddie saves the squaddie into the entry.
+//   Powers granted by equipped items are left out, equipping the items again restores them.
+func recordSquaddie(entry *campaign.RosterEntry, source squaddieinterface.Interface) {
+	grantedPowerIDs := map[string]bool{}
+	entry.EquippedItemIDs = map[string]string{}
+	for _, slot := range source.GetEquippedItemSlots() {
+		entry.EquippedItemIDs[slot] = source.GetEquippedItemID(slot)
+		for _, powerID := range source.GetGrantedPowerIDs(slot) {
+			grantedPowerIDs[powerID] = true
+		}
+	}
+
+	entry.Squaddie = squaddie.NewMarshalFromSquaddie(source)
+	innatePowerReferences := []*powerreference.Reference{}
+	for _, reference := range entry.Squaddie.PowerReferences {
+		if !grantedPowerIDs[reference.PowerID] {
+			innatePowerReferences = append(innatePowerReferences, reference)
+		}
+	}
+	entry.Squaddie.PowerReferences = innatePowerReferences
+
+	entry.CurrentHitPoints = source.CurrentHitPoints()
+	entry.EquippedPowerID = source.GetEquippedPowerID()
+}
+
+func restoreSquaddie(entry *campaign.RosterEntry, repos *repositories.RepositoryCollection) (squaddieinterface.Interface, error) {
+	restoredSquaddieBuilder, err := squaddie.NewSquaddieFromMarshal(entry.Squaddie)
+	if err != nil {
+		return nil, err
+	}
+	restoredSquaddie := restoredSquaddieBuilder.Build()
+
+	equipCheck := powerequip.CheckRepositories{}
+	err = equipCheck.LoadAllOfSquaddieInnatePowers(restoredSquaddie, entry.Squaddie.PowerReferences, repos)
+	if err != nil {
+		return nil, err
+	}
+
+	itemCheck := itemequip.CheckRepositories{}
+	for _, itemID := range entry.EquippedItemIDs {
+		err = itemCheck.SquaddieEquipItem(restoredSquaddie, itemID, repos)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if entry.EquippedPowerID == "" || equipCheck.SquaddieEquipPower(restoredSquaddie, entry.EquippedPowerID, repos) == false {
+		equipCheck.EquipDefaultPower(restoredSquaddie, repos)
+	}
+
+	restoredSquaddie.SetHPToMax()
+	restoredSquaddie.ReduceHitPoints(restoredSquaddie.MaxHitPoints() - entry.CurrentHitPoints)
+	restoredSquaddie.SetBarrierToMax()
+	restoredSquaddie.SetManaToMax()
+	return restoredSquaddie, nil
+}
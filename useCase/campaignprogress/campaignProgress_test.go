@@ -0,0 +1,206 @@
+package campaignprogress_test
+
+import (
+	"github.com/chadius/terosgamerules/entity/campaign"
+	"github.com/chadius/terosgamerules/entity/item"
+	"github.com/chadius/terosgamerules/entity/power"
+	"github.com/chadius/terosgamerules/entity/powerinterface"
+	"github.com/chadius/terosgamerules/entity/powerreference"
+	"github.com/chadius/terosgamerules/entity/powerrepository"
+	"github.com/chadius/terosgamerules/entity/squaddie"
+	"github.com/chadius/terosgamerules/entity/squaddieinterface"
+	"github.com/chadius/terosgamerules/usecase/campaignprogress"
+	"github.com/chadius/terosgamerules/usecase/itemequip"
+	"github.com/chadius/terosgamerules/usecase/powerequip"
+	"github.com/chadius/terosgamerules/usecase/repositories"
+	. "gopkg.in/check.v1"
+	"testing"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type CampaignProgressSuite struct {
+	teros squaddieinterface.Interface
+	lini  squaddieinterface.Interface
+
+	blot  powerinterface.Interface
+	spear powerinterface.Interface
+
+	spearItem *item.Item
+
+	permadeathCampaign *campaign.Campaign
+	injuredCampaign    *campaign.Campaign
+
+	repos    *repositories.RepositoryCollection
+	progress campaignprogress.Strategy
+}
+
+var _ = Suite(&CampaignProgressSuite{})
+
+func (suite *CampaignProgressSuite) SetUpTest(checker *C) {
+	suite.teros = squaddie.NewSquaddieBuilder().Teros().HitPoints(5).Barrier(2).AddItem("itemSpear").Build()
+	suite.lini = squaddie.NewSquaddieBuilder().Lini().HitPoints(4).Build()
+
+	suite.blot = power.NewPowerBuilder().Blot().Build()
+	suite.spear = power.NewPowerBuilder().Spear().Build()
+	suite.spearItem = item.NewItem("itemSpear", "Spear", item.Weapon, item.StatModifiers{Aim: 1}, []*powerreference.Reference{suite.spear.GetReference()})
+
+	powerRepo := powerrepository.NewPowerRepository()
+	powerRepo.AddSlicePowerSource([]powerinterface.Interface{suite.blot, suite.spear})
+
+	squaddieRepo := squaddie.NewSquaddieRepository()
+	squaddieRepo.AddSquaddie(suite.teros)
+	squaddieRepo.AddSquaddie(suite.lini)
+
+	itemRepo := item.NewRepository()
+	itemRepo.AddListOfItems([]*item.Item{suite.spearItem})
+
+	suite.repos = &repositories.RepositoryCollection{
+		SquaddieRepo: squaddieRepo,
+		PowerRepo:    powerRepo,
+		ItemRepo:     itemRepo,
+	}
+
+	powerCheck := powerequip.CheckRepositories{}
+	powerCheck.LoadAllOfSquaddieInnatePowers(suite.teros, []*powerreference.Reference{suite.blot.GetReference()}, suite.repos)
+	powerCheck.LoadAllOfSquaddieInnatePowers(suite.lini, []*powerreference.Reference{suite.blot.GetReference()}, suite.repos)
+	powerCheck.EquipDefaultPower(suite.teros, suite.repos)
+	powerCheck.EquipDefaultPower(suite.lini, suite.repos)
+
+	itemCheck := itemequip.CheckRepositories{}
+	itemCheck.SquaddieEquipItem(suite.teros, suite.spearItem.ID(), suite.repos)
+
+	suite.permadeathCampaign = &campaign.Campaign{
+		ID:             "campaignBandits",
+		ChapterIDs:     []string{"chapterAmbush", "chapterHideout", "chapterBoss"},
+		CasualtyPolicy: campaign.Permadeath,
+	}
+	suite.injuredCampaign = &campaign.Campaign{
+		ID:              "campaignTraining",
+		ChapterIDs:      []string{"chapterDrill", "chapterSpar", "chapterExam"},
+		CasualtyPolicy:  campaign.Injured,
+		InjuredChapters: 1,
+	}
+
+	suite.progress = &campaignprogress.CheckRepositories{}
+}
+
+func (suite *CampaignProgressSuite) startAndDeploy(checker *C, campaignToStart *campaign.Campaign) *campaign.SaveFile {
+	save, err := suite.progress.StartCampaign(campaignToStart, []string{suite.teros.ID(), suite.lini.ID()}, suite.repos)
+	checker.Assert(err, IsNil)
+	_, err = suite.progress.DeployRoster(save, suite.repos)
+	checker.Assert(err, IsNil)
+	return save
+}
+
+func (suite *CampaignProgressSuite) TestStartCampaignRecordsTheRoster(checker *C) {
+	save, err := suite.progress.StartCampaign(suite.permadeathCampaign, []string{suite.teros.ID(), suite.lini.ID()}, suite.repos)
+	checker.Assert(err, IsNil)
+	checker.Assert(save.CampaignID, Equals, suite.permadeathCampaign.ID)
+	checker.Assert(save.ChapterIndex, Equals, 0)
+	checker.Assert(save.GetAvailableSquaddieIDs(), DeepEquals, []string{suite.teros.ID(), suite.lini.ID()})
+
+	terosEntry := save.GetRosterEntry(suite.teros.ID())
+	checker.Assert(terosEntry.EquippedItemIDs, DeepEquals, map[string]string{item.Weapon: suite.spearItem.ID()})
+	checker.Assert(terosEntry.EquippedPowerID, Equals, suite.spear.ID())
+	checker.Assert(terosEntry.Squaddie.PowerReferences, DeepEquals, []*powerreference.Reference{suite.blot.GetReference()})
+}
+
+func (suite *CampaignProgressSuite) TestCannotRecruitUnknownSquaddies(checker *C) {
+	_, err := suite.progress.StartCampaign(suite.permadeathCampaign, []string{"squaddieNobody"}, suite.repos)
+	checker.Assert(err, ErrorMatches, `campaign "campaignBandits" cannot recruit unknown squaddie "squaddieNobody"`)
+}
+
+func (suite *CampaignProgressSuite) TestSquaddiesKeepTheirProgressBetweenChapters(checker *C) {
+	save := suite.startAndDeploy(checker, suite.permadeathCampaign)
+
+	deployedTeros := suite.repos.SquaddieRepo.GetOriginalSquaddieByID(suite.teros.ID())
+	deployedTeros.ReduceHitPoints(2)
+	deployedTeros.ReduceBarrier(2)
+	deployedTeros.GainExperience(150)
+
+	err := suite.progress.CompleteChapter(suite.permadeathCampaign, save, suite.repos)
+	checker.Assert(err, IsNil)
+	checker.Assert(save.ChapterIndex, Equals, 1)
+
+	deployedSquaddieIDs, err := suite.progress.DeployRoster(save, suite.repos)
+	checker.Assert(err, IsNil)
+	checker.Assert(deployedSquaddieIDs, DeepEquals, []string{suite.teros.ID(), suite.lini.ID()})
+
+	nextChapterTeros := suite.repos.SquaddieRepo.GetOriginalSquaddieByID(suite.teros.ID())
+	checker.Assert(nextChapterTeros.CurrentHitPoints(), Equals, 3)
+	checker.Assert(nextChapterTeros.CurrentBarrier(), Equals, 2)
+	checker.Assert(nextChapterTeros.ExperiencePoints(), Equals, deployedTeros.ExperiencePoints())
+	checker.Assert(nextChapterTeros.LevelUpsPending(), Equals, deployedTeros.LevelUpsPending())
+	checker.Assert(nextChapterTeros.GetEquippedItemID(item.Weapon), Equals, suite.spearItem.ID())
+	checker.Assert(nextChapterTeros.GetGrantedPowerIDs(item.Weapon), DeepEquals, []string{suite.spear.ID()})
+	checker.Assert(nextChapterTeros.GetEquippedPowerID(), Equals, suite.spear.ID())
+	checker.Assert(nextChapterTeros.HasPowerWithID(suite.blot.ID()), Equals, true)
+}
+
+func (suite *CampaignProgressSuite) TestFallenSquaddiesAreLostWithPermadeath(checker *C) {
+	save := suite.startAndDeploy(checker, suite.permadeathCampaign)
+
+	deployedLini := suite.repos.SquaddieRepo.GetOriginalSquaddieByID(suite.lini.ID())
+	deployedLini.ReduceHitPoints(deployedLini.MaxHitPoints())
+
+	suite.progress.CompleteChapter(suite.permadeathCampaign, save, suite.repos)
+	checker.Assert(save.GetRosterEntry(suite.lini.ID()).IsDead, Equals, true)
+	checker.Assert(save.GetAvailableSquaddieIDs(), DeepEquals, []string{suite.teros.ID()})
+
+	suite.progress.DeployRoster(save, suite.repos)
+	suite.progress.CompleteChapter(suite.permadeathCampaign, save, suite.repos)
+	checker.Assert(save.GetAvailableSquaddieIDs(), DeepEquals, []string{suite.teros.ID()})
+}
+
+func (suite *CampaignProgressSuite) TestFallenSquaddiesSitOutWhileInjured(checker *C) {
+	save := suite.startAndDeploy(checker, suite.injuredCampaign)
+
+	deployedLini := suite.repos.SquaddieRepo.GetOriginalSquaddieByID(suite.lini.ID())
+	deployedLini.ReduceHitPoints(deployedLini.MaxHitPoints())
+
+	suite.progress.CompleteChapter(suite.injuredCampaign, save, suite.repos)
+	liniEntry := save.GetRosterEntry(suite.lini.ID())
+	checker.Assert(liniEntry.IsDead, Equals, false)
+	checker.Assert(liniEntry.InjuredChaptersRemaining, Equals, 1)
+	checker.Assert(save.GetAvailableSquaddieIDs(), DeepEquals, []string{suite.teros.ID()})
+
+	deployedSquaddieIDs, _ := suite.progress.DeployRoster(save, suite.repos)
+	checker.Assert(deployedSquaddieIDs, DeepEquals, []string{suite.teros.ID()})
+	suite.progress.CompleteChapter(suite.injuredCampaign, save, suite.repos)
+	checker.Assert(liniEntry.InjuredChaptersRemaining, Equals, 0)
+
+	deployedSquaddieIDs, _ = suite.progress.DeployRoster(save, suite.repos)
+	checker.Assert(deployedSquaddieIDs, DeepEquals, []string{suite.teros.ID(), suite.lini.ID()})
+	recoveredLini := suite.repos.SquaddieRepo.GetOriginalSquaddieByID(suite.lini.ID())
+	checker.Assert(recoveredLini.CurrentHitPoints(), Equals, recoveredLini.MaxHitPoints())
+}
+
+func (suite *CampaignProgressSuite) TestCannotCompleteAFinishedCampaign(checker *C) {
+	save := suite.startAndDeploy(checker, suite.permadeathCampaign)
+	save.ChapterIndex = suite.permadeathCampaign.NumberOfChapters()
+
+	err := suite.progress.CompleteChapter(suite.permadeathCampaign, save, suite.repos)
+	checker.Assert(err, ErrorMatches, `campaign "campaignBandits" is already complete`)
+}
+
+func (suite *CampaignProgressSuite) TestRosterSurvivesSavingAndLoading(checker *C) {
+	save := suite.startAndDeploy(checker, suite.permadeathCampaign)
+	suite.repos.SquaddieRepo.GetOriginalSquaddieByID(suite.teros.ID()).ReduceHitPoints(1)
+	suite.progress.CompleteChapter(suite.permadeathCampaign, save, suite.repos)
+
+	data, err := save.ToYAML()
+	checker.Assert(err, IsNil)
+	loadedSave, err := campaign.NewSaveFileFromYAML(data)
+	checker.Assert(err, IsNil)
+
+	suite.repos.SquaddieRepo = squaddie.NewSquaddieRepository()
+	_, err = suite.progress.DeployRoster(loadedSave, suite.repos)
+	checker.Assert(err, IsNil)
+
+	loadedTeros := suite.repos.SquaddieRepo.GetOriginalSquaddieByID(suite.teros.ID())
+	checker.Assert(loadedTeros.CurrentHitPoints(), Equals, 4)
+	checker.Assert(loadedTeros.GetEquippedPowerID(), Equals, suite.spear.ID())
+	checker.Assert(loadedTeros.HasPowerWithID(suite.blot.ID()), Equals, true)
+	checker.Assert(loadedTeros.GetCopyOfPowerReferences(), HasLen, 2)
+}
@@ -0,0 +1,116 @@
+package experience
+
+import (
+	"github.com/chadius/terosgamerules/entity/squaddieinterface"
+	"github.com/chadius/terosgamerules/usecase/levelup"
+	"github.com/chadius/terosgamerules/usecase/powercommit"
+	"github.com/chadius/terosgamerules/usecase/repositories"
+)
+
+// Base experience awards. Awards are adjusted by the level difference between the target and the user.
+const (
+	// ExperienceForAttempt is awarded when the power missed or had no effect.
+	ExperienceForAttempt = 1
+	// ExperienceForHit is awarded when the attack hit the target.
+	ExperienceForHit = 10
+	// ExperienceForFelling is awarded when the attack felled the target.
+	ExperienceForFelling = 30
+	// ExperienceForHealing is awarded when the power restored hit points to the target.
+	ExperienceForHealing = 10
+	// ExperiencePerLevelDifference is added for every level the target is above the user (and removed for every level below.)
+	ExperiencePerLevelDifference = 2
+)
+
+// Award records the experience a squaddie earned after using a power.
+type Award struct {
+	SquaddieID       string
+	ExperienceGained int
+	LevelUpsQueued   int
+}
+
+// AwardStrategy describes objects that give experience to squaddies based on the results of their powers.
+type AwardStrategy interface {
+	AwardExperience(result powercommit.ResultStrategy, repos *repositories.RepositoryCollection) []*Award
+	CalculateExperience(resultPerTarget *powercommit.ResultPerTarget, repos *repositories.RepositoryCollection) int
+}
+
+// AwardByRelativeLevel awards experience based on the outcome of each power
+//   and how the target's level compares to the user's.
+type AwardByRelativeLevel struct{}
+
+// AwardExperience gives experience to every living squaddie that used a power in the result.
+//   Returns one Award per squaddie, in the order they first used a power.
+func (a *AwardByRelativeLevel) AwardExperience(result powercommit.ResultStrategy, repos *repositories.RepositoryCollection) []*Award {
+	awardsBySquaddieID := map[string]*Award{}
+	awardsInOrder := []*Award{}
+	for _, resultPerTarget := range result.ResultPerTarget() {
+		award, alreadyAwarded := awardsBySquaddieID[resultPerTarget.UserID()]
+		if !alreadyAwarded {
+			award = &Award{SquaddieID: resultPerTarget.UserID()}
+			awardsBySquaddieID[resultPerTarget.UserID()] = award
+			awardsInOrder = append(awardsInOrder, award)
+		}
+		award.ExperienceGained += a.CalculateExperience(resultPerTarget, repos)
+	}
+
+	awards := []*Award{}
+	for _, award := range awardsInOrder {
+		user := repos.SquaddieRepo.GetOriginalSquaddieByID(award.SquaddieID)
+		if user == nil || user.IsDead() {
+			continue
+		}
+		award.LevelUpsQueued = user.GainExperience(award.ExperienceGained)
+		awards = append(awards, award)
+	}
+	return awards
+}
+
+// CalculateExperience returns the experience the user earns for this result.
+func (a *AwardByRelativeLevel) CalculateExperience(resultPerTarget *powercommit.ResultPerTarget, repos *repositories.RepositoryCollection) int {
+	user := repos.SquaddieRepo.GetOriginalSquaddieByID(resultPerTarget.UserID())
+	target := repos.SquaddieRepo.GetOriginalSquaddieByID(resultPerTarget.TargetID())
+	if user == nil || target == nil {
+		return 0
+	}
+
+	levelDifference := GetSquaddieLevel(target, repos) - GetSquaddieLevel(user, repos)
+
+	if resultPerTarget.Attack() != nil {
+		if !resultPerTarget.Attack().HitTarget() {
+			return ExperienceForAttempt
+		}
+		if resultPerTarget.Attack().FelledTarget() {
+			return adjustExperienceForLevelDifference(ExperienceForFelling, levelDifference)
+		}
+		return adjustExperienceForLevelDifference(ExperienceForHit, levelDifference)
+	}
+
+	if resultPerTarget.Healing() != nil && resultPerTarget.Healing().HitPointsRestored() > 0 {
+		return adjustExperienceForLevelDifference(ExperienceForHealing, levelDifference)
+	}
+	return ExperienceForAttempt
+}
+
+func adjustExperienceForLevelDifference(baseExperience, levelDifference int) int {
+	adjustedExperience := baseExperience + levelDifference*ExperiencePerLevelDifference
+	if adjustedExperience < ExperienceForAttempt {
+		return ExperienceForAttempt
+	}
+	return adjustedExperience
+}
+
+// GetSquaddieLevel returns the number of times the squaddie has levelled up across all classes.
+//   Every level up consumes one small level, so only small levels are counted if the LevelRepo is available.
+func GetSquaddieLevel(squaddieToInspect squaddieinterface.Interface, repos *repositories.RepositoryCollection) int {
+	levelsByClassID := squaddieToInspect.GetLevelCountsByClass()
+	if repos.LevelRepo != nil {
+		levelCounter := levelup.SelectLevelUpBasedOnSquaddieBigLevelsOnEvenLevels{}
+		levelsByClassID = levelCounter.GetSquaddieClassLevels(squaddieToInspect, repos)
+	}
+
+	totalLevels := 0
+	for _, levels := range levelsByClassID {
+		totalLevels += levels
+	}
+	return totalLevels
+}
@@ -0,0 +1,161 @@
+package experience_test
+
+import (
+	"github.com/chadius/terosgamerules/entity/levelupbenefit"
+	"github.com/chadius/terosgamerules/entity/power"
+	"github.com/chadius/terosgamerules/entity/powerinterface"
+	"github.com/chadius/terosgamerules/entity/powerrepository"
+	"github.com/chadius/terosgamerules/entity/squaddie"
+	"github.com/chadius/terosgamerules/entity/squaddieclass"
+	"github.com/chadius/terosgamerules/entity/squaddieinterface"
+	"github.com/chadius/terosgamerules/usecase/experience"
+	"github.com/chadius/terosgamerules/usecase/powercommit"
+	"github.com/chadius/terosgamerules/usecase/repositories"
+	"github.com/chadius/terosgamerules/utility/testutility/builder"
+	. "gopkg.in/check.v1"
+	"testing"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type AwardExperienceSuite struct {
+	teros  squaddieinterface.Interface
+	lini   squaddieinterface.Interface
+	bandit squaddieinterface.Interface
+
+	spear        powerinterface.Interface
+	axe          powerinterface.Interface
+	healingStaff powerinterface.Interface
+
+	repos *repositories.RepositoryCollection
+
+	awardStrategy *experience.AwardByRelativeLevel
+}
+
+var _ = Suite(&AwardExperienceSuite{})
+
+func (suite *AwardExperienceSuite) SetUpTest(checker *C) {
+	suite.teros = squaddie.NewSquaddieBuilder().Teros().Build()
+	suite.lini = squaddie.NewSquaddieBuilder().Lini().Build()
+	suite.bandit = squaddie.NewSquaddieBuilder().Bandit().Build()
+
+	suite.spear = power.NewPowerBuilder().Spear().Build()
+	suite.axe = power.NewPowerBuilder().Axe().Build()
+	suite.healingStaff = power.NewPowerBuilder().HealingStaff().Build()
+
+	squaddieRepo := squaddie.NewSquaddieRepository()
+	squaddieRepo.AddSquaddies([]squaddieinterface.Interface{suite.teros, suite.lini, suite.bandit})
+
+	powerRepo := powerrepository.NewPowerRepository()
+	powerRepo.AddSlicePowerSource([]powerinterface.Interface{suite.spear, suite.axe, suite.healingStaff})
+
+	suite.repos = &repositories.RepositoryCollection{
+		SquaddieRepo: squaddieRepo,
+		PowerRepo:    powerRepo,
+	}
+
+	suite.awardStrategy = &experience.AwardByRelativeLevel{}
+}
+
+func (suite *AwardExperienceSuite) attackResult(user, target squaddieinterface.Interface, attack *powercommit.AttackResult) *powercommit.ResultPerTarget {
+	return powercommit.NewResultPerTargetBuilder().User(user).Power(suite.spear).Target(target).AttackResult(attack).Build()
+}
+
+func (suite *AwardExperienceSuite) TestMissingEarnsExperienceForTheAttempt(checker *C) {
+	miss := suite.attackResult(suite.teros, suite.bandit, powercommit.NewAttackResultBuilder().Build())
+	checker.Assert(suite.awardStrategy.CalculateExperience(miss, suite.repos), Equals, experience.ExperienceForAttempt)
+}
+
+func (suite *AwardExperienceSuite) TestHittingEarnsMoreExperience(checker *C) {
+	hit := suite.attackResult(suite.teros, suite.bandit, powercommit.NewAttackResultBuilder().HitTarget().Build())
+	checker.Assert(suite.awardStrategy.CalculateExperience(hit, suite.repos), Equals, experience.ExperienceForHit)
+}
+
+func (suite *AwardExperienceSuite) TestFellingEarnsTheMostExperience(checker *C) {
+	suite.bandit.ReduceHitPoints(suite.bandit.MaxHitPoints())
+	hit := suite.attackResult(suite.teros, suite.bandit, powercommit.NewAttackResultBuilder().HitTarget().FelledTarget().Build())
+	checker.Assert(suite.awardStrategy.CalculateExperience(hit, suite.repos), Equals, experience.ExperienceForFelling)
+}
+
+func (suite *AwardExperienceSuite) TestOnlyTheAttackThatFellsTheTargetEarnsFellingExperience(checker *C) {
+	suite.bandit.ReduceHitPoints(suite.bandit.MaxHitPoints())
+	earlierHit := suite.attackResult(suite.teros, suite.bandit, powercommit.NewAttackResultBuilder().HitTarget().Build())
+	checker.Assert(suite.awardStrategy.CalculateExperience(earlierHit, suite.repos), Equals, experience.ExperienceForHit)
+}
+
+func (suite *AwardExperienceSuite) TestHealingEarnsExperienceIfHitPointsWereRestored(checker *C) {
+	heal := powercommit.NewResultPerTargetBuilder().User(suite.lini).Power(suite.healingStaff).Target(suite.teros).
+		HealResult(powercommit.NewHealResultBuilder().HitPointsRestored(2).Build()).Build()
+	checker.Assert(suite.awardStrategy.CalculateExperience(heal, suite.repos), Equals, experience.ExperienceForHealing)
+
+	noHealing := powercommit.NewResultPerTargetBuilder().User(suite.lini).Power(suite.healingStaff).Target(suite.teros).
+		HealResult(powercommit.NewHealResultBuilder().HitPointsRestored(0).Build()).Build()
+	checker.Assert(suite.awardStrategy.CalculateExperience(noHealing, suite.repos), Equals, experience.ExperienceForAttempt)
+}
+
+func (suite *AwardExperienceSuite) TestHigherLevelTargetsAwardMoreExperience(checker *C) {
+	suite.bandit.AddClass(&squaddieclass.ClassReference{ID: "bandit class", Name: "Bandit class"})
+	suite.bandit.MarkLevelUpBenefitAsConsumed("bandit class", "bandit level 0")
+	suite.bandit.MarkLevelUpBenefitAsConsumed("bandit class", "bandit level 1")
+
+	hit := suite.attackResult(suite.teros, suite.bandit, powercommit.NewAttackResultBuilder().HitTarget().Build())
+	checker.Assert(suite.awardStrategy.CalculateExperience(hit, suite.repos), Equals, experience.ExperienceForHit+2*experience.ExperiencePerLevelDifference)
+}
+
+func (suite *AwardExperienceSuite) TestLowerLevelTargetsAwardAtLeastTheAttemptExperience(checker *C) {
+	suite.teros.AddClass(&squaddieclass.ClassReference{ID: "teros class", Name: "Teros class"})
+	for _, level := range (&builder.LevelGenerator{
+		Instructions: &builder.LevelGeneratorInstruction{
+			NumberOfLevels: 10,
+			ClassID:        "teros class",
+			PrefixLevelID:  "teros level ",
+			Type:           levelupbenefit.Small,
+		},
+	}).Build() {
+		suite.teros.MarkLevelUpBenefitAsConsumed("teros class", level.ID())
+	}
+
+	hit := suite.attackResult(suite.teros, suite.bandit, powercommit.NewAttackResultBuilder().HitTarget().Build())
+	checker.Assert(suite.awardStrategy.CalculateExperience(hit, suite.repos), Equals, experience.ExperienceForAttempt)
+}
+
+func (suite *AwardExperienceSuite) TestAwardExperienceGroupsResultsByUser(checker *C) {
+	result := powercommit.NewResult(nil, nil, []*powercommit.ResultPerTarget{
+		suite.attackResult(suite.teros, suite.bandit, powercommit.NewAttackResultBuilder().HitTarget().Build()),
+		suite.attackResult(suite.bandit, suite.teros, powercommit.NewAttackResultBuilder().CounterAttack().Build()),
+		suite.attackResult(suite.teros, suite.bandit, powercommit.NewAttackResultBuilder().HitTarget().Build()),
+	})
+
+	awards := suite.awardStrategy.AwardExperience(result, suite.repos)
+	checker.Assert(awards, HasLen, 2)
+	checker.Assert(awards[0].SquaddieID, Equals, suite.teros.ID())
+	checker.Assert(awards[0].ExperienceGained, Equals, 2*experience.ExperienceForHit)
+	checker.Assert(awards[1].SquaddieID, Equals, suite.bandit.ID())
+	checker.Assert(awards[1].ExperienceGained, Equals, experience.ExperienceForAttempt)
+
+	checker.Assert(suite.teros.ExperiencePoints(), Equals, 2*experience.ExperienceForHit)
+	checker.Assert(suite.bandit.ExperiencePoints(), Equals, experience.ExperienceForAttempt)
+}
+
+func (suite *AwardExperienceSuite) TestDeadUsersDoNotEarnExperience(checker *C) {
+	suite.bandit.ReduceHitPoints(suite.bandit.MaxHitPoints())
+	result := powercommit.NewResult(nil, nil, []*powercommit.ResultPerTarget{
+		suite.attackResult(suite.bandit, suite.teros, powercommit.NewAttackResultBuilder().Build()),
+	})
+
+	awards := suite.awardStrategy.AwardExperience(result, suite.repos)
+	checker.Assert(awards, HasLen, 0)
+	checker.Assert(suite.bandit.ExperiencePoints(), Equals, 0)
+}
+
+func (suite *AwardExperienceSuite) TestAwardQueuesLevelUpWhenCrossingThreshold(checker *C) {
+	suite.teros.GainExperience(squaddie.ExperiencePerLevelUp - 5)
+	result := powercommit.NewResult(nil, nil, []*powercommit.ResultPerTarget{
+		suite.attackResult(suite.teros, suite.bandit, powercommit.NewAttackResultBuilder().HitTarget().Build()),
+	})
+
+	awards := suite.awardStrategy.AwardExperience(result, suite.repos)
+	checker.Assert(awards[0].LevelUpsQueued, Equals, 1)
+	checker.Assert(suite.teros.LevelUpsPending(), Equals, 1)
+	checker.Assert(suite.teros.ExperiencePoints(), Equals, 5)
+}
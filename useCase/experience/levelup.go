@@ -0,0 +1,119 @@
+package experience
+
+import (
+	"fmt"
+	"github.com/chadius/terosgamerules/entity/levelupbenefit"
+	"github.com/chadius/terosgamerules/entity/squaddieinterface"
+	"github.com/chadius/terosgamerules/usecase/levelup"
+	"github.com/chadius/terosgamerules/usecase/repositories"
+	"github.com/chadius/terosgamerules/utility"
+)
+
+// LevelUp records the LevelUpBenefits a squaddie gained when it applied a pending level up.
+type LevelUp struct {
+	SquaddieID      string
+	BenefitsApplied []*levelupbenefit.LevelUpBenefit
+}
+
+// ResolveLevelUpStrategy describes objects that apply level ups to squaddies.
+type ResolveLevelUpStrategy interface {
+	ResolvePendingLevelUp(squaddieToLevelUp squaddieinterface.Interface, bigLevelID string, repos *repositories.RepositoryCollection) (*LevelUp, error)
+	ApplyLevelUp(squaddieToLevelUp squaddieinterface.Interface, bigLevelID string, repos *repositories.RepositoryCollection) (*LevelUp, error)
+}
+
+// ResolveLevelUpInCurrentClass applies level ups using the squaddie's current class.
+//   The zero value selects small levels randomly.
+type ResolveLevelUpInCurrentClass struct {
+	selectStrategy levelup.SelectLevelUpBasedOnSquaddieStrategy
+}
+
+// NewResolveLevelUpInCurrentClass uses selectStrategy to choose which LevelUpBenefits to apply.
+func NewResolveLevelUpInCurrentClass(selectStrategy levelup.SelectLevelUpBasedOnSquaddieStrategy) *ResolveLevelUpInCurrentClass {
+	return &ResolveLevelUpInCurrentClass{
+		selectStrategy: selectStrategy,
+	}
+}
+
+// ResolvePendingLevelUp consumes one pending level up and improves the squaddie.
+//   bigLevelID is used if the squaddie qualifies for a big level.
+//   Raises an error if the squaddie has no pending level ups or no level up benefits could be applied.
+func (r *ResolveLevelUpInCurrentClass) ResolvePendingLevelUp(squaddieToLevelUp squaddieinterface.Interface, bigLevelID string, repos *repositories.RepositoryCollection) (*LevelUp, error) {
+	if squaddieToLevelUp.LevelUpsPending() <= 0 {
+		newError := fmt.Errorf(`squaddie "%s" has no pending level ups`, squaddieToLevelUp.Name())
+		utility.Log(newError.Error(), 0, utility.Error)
+		return nil, newError
+	}
+
+	levelUp, err := r.ApplyLevelUp(squaddieToLevelUp, bigLevelID, repos)
+	if err != nil {
+		return nil, err
+	}
+
+	squaddieToLevelUp.ConsumePendingLevelUp()
+	return levelUp, nil
+}
+
+// ApplyLevelUp improves the squaddie by one level in their current class, whether or not they earned it.
+//   bigLevelID is used if the squaddie qualifies for a big level.
+//   Raises an error if no level up benefits could be applied.
+func (r *ResolveLevelUpInCurrentClass) ApplyLevelUp(squaddieToLevelUp squaddieinterface.Interface, bigLevelID string, repos *repositories.RepositoryCollection) (*LevelUp, error) {
+	if repos.LevelRepo == nil || repos.ClassRepo == nil {
+		newError := fmt.Errorf(`squaddie "%s" cannot level up without level and class repositories`, squaddieToLevelUp.Name())
+		utility.Log(newError.Error(), 0, utility.Error)
+		return nil, newError
+	}
+
+	classID := squaddieToLevelUp.CurrentClassID()
+	levelsConsumedBefore := getLevelsConsumedInClass(squaddieToLevelUp, classID)
+
+	err := r.getSelectStrategy().ImproveSquaddieBasedOnLevel(squaddieToLevelUp, bigLevelID, repos)
+	if err != nil {
+		return nil, err
+	}
+
+	levelsInClass, _ := repos.LevelRepo.GetLevelUpBenefitsByClassID(classID)
+	benefitsApplied := []*levelupbenefit.LevelUpBenefit{}
+	for _, levelID := range getLevelsConsumedInClass(squaddieToLevelUp, classID) {
+		if isLevelIDInList(levelID, levelsConsumedBefore) {
+			continue
+		}
+		benefitsApplied = append(benefitsApplied, levelupbenefit.FilterLevelUpBenefits(levelsInClass, func(benefit *levelupbenefit.LevelUpBenefit) bool {
+			return benefit.ID() == levelID
+		})...)
+	}
+
+	if len(benefitsApplied) == 0 {
+		newError := fmt.Errorf(`squaddie "%s" has no level up benefits left in class "%s"`, squaddieToLevelUp.Name(), classID)
+		utility.Log(newError.Error(), 0, utility.Error)
+		return nil, newError
+	}
+
+	return &LevelUp{
+		SquaddieID:      squaddieToLevelUp.ID(),
+		BenefitsApplied: benefitsApplied,
+	}, nil
+}
+
+func (r *ResolveLevelUpInCurrentClass) getSelectStrategy() levelup.SelectLevelUpBasedOnSquaddieStrategy {
+	if r.selectStrategy == nil {
+		return &levelup.SelectLevelUpBasedOnSquaddieBigLevelsOnEvenLevels{}
+	}
+	return r.selectStrategy
+}
+
+func getLevelsConsumedInClass(squaddieToInspect squaddieinterface.Interface, classID string) []string {
+	progress, classExists := (*squaddieToInspect.ClassLevelsConsumed())[classID]
+	if !classExists {
+		return []string{}
+	}
+	return append([]string{}, progress.GetLevelsConsumed()...)
+}
+
+func isLevelIDInList(levelID string, levelIDs []string) bool {
+	for _, otherLevelID := range levelIDs {
+		if levelID == otherLevelID {
+			return true
+		}
+	}
+	return false
+}
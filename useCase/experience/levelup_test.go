@@ -0,0 +1,101 @@
+package experience_test
+
+import (
+	"github.com/chadius/terosgamerules/entity/levelupbenefit"
+	"github.com/chadius/terosgamerules/entity/squaddie"
+	"github.com/chadius/terosgamerules/entity/squaddieclass"
+	"github.com/chadius/terosgamerules/entity/squaddieinterface"
+	"github.com/chadius/terosgamerules/usecase/experience"
+	"github.com/chadius/terosgamerules/usecase/repositories"
+	. "gopkg.in/check.v1"
+)
+
+type ResolveLevelUpSuite struct {
+	teros squaddieinterface.Interface
+	mage  *squaddieclass.Class
+
+	smallLevel *levelupbenefit.LevelUpBenefit
+	bigLevel   *levelupbenefit.LevelUpBenefit
+
+	repos *repositories.RepositoryCollection
+
+	resolveStrategy *experience.ResolveLevelUpInCurrentClass
+}
+
+var _ = Suite(&ResolveLevelUpSuite{})
+
+func (suite *ResolveLevelUpSuite) SetUpTest(checker *C) {
+	suite.mage = squaddieclass.ClassBuilder().WithID("mage").WithName("Mage").Build()
+	suite.teros = squaddie.NewSquaddieBuilder().Teros().AddClassByReference(suite.mage.GetReference()).Build()
+	suite.teros.SetClass(suite.mage.ID())
+
+	suite.smallLevel, _ = levelupbenefit.NewLevelUpBenefitBuilder().WithID("mage small").WithClassID(suite.mage.ID()).
+		Aim(1).HitPoints(2).Build()
+	suite.bigLevel, _ = levelupbenefit.NewLevelUpBenefitBuilder().WithID("mage big").WithClassID(suite.mage.ID()).BigLevel().
+		GainPower("fireballID", "Fireball").Build()
+
+	squaddieRepo := squaddie.NewSquaddieRepository()
+	squaddieRepo.AddSquaddies([]squaddieinterface.Interface{suite.teros})
+
+	classRepo := squaddieclass.NewRepository()
+	classRepo.AddListOfClasses([]*squaddieclass.Class{suite.mage})
+
+	levelRepo := levelupbenefit.NewLevelUpBenefitRepository()
+	levelRepo.AddLevels([]*levelupbenefit.LevelUpBenefit{suite.smallLevel, suite.bigLevel})
+
+	suite.repos = &repositories.RepositoryCollection{
+		SquaddieRepo: squaddieRepo,
+		LevelRepo:    levelRepo,
+		ClassRepo:    classRepo,
+	}
+
+	suite.resolveStrategy = &experience.ResolveLevelUpInCurrentClass{}
+}
+
+func (suite *ResolveLevelUpSuite) TestRaisesAnErrorWithoutPendingLevelUps(checker *C) {
+	levelUp, err := suite.resolveStrategy.ResolvePendingLevelUp(suite.teros, suite.bigLevel.ID(), suite.repos)
+	checker.Assert(levelUp, IsNil)
+	checker.Assert(err, ErrorMatches, `squaddie "Teros" has no pending level ups`)
+}
+
+func (suite *ResolveLevelUpSuite) TestAppliesChosenBigLevelAndSmallLevel(checker *C) {
+	suite.teros.GainExperience(squaddie.ExperiencePerLevelUp)
+	startingAim := suite.teros.Aim()
+
+	levelUp, err := suite.resolveStrategy.ResolvePendingLevelUp(suite.teros, suite.bigLevel.ID(), suite.repos)
+	checker.Assert(err, IsNil)
+	checker.Assert(levelUp.SquaddieID, Equals, suite.teros.ID())
+	checker.Assert(levelUp.BenefitsApplied, HasLen, 2)
+	checker.Assert(levelUp.BenefitsApplied[0].ID(), Equals, suite.bigLevel.ID())
+	checker.Assert(levelUp.BenefitsApplied[1].ID(), Equals, suite.smallLevel.ID())
+
+	checker.Assert(suite.teros.LevelUpsPending(), Equals, 0)
+	checker.Assert(suite.teros.Aim(), Equals, startingAim+1)
+	checker.Assert(suite.teros.HasPowerWithID("fireballID"), Equals, true)
+}
+
+func (suite *ResolveLevelUpSuite) TestKeepsPendingLevelUpIfNoBenefitsRemain(checker *C) {
+	suite.teros.MarkLevelUpBenefitAsConsumed(suite.mage.ID(), suite.smallLevel.ID())
+	suite.teros.GainExperience(squaddie.ExperiencePerLevelUp)
+
+	levelUp, err := suite.resolveStrategy.ResolvePendingLevelUp(suite.teros, "", suite.repos)
+	checker.Assert(levelUp, IsNil)
+	checker.Assert(err, ErrorMatches, `squaddie "Teros" has no level up benefits left in class "mage"`)
+	checker.Assert(suite.teros.LevelUpsPending(), Equals, 1)
+}
+
+func (suite *ResolveLevelUpSuite) TestRaisesAnErrorWithoutLevelAndClassRepositories(checker *C) {
+	suite.teros.GainExperience(squaddie.ExperiencePerLevelUp)
+	suite.repos.LevelRepo = nil
+
+	_, err := suite.resolveStrategy.ResolvePendingLevelUp(suite.teros, suite.bigLevel.ID(), suite.repos)
+	checker.Assert(err, ErrorMatches, `squaddie "Teros" cannot level up without level and class repositories`)
+	checker.Assert(suite.teros.LevelUpsPending(), Equals, 1)
+}
+
+func (suite *ResolveLevelUpSuite) TestApplyLevelUpDoesNotNeedPendingLevelUps(checker *C) {
+	levelUp, err := suite.resolveStrategy.ApplyLevelUp(suite.teros, suite.bigLevel.ID(), suite.repos)
+	checker.Assert(err, IsNil)
+	checker.Assert(levelUp.BenefitsApplied, HasLen, 2)
+	checker.Assert(suite.teros.LevelUpsPending(), Equals, 0)
+}
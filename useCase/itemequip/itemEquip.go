@@ -0,0 +1,98 @@
+package itemequip
+
+import (
+	"fmt"
+	"github.com/chadius/terosgamerules/entity/item"
+	"github.com/chadius/terosgamerules/entity/squaddieinterface"
+	"github.com/chadius/terosgamerules/usecase/powerequip"
+	"github.com/chadius/terosgamerules/usecase/repositories"
+	"github.com/chadius/terosgamerules/utility"
+)
+
+// Strategy will equip squaddies with items from their inventory.
+type Strategy interface {
+	SquaddieEquipItem(squaddie squaddieinterface.Interface, itemID string, repos *repositories.RepositoryCollection) error
+	SquaddieUnequipItem(squaddie squaddieinterface.Interface, slot string, repos *repositories.RepositoryCollection) error
+}
+
+// CheckRepositories uses the repositories to make sure items and their powers exist.
+type CheckRepositories struct{}
+
+// SquaddieEquipItem makes the squaddie equip an item it carries, replacing the item already in that slot.
+//   The squaddie gains the item's powers. Weapons also equip the first power they grant.
+//   Raises an error if the squaddie does not carry the item or one of its powers does not exist.
+func (c *CheckRepositories) SquaddieEquipItem(squaddie squaddieinterface.Interface, itemID string, repos *repositories.RepositoryCollection) error {
+	if repos.ItemRepo == nil {
+		newError := fmt.Errorf(`squaddie "%s" cannot equip items without an item repository`, squaddie.Name())
+		utility.Log(newError.Error(), 0, utility.Error)
+		return newError
+	}
+
+	itemToEquip, itemErr := repos.ItemRepo.GetItemByID(itemID)
+	if itemErr != nil {
+		return itemErr
+	}
+
+	if squaddie.HasItem(itemID) == false {
+		newError := fmt.Errorf(`squaddie "%s" does not carry item "%s"`, squaddie.Name(), itemToEquip.Name())
+		utility.Log(newError.Error(), 0, utility.Error)
+		return newError
+	}
+
+	for _, reference := range itemToEquip.GetCopyOfGrantedPowerReferences() {
+		if repos.PowerRepo.GetPowerByID(reference.PowerID) == nil {
+			newError := fmt.Errorf(`item "%s" grants power "%s" but it does not exist`, itemToEquip.Name(), reference.Name)
+			utility.Log(newError.Error(), 0, utility.Error)
+			return newError
+		}
+	}
+
+	if squaddie.GetEquippedItemID(itemToEquip.Slot()) != "" {
+		c.SquaddieUnequipItem(squaddie, itemToEquip.Slot(), repos)
+	}
+
+	grantedPowerIDs := []string{}
+	for _, reference := range itemToEquip.GetCopyOfGrantedPowerReferences() {
+		if squaddie.HasPowerWithID(reference.PowerID) {
+			continue
+		}
+		squaddie.AddPowerReference(repos.PowerRepo.GetPowerByID(reference.PowerID).GetReference())
+		grantedPowerIDs = append(grantedPowerIDs, reference.PowerID)
+	}
+	squaddie.EquipItem(itemToEquip.Slot(), itemID, grantedPowerIDs)
+
+	if itemToEquip.Slot() == item.Weapon {
+		equipCheck := powerequip.CheckRepositories{}
+		for _, reference := range itemToEquip.GetCopyOfGrantedPowerReferences() {
+			if equipCheck.SquaddieEquipPower(squaddie, reference.PowerID, repos) {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// SquaddieUnequipItem empties the squaddie's slot and takes away the powers the item granted.
+//   If the squaddie loses its equipped power, it equips its default power instead.
+//   Raises an error if nothing is equipped in the slot.
+func (c *CheckRepositories) SquaddieUnequipItem(squaddie squaddieinterface.Interface, slot string, repos *repositories.RepositoryCollection) error {
+	itemID, grantedPowerIDs := squaddie.UnequipItem(slot)
+	if itemID == "" {
+		newError := fmt.Errorf(`squaddie "%s" has nothing equipped in slot "%s"`, squaddie.Name(), slot)
+		utility.Log(newError.Error(), 0, utility.Error)
+		return newError
+	}
+
+	for _, powerID := range grantedPowerIDs {
+		if squaddie.GetEquippedPowerID() == powerID {
+			squaddie.EquipPower("")
+		}
+		squaddie.RemovePowerReferenceByPowerID(powerID)
+	}
+
+	if squaddie.HasEquippedPower() == false {
+		equipCheck := powerequip.CheckRepositories{}
+		equipCheck.EquipDefaultPower(squaddie, repos)
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+package itemequip_test
+
+import (
+	"github.com/chadius/terosgamerules/entity/item"
+	"github.com/chadius/terosgamerules/entity/power"
+	"github.com/chadius/terosgamerules/entity/powerinterface"
+	"github.com/chadius/terosgamerules/entity/powerreference"
+	"github.com/chadius/terosgamerules/entity/powerrepository"
+	"github.com/chadius/terosgamerules/entity/squaddie"
+	"github.com/chadius/terosgamerules/entity/squaddieinterface"
+	"github.com/chadius/terosgamerules/usecase/itemequip"
+	"github.com/chadius/terosgamerules/usecase/powerequip"
+	"github.com/chadius/terosgamerules/usecase/repositories"
+	. "gopkg.in/check.v1"
+	"testing"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type SquaddieEquipItemSuite struct {
+	teros squaddieinterface.Interface
+
+	blot  powerinterface.Interface
+	spear powerinterface.Interface
+	axe   powerinterface.Interface
+
+	spearItem   *item.Item
+	axeItem     *item.Item
+	leatherItem *item.Item
+
+	repos      *repositories.RepositoryCollection
+	equipCheck itemequip.Strategy
+}
+
+var _ = Suite(&SquaddieEquipItemSuite{})
+
+func (suite *SquaddieEquipItemSuite) SetUpTest(checker *C) {
+	suite.teros = squaddie.NewSquaddieBuilder().Teros().
+		AddItem("itemSpear").AddItem("itemAxe").AddItem("itemLeather").Build()
+
+	suite.blot = power.NewPowerBuilder().Blot().Build()
+	suite.spear = power.NewPowerBuilder().Spear().Build()
+	suite.axe = power.NewPowerBuilder().WithName("axe").WithID("powerAxe").TargetsFoe().CanBeEquipped().DealsDamage(2).Build()
+
+	suite.spearItem = item.NewItem("itemSpear", "Spear", item.Weapon, item.StatModifiers{Aim: 1}, []*powerreference.Reference{suite.spear.GetReference()})
+	suite.axeItem = item.NewItem("itemAxe", "Axe", item.Weapon, item.StatModifiers{}, []*powerreference.Reference{suite.axe.GetReference()})
+	suite.leatherItem = item.NewItem("itemLeather", "Leather Armor", item.Armor, item.StatModifiers{Armor: 1}, nil)
+
+	powerRepo := powerrepository.NewPowerRepository()
+	powerRepo.AddSlicePowerSource([]powerinterface.Interface{suite.blot, suite.spear, suite.axe})
+
+	squaddieRepo := squaddie.NewSquaddieRepository()
+	squaddieRepo.AddSquaddie(suite.teros)
+
+	itemRepo := item.NewRepository()
+	itemRepo.AddListOfItems([]*item.Item{suite.spearItem, suite.axeItem, suite.leatherItem})
+
+	suite.repos = &repositories.RepositoryCollection{
+		SquaddieRepo: squaddieRepo,
+		PowerRepo:    powerRepo,
+		ItemRepo:     itemRepo,
+	}
+
+	powerCheck := powerequip.CheckRepositories{}
+	powerCheck.LoadAllOfSquaddieInnatePowers(suite.teros, []*powerreference.Reference{suite.blot.GetReference()}, suite.repos)
+	powerCheck.EquipDefaultPower(suite.teros, suite.repos)
+
+	suite.equipCheck = &itemequip.CheckRepositories{}
+}
+
+func (suite *SquaddieEquipItemSuite) TestWeaponGrantsAndEquipsItsPower(checker *C) {
+	err := suite.equipCheck.SquaddieEquipItem(suite.teros, suite.spearItem.ID(), suite.repos)
+	checker.Assert(err, IsNil)
+	checker.Assert(suite.teros.GetEquippedItemID(item.Weapon), Equals, suite.spearItem.ID())
+	checker.Assert(suite.teros.HasPowerWithID(suite.spear.ID()), Equals, true)
+	checker.Assert(suite.teros.GetEquippedPowerID(), Equals, suite.spear.ID())
+}
+
+func (suite *SquaddieEquipItemSuite) TestUnequipWeaponRemovesItsPower(checker *C) {
+	suite.equipCheck.SquaddieEquipItem(suite.teros, suite.spearItem.ID(), suite.repos)
+
+	err := suite.equipCheck.SquaddieUnequipItem(suite.teros, item.Weapon, suite.repos)
+	checker.Assert(err, IsNil)
+	checker.Assert(suite.teros.GetEquippedItemID(item.Weapon), Equals, "")
+	checker.Assert(suite.teros.HasPowerWithID(suite.spear.ID()), Equals, false)
+	checker.Assert(suite.teros.GetEquippedPowerID(), Equals, suite.blot.ID())
+	checker.Assert(suite.teros.HasItem(suite.spearItem.ID()), Equals, true)
+}
+
+func (suite *SquaddieEquipItemSuite) TestEquippingReplacesItemInTheSameSlot(checker *C) {
+	suite.equipCheck.SquaddieEquipItem(suite.teros, suite.spearItem.ID(), suite.repos)
+	suite.equipCheck.SquaddieEquipItem(suite.teros, suite.axeItem.ID(), suite.repos)
+
+	checker.Assert(suite.teros.GetEquippedItemID(item.Weapon), Equals, suite.axeItem.ID())
+	checker.Assert(suite.teros.HasPowerWithID(suite.spear.ID()), Equals, false)
+	checker.Assert(suite.teros.GetEquippedPowerID(), Equals, suite.axe.ID())
+}
+
+func (suite *SquaddieEquipItemSuite) TestArmorDoesNotChangeEquippedPower(checker *C) {
+	suite.equipCheck.SquaddieEquipItem(suite.teros, suite.leatherItem.ID(), suite.repos)
+
+	checker.Assert(suite.teros.GetEquippedItemID(item.Armor), Equals, suite.leatherItem.ID())
+	checker.Assert(suite.teros.GetEquippedPowerID(), Equals, suite.blot.ID())
+}
+
+func (suite *SquaddieEquipItemSuite) TestInnatePowersAreKeptAfterUnequipping(checker *C) {
+	blotWand := item.NewItem("itemWand", "Wand", item.Weapon, item.StatModifiers{}, []*powerreference.Reference{suite.blot.GetReference()})
+	suite.repos.ItemRepo.AddListOfItems([]*item.Item{blotWand})
+	suite.teros.AddItem(blotWand.ID())
+
+	suite.equipCheck.SquaddieEquipItem(suite.teros, blotWand.ID(), suite.repos)
+	suite.equipCheck.SquaddieUnequipItem(suite.teros, item.Weapon, suite.repos)
+
+	checker.Assert(suite.teros.HasPowerWithID(suite.blot.ID()), Equals, true)
+}
+
+func (suite *SquaddieEquipItemSuite) TestCannotEquipItemsTheSquaddieDoesNotCarry(checker *C) {
+	suite.teros.RemoveItem(suite.spearItem.ID())
+
+	err := suite.equipCheck.SquaddieEquipItem(suite.teros, suite.spearItem.ID(), suite.repos)
+	checker.Assert(err, ErrorMatches, `squaddie "Teros" does not carry item "Spear"`)
+	checker.Assert(suite.teros.GetEquippedItemID(item.Weapon), Equals, "")
+}
+
+func (suite *SquaddieEquipItemSuite) TestCannotEquipItemsWithMissingPowers(checker *C) {
+	brokenItem := item.NewItem("itemBroken", "Broken Sword", item.Weapon, item.StatModifiers{}, []*powerreference.Reference{{Name: "Slash", PowerID: "powerMissing"}})
+	suite.repos.ItemRepo.AddListOfItems([]*item.Item{brokenItem})
+	suite.teros.AddItem(brokenItem.ID())
+
+	err := suite.equipCheck.SquaddieEquipItem(suite.teros, brokenItem.ID(), suite.repos)
+	checker.Assert(err, ErrorMatches, `item "Broken Sword" grants power "Slash" but it does not exist`)
+}
+
+func (suite *SquaddieEquipItemSuite) TestCannotUnequipEmptySlot(checker *C) {
+	err := suite.equipCheck.SquaddieUnequipItem(suite.teros, item.Accessory, suite.repos)
+	checker.Assert(err, ErrorMatches, `squaddie "Teros" has nothing equipped in slot "accessory"`)
+}
@@ -0,0 +1,78 @@
+package itemuse
+
+import (
+	"fmt"
+	"github.com/chadius/terosgamerules/entity/item"
+	"github.com/chadius/terosgamerules/entity/squaddieinterface"
+	"github.com/chadius/terosgamerules/usecase/repositories"
+	"github.com/chadius/terosgamerules/utility"
+)
+
+// Strategy lets squaddies use up consumable items.
+type Strategy interface {
+	GetConsumablePowerID(squaddie squaddieinterface.Interface, itemID string, repos *repositories.RepositoryCollection) (string, error)
+	ConsumeItem(squaddie squaddieinterface.Interface, itemID string, repos *repositories.RepositoryCollection) error
+}
+
+// CheckInventories looks in the squaddie's inventory first, then the inventory of its team.
+type CheckInventories struct{}
+
+// GetConsumablePowerID returns the power the squaddie uses when it consumes the item.
+//   Raises an error if the item cannot be consumed, its power does not exist,
+//   or neither the squaddie nor its team has any left.
+func (c *CheckInventories) GetConsumablePowerID(squaddie squaddieinterface.Interface, itemID string, repos *repositories.RepositoryCollection) (string, error) {
+	if repos.ItemRepo == nil {
+		newError := fmt.Errorf(`squaddie "%s" cannot use items without an item repository`, squaddie.Name())
+		utility.Log(newError.Error(), 0, utility.Error)
+		return "", newError
+	}
+
+	itemToUse, itemErr := repos.ItemRepo.GetItemByID(itemID)
+	if itemErr != nil {
+		return "", itemErr
+	}
+
+	if itemToUse.IsConsumable() == false {
+		newError := fmt.Errorf(`item "%s" cannot be used`, itemToUse.Name())
+		utility.Log(newError.Error(), 0, utility.Error)
+		return "", newError
+	}
+
+	if repos.PowerRepo.GetPowerByID(itemToUse.UsePowerID()) == nil {
+		newError := fmt.Errorf(`item "%s" uses power "%s" but it does not exist`, itemToUse.Name(), itemToUse.UsePowerID())
+		utility.Log(newError.Error(), 0, utility.Error)
+		return "", newError
+	}
+
+	if squaddie.HasItem(itemID) == false && c.teamItemCount(squaddie, itemToUse, repos) <= 0 {
+		newError := fmt.Errorf(`squaddie "%s" has no "%s" left`, squaddie.Name(), itemToUse.Name())
+		utility.Log(newError.Error(), 0, utility.Error)
+		return "", newError
+	}
+
+	return itemToUse.UsePowerID(), nil
+}
+
+// ConsumeItem uses up one copy of the item, taking it from the squaddie before its team.
+//   The team is the squaddie's own affiliation, even while it is charmed.
+//   Raises an error if neither the squaddie nor its team has any left.
+func (c *CheckInventories) ConsumeItem(squaddie squaddieinterface.Interface, itemID string, repos *repositories.RepositoryCollection) error {
+	if squaddie.RemoveItem(itemID) {
+		return nil
+	}
+
+	if repos.TeamInventory != nil && repos.TeamInventory.RemoveItem(squaddie.BaseAffiliationLogic().Name(), itemID) {
+		return nil
+	}
+
+	newError := fmt.Errorf(`squaddie "%s" has no "%s" left`, squaddie.Name(), itemID)
+	utility.Log(newError.Error(), 0, utility.Error)
+	return newError
+}
+
+func (c *CheckInventories) teamItemCount(squaddie squaddieinterface.Interface, itemToUse *item.Item, repos *repositories.RepositoryCollection) int {
+	if repos.TeamInventory == nil {
+		return 0
+	}
+	return repos.TeamInventory.CountItem(squaddie.BaseAffiliationLogic().Name(), itemToUse.ID())
+}
@@ -0,0 +1,127 @@
+package itemuse_test
+
+import (
+	"github.com/chadius/terosgamerules/entity/affiliation"
+	"github.com/chadius/terosgamerules/entity/item"
+	"github.com/chadius/terosgamerules/entity/power"
+	"github.com/chadius/terosgamerules/entity/powerinterface"
+	"github.com/chadius/terosgamerules/entity/powerreference"
+	"github.com/chadius/terosgamerules/entity/powerrepository"
+	"github.com/chadius/terosgamerules/entity/squaddie"
+	"github.com/chadius/terosgamerules/entity/squaddieinterface"
+	"github.com/chadius/terosgamerules/usecase/itemuse"
+	"github.com/chadius/terosgamerules/usecase/repositories"
+	. "gopkg.in/check.v1"
+	"testing"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type SquaddieUsesItemSuite struct {
+	teros squaddieinterface.Interface
+
+	potionPower powerinterface.Interface
+	potion      *item.Item
+	leather     *item.Item
+
+	repos    *repositories.RepositoryCollection
+	useCheck itemuse.Strategy
+}
+
+var _ = Suite(&SquaddieUsesItemSuite{})
+
+func (suite *SquaddieUsesItemSuite) SetUpTest(checker *C) {
+	suite.teros = squaddie.NewSquaddieBuilder().Teros().AddItem("itemPotion").AddItem("itemLeather").Build()
+
+	suite.potionPower = power.NewPowerBuilder().HealingStaff().WithName("Drink Potion").WithID("powerPotion").Build()
+	suite.potion = item.NewConsumableItem("itemPotion", "Potion", suite.potionPower.GetReference())
+	suite.leather = item.NewItem("itemLeather", "Leather Armor", item.Armor, item.StatModifiers{Armor: 1}, nil)
+
+	powerRepo := powerrepository.NewPowerRepository()
+	powerRepo.AddSlicePowerSource([]powerinterface.Interface{suite.potionPower})
+
+	squaddieRepo := squaddie.NewSquaddieRepository()
+	squaddieRepo.AddSquaddie(suite.teros)
+
+	itemRepo := item.NewRepository()
+	itemRepo.AddListOfItems([]*item.Item{suite.potion, suite.leather})
+
+	suite.repos = &repositories.RepositoryCollection{
+		SquaddieRepo:  squaddieRepo,
+		PowerRepo:     powerRepo,
+		ItemRepo:      itemRepo,
+		TeamInventory: item.NewTeamInventory(),
+	}
+
+	suite.useCheck = &itemuse.CheckInventories{}
+}
+
+func (suite *SquaddieUsesItemSuite) TestConsumableItemsUseTheirPower(checker *C) {
+	powerID, err := suite.useCheck.GetConsumablePowerID(suite.teros, suite.potion.ID(), suite.repos)
+	checker.Assert(err, IsNil)
+	checker.Assert(powerID, Equals, suite.potionPower.ID())
+}
+
+func (suite *SquaddieUsesItemSuite) TestConsumeItemFromSquaddieBeforeTeam(checker *C) {
+	suite.repos.TeamInventory.AddItem("player", suite.potion.ID(), 1)
+
+	err := suite.useCheck.ConsumeItem(suite.teros, suite.potion.ID(), suite.repos)
+	checker.Assert(err, IsNil)
+	checker.Assert(suite.teros.HasItem(suite.potion.ID()), Equals, false)
+	checker.Assert(suite.repos.TeamInventory.CountItem("player", suite.potion.ID()), Equals, 1)
+
+	err = suite.useCheck.ConsumeItem(suite.teros, suite.potion.ID(), suite.repos)
+	checker.Assert(err, IsNil)
+	checker.Assert(suite.repos.TeamInventory.CountItem("player", suite.potion.ID()), Equals, 0)
+}
+
+func (suite *SquaddieUsesItemSuite) TestSquaddieCanUseTeamItems(checker *C) {
+	suite.teros.RemoveItem(suite.potion.ID())
+	suite.repos.TeamInventory.AddItem("player", suite.potion.ID(), 1)
+
+	powerID, err := suite.useCheck.GetConsumablePowerID(suite.teros, suite.potion.ID(), suite.repos)
+	checker.Assert(err, IsNil)
+	checker.Assert(powerID, Equals, suite.potionPower.ID())
+}
+
+func (suite *SquaddieUsesItemSuite) TestCharmedSquaddiesUseTheirOwnTeamItems(checker *C) {
+	enemyAffiliation, _ := affiliation.NewAffiliationLogic("enemy")
+	suite.teros.OverrideAffiliation(enemyAffiliation, 1)
+	suite.teros.RemoveItem(suite.potion.ID())
+	suite.repos.TeamInventory.AddItem("player", suite.potion.ID(), 1)
+	suite.repos.TeamInventory.AddItem("enemy", suite.potion.ID(), 1)
+
+	powerID, err := suite.useCheck.GetConsumablePowerID(suite.teros, suite.potion.ID(), suite.repos)
+	checker.Assert(err, IsNil)
+	checker.Assert(powerID, Equals, suite.potionPower.ID())
+
+	err = suite.useCheck.ConsumeItem(suite.teros, suite.potion.ID(), suite.repos)
+	checker.Assert(err, IsNil)
+	checker.Assert(suite.repos.TeamInventory.CountItem("player", suite.potion.ID()), Equals, 0)
+	checker.Assert(suite.repos.TeamInventory.CountItem("enemy", suite.potion.ID()), Equals, 1)
+}
+
+func (suite *SquaddieUsesItemSuite) TestCannotUseItemsWhenNoneAreLeft(checker *C) {
+	suite.teros.RemoveItem(suite.potion.ID())
+	suite.repos.TeamInventory.AddItem("enemy", suite.potion.ID(), 1)
+
+	_, err := suite.useCheck.GetConsumablePowerID(suite.teros, suite.potion.ID(), suite.repos)
+	checker.Assert(err, ErrorMatches, `squaddie "Teros" has no "Potion" left`)
+
+	consumeErr := suite.useCheck.ConsumeItem(suite.teros, suite.potion.ID(), suite.repos)
+	checker.Assert(consumeErr, ErrorMatches, `squaddie "Teros" has no "itemPotion" left`)
+}
+
+func (suite *SquaddieUsesItemSuite) TestCannotUseEquipment(checker *C) {
+	_, err := suite.useCheck.GetConsumablePowerID(suite.teros, suite.leather.ID(), suite.repos)
+	checker.Assert(err, ErrorMatches, `item "Leather Armor" cannot be used`)
+}
+
+func (suite *SquaddieUsesItemSuite) TestCannotUseItemsWithMissingPowers(checker *C) {
+	bomb := item.NewConsumableItem("itemBomb", "Bomb", &powerreference.Reference{Name: "Explode", PowerID: "powerMissing"})
+	suite.repos.ItemRepo.AddListOfItems([]*item.Item{bomb})
+	suite.teros.AddItem(bomb.ID())
+
+	_, err := suite.useCheck.GetConsumablePowerID(suite.teros, bomb.ID(), suite.repos)
+	checker.Assert(err, ErrorMatches, `item "Bomb" uses power "powerMissing" but it does not exist`)
+}
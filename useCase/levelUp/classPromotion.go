@@ -0,0 +1,72 @@
+package levelup
+
+import (
+	"github.com/chadius/terosgamerules/entity/squaddieclass"
+	"github.com/chadius/terosgamerules/entity/squaddieinterface"
+	"github.com/chadius/terosgamerules/usecase/repositories"
+)
+
+// PromotionChecker uses the class tree in the PromotionRepo to decide if a squaddie can switch classes.
+//   Classes outside the class tree follow the LevelsConsumedChecker rules.
+type PromotionChecker struct{}
+
+// GetAvailablePromotions lists the promotions the squaddie qualifies for.
+func (p *PromotionChecker) GetAvailablePromotions(squaddieToTest squaddieinterface.Interface, repositories *repositories.RepositoryCollection) []*squaddieclass.Promotion {
+	availablePromotions := []*squaddieclass.Promotion{}
+	if repositories.PromotionRepo == nil {
+		return availablePromotions
+	}
+
+	for _, class := range repositories.ClassRepo.GetAllClasses() {
+		if squaddieToTest.HasAddedClass(class.ID()) == false {
+			continue
+		}
+		for _, promotion := range repositories.PromotionRepo.GetPromotionsFromClass(class.ID()) {
+			if p.squaddieQualifiesForPromotion(squaddieToTest, promotion, repositories) {
+				availablePromotions = append(availablePromotions, promotion)
+			}
+		}
+	}
+	return availablePromotions
+}
+
+// SquaddieCanSwitchToClass returns true if the squaddie can use the class with the given id.
+//   A squaddie can only add a class in the class tree by qualifying for a promotion to it.
+func (p *PromotionChecker) SquaddieCanSwitchToClass(squaddieToTest squaddieinterface.Interface, testingClassID string, repositories *repositories.RepositoryCollection) bool {
+	levelsConsumedChecker := LevelsConsumedChecker{}
+	if repositories.PromotionRepo == nil ||
+		squaddieToTest.HasAddedClass(testingClassID) ||
+		len(repositories.PromotionRepo.GetPromotionsToClass(testingClassID)) == 0 {
+		return levelsConsumedChecker.SquaddieCanSwitchToClass(squaddieToTest, testingClassID, repositories)
+	}
+
+	for _, promotion := range p.GetAvailablePromotions(squaddieToTest, repositories) {
+		if promotion.ToClassID == testingClassID {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *PromotionChecker) squaddieQualifiesForPromotion(squaddieToTest squaddieinterface.Interface, promotion *squaddieclass.Promotion, repositories *repositories.RepositoryCollection) bool {
+	if squaddieToTest.HasAddedClass(promotion.ToClassID) {
+		return false
+	}
+
+	if countLevelsInClassTaken(squaddieToTest, promotion.FromClassID) < promotion.MinimumLevelsInClass {
+		return false
+	}
+
+	for _, bigLevelID := range promotion.BigLevelsRequired {
+		if squaddieToTest.IsClassLevelAlreadyUsed(bigLevelID) == false {
+			return false
+		}
+	}
+
+	for _, otherPromotion := range repositories.PromotionRepo.GetPromotionsFromClass(promotion.FromClassID) {
+		if promotion.IsExclusiveWith(otherPromotion) && squaddieToTest.HasAddedClass(otherPromotion.ToClassID) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,131 @@
+package levelup_test
+
+import (
+	"github.com/chadius/terosgamerules/entity/levelupbenefit"
+	"github.com/chadius/terosgamerules/entity/squaddie"
+	"github.com/chadius/terosgamerules/entity/squaddieclass"
+	"github.com/chadius/terosgamerules/entity/squaddieinterface"
+	"github.com/chadius/terosgamerules/usecase/levelup"
+	"github.com/chadius/terosgamerules/usecase/repositories"
+	"github.com/chadius/terosgamerules/utility/testutility/builder"
+	. "gopkg.in/check.v1"
+)
+
+type ClassPromotionSuite struct {
+	squireClass  *squaddieclass.Class
+	knightClass  *squaddieclass.Class
+	rangerClass  *squaddieclass.Class
+	wizardClass  *squaddieclass.Class
+	squireLevels []*levelupbenefit.LevelUpBenefit
+	squireBig    *levelupbenefit.LevelUpBenefit
+
+	repos                   *repositories.RepositoryCollection
+	teros                   squaddieinterface.Interface
+	improveSquaddieStrategy levelup.ImproveSquaddieStrategy
+	promotionChecker        *levelup.PromotionChecker
+}
+
+var _ = Suite(&ClassPromotionSuite{})
+
+func (suite *ClassPromotionSuite) SetUpTest(checker *C) {
+	suite.squireClass = squaddieclass.ClassBuilder().WithID("squire").WithName("Squire").Build()
+	suite.knightClass = squaddieclass.ClassBuilder().WithID("knight").WithName("Knight").RequiresBaseClass().Build()
+	suite.rangerClass = squaddieclass.ClassBuilder().WithID("ranger").WithName("Ranger").RequiresBaseClass().Build()
+	suite.wizardClass = squaddieclass.ClassBuilder().WithID("wizard").WithName("Wizard").RequiresBaseClass().Build()
+
+	classRepo := squaddieclass.NewRepository()
+	classRepo.AddListOfClasses([]*squaddieclass.Class{suite.squireClass, suite.knightClass, suite.rangerClass, suite.wizardClass})
+
+	suite.squireLevels = (&builder.LevelGenerator{
+		Instructions: &builder.LevelGeneratorInstruction{
+			NumberOfLevels: 4,
+			ClassID:        suite.squireClass.ID(),
+			PrefixLevelID:  "squireLevel",
+			Type:           levelupbenefit.Small,
+		},
+	}).Build()
+	suite.squireBig, _ = levelupbenefit.NewLevelUpBenefitBuilder().LevelID("squireMastery").ClassID(suite.squireClass.ID()).BigLevel().Build()
+
+	levelRepo := levelupbenefit.NewLevelUpBenefitRepository()
+	levelRepo.AddLevels(suite.squireLevels)
+	levelRepo.AddLevels([]*levelupbenefit.LevelUpBenefit{suite.squireBig})
+	levelRepo.AddLevels((&builder.LevelGenerator{
+		Instructions: &builder.LevelGeneratorInstruction{
+			NumberOfLevels: 2,
+			ClassID:        suite.wizardClass.ID(),
+			PrefixLevelID:  "wizardLevel",
+			Type:           levelupbenefit.Small,
+		},
+	}).Build())
+
+	promotionRepo := squaddieclass.NewPromotionRepository()
+	promotionRepo.AddPromotions([]*squaddieclass.Promotion{
+		{
+			FromClassID:          suite.squireClass.ID(),
+			ToClassID:            suite.knightClass.ID(),
+			MinimumLevelsInClass: 2,
+			BigLevelsRequired:    []string{suite.squireBig.ID()},
+			ExclusiveGroup:       "squireCareer",
+		},
+		{
+			FromClassID:          suite.squireClass.ID(),
+			ToClassID:            suite.rangerClass.ID(),
+			MinimumLevelsInClass: 2,
+			ExclusiveGroup:       "squireCareer",
+		},
+	})
+
+	suite.repos = &repositories.RepositoryCollection{
+		LevelRepo:     levelRepo,
+		ClassRepo:     classRepo,
+		PromotionRepo: promotionRepo,
+	}
+
+	suite.teros = squaddie.NewSquaddieBuilder().Teros().AddClassByReference(suite.squireClass.GetReference()).Build()
+	suite.teros.SetClass(suite.squireClass.ID())
+	suite.improveSquaddieStrategy = &levelup.ImproveSquaddieClass{}
+	suite.promotionChecker = &levelup.PromotionChecker{}
+}
+
+func (suite *ClassPromotionSuite) TestNoPromotionsUntilRequirementsAreMet(checker *C) {
+	checker.Assert(suite.promotionChecker.GetAvailablePromotions(suite.teros, suite.repos), HasLen, 0)
+	checker.Assert(suite.promotionChecker.SquaddieCanSwitchToClass(suite.teros, suite.rangerClass.ID(), suite.repos), Equals, false)
+}
+
+func (suite *ClassPromotionSuite) TestListsPromotionsWhenRequirementsAreMet(checker *C) {
+	suite.improveSquaddieStrategy.ImproveSquaddie(suite.squireLevels[0], suite.teros)
+	suite.improveSquaddieStrategy.ImproveSquaddie(suite.squireLevels[1], suite.teros)
+
+	promotions := suite.promotionChecker.GetAvailablePromotions(suite.teros, suite.repos)
+	checker.Assert(promotions, HasLen, 1)
+	checker.Assert(promotions[0].ToClassID, Equals, suite.rangerClass.ID())
+
+	suite.improveSquaddieStrategy.ImproveSquaddie(suite.squireBig, suite.teros)
+	promotions = suite.promotionChecker.GetAvailablePromotions(suite.teros, suite.repos)
+	checker.Assert(promotions, HasLen, 2)
+	checker.Assert(promotions[0].ToClassID, Equals, suite.knightClass.ID())
+	checker.Assert(promotions[1].ToClassID, Equals, suite.rangerClass.ID())
+	checker.Assert(suite.promotionChecker.SquaddieCanSwitchToClass(suite.teros, suite.knightClass.ID(), suite.repos), Equals, true)
+}
+
+func (suite *ClassPromotionSuite) TestExclusivePromotionsBlockEachOther(checker *C) {
+	suite.improveSquaddieStrategy.ImproveSquaddie(suite.squireLevels[0], suite.teros)
+	suite.improveSquaddieStrategy.ImproveSquaddie(suite.squireLevels[1], suite.teros)
+	suite.improveSquaddieStrategy.ImproveSquaddie(suite.squireBig, suite.teros)
+
+	suite.teros.AddClass(suite.rangerClass.GetReference())
+	suite.teros.SetClass(suite.rangerClass.ID())
+
+	checker.Assert(suite.promotionChecker.GetAvailablePromotions(suite.teros, suite.repos), HasLen, 0)
+	checker.Assert(suite.promotionChecker.SquaddieCanSwitchToClass(suite.teros, suite.knightClass.ID(), suite.repos), Equals, false)
+}
+
+func (suite *ClassPromotionSuite) TestClassesOutsideTheTreeUseLevelsConsumed(checker *C) {
+	checker.Assert(suite.promotionChecker.SquaddieCanSwitchToClass(suite.teros, suite.wizardClass.ID(), suite.repos), Equals, false)
+
+	for _, level := range suite.squireLevels {
+		suite.improveSquaddieStrategy.ImproveSquaddie(level, suite.teros)
+	}
+	suite.improveSquaddieStrategy.ImproveSquaddie(suite.squireBig, suite.teros)
+	checker.Assert(suite.promotionChecker.SquaddieCanSwitchToClass(suite.teros, suite.wizardClass.ID(), suite.repos), Equals, true)
+}
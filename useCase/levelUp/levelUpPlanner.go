@@ -0,0 +1,266 @@
+package levelup
+
+import (
+	"fmt"
+	"github.com/chadius/terosgamerules/entity/levelupbenefit"
+	"github.com/chadius/terosgamerules/entity/powerreference"
+	"github.com/chadius/terosgamerules/entity/squaddie"
+	"github.com/chadius/terosgamerules/entity/squaddieinterface"
+	"github.com/chadius/terosgamerules/usecase/repositories"
+	"github.com/chadius/terosgamerules/utility"
+)
+
+// PlannedLevelUp is one step of a level up plan.
+//   If ClassID is set, the squaddie switches to that class first.
+//   If LevelUpBenefitID is set, the squaddie then consumes that LevelUpBenefit from its current class.
+type PlannedLevelUp struct {
+	ClassID          string
+	LevelUpBenefitID string
+}
+
+// PlannedLevelUpResult shows what happened during a step and which options the squaddie has afterwards.
+type PlannedLevelUpResult struct {
+	ClassID            string
+	BenefitsApplied    []*levelupbenefit.LevelUpBenefit
+	AvailableBigLevels []*levelupbenefit.LevelUpBenefit
+	AvailableClassIDs  []string
+}
+
+// StatChanges is the difference between two squaddies' stats.
+type StatChanges struct {
+	MaxHitPoints     int
+	Dodge            int
+	Deflect          int
+	MaxBarrier       int
+	Armor            int
+	Aim              int
+	Strength         int
+	Mind             int
+	MovementDistance int
+}
+
+// LevelUpPlan describes a squaddie after following a plan.
+//   PlannedSquaddie is a clone, so the original squaddie is not changed.
+type LevelUpPlan struct {
+	PlannedSquaddie squaddieinterface.Interface
+	Steps           []*PlannedLevelUpResult
+	StatChanges     StatChanges
+	PowersGained    []*powerreference.Reference
+	PowersLost      []*powerreference.Reference
+}
+
+// PlannerStrategy describes objects that preview level ups without changing the squaddie.
+type PlannerStrategy interface {
+	PreviewNextLevel(squaddieToPlan squaddieinterface.Interface, bigLevelID string, repos *repositories.RepositoryCollection) (*LevelUpPlan, error)
+	PlanLevelUps(squaddieToPlan squaddieinterface.Interface, steps []*PlannedLevelUp, repos *repositories.RepositoryCollection) (*LevelUpPlan, error)
+}
+
+// PlanOnClone applies level ups to a clone of the squaddie and compares it to the original.
+type PlanOnClone struct {
+	randomGenerator utility.IntGenerator
+}
+
+// NewPlanOnClone uses the randomGenerator to pick small levels when previewing.
+//   Use a seeded generator to preview the same small levels the squaddie will get.
+func NewPlanOnClone(randomGenerator utility.IntGenerator) *PlanOnClone {
+	return &PlanOnClone{
+		randomGenerator: randomGenerator,
+	}
+}
+
+// PreviewNextLevel shows the squaddie after the next level up in its current class.
+//   The squaddie chooses bigLevelID if it qualifies for a big level.
+//   Raises an error if the level or class repositories are missing.
+func (p *PlanOnClone) PreviewNextLevel(squaddieToPlan squaddieinterface.Interface, bigLevelID string, repos *repositories.RepositoryCollection) (*LevelUpPlan, error) {
+	err := checkPlanningRepositories(squaddieToPlan, repos)
+	if err != nil {
+		return nil, err
+	}
+
+	plannedSquaddie := cloneSquaddieForPlanning(squaddieToPlan)
+	levelsConsumedBefore := len(getLevelsConsumedInCurrentClass(plannedSquaddie))
+
+	selectStrategy := NewSelectLevelUpBasedOnSquaddieBigLevelsOnEvenLevels(p.randomGenerator)
+	err = selectStrategy.ImproveSquaddieBasedOnLevel(plannedSquaddie, bigLevelID, repos)
+	if err != nil {
+		return nil, err
+	}
+
+	levelsInClass, _ := repos.LevelRepo.GetLevelUpBenefitsByClassID(plannedSquaddie.CurrentClassID())
+	benefitsApplied := []*levelupbenefit.LevelUpBenefit{}
+	levelsConsumed := getLevelsConsumedInCurrentClass(plannedSquaddie)
+	for _, levelID := range levelsConsumed[levelsConsumedBefore:] {
+		benefitsApplied = append(benefitsApplied, levelupbenefit.FilterLevelUpBenefits(levelsInClass, func(benefit *levelupbenefit.LevelUpBenefit) bool {
+			return benefit.ID() == levelID
+		})...)
+	}
+
+	plan := newLevelUpPlan(squaddieToPlan, plannedSquaddie)
+	plan.Steps = append(plan.Steps, p.describeStep(plannedSquaddie, benefitsApplied, repos))
+	return plan, nil
+}
+
+// PlanLevelUps follows the steps on a clone of the squaddie.
+//   Raises an error if the squaddie cannot switch to a class or use a LevelUpBenefit,
+//   or if the level or class repositories are missing.
+func (p *PlanOnClone) PlanLevelUps(squaddieToPlan squaddieinterface.Interface, steps []*PlannedLevelUp, repos *repositories.RepositoryCollection) (*LevelUpPlan, error) {
+	err := checkPlanningRepositories(squaddieToPlan, repos)
+	if err != nil {
+		return nil, err
+	}
+
+	plannedSquaddie := cloneSquaddieForPlanning(squaddieToPlan)
+	stepResults := []*PlannedLevelUpResult{}
+
+	for _, step := range steps {
+		if step.ClassID != "" {
+			err := p.switchClass(plannedSquaddie, step.ClassID, repos)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		benefitsApplied := []*levelupbenefit.LevelUpBenefit{}
+		if step.LevelUpBenefitID != "" {
+			benefit, err := p.applyLevelUpBenefit(plannedSquaddie, step.LevelUpBenefitID, repos)
+			if err != nil {
+				return nil, err
+			}
+			benefitsApplied = append(benefitsApplied, benefit)
+		}
+
+		stepResults = append(stepResults, p.describeStep(plannedSquaddie, benefitsApplied, repos))
+	}
+
+	plan := newLevelUpPlan(squaddieToPlan, plannedSquaddie)
+	plan.Steps = stepResults
+	return plan, nil
+}
+
+func (p *PlanOnClone) switchClass(plannedSquaddie squaddieinterface.Interface, classID string, repos *repositories.RepositoryCollection) error {
+	classToUse, err := repos.ClassRepo.GetClassByID(classID)
+	if err != nil {
+		return err
+	}
+
+	classChecker := PromotionChecker{}
+	if classChecker.SquaddieCanSwitchToClass(plannedSquaddie, classID, repos) == false {
+		newError := fmt.Errorf(`squaddie "%s" cannot switch to class "%s"`, plannedSquaddie.Name(), classToUse.Name())
+		utility.Log(newError.Error(), 0, utility.Error)
+		return newError
+	}
+
+	plannedSquaddie.AddClass(classToUse.GetReference())
+	return plannedSquaddie.SetClass(classID)
+}
+
+func (p *PlanOnClone) applyLevelUpBenefit(plannedSquaddie squaddieinterface.Interface, levelUpBenefitID string, repos *repositories.RepositoryCollection) (*levelupbenefit.LevelUpBenefit, error) {
+	levelsInClass, err := repos.LevelRepo.GetLevelUpBenefitsByClassID(plannedSquaddie.CurrentClassID())
+	if err != nil {
+		return nil, err
+	}
+
+	benefits := levelupbenefit.FilterLevelUpBenefits(levelsInClass, func(benefit *levelupbenefit.LevelUpBenefit) bool {
+		return benefit.ID() == levelUpBenefitID
+	})
+	if len(benefits) == 0 {
+		newError := fmt.Errorf(`squaddie "%s" cannot find LevelUpBenefit "%s" in class "%s"`, plannedSquaddie.Name(), levelUpBenefitID, plannedSquaddie.CurrentClassID())
+		utility.Log(newError.Error(), 0, utility.Error)
+		return nil, newError
+	}
+
+	improveStrategy := ImproveSquaddieClass{}
+	err = improveStrategy.ImproveSquaddie(benefits[0], plannedSquaddie)
+	if err != nil {
+		return nil, err
+	}
+	return benefits[0], nil
+}
+
+func (p *PlanOnClone) describeStep(plannedSquaddie squaddieinterface.Interface, benefitsApplied []*levelupbenefit.LevelUpBenefit, repos *repositories.RepositoryCollection) *PlannedLevelUpResult {
+	return &PlannedLevelUpResult{
+		ClassID:            plannedSquaddie.CurrentClassID(),
+		BenefitsApplied:    benefitsApplied,
+		AvailableBigLevels: p.getAvailableBigLevels(plannedSquaddie, repos),
+		AvailableClassIDs:  p.getAvailableClassIDs(plannedSquaddie, repos),
+	}
+}
+
+func (p *PlanOnClone) getAvailableBigLevels(plannedSquaddie squaddieinterface.Interface, repos *repositories.RepositoryCollection) []*levelupbenefit.LevelUpBenefit {
+	levelsFromClass, err := repos.LevelRepo.GetLevelUpBenefitsForClassByType(plannedSquaddie.CurrentClassID())
+	if err != nil {
+		return []*levelupbenefit.LevelUpBenefit{}
+	}
+
+	selectStrategy := NewSelectLevelUpBasedOnSquaddieBigLevelsOnEvenLevels(p.randomGenerator)
+	squaddieLevels := selectStrategy.GetSquaddieClassLevels(plannedSquaddie, repos)
+	if squaddieLevels[plannedSquaddie.CurrentClassID()]%2 != 0 {
+		return []*levelupbenefit.LevelUpBenefit{}
+	}
+
+	return levelupbenefit.FilterLevelUpBenefits(levelsFromClass[levelupbenefit.Big], func(benefit *levelupbenefit.LevelUpBenefit) bool {
+		return plannedSquaddie.IsClassLevelAlreadyUsed(benefit.ID()) == false
+	})
+}
+
+func (p *PlanOnClone) getAvailableClassIDs(plannedSquaddie squaddieinterface.Interface, repos *repositories.RepositoryCollection) []string {
+	classChecker := PromotionChecker{}
+	availableClassIDs := []string{}
+	for _, class := range repos.ClassRepo.GetAllClasses() {
+		if classChecker.SquaddieCanSwitchToClass(plannedSquaddie, class.ID(), repos) {
+			availableClassIDs = append(availableClassIDs, class.ID())
+		}
+	}
+	return availableClassIDs
+}
+
+func checkPlanningRepositories(squaddieToPlan squaddieinterface.Interface, repos *repositories.RepositoryCollection) error {
+	if repos.LevelRepo == nil || repos.ClassRepo == nil {
+		newError := fmt.Errorf(`squaddie "%s" cannot plan level ups without level and class repositories`, squaddieToPlan.Name())
+		utility.Log(newError.Error(), 0, utility.Error)
+		return newError
+	}
+	return nil
+}
+
+func cloneSquaddieForPlanning(squaddieToPlan squaddieinterface.Interface) squaddieinterface.Interface {
+	return squaddie.NewSquaddieBuilder().CloneOf(squaddieToPlan).WithID(squaddieToPlan.ID()).Build()
+}
+
+func getLevelsConsumedInCurrentClass(squaddieToInspect squaddieinterface.Interface) []string {
+	progress, classExists := (*squaddieToInspect.ClassLevelsConsumed())[squaddieToInspect.CurrentClassID()]
+	if !classExists {
+		return []string{}
+	}
+	return progress.GetLevelsConsumed()
+}
+
+func newLevelUpPlan(original, plannedSquaddie squaddieinterface.Interface) *LevelUpPlan {
+	return &LevelUpPlan{
+		PlannedSquaddie: plannedSquaddie,
+		Steps:           []*PlannedLevelUpResult{},
+		StatChanges: StatChanges{
+			MaxHitPoints:     plannedSquaddie.MaxHitPoints() - original.MaxHitPoints(),
+			Dodge:            plannedSquaddie.Dodge() - original.Dodge(),
+			Deflect:          plannedSquaddie.Deflect() - original.Deflect(),
+			MaxBarrier:       plannedSquaddie.MaxBarrier() - original.MaxBarrier(),
+			Armor:            plannedSquaddie.Armor() - original.Armor(),
+			Aim:              plannedSquaddie.Aim() - original.Aim(),
+			Strength:         plannedSquaddie.Strength() - original.Strength(),
+			Mind:             plannedSquaddie.Mind() - original.Mind(),
+			MovementDistance: plannedSquaddie.MovementDistance() - original.MovementDistance(),
+		},
+		PowersGained: getPowerReferencesMissingFrom(plannedSquaddie, original),
+		PowersLost:   getPowerReferencesMissingFrom(original, plannedSquaddie),
+	}
+}
+
+func getPowerReferencesMissingFrom(squaddieWithPowers, squaddieToCheck squaddieinterface.Interface) []*powerreference.Reference {
+	missingReferences := []*powerreference.Reference{}
+	for _, reference := range squaddieWithPowers.GetCopyOfPowerReferences() {
+		if squaddieToCheck.HasPowerWithID(reference.PowerID) == false {
+			missingReferences = append(missingReferences, reference)
+		}
+	}
+	return missingReferences
+}
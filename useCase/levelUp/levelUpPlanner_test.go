@@ -0,0 +1,166 @@
+package levelup_test
+
+import (
+	"github.com/chadius/terosgamerules/entity/levelupbenefit"
+	"github.com/chadius/terosgamerules/entity/squaddie"
+	"github.com/chadius/terosgamerules/entity/squaddieclass"
+	"github.com/chadius/terosgamerules/entity/squaddieinterface"
+	"github.com/chadius/terosgamerules/usecase/levelup"
+	"github.com/chadius/terosgamerules/usecase/repositories"
+	"github.com/chadius/terosgamerules/utility"
+	. "gopkg.in/check.v1"
+)
+
+type LevelUpPlannerSuite struct {
+	mageClass            *squaddieclass.Class
+	dimensionWalkerClass *squaddieclass.Class
+
+	mageSmallLevel     *levelupbenefit.LevelUpBenefit
+	mageBigLevelBlot   *levelupbenefit.LevelUpBenefit
+	mageBigLevelShield *levelupbenefit.LevelUpBenefit
+
+	teros   squaddieinterface.Interface
+	repos   *repositories.RepositoryCollection
+	planner *levelup.PlanOnClone
+}
+
+var _ = Suite(&LevelUpPlannerSuite{})
+
+func (suite *LevelUpPlannerSuite) SetUpTest(checker *C) {
+	suite.mageClass = squaddieclass.ClassBuilder().WithID("mage").WithName("Mage").Build()
+	suite.dimensionWalkerClass = squaddieclass.ClassBuilder().WithID("dimensionWalker").WithName("Dimension Walker").RequiresBaseClass().Build()
+
+	suite.mageSmallLevel, _ = levelupbenefit.NewLevelUpBenefitBuilder().WithID("mageSmall").WithClassID(suite.mageClass.ID()).
+		Aim(1).Mind(2).Build()
+	suite.mageBigLevelBlot, _ = levelupbenefit.NewLevelUpBenefitBuilder().WithID("mageBigBlot").WithClassID(suite.mageClass.ID()).BigLevel().
+		GainPower("blotID", "Blot").Build()
+	suite.mageBigLevelShield, _ = levelupbenefit.NewLevelUpBenefitBuilder().WithID("mageBigShield").WithClassID(suite.mageClass.ID()).BigLevel().
+		Armor(1).Build()
+
+	classRepo := squaddieclass.NewRepository()
+	classRepo.AddListOfClasses([]*squaddieclass.Class{suite.mageClass, suite.dimensionWalkerClass})
+
+	levelRepo := levelupbenefit.NewLevelUpBenefitRepository()
+	levelRepo.AddLevels([]*levelupbenefit.LevelUpBenefit{suite.mageSmallLevel, suite.mageBigLevelBlot, suite.mageBigLevelShield})
+
+	suite.teros = squaddie.NewSquaddieBuilder().Teros().Build()
+	squaddieRepo := squaddie.NewSquaddieRepository()
+	squaddieRepo.AddSquaddies([]squaddieinterface.Interface{suite.teros})
+
+	suite.repos = &repositories.RepositoryCollection{
+		SquaddieRepo: squaddieRepo,
+		LevelRepo:    levelRepo,
+		ClassRepo:    classRepo,
+	}
+
+	suite.planner = levelup.NewPlanOnClone(utility.NewSeededIntGenerator(0))
+}
+
+func (suite *LevelUpPlannerSuite) TestPlanReportsStatAndPowerDifferences(checker *C) {
+	plan, err := suite.planner.PlanLevelUps(suite.teros, []*levelup.PlannedLevelUp{
+		{ClassID: suite.mageClass.ID(), LevelUpBenefitID: suite.mageBigLevelBlot.ID()},
+		{LevelUpBenefitID: suite.mageSmallLevel.ID()},
+	}, suite.repos)
+
+	checker.Assert(err, IsNil)
+	checker.Assert(plan.StatChanges.Aim, Equals, 1)
+	checker.Assert(plan.StatChanges.Mind, Equals, 2)
+	checker.Assert(plan.StatChanges.Armor, Equals, 0)
+	checker.Assert(plan.PowersGained, HasLen, 1)
+	checker.Assert(plan.PowersGained[0].PowerID, Equals, "blotID")
+	checker.Assert(plan.PowersLost, HasLen, 0)
+	checker.Assert(plan.PlannedSquaddie.CurrentClassID(), Equals, suite.mageClass.ID())
+}
+
+func (suite *LevelUpPlannerSuite) TestPlanDoesNotChangeOriginalSquaddie(checker *C) {
+	startingAim := suite.teros.Aim()
+	suite.planner.PlanLevelUps(suite.teros, []*levelup.PlannedLevelUp{
+		{ClassID: suite.mageClass.ID(), LevelUpBenefitID: suite.mageSmallLevel.ID()},
+	}, suite.repos)
+
+	originalInRepo := suite.repos.SquaddieRepo.GetOriginalSquaddieByID(suite.teros.ID())
+	checker.Assert(originalInRepo.Aim(), Equals, startingAim)
+	checker.Assert(originalInRepo.CurrentClassID(), Equals, "")
+	checker.Assert(originalInRepo.HasPowerWithID("blotID"), Equals, false)
+}
+
+func (suite *LevelUpPlannerSuite) TestPlanListsAvailableBigLevelsAndClassesPerStep(checker *C) {
+	plan, err := suite.planner.PlanLevelUps(suite.teros, []*levelup.PlannedLevelUp{
+		{ClassID: suite.mageClass.ID()},
+		{LevelUpBenefitID: suite.mageBigLevelBlot.ID()},
+		{LevelUpBenefitID: suite.mageSmallLevel.ID()},
+	}, suite.repos)
+
+	checker.Assert(err, IsNil)
+	checker.Assert(plan.Steps, HasLen, 3)
+
+	checker.Assert(plan.Steps[0].BenefitsApplied, HasLen, 0)
+	checker.Assert(plan.Steps[0].AvailableBigLevels, HasLen, 2)
+
+	checker.Assert(plan.Steps[1].BenefitsApplied[0].ID(), Equals, suite.mageBigLevelBlot.ID())
+	checker.Assert(plan.Steps[1].AvailableBigLevels, HasLen, 1)
+	checker.Assert(plan.Steps[1].AvailableBigLevels[0].ID(), Equals, suite.mageBigLevelShield.ID())
+
+	checker.Assert(plan.Steps[2].AvailableBigLevels, HasLen, 0)
+	checker.Assert(plan.Steps[2].AvailableClassIDs, DeepEquals, []string{})
+}
+
+func (suite *LevelUpPlannerSuite) TestPlanRaisesAnErrorIfSquaddieCannotSwitchClass(checker *C) {
+	plan, err := suite.planner.PlanLevelUps(suite.teros, []*levelup.PlannedLevelUp{
+		{ClassID: suite.dimensionWalkerClass.ID()},
+	}, suite.repos)
+
+	checker.Assert(plan, IsNil)
+	checker.Assert(err, ErrorMatches, `squaddie "Teros" cannot switch to class "Dimension Walker"`)
+}
+
+func (suite *LevelUpPlannerSuite) TestPlanRaisesAnErrorIfLevelUpBenefitIsNotInClass(checker *C) {
+	_, err := suite.planner.PlanLevelUps(suite.teros, []*levelup.PlannedLevelUp{
+		{ClassID: suite.mageClass.ID(), LevelUpBenefitID: "unknownLevel"},
+	}, suite.repos)
+
+	checker.Assert(err, ErrorMatches, `squaddie "Teros" cannot find LevelUpBenefit "unknownLevel" in class "mage"`)
+}
+
+func (suite *LevelUpPlannerSuite) TestPreviewNextLevelUsesBigLevelChoice(checker *C) {
+	suite.teros.AddClass(suite.mageClass.GetReference())
+	suite.teros.SetClass(suite.mageClass.ID())
+
+	plan, err := suite.planner.PreviewNextLevel(suite.teros, suite.mageBigLevelShield.ID(), suite.repos)
+	checker.Assert(err, IsNil)
+	checker.Assert(plan.Steps, HasLen, 1)
+	checker.Assert(plan.Steps[0].BenefitsApplied, HasLen, 2)
+	checker.Assert(plan.StatChanges.Armor, Equals, 1)
+	checker.Assert(plan.StatChanges.Aim, Equals, 1)
+	checker.Assert(suite.teros.IsClassLevelAlreadyUsed(suite.mageSmallLevel.ID()), Equals, false)
+}
+
+func (suite *LevelUpPlannerSuite) TestPreviewNextLevelPicksTheSameSmallLevelWithTheSameSeed(checker *C) {
+	mageSmallStrength, _ := levelupbenefit.NewLevelUpBenefitBuilder().WithID("mageSmallStrength").WithClassID(suite.mageClass.ID()).
+		Strength(1).Build()
+	mageSmallArmor, _ := levelupbenefit.NewLevelUpBenefitBuilder().WithID("mageSmallArmor").WithClassID(suite.mageClass.ID()).
+		Armor(1).Build()
+	suite.repos.LevelRepo.AddLevels([]*levelupbenefit.LevelUpBenefit{mageSmallStrength, mageSmallArmor})
+	suite.teros.AddClass(suite.mageClass.GetReference())
+	suite.teros.SetClass(suite.mageClass.ID())
+
+	firstPlan, err := levelup.NewPlanOnClone(utility.NewSeededIntGenerator(1000)).PreviewNextLevel(suite.teros, suite.mageBigLevelBlot.ID(), suite.repos)
+	checker.Assert(err, IsNil)
+	secondPlan, err := levelup.NewPlanOnClone(utility.NewSeededIntGenerator(1000)).PreviewNextLevel(suite.teros, suite.mageBigLevelBlot.ID(), suite.repos)
+	checker.Assert(err, IsNil)
+
+	checker.Assert(secondPlan.Steps[0].BenefitsApplied, DeepEquals, firstPlan.Steps[0].BenefitsApplied)
+	checker.Assert(secondPlan.StatChanges, DeepEquals, firstPlan.StatChanges)
+}
+
+func (suite *LevelUpPlannerSuite) TestPlannerRaisesAnErrorWithoutLevelAndClassRepositories(checker *C) {
+	suite.repos.ClassRepo = nil
+
+	_, err := suite.planner.PreviewNextLevel(suite.teros, suite.mageBigLevelBlot.ID(), suite.repos)
+	checker.Assert(err, ErrorMatches, `squaddie "Teros" cannot plan level ups without level and class repositories`)
+
+	_, err = suite.planner.PlanLevelUps(suite.teros, []*levelup.PlannedLevelUp{
+		{ClassID: suite.mageClass.ID()},
+	}, suite.repos)
+	checker.Assert(err, ErrorMatches, `squaddie "Teros" cannot plan level ups without level and class repositories`)
+}
@@ -23,12 +23,73 @@ type SelectLevelUpBasedOnSquaddieStrategy interface {
 }
 
 // SelectLevelUpBasedOnSquaddieBigLevelsOnEvenLevels will select a random small level every level and a selected big level at every even level.
-type SelectLevelUpBasedOnSquaddieBigLevelsOnEvenLevels struct{}
+//   Small levels with a higher weight are more likely to be chosen.
+type SelectLevelUpBasedOnSquaddieBigLevelsOnEvenLevels struct {
+	randomGenerator utility.IntGenerator
+}
+
+// NewSelectLevelUpBasedOnSquaddieBigLevelsOnEvenLevels uses the randomGenerator to pick small levels.
+//   Use a seeded generator to get the same small levels every time.
+func NewSelectLevelUpBasedOnSquaddieBigLevelsOnEvenLevels(randomGenerator utility.IntGenerator) *SelectLevelUpBasedOnSquaddieBigLevelsOnEvenLevels {
+	return &SelectLevelUpBasedOnSquaddieBigLevelsOnEvenLevels{
+		randomGenerator: randomGenerator,
+	}
+}
 
 // GetSquaddieClassLevels counts the levels for each class.
 func (s *SelectLevelUpBasedOnSquaddieBigLevelsOnEvenLevels) GetSquaddieClassLevels(
 	squaddieToInspect squaddieinterface.Interface,
 	repos *repositories.RepositoryCollection,
+) map[string]int {
+	return getSquaddieClassLevels(squaddieToInspect, repos)
+}
+
+// ImproveSquaddieBasedOnLevel selects the levels the squaddie should get and then applies them.
+func (s *SelectLevelUpBasedOnSquaddieBigLevelsOnEvenLevels) ImproveSquaddieBasedOnLevel(
+	squaddieToLevelUp squaddieinterface.Interface,
+	bigLevelID string,
+	repos *repositories.RepositoryCollection,
+) error {
+	return improveSquaddieBasedOnLevel(squaddieToLevelUp, bigLevelID, repos, s.selectSmallLevelUpForSquaddie)
+}
+
+// SelectLevelUpInFixedOrder will select the next unused small level in the order they were declared every level,
+//   and a selected big level at every even level.
+type SelectLevelUpInFixedOrder struct{}
+
+// GetSquaddieClassLevels counts the levels for each class.
+func (s *SelectLevelUpInFixedOrder) GetSquaddieClassLevels(
+	squaddieToInspect squaddieinterface.Interface,
+	repos *repositories.RepositoryCollection,
+) map[string]int {
+	return getSquaddieClassLevels(squaddieToInspect, repos)
+}
+
+// ImproveSquaddieBasedOnLevel selects the levels the squaddie should get and then applies them.
+func (s *SelectLevelUpInFixedOrder) ImproveSquaddieBasedOnLevel(
+	squaddieToLevelUp squaddieinterface.Interface,
+	bigLevelID string,
+	repos *repositories.RepositoryCollection,
+) error {
+	return improveSquaddieBasedOnLevel(squaddieToLevelUp, bigLevelID, repos, s.selectSmallLevelUpForSquaddie)
+}
+
+// selectSmallLevelUpForSquaddie chooses the first unused Small LevelUpBenefit for the squaddie.
+//    If there are no small levels to choose from, return nil
+func (s *SelectLevelUpInFixedOrder) selectSmallLevelUpForSquaddie(
+	squaddieToLevelUp squaddieinterface.Interface,
+	levelsFromClass map[levelupbenefit.Size][]*levelupbenefit.LevelUpBenefit,
+) *levelupbenefit.LevelUpBenefit {
+	smallLevelsToChooseFrom := getUnusedSmallLevels(squaddieToLevelUp, levelsFromClass)
+	if len(smallLevelsToChooseFrom) > 0 {
+		return smallLevelsToChooseFrom[0]
+	}
+	return nil
+}
+
+func getSquaddieClassLevels(
+	squaddieToInspect squaddieinterface.Interface,
+	repos *repositories.RepositoryCollection,
 ) map[string]int {
 	levels := map[string]int{}
 	for classID, progress := range *squaddieToInspect.ClassLevelsConsumed() {
@@ -44,11 +105,11 @@ func (s *SelectLevelUpBasedOnSquaddieBigLevelsOnEvenLevels) GetSquaddieClassLeve
 	return levels
 }
 
-// ImproveSquaddieBasedOnLevel selects the levels the squaddie should get and then applies them.
-func (s *SelectLevelUpBasedOnSquaddieBigLevelsOnEvenLevels) ImproveSquaddieBasedOnLevel(
+func improveSquaddieBasedOnLevel(
 	squaddieToLevelUp squaddieinterface.Interface,
 	bigLevelID string,
 	repos *repositories.RepositoryCollection,
+	selectSmallLevel func(squaddieinterface.Interface, map[levelupbenefit.Size][]*levelupbenefit.LevelUpBenefit) *levelupbenefit.LevelUpBenefit,
 ) error {
 	classToUse, err := repos.ClassRepo.GetClassByID(squaddieToLevelUp.CurrentClassID())
 	if err != nil {
@@ -60,16 +121,16 @@ func (s *SelectLevelUpBasedOnSquaddieBigLevelsOnEvenLevels) ImproveSquaddieBased
 		return err
 	}
 
-	squaddieLevels := s.GetSquaddieClassLevels(squaddieToLevelUp, repos)
+	squaddieLevels := getSquaddieClassLevels(squaddieToLevelUp, repos)
 
 	levelUpStrategy := ImproveSquaddieClass{}
 
-	bigLevelToConsume := s.selectBigLevelUpForSquaddie(squaddieToLevelUp, bigLevelID, squaddieLevels, classToUse, levelsFromClass)
+	bigLevelToConsume := selectBigLevelUpForSquaddie(squaddieToLevelUp, bigLevelID, squaddieLevels, classToUse, levelsFromClass)
 	if bigLevelToConsume != nil {
 		levelUpStrategy.ImproveSquaddie(bigLevelToConsume, squaddieToLevelUp)
 	}
 
-	smallLevelToConsume := s.selectSmallLevelUpForSquaddie(squaddieToLevelUp, levelsFromClass)
+	smallLevelToConsume := selectSmallLevel(squaddieToLevelUp, levelsFromClass)
 	if smallLevelToConsume != nil {
 		levelUpStrategy.ImproveSquaddie(smallLevelToConsume, squaddieToLevelUp)
 	}
@@ -82,7 +143,7 @@ func (s *SelectLevelUpBasedOnSquaddieBigLevelsOnEvenLevels) ImproveSquaddieBased
 //    but the classToUse can override this choice with an initial big level.
 //    If the squaddie does not qualify for a Big level up, this will return nil.
 //    If there are no big levels, return nil
-func (s *SelectLevelUpBasedOnSquaddieBigLevelsOnEvenLevels) selectBigLevelUpForSquaddie(
+func selectBigLevelUpForSquaddie(
 	squaddieToLevelUp squaddieinterface.Interface,
 	bigLevelSelectedID string,
 	squaddieLevels map[string]int,
@@ -113,13 +174,44 @@ func (s *SelectLevelUpBasedOnSquaddieBigLevelsOnEvenLevels) selectBigLevelUpForS
 }
 
 // selectSmallLevelUpForSquaddie chooses a Small LevelUpBenefit for the squaddie
-//    and returns a pointer to it. It is selected randomly.
+//    and returns a pointer to it. It is selected randomly, favoring levels with higher weights.
 //    If there are no small levels to choose from, return nil
 func (s *SelectLevelUpBasedOnSquaddieBigLevelsOnEvenLevels) selectSmallLevelUpForSquaddie(
 	squaddieToLevelUp squaddieinterface.Interface,
 	levelsFromClass map[levelupbenefit.Size][]*levelupbenefit.LevelUpBenefit,
 ) *levelupbenefit.LevelUpBenefit {
-	smallLevelsToChooseFrom := levelupbenefit.FilterLevelUpBenefits(levelsFromClass[levelupbenefit.Small],
+	smallLevelsToChooseFrom := getUnusedSmallLevels(squaddieToLevelUp, levelsFromClass)
+	if len(smallLevelsToChooseFrom) == 0 {
+		return nil
+	}
+
+	totalWeight := 0
+	for _, level := range smallLevelsToChooseFrom {
+		totalWeight += level.Weight()
+	}
+
+	roll := s.getRandomGenerator().Intn(totalWeight)
+	for _, level := range smallLevelsToChooseFrom {
+		if roll < level.Weight() {
+			return level
+		}
+		roll -= level.Weight()
+	}
+	return smallLevelsToChooseFrom[len(smallLevelsToChooseFrom)-1]
+}
+
+func (s *SelectLevelUpBasedOnSquaddieBigLevelsOnEvenLevels) getRandomGenerator() utility.IntGenerator {
+	if s.randomGenerator == nil {
+		return utility.GlobalIntGenerator{}
+	}
+	return s.randomGenerator
+}
+
+func getUnusedSmallLevels(
+	squaddieToLevelUp squaddieinterface.Interface,
+	levelsFromClass map[levelupbenefit.Size][]*levelupbenefit.LevelUpBenefit,
+) []*levelupbenefit.LevelUpBenefit {
+	return levelupbenefit.FilterLevelUpBenefits(levelsFromClass[levelupbenefit.Small],
 		func(level *levelupbenefit.LevelUpBenefit) bool {
 			if squaddieToLevelUp.IsClassLevelAlreadyUsed(level.ID()) {
 				return false
@@ -127,9 +219,4 @@ func (s *SelectLevelUpBasedOnSquaddieBigLevelsOnEvenLevels) selectSmallLevelUpFo
 			return true
 		},
 	)
-
-	if len(smallLevelsToChooseFrom) > 0 {
-		return smallLevelsToChooseFrom[utility.RandomInt(len(smallLevelsToChooseFrom))]
-	}
-	return nil
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/chadius/terosgamerules/entity/squaddieinterface"
 	"github.com/chadius/terosgamerules/usecase/levelup"
 	"github.com/chadius/terosgamerules/usecase/repositories"
+	"github.com/chadius/terosgamerules/utility"
 	"github.com/chadius/terosgamerules/utility/testutility/builder"
 	. "gopkg.in/check.v1"
 )
@@ -189,3 +190,95 @@ func (suite *SquaddieChoosesLevelsSuite) TestSquaddieMustChooseInitialLevel(chec
 	checker.Assert((*suite.teros.ClassLevelsConsumed())[suite.classWithInitialLevel.ID()].GetLevelsConsumed(), HasLen, 5)
 	checker.Assert(suite.teros.IsClassLevelAlreadyUsed("classWithInitialLevelThisShouldNotBeTakenFirst"), Equals, true)
 }
+
+type alwaysRollIntGenerator struct {
+	roll int
+}
+
+func (g *alwaysRollIntGenerator) Intn(maxInt int) int {
+	return g.roll
+}
+
+type SmallLevelSelectionSuite struct {
+	teros        squaddieinterface.Interface
+	weightyClass *squaddieclass.Class
+	lightLevel   *levelupbenefit.LevelUpBenefit
+	heavyLevel   *levelupbenefit.LevelUpBenefit
+	lastLevel    *levelupbenefit.LevelUpBenefit
+	repos        *repositories.RepositoryCollection
+}
+
+var _ = Suite(&SmallLevelSelectionSuite{})
+
+func (suite *SmallLevelSelectionSuite) SetUpTest(checker *C) {
+	suite.weightyClass = squaddieclass.ClassBuilder().WithID("weightyClass").WithName("Weighty").Build()
+	classRepo := squaddieclass.NewRepository()
+	classRepo.AddListOfClasses([]*squaddieclass.Class{suite.weightyClass})
+
+	suite.lightLevel, _ = levelupbenefit.NewLevelUpBenefitBuilder().LevelID("lightLevel").ClassID(suite.weightyClass.ID()).Build()
+	suite.heavyLevel, _ = levelupbenefit.NewLevelUpBenefitBuilder().LevelID("heavyLevel").ClassID(suite.weightyClass.ID()).Weight(8).Build()
+	suite.lastLevel, _ = levelupbenefit.NewLevelUpBenefitBuilder().LevelID("lastLevel").ClassID(suite.weightyClass.ID()).Build()
+
+	levelRepo := levelupbenefit.NewLevelUpBenefitRepository()
+	levelRepo.AddLevels([]*levelupbenefit.LevelUpBenefit{suite.lightLevel, suite.heavyLevel, suite.lastLevel})
+
+	suite.repos = &repositories.RepositoryCollection{
+		LevelRepo: levelRepo,
+		ClassRepo: classRepo,
+	}
+
+	suite.teros = squaddie.NewSquaddieBuilder().Teros().AddClassByReference(suite.weightyClass.GetReference()).Build()
+	suite.teros.SetClass(suite.weightyClass.ID())
+}
+
+func (suite *SmallLevelSelectionSuite) TestRollsAreSpreadByWeight(checker *C) {
+	expectedLevelIDByRoll := map[int]string{
+		0: "lightLevel",
+		1: "heavyLevel",
+		8: "heavyLevel",
+		9: "lastLevel",
+	}
+
+	for roll, expectedLevelID := range expectedLevelIDByRoll {
+		squaddieToLevel := squaddie.NewSquaddieBuilder().Teros().AddClassByReference(suite.weightyClass.GetReference()).Build()
+		squaddieToLevel.SetClass(suite.weightyClass.ID())
+
+		selectStrategy := levelup.NewSelectLevelUpBasedOnSquaddieBigLevelsOnEvenLevels(&alwaysRollIntGenerator{roll: roll})
+		err := selectStrategy.ImproveSquaddieBasedOnLevel(squaddieToLevel, "", suite.repos)
+		checker.Assert(err, IsNil)
+		checker.Assert(squaddieToLevel.IsClassLevelAlreadyUsed(expectedLevelID), Equals, true)
+	}
+}
+
+func (suite *SmallLevelSelectionSuite) TestSameSeedChoosesSameLevels(checker *C) {
+	otherTeros := squaddie.NewSquaddieBuilder().Teros().AddClassByReference(suite.weightyClass.GetReference()).Build()
+	otherTeros.SetClass(suite.weightyClass.ID())
+
+	selectStrategy := levelup.NewSelectLevelUpBasedOnSquaddieBigLevelsOnEvenLevels(utility.NewSeededIntGenerator(1234))
+	otherSelectStrategy := levelup.NewSelectLevelUpBasedOnSquaddieBigLevelsOnEvenLevels(utility.NewSeededIntGenerator(1234))
+	for range [3]int{} {
+		selectStrategy.ImproveSquaddieBasedOnLevel(suite.teros, "", suite.repos)
+		otherSelectStrategy.ImproveSquaddieBasedOnLevel(otherTeros, "", suite.repos)
+	}
+
+	checker.Assert(
+		(*suite.teros.ClassLevelsConsumed())[suite.weightyClass.ID()].GetLevelsConsumed(),
+		DeepEquals,
+		(*otherTeros.ClassLevelsConsumed())[suite.weightyClass.ID()].GetLevelsConsumed(),
+	)
+}
+
+func (suite *SmallLevelSelectionSuite) TestFixedOrderUsesDeclaredOrder(checker *C) {
+	selectStrategy := &levelup.SelectLevelUpInFixedOrder{}
+
+	selectStrategy.ImproveSquaddieBasedOnLevel(suite.teros, "", suite.repos)
+	checker.Assert((*suite.teros.ClassLevelsConsumed())[suite.weightyClass.ID()].GetLevelsConsumed(), DeepEquals, []string{"lightLevel"})
+
+	selectStrategy.ImproveSquaddieBasedOnLevel(suite.teros, "", suite.repos)
+	selectStrategy.ImproveSquaddieBasedOnLevel(suite.teros, "", suite.repos)
+	checker.Assert((*suite.teros.ClassLevelsConsumed())[suite.weightyClass.ID()].GetLevelsConsumed(), DeepEquals, []string{"lightLevel", "heavyLevel", "lastLevel"})
+
+	err := selectStrategy.ImproveSquaddieBasedOnLevel(suite.teros, "", suite.repos)
+	checker.Assert(err, IsNil)
+	checker.Assert(selectStrategy.GetSquaddieClassLevels(suite.teros, suite.repos)[suite.weightyClass.ID()], Equals, 3)
+}
@@ -18,6 +18,7 @@ type AttackerContextStrategy interface {
 	CriticalHitThreshold() int
 	CriticalHitDamage() int
 	PowerSourceLogic() powersource.Interface
+	HitsPerUse() int
 }
 
 // AttackerContext lists the attacker's relevant information when attacking
@@ -31,6 +32,7 @@ type AttackerContext struct {
 	canCritical          bool
 	criticalHitThreshold int
 	criticalHitDamage    int
+	hitsPerUse           int
 
 	offenseStrategy squaddiestats.CalculateSquaddieOffenseStatsStrategy
 }
@@ -49,6 +51,7 @@ func (context *AttackerContext) Calculate(setup powerusagescenario.Setup, reposi
 	context.powerSourceLogic = power.PowerSourceLogic()
 
 	context.extraBarrierBurn = power.ExtraBarrierBurn()
+	context.hitsPerUse = power.HitsPerUse()
 
 	context.rawDamage, err = context.calculateRawDamage(setup, repositories)
 	if err != nil {
@@ -161,3 +164,11 @@ func (context *AttackerContext) CriticalHitThreshold() int {
 func (context *AttackerContext) CriticalHitDamage() int {
 	return context.criticalHitDamage
 }
+
+// HitsPerUse gets the field value. Attacks always make at least one strike.
+func (context *AttackerContext) HitsPerUse() int {
+	if context.hitsPerUse < 1 {
+		return 1
+	}
+	return context.hitsPerUse
+}
@@ -14,6 +14,7 @@ type DefenderContextStrategy interface {
 	HitPoints() int
 	ArmorResistance() int
 	BarrierResistance() int
+	DamageResistancePercent() int
 }
 
 // DefenderContext lists the target's relevant information when under attack
@@ -23,6 +24,7 @@ type DefenderContext struct {
 	hitPoints         int
 	armorResistance   int
 	barrierResistance int
+	damageResistance  int
 	defenseStrategy   squaddiestats.CalculateSquaddieDefenseStatsStrategy
 }
 
@@ -54,6 +56,11 @@ func (context *DefenderContext) Calculate(setup *powerusagescenario.Setup, repos
 	if err != nil {
 		return err
 	}
+
+	context.damageResistance, err = context.calculateDamageResistance(setup, repositories)
+	if err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -89,6 +96,14 @@ func (context *DefenderContext) calculateHitPoints(setup *powerusagescenario.Set
 	return hitPoints, nil
 }
 
+func (context *DefenderContext) calculateDamageResistance(setup *powerusagescenario.Setup, repositories *repositories.RepositoryCollection) (int, error) {
+	resistance, err := context.defenseStrategy.GetSquaddieDamageResistanceAgainstPower(context.targetID, setup.PowerID, repositories)
+	if err != nil {
+		return 0, err
+	}
+	return resistance, nil
+}
+
 // TargetID is a getter.
 func (context *DefenderContext) TargetID() string {
 	return context.targetID
@@ -113,3 +128,8 @@ func (context *DefenderContext) ArmorResistance() int {
 func (context *DefenderContext) BarrierResistance() int {
 	return context.barrierResistance
 }
+
+// DamageResistancePercent is a getter.
+func (context *DefenderContext) DamageResistancePercent() int {
+	return context.damageResistance
+}
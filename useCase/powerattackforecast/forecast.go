@@ -3,7 +3,13 @@ package powerattackforecast
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
 
 import (
+	"fmt"
+	"github.com/chadius/terosgamerules/entity/damagedistribution"
+	"github.com/chadius/terosgamerules/entity/powerinterface"
 	"github.com/chadius/terosgamerules/entity/powerusagescenario"
+	"github.com/chadius/terosgamerules/entity/squaddieinterface"
+	"github.com/chadius/terosgamerules/usecase/powercantarget"
+	"github.com/chadius/terosgamerules/usecase/reaction"
 	"github.com/chadius/terosgamerules/usecase/repositories"
 	"github.com/chadius/terosgamerules/usecase/squaddiestats"
 )
@@ -75,6 +81,7 @@ type Forecast struct {
 	repositories              *repositories.RepositoryCollection
 	forecastedResultPerTarget []CalculationInterface
 	offenseStrategy           squaddiestats.CalculateSquaddieOffenseStatsStrategy
+	summon                    *SummonForecast
 }
 
 // NewForecast returns a new Forecast object.
@@ -92,6 +99,11 @@ func NewForecast(
 	}
 }
 
+// Setup gets the object
+func (forecast *Forecast) Setup() *powerusagescenario.Setup {
+	return &forecast.setup
+}
+
 // Repositories gets the object
 func (forecast *Forecast) Repositories() *repositories.RepositoryCollection {
 	return forecast.repositories
@@ -102,6 +114,11 @@ func (forecast *Forecast) ForecastedResultPerTarget() []CalculationInterface {
 	return forecast.forecastedResultPerTarget
 }
 
+// Summon gets the object. Returns nil if the power does not summon.
+func (forecast *Forecast) Summon() *SummonForecast {
+	return forecast.summon
+}
+
 //counterfeiter:generate . CalculationInterface
 // CalculationInterface describes what all calculations will subscribe to
 type CalculationInterface interface {
@@ -109,8 +126,12 @@ type CalculationInterface interface {
 	Attack() *AttackForecast
 	CounterAttackSetup() *powerusagescenario.Setup
 	CounterAttack() *AttackForecast
+	CounterAttackStrikesFirst() bool
+	RiposteSetup() *powerusagescenario.Setup
+	Riposte() *AttackForecast
 	Repositories() *repositories.RepositoryCollection
 	HealingForecast() *HealingForecast
+	Reaction() *ReactionForecast
 }
 
 // Calculation holds the results of the forecast.
@@ -118,11 +139,16 @@ type Calculation struct {
 	repositories *repositories.RepositoryCollection
 	setup        *powerusagescenario.Setup
 
-	attack             *AttackForecast
-	counterAttackSetup *powerusagescenario.Setup
-	counterAttack      *AttackForecast
+	attack                    *AttackForecast
+	counterAttackSetup        *powerusagescenario.Setup
+	counterAttack             *AttackForecast
+	counterAttackStrikesFirst bool
+	riposteSetup              *powerusagescenario.Setup
+	riposte                   *AttackForecast
 
 	healingForecast *HealingForecast
+
+	reaction *ReactionForecast
 }
 
 // Attack is a getter.
@@ -140,6 +166,21 @@ func (c *Calculation) CounterAttackSetup() *powerusagescenario.Setup {
 	return c.counterAttackSetup
 }
 
+// CounterAttackStrikesFirst is a getter.
+func (c *Calculation) CounterAttackStrikesFirst() bool {
+	return c.counterAttackStrikesFirst
+}
+
+// RiposteSetup is a getter.
+func (c *Calculation) RiposteSetup() *powerusagescenario.Setup {
+	return c.riposteSetup
+}
+
+// Riposte is a getter.
+func (c *Calculation) Riposte() *AttackForecast {
+	return c.riposte
+}
+
 // Setup is a getter.
 func (c *Calculation) Setup() *powerusagescenario.Setup {
 	return c.setup
@@ -155,18 +196,69 @@ func (c *Calculation) HealingForecast() *HealingForecast {
 	return c.healingForecast
 }
 
+// Reaction is a getter. Returns nil if nobody reacted to the power.
+func (c *Calculation) Reaction() *ReactionForecast {
+	return c.reaction
+}
+
+// ReactionForecast shows a squaddie reacting to the power before it is used.
+//   Kind names the reaction. ProtectedSquaddieID is the target the reacting squaddie took the power for.
+type ReactionForecast struct {
+	Kind                string
+	SquaddieID          string
+	ProtectedSquaddieID string
+}
+
 // AttackForecast shows what will happen if the power used is offensive.
 type AttackForecast struct {
 	AttackerContext AttackerContext
 	DefenderContext DefenderContext
 	VersusContext   VersusContextStrategy
+	AttackerEffect  AttackerEffectForecast
+}
+
+// AttackerEffectForecast shows what happens to the attacker if the attack hits normally.
+type AttackerEffectForecast struct {
+	HitPointsStolen   int
+	BarrierSiphoned   int
+	RecoilDamage      int
+	IsFatalToAttacker bool
+}
+
+// ExpectedDamagePerStrike returns the average damage each strike deals, including the chance to critically hit.
+func (attack *AttackForecast) ExpectedDamagePerStrike() float64 {
+	toHitBonus := attack.VersusContext.ToHit().ToHitBonus
+	chanceToHit := float64(damagedistribution.ChanceToHitOutOf36(toHitBonus)) / 36.0
+	expectedDamage := chanceToHit * float64(attack.VersusContext.NormalDamage().RawDamageDealt)
+
+	if attack.VersusContext.CanCritical() {
+		chanceToCrit := float64(damagedistribution.ChanceToHitOutOf36(toHitBonus-attack.VersusContext.CriticalHitThreshold())) / 36.0
+		extraCriticalDamage := attack.VersusContext.CriticalHitDamage().RawDamageDealt - attack.VersusContext.NormalDamage().RawDamageDealt
+		expectedDamage += chanceToCrit * float64(extraCriticalDamage)
+	}
+	return expectedDamage
+}
+
+// ExpectedTotalDamage returns the average damage the attack deals across all of its strikes.
+//   Assumes every strike faces the target's barrier and armor as they are now.
+func (attack *AttackForecast) ExpectedTotalDamage() float64 {
+	return attack.ExpectedDamagePerStrike() * float64(attack.AttackerContext.HitsPerUse())
 }
 
 // CalculateForecast gives a numerical prediction of the power's effect.
 func (forecast *Forecast) CalculateForecast() {
 	powerToUse := forecast.repositories.PowerRepo.GetPowerByID(forecast.setup.PowerID)
 
-	for _, targetID := range forecast.setup.Targets {
+	for _, originalTargetID := range forecast.setup.Targets {
+		targetID := originalTargetID
+		var guardReaction *ReactionForecast
+		if powerToUse.CanAttack() {
+			guardReaction = forecast.calculateGuardReaction(originalTargetID)
+		}
+		if guardReaction != nil {
+			targetID = guardReaction.SquaddieID
+		}
+
 		calculation := Calculation{
 			setup: &powerusagescenario.Setup{
 				UserID:          forecast.setup.UserID,
@@ -177,18 +269,43 @@ func (forecast *Forecast) CalculateForecast() {
 			repositories: &repositories.RepositoryCollection{
 				SquaddieRepo: forecast.repositories.SquaddieRepo,
 				PowerRepo:    forecast.repositories.PowerRepo,
+				ItemRepo:     forecast.repositories.ItemRepo,
+				FactionRepo:  forecast.repositories.FactionRepo,
+				Grid:         forecast.repositories.Grid,
 			},
+			reaction: guardReaction,
 		}
 
 		if powerToUse.CanAttack() {
 			forecast.addAttackAndCounterAttackToCalculation(targetID, &calculation)
 		}
-		if powerToUse.CanHeal() {
+		if hasHealingEffect(powerToUse) {
 			forecast.addHealingEffectToCalculation(targetID, &calculation)
 		}
 
 		forecast.forecastedResultPerTarget = append(forecast.forecastedResultPerTarget, &calculation)
 	}
+
+	if powerToUse.CanSummon() {
+		forecast.summon = forecast.CalculateSummonForecast()
+	}
+}
+
+// calculateGuardReaction returns the reaction of the squaddie guarding the target, or nil if nobody guards it.
+//   Counterattacks cannot be guarded.
+func (forecast *Forecast) calculateGuardReaction(targetID string) *ReactionForecast {
+	if forecast.setup.IsCounterAttack {
+		return nil
+	}
+	guardianID := reaction.FindGuardian(forecast.setup.UserID, targetID, forecast.repositories)
+	if guardianID == "" {
+		return nil
+	}
+	return &ReactionForecast{
+		Kind:                reaction.Guard,
+		SquaddieID:          guardianID,
+		ProtectedSquaddieID: targetID,
+	}
 }
 
 func (forecast *Forecast) addAttackAndCounterAttackToCalculation(targetID string, calculation *Calculation) {
@@ -202,6 +319,15 @@ func (forecast *Forecast) addAttackAndCounterAttackToCalculation(targetID string
 	calculation.attack = attack
 	calculation.counterAttackSetup = counterAttackSetup
 	calculation.counterAttack = counterAttack
+	if counterAttack == nil {
+		return
+	}
+
+	counterAttackPower := forecast.repositories.PowerRepo.GetPowerByID(counterAttackSetup.PowerID)
+	calculation.counterAttackStrikesFirst = counterAttackPower.FirstStrike()
+	if forecast.IsRipostePossible(targetID, forecast.repositories) {
+		calculation.riposteSetup, calculation.riposte = forecast.createRiposteForecast(targetID)
+	}
 }
 
 func (forecast *Forecast) addHealingEffectToCalculation(targetID string, calculation *Calculation) {
@@ -209,16 +335,53 @@ func (forecast *Forecast) addHealingEffectToCalculation(targetID string, calcula
 }
 
 // IsCounterattackPossible returns true if the squaddie with the targetID can currently counterattack.
+//   Squaddies only counter their foes, and only if their equipped power reaches the attacker.
 func (forecast *Forecast) IsCounterattackPossible(targetID string, collection *repositories.RepositoryCollection) bool {
+	counterAttacker := collection.SquaddieRepo.GetOriginalSquaddieByID(targetID)
+	attacker := collection.SquaddieRepo.GetOriginalSquaddieByID(forecast.setup.UserID)
+	if !powercantarget.AreFoes(counterAttacker, attacker, collection) {
+		return false
+	}
+
 	if forecast.setup.IsCounterAttack == false {
 		canCounter, _ := forecast.offenseStrategy.CanSquaddieCounterWithEquippedWeapon(targetID, collection)
-		if canCounter {
+		if canCounter && hasCounterAttacksLeft(counterAttacker, counterAttacker.GetEquippedPowerID(), collection) &&
+			counterAttackReachesAttacker(targetID, counterAttacker.GetEquippedPowerID(), forecast.setup.UserID, collection) {
 			return true
 		}
 	}
 	return false
 }
 
+// IsRipostePossible returns true if the user can counter the counterattack from the squaddie with the targetID.
+//   The power the user attacked with must riposte.
+func (forecast *Forecast) IsRipostePossible(targetID string, collection *repositories.RepositoryCollection) bool {
+	user := collection.SquaddieRepo.GetOriginalSquaddieByID(forecast.setup.UserID)
+	powerUsed := collection.PowerRepo.GetPowerByID(forecast.setup.PowerID)
+	if forecast.setup.IsCounterAttack || !powerUsed.Riposte() {
+		return false
+	}
+	return hasCounterAttacksLeft(user, powerUsed.ID(), collection)
+}
+
+// hasCounterAttacksLeft returns true if the squaddie can counterattack with the power again this turn.
+func hasCounterAttacksLeft(counterAttacker squaddieinterface.Interface, powerID string, collection *repositories.RepositoryCollection) bool {
+	counterAttackPower := collection.PowerRepo.GetPowerByID(powerID)
+	if counterAttackPower == nil || counterAttackPower.CounterAttacksPerTurn() == 0 {
+		return true
+	}
+	return counterAttacker.CounterAttacksThisTurn() < counterAttackPower.CounterAttacksPerTurn()
+}
+
+// counterAttackReachesAttacker returns true if the counterattacking power reaches the attacker.
+func counterAttackReachesAttacker(counterAttackerID, powerID, attackerID string, collection *repositories.RepositoryCollection) bool {
+	counterAttackPower := collection.PowerRepo.GetPowerByID(powerID)
+	if counterAttackPower == nil {
+		return true
+	}
+	return powercantarget.IsWithinRange(counterAttackerID, attackerID, counterAttackPower.Range(), collection)
+}
+
 func (forecast *Forecast) createCounterAttackForecast(counterAttackingSquaddieID string) (*powerusagescenario.Setup, *AttackForecast) {
 	counterAttackingSquaddie := forecast.repositories.SquaddieRepo.GetOriginalSquaddieByID(counterAttackingSquaddieID)
 	counterAttackingPowerID := counterAttackingSquaddie.GetEquippedPowerID()
@@ -236,6 +399,9 @@ func (forecast *Forecast) createCounterAttackForecast(counterAttackingSquaddieID
 		repositories: &repositories.RepositoryCollection{
 			SquaddieRepo: forecast.repositories.SquaddieRepo,
 			PowerRepo:    forecast.repositories.PowerRepo,
+			ItemRepo:     forecast.repositories.ItemRepo,
+			FactionRepo:  forecast.repositories.FactionRepo,
+			Grid:         forecast.repositories.Grid,
 		},
 	}
 
@@ -244,6 +410,29 @@ func (forecast *Forecast) createCounterAttackForecast(counterAttackingSquaddieID
 	return &counterForecastSetup, counterAttackForecast.CalculateAttackForecast(counterAttackingTargetID)
 }
 
+// createRiposteForecast has the user counter the counterattack with the power it attacked with.
+func (forecast *Forecast) createRiposteForecast(counterAttackingSquaddieID string) (*powerusagescenario.Setup, *AttackForecast) {
+	riposteSetup := powerusagescenario.Setup{
+		UserID:          forecast.setup.UserID,
+		PowerID:         forecast.setup.PowerID,
+		Targets:         []string{counterAttackingSquaddieID},
+		IsCounterAttack: true,
+	}
+
+	riposteForecast := Forecast{
+		setup: riposteSetup,
+		repositories: &repositories.RepositoryCollection{
+			SquaddieRepo: forecast.repositories.SquaddieRepo,
+			PowerRepo:    forecast.repositories.PowerRepo,
+			ItemRepo:     forecast.repositories.ItemRepo,
+			FactionRepo:  forecast.repositories.FactionRepo,
+			Grid:         forecast.repositories.Grid,
+		},
+	}
+
+	return &riposteSetup, riposteForecast.CalculateAttackForecast(counterAttackingSquaddieID)
+}
+
 // CalculateAttackForecast figures out what will happen when this attack power is used.
 func (forecast *Forecast) CalculateAttackForecast(targetID string) *AttackForecast {
 	attackerContext := *NewAttackerContext(&squaddiestats.CalculateSquaddieOffenseStats{})
@@ -259,32 +448,149 @@ func (forecast *Forecast) CalculateAttackForecast(targetID string) *AttackForeca
 		AttackerContext: attackerContext,
 		DefenderContext: defenderContext,
 		VersusContext:   &versusContext,
+		AttackerEffect:  forecast.calculateAttackerEffect(targetID, &versusContext, defenderContext),
+	}
+}
+
+func (forecast *Forecast) calculateAttackerEffect(targetID string, versusContext *VersusContext, defenderContext DefenderContext) AttackerEffectForecast {
+	powerUsed := forecast.repositories.PowerRepo.GetPowerByID(forecast.setup.PowerID)
+	attacker := forecast.repositories.SquaddieRepo.GetOriginalSquaddieByID(forecast.setup.UserID)
+	target := forecast.repositories.SquaddieRepo.GetOriginalSquaddieByID(targetID)
+	if powerUsed == nil || attacker == nil || target == nil {
+		return AttackerEffectForecast{}
+	}
+
+	damageTaken := versusContext.NormalDamage().RawDamageDealt
+	if damageTaken > defenderContext.HitPoints() {
+		damageTaken = defenderContext.HitPoints()
+	}
+	hitPointsStolen := damageTaken * powerUsed.LifeStealPercent() / 100
+	if hitPointsStolen > attacker.MaxHitPoints()-attacker.CurrentHitPoints() {
+		hitPointsStolen = attacker.MaxHitPoints() - attacker.CurrentHitPoints()
+	}
+
+	barrierSiphoned := powerUsed.BarrierSiphon()
+	targetBarrierAfterAttack := target.CurrentBarrier() - versusContext.NormalDamage().DamageAbsorbedByBarrier
+	if targetBarrierAfterAttack < 0 {
+		targetBarrierAfterAttack = 0
+	}
+	if barrierSiphoned > targetBarrierAfterAttack {
+		barrierSiphoned = targetBarrierAfterAttack
+	}
+
+	recoilDamage := powerUsed.RecoilDamage()
+	hitPointsAfterLifeSteal := attacker.CurrentHitPoints() + hitPointsStolen
+	if recoilDamage > hitPointsAfterLifeSteal {
+		recoilDamage = hitPointsAfterLifeSteal
+	}
+
+	return AttackerEffectForecast{
+		HitPointsStolen:   hitPointsStolen,
+		BarrierSiphoned:   barrierSiphoned,
+		RecoilDamage:      recoilDamage,
+		IsFatalToAttacker: recoilDamage > 0 && recoilDamage >= hitPointsAfterLifeSteal,
+	}
+}
+
+// SummonForecast shows the squaddie the power will summon.
+//   Turns is the number of turns the summon lasts, or 0 if it lasts until it falls.
+type SummonForecast struct {
+	TemplateID string
+	SquaddieID string
+	Turns      int
+}
+
+// CalculateSummonForecast figures out which squaddie the power will summon.
+//   Summons are named after the user and the template, followed by the first number
+//   that is not in use, so replays always summon squaddies with the same IDs.
+func (forecast *Forecast) CalculateSummonForecast() *SummonForecast {
+	powerToUse := forecast.repositories.PowerRepo.GetPowerByID(forecast.setup.PowerID)
+
+	summonNumber := 1
+	summonID := fmt.Sprintf("%s_%s_%d", forecast.setup.UserID, powerToUse.SummonTemplateID(), summonNumber)
+	for forecast.repositories.SquaddieRepo.GetOriginalSquaddieByID(summonID) != nil {
+		summonNumber++
+		summonID = fmt.Sprintf("%s_%s_%d", forecast.setup.UserID, powerToUse.SummonTemplateID(), summonNumber)
+	}
+
+	return &SummonForecast{
+		TemplateID: powerToUse.SummonTemplateID(),
+		SquaddieID: summonID,
+		Turns:      powerToUse.SummonTurns(),
 	}
 }
 
 // HealingForecast showcases beneficial abilities
 type HealingForecast struct {
-	RawHitPointsRestored int
-	TargetID             string
+	RawHitPointsRestored   int
+	RawManaRestored        int
+	RawBarrierRestored     int
+	HitPointsHealedPerTurn int
+	HealOverTimeTurns      int
+	HarmfulEffectsRemoved  int
+	TargetID               string
 }
 
 // CalculateHealingForecast figures out what will happen when this attack power is used.
 func (forecast *Forecast) CalculateHealingForecast(targetID string) *HealingForecast {
+	powerToUse := forecast.repositories.PowerRepo.GetPowerByID(forecast.setup.PowerID)
+	healingForecast := &HealingForecast{
+		RawHitPointsRestored: 0,
+		RawManaRestored:      powerToUse.ManaRestored(),
+		RawBarrierRestored:   powerToUse.BarrierRestored(),
+		TargetID:             targetID,
+	}
+
+	if powerToUse.HealsOverTime() {
+		healingForecast.HitPointsHealedPerTurn = powerToUse.HitPointsHealedPerTurn()
+		healingForecast.HealOverTimeTurns = powerToUse.HealOverTimeTurns()
+	}
+
+	if powerToUse.Cleanses() {
+		healingForecast.HarmfulEffectsRemoved = countHarmfulEffects(targetID, forecast.repositories)
+	}
+
+	if !powerToUse.CanHeal() {
+		return healingForecast
+	}
+
 	maximumHealing, err := forecast.offenseStrategy.GetHitPointsHealedWithPower(
 		forecast.setup.UserID,
 		forecast.setup.PowerID,
 		targetID,
 		forecast.repositories,
 	)
-	if err != nil {
-		return &HealingForecast{
-			RawHitPointsRestored: 0,
-			TargetID:             targetID,
-		}
+	if err == nil {
+		healingForecast.RawHitPointsRestored = maximumHealing
 	}
+	return healingForecast
+}
 
-	return &HealingForecast{
-		RawHitPointsRestored: maximumHealing,
-		TargetID:             targetID,
+// countHarmfulEffects counts the target's harmful lingering effects.
+//   Charms and confusion override the target's affiliation and count as one more effect.
+func countHarmfulEffects(targetID string, repositories *repositories.RepositoryCollection) int {
+	target := repositories.SquaddieRepo.GetOriginalSquaddieByID(targetID)
+	if target == nil {
+		return 0
 	}
+
+	harmfulEffects := 0
+	for _, effect := range target.LingeringEffects() {
+		if effect.IsHarmful() {
+			harmfulEffects++
+		}
+	}
+	if target.HasAffiliationOverride() {
+		harmfulEffects++
+	}
+	return harmfulEffects
+}
+
+// hasHealingEffect returns true if the power helps its targets in any way.
+func hasHealingEffect(powerToUse powerinterface.Interface) bool {
+	return powerToUse.CanHeal() ||
+		powerToUse.RestoresMana() ||
+		powerToUse.BarrierRestored() > 0 ||
+		powerToUse.HealsOverTime() ||
+		powerToUse.Cleanses()
 }
@@ -1,14 +1,17 @@
 package powerattackforecast_test
 
 import (
+	"github.com/chadius/terosgamerules/entity/battlegrid"
 	"github.com/chadius/terosgamerules/entity/power"
 	"github.com/chadius/terosgamerules/entity/powerinterface"
+	"github.com/chadius/terosgamerules/entity/powerreference"
 	"github.com/chadius/terosgamerules/entity/powerrepository"
 	"github.com/chadius/terosgamerules/entity/powerusagescenario"
 	"github.com/chadius/terosgamerules/entity/squaddie"
 	"github.com/chadius/terosgamerules/entity/squaddieinterface"
 	"github.com/chadius/terosgamerules/usecase/powerattackforecast"
 	"github.com/chadius/terosgamerules/usecase/powerequip"
+	"github.com/chadius/terosgamerules/usecase/reaction"
 	"github.com/chadius/terosgamerules/usecase/repositories"
 	"github.com/chadius/terosgamerules/usecase/squaddiestats"
 	. "gopkg.in/check.v1"
@@ -120,6 +123,45 @@ func (suite *CounterAttackCalculate) TestCounterAttackHappensIfPossible(checker
 	checker.Assert(suite.forecastSpearOnBandit.ForecastedResultPerTarget()[0].CounterAttack().VersusContext.ToHit().ToHitBonus, Equals, -1)
 }
 
+func (suite *CounterAttackCalculate) TestNoCounterAttackHappensIfTargetIsCharmed(checker *C) {
+	suite.bandit.AddPowerReference(suite.axe.GetReference())
+	checkEquip := powerequip.CheckRepositories{}
+	checkEquip.SquaddieEquipPower(suite.bandit, suite.axe.ID(), suite.repos)
+	suite.bandit.OverrideAffiliation(suite.teros.AffiliationLogic(), 1)
+
+	suite.forecastSpearOnBandit.CalculateForecast()
+
+	checker.Assert(suite.forecastSpearOnBandit.ForecastedResultPerTarget()[0].CounterAttack(), IsNil)
+}
+
+func (suite *CounterAttackCalculate) TestNoCounterAttackHappensIfTheAttackerIsOutOfReach(checker *C) {
+	suite.bandit.AddPowerReference(suite.axe.GetReference())
+	checkEquip := powerequip.CheckRepositories{}
+	checkEquip.SquaddieEquipPower(suite.bandit, suite.axe.ID(), suite.repos)
+
+	suite.repos.Grid = battlegrid.NewGrid()
+	suite.repos.Grid.PlaceSquaddie(suite.teros.ID(), battlegrid.Coordinate{Row: 0, Column: 0})
+	suite.repos.Grid.PlaceSquaddie(suite.bandit.ID(), battlegrid.Coordinate{Row: 0, Column: 2})
+
+	longSpear := power.NewPowerBuilder().CloneOf(suite.spear).WithID("longSpear").Range(2).Build()
+	suite.powerRepo.AddPower(longSpear)
+
+	forecast := powerattackforecast.NewForecastBuilder().
+		Setup(&powerusagescenario.Setup{
+			UserID:          suite.teros.ID(),
+			PowerID:         longSpear.ID(),
+			Targets:         []string{suite.bandit.ID()},
+			IsCounterAttack: false,
+		}).
+		Repositories(suite.repos).
+		OffenseStrategy(&squaddiestats.CalculateSquaddieOffenseStats{}).
+		Build()
+	forecast.CalculateForecast()
+
+	checker.Assert(forecast.ForecastedResultPerTarget()[0].Attack(), NotNil)
+	checker.Assert(forecast.ForecastedResultPerTarget()[0].CounterAttack(), IsNil)
+}
+
 type HealingEffectForecast struct {
 	lini  squaddieinterface.Interface
 	teros squaddieinterface.Interface
@@ -260,3 +302,270 @@ func (suite *HealingEffectForecast) TestHealMultipleTargets(checker *C) {
 	checker.Assert(suite.forecastHealingStaffOnTerosAndVale.ForecastedResultPerTarget()[0].HealingForecast().TargetID, Equals, suite.teros.ID())
 	checker.Assert(suite.forecastHealingStaffOnTerosAndVale.ForecastedResultPerTarget()[1].HealingForecast().TargetID, Equals, suite.vale.ID())
 }
+
+type AttackerEffectForecast struct {
+	teros          squaddieinterface.Interface
+	shieldedBandit squaddieinterface.Interface
+
+	siphoningBite powerinterface.Interface
+
+	repos *repositories.RepositoryCollection
+}
+
+var _ = Suite(&AttackerEffectForecast{})
+
+func (suite *AttackerEffectForecast) SetUpTest(checker *C) {
+	suite.teros = squaddie.NewSquaddieBuilder().Teros().HitPoints(10).Barrier(3).Build()
+	suite.teros.ReduceHitPoints(8)
+	suite.shieldedBandit = squaddie.NewSquaddieBuilder().Bandit().HitPoints(10).Barrier(2).Build()
+	suite.shieldedBandit.SetBarrierToMax()
+
+	suite.siphoningBite = power.NewPowerBuilder().WithName("Siphoning Bite").TargetsFoe().DealsDamage(5).
+		LifeStealPercent(50).BarrierSiphon(2).RecoilDamage(9).Build()
+
+	squaddieRepo := squaddie.NewSquaddieRepository()
+	squaddieRepo.AddSquaddies([]squaddieinterface.Interface{suite.teros, suite.shieldedBandit})
+
+	powerRepo := powerrepository.NewPowerRepository()
+	powerRepo.AddSlicePowerSource([]powerinterface.Interface{suite.siphoningBite})
+
+	suite.repos = &repositories.RepositoryCollection{PowerRepo: powerRepo, SquaddieRepo: squaddieRepo}
+}
+
+func (suite *AttackerEffectForecast) TestForecastShowsEffectsOnAttacker(checker *C) {
+	forecast := powerattackforecast.NewForecastBuilder().
+		Setup(&powerusagescenario.Setup{
+			UserID:          suite.teros.ID(),
+			PowerID:         suite.siphoningBite.ID(),
+			Targets:         []string{suite.shieldedBandit.ID()},
+			IsCounterAttack: false,
+		}).
+		Repositories(suite.repos).
+		OffenseStrategy(&squaddiestats.CalculateSquaddieOffenseStats{}).
+		Build()
+	forecast.CalculateForecast()
+
+	attackerEffect := forecast.ForecastedResultPerTarget()[0].Attack().AttackerEffect
+	checker.Assert(attackerEffect.HitPointsStolen, Equals, 1)
+	checker.Assert(attackerEffect.BarrierSiphoned, Equals, 0)
+	checker.Assert(attackerEffect.RecoilDamage, Equals, 3)
+	checker.Assert(attackerEffect.IsFatalToAttacker, Equals, true)
+}
+
+type SummonForecastSuite struct {
+	teros squaddieinterface.Interface
+	wolf  squaddieinterface.Interface
+
+	callWolf powerinterface.Interface
+
+	repos *repositories.RepositoryCollection
+}
+
+var _ = Suite(&SummonForecastSuite{})
+
+func (suite *SummonForecastSuite) SetUpTest(checker *C) {
+	suite.teros = squaddie.NewSquaddieBuilder().Teros().WithID("teros").Build()
+	suite.wolf = squaddie.NewSquaddieBuilder().WithName("Wolf").WithID("wolf").Build()
+
+	suite.callWolf = power.NewPowerBuilder().WithName("Call Wolf").TargetsSelf().Summons("wolf", 3, 2).Build()
+
+	squaddieRepo := squaddie.NewSquaddieRepository()
+	squaddieRepo.AddSquaddies([]squaddieinterface.Interface{suite.teros, suite.wolf})
+
+	powerRepo := powerrepository.NewPowerRepository()
+	powerRepo.AddSlicePowerSource([]powerinterface.Interface{suite.callWolf})
+
+	suite.repos = &repositories.RepositoryCollection{PowerRepo: powerRepo, SquaddieRepo: squaddieRepo}
+}
+
+func (suite *SummonForecastSuite) forecastCallWolf() *powerattackforecast.Forecast {
+	forecast := powerattackforecast.NewForecastBuilder().
+		Setup(&powerusagescenario.Setup{
+			UserID:          suite.teros.ID(),
+			PowerID:         suite.callWolf.ID(),
+			Targets:         []string{suite.teros.ID()},
+			IsCounterAttack: false,
+		}).
+		Repositories(suite.repos).
+		OffenseStrategy(&squaddiestats.CalculateSquaddieOffenseStats{}).
+		Build()
+	forecast.CalculateForecast()
+	return forecast
+}
+
+func (suite *SummonForecastSuite) TestForecastNamesTheSummon(checker *C) {
+	forecast := suite.forecastCallWolf()
+
+	checker.Assert(forecast.Summon(), NotNil)
+	checker.Assert(forecast.Summon().TemplateID, Equals, "wolf")
+	checker.Assert(forecast.Summon().SquaddieID, Equals, "teros_wolf_1")
+	checker.Assert(forecast.Summon().Turns, Equals, 3)
+}
+
+func (suite *SummonForecastSuite) TestSummonIDSkipsIDsInUse(checker *C) {
+	firstWolf, _ := suite.repos.SquaddieRepo.CloneSquaddieWithNewID(suite.wolf, "teros_wolf_1")
+	suite.repos.SquaddieRepo.AddSquaddie(firstWolf)
+
+	forecast := suite.forecastCallWolf()
+
+	checker.Assert(forecast.Summon().SquaddieID, Equals, "teros_wolf_2")
+}
+
+func (suite *SummonForecastSuite) TestPowersThatDoNotSummonHaveNoSummonForecast(checker *C) {
+	shout := power.NewPowerBuilder().WithName("Shout").TargetsSelf().Build()
+	suite.repos.PowerRepo.AddSlicePowerSource([]powerinterface.Interface{shout})
+
+	forecast := powerattackforecast.NewForecastBuilder().
+		Setup(&powerusagescenario.Setup{
+			UserID:          suite.teros.ID(),
+			PowerID:         shout.ID(),
+			Targets:         []string{suite.teros.ID()},
+			IsCounterAttack: false,
+		}).
+		Repositories(suite.repos).
+		OffenseStrategy(&squaddiestats.CalculateSquaddieOffenseStats{}).
+		Build()
+	forecast.CalculateForecast()
+
+	checker.Assert(forecast.Summon(), IsNil)
+}
+
+type CounterAttackVariantForecast struct {
+	teros  squaddieinterface.Interface
+	bandit squaddieinterface.Interface
+
+	rapier powerinterface.Interface
+	pike   powerinterface.Interface
+
+	repos *repositories.RepositoryCollection
+}
+
+var _ = Suite(&CounterAttackVariantForecast{})
+
+func (suite *CounterAttackVariantForecast) SetUpTest(checker *C) {
+	suite.teros = squaddie.NewSquaddieBuilder().Teros().Build()
+	suite.bandit = squaddie.NewSquaddieBuilder().Bandit().Build()
+
+	suite.rapier = power.NewPowerBuilder().WithName("Rapier").TargetsFoe().CanBeEquipped().DealsDamage(1).Riposte().Build()
+	suite.pike = power.NewPowerBuilder().WithName("Pike").TargetsFoe().CanBeEquipped().DealsDamage(1).FirstStrike().CounterAttacksPerTurn(1).Build()
+
+	squaddieRepo := squaddie.NewSquaddieRepository()
+	squaddieRepo.AddSquaddies([]squaddieinterface.Interface{suite.teros, suite.bandit})
+
+	powerRepo := powerrepository.NewPowerRepository()
+	powerRepo.AddSlicePowerSource([]powerinterface.Interface{suite.rapier, suite.pike})
+
+	suite.repos = &repositories.RepositoryCollection{PowerRepo: powerRepo, SquaddieRepo: squaddieRepo}
+
+	checkEquip := powerequip.CheckRepositories{}
+	checkEquip.LoadAllOfSquaddieInnatePowers(suite.teros, []*powerreference.Reference{suite.rapier.GetReference()}, suite.repos)
+	checkEquip.LoadAllOfSquaddieInnatePowers(suite.bandit, []*powerreference.Reference{suite.pike.GetReference()}, suite.repos)
+	checkEquip.SquaddieEquipPower(suite.bandit, suite.pike.ID(), suite.repos)
+}
+
+func (suite *CounterAttackVariantForecast) forecastRapierOnBandit() powerattackforecast.CalculationInterface {
+	forecast := powerattackforecast.NewForecastBuilder().
+		Setup(&powerusagescenario.Setup{
+			UserID:          suite.teros.ID(),
+			PowerID:         suite.rapier.ID(),
+			Targets:         []string{suite.bandit.ID()},
+			IsCounterAttack: false,
+		}).
+		Repositories(suite.repos).
+		OffenseStrategy(&squaddiestats.CalculateSquaddieOffenseStats{}).
+		Build()
+	forecast.CalculateForecast()
+	return forecast.ForecastedResultPerTarget()[0]
+}
+
+func (suite *CounterAttackVariantForecast) TestFirstStrikeCountersBeforeTheAttack(checker *C) {
+	calculation := suite.forecastRapierOnBandit()
+
+	checker.Assert(calculation.CounterAttack(), NotNil)
+	checker.Assert(calculation.CounterAttackStrikesFirst(), Equals, true)
+}
+
+func (suite *CounterAttackVariantForecast) TestRiposteCountersTheCounterAttack(checker *C) {
+	calculation := suite.forecastRapierOnBandit()
+
+	checker.Assert(calculation.Riposte(), NotNil)
+	checker.Assert(calculation.RiposteSetup().UserID, Equals, suite.teros.ID())
+	checker.Assert(calculation.RiposteSetup().Targets, DeepEquals, []string{suite.bandit.ID()})
+	checker.Assert(calculation.RiposteSetup().IsCounterAttack, Equals, true)
+	checker.Assert(calculation.Riposte().AttackerContext.IsCounterAttack(), Equals, true)
+}
+
+func (suite *CounterAttackVariantForecast) TestNoCounterAttackAfterReachingTheLimit(checker *C) {
+	suite.bandit.MarkCounterAttackMade()
+
+	calculation := suite.forecastRapierOnBandit()
+
+	checker.Assert(calculation.CounterAttack(), IsNil)
+	checker.Assert(calculation.Riposte(), IsNil)
+}
+
+type GuardReactionForecast struct {
+	teros  squaddieinterface.Interface
+	lini   squaddieinterface.Interface
+	bandit squaddieinterface.Interface
+
+	axe powerinterface.Interface
+
+	repos *repositories.RepositoryCollection
+}
+
+var _ = Suite(&GuardReactionForecast{})
+
+func (suite *GuardReactionForecast) SetUpTest(checker *C) {
+	suite.teros = squaddie.NewSquaddieBuilder().Teros().Build()
+	suite.lini = squaddie.NewSquaddieBuilder().Lini().Build()
+	suite.bandit = squaddie.NewSquaddieBuilder().Bandit().Build()
+
+	suite.axe = power.NewPowerBuilder().WithName("Axe").TargetsFoe().DealsDamage(1).Build()
+
+	squaddieRepo := squaddie.NewSquaddieRepository()
+	squaddieRepo.AddSquaddies([]squaddieinterface.Interface{suite.teros, suite.lini, suite.bandit})
+
+	powerRepo := powerrepository.NewPowerRepository()
+	powerRepo.AddSlicePowerSource([]powerinterface.Interface{suite.axe})
+
+	suite.repos = &repositories.RepositoryCollection{PowerRepo: powerRepo, SquaddieRepo: squaddieRepo}
+
+	checkEquip := powerequip.CheckRepositories{}
+	checkEquip.LoadAllOfSquaddieInnatePowers(suite.bandit, []*powerreference.Reference{suite.axe.GetReference()}, suite.repos)
+}
+
+func (suite *GuardReactionForecast) forecastAxeOnLini() powerattackforecast.CalculationInterface {
+	forecast := powerattackforecast.NewForecastBuilder().
+		Setup(&powerusagescenario.Setup{
+			UserID:          suite.bandit.ID(),
+			PowerID:         suite.axe.ID(),
+			Targets:         []string{suite.lini.ID()},
+			IsCounterAttack: false,
+		}).
+		Repositories(suite.repos).
+		OffenseStrategy(&squaddiestats.CalculateSquaddieOffenseStats{}).
+		Build()
+	forecast.CalculateForecast()
+	return forecast.ForecastedResultPerTarget()[0]
+}
+
+func (suite *GuardReactionForecast) TestGuardianTakesTheAttack(checker *C) {
+	suite.teros.Guard(suite.lini.ID())
+
+	calculation := suite.forecastAxeOnLini()
+
+	checker.Assert(calculation.Reaction(), NotNil)
+	checker.Assert(calculation.Reaction().Kind, Equals, reaction.Guard)
+	checker.Assert(calculation.Reaction().SquaddieID, Equals, suite.teros.ID())
+	checker.Assert(calculation.Reaction().ProtectedSquaddieID, Equals, suite.lini.ID())
+	checker.Assert(calculation.Setup().Targets, DeepEquals, []string{suite.teros.ID()})
+	checker.Assert(calculation.Attack().DefenderContext.TargetID(), Equals, suite.teros.ID())
+}
+
+func (suite *GuardReactionForecast) TestNoReactionWithoutAGuardian(checker *C) {
+	calculation := suite.forecastAxeOnLini()
+
+	checker.Assert(calculation.Reaction(), IsNil)
+	checker.Assert(calculation.Setup().Targets, DeepEquals, []string{suite.lini.ID()})
+}
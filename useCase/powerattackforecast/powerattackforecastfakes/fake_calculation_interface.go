@@ -40,6 +40,16 @@ type FakeCalculationInterface struct {
 	counterAttackSetupReturnsOnCall map[int]struct {
 		result1 *powerusagescenario.Setup
 	}
+	CounterAttackStrikesFirstStub        func() bool
+	counterAttackStrikesFirstMutex       sync.RWMutex
+	counterAttackStrikesFirstArgsForCall []struct {
+	}
+	counterAttackStrikesFirstReturns struct {
+		result1 bool
+	}
+	counterAttackStrikesFirstReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	HealingForecastStub        func() *powerattackforecast.HealingForecast
 	healingForecastMutex       sync.RWMutex
 	healingForecastArgsForCall []struct {
@@ -50,6 +60,16 @@ type FakeCalculationInterface struct {
 	healingForecastReturnsOnCall map[int]struct {
 		result1 *powerattackforecast.HealingForecast
 	}
+	ReactionStub        func() *powerattackforecast.ReactionForecast
+	reactionMutex       sync.RWMutex
+	reactionArgsForCall []struct {
+	}
+	reactionReturns struct {
+		result1 *powerattackforecast.ReactionForecast
+	}
+	reactionReturnsOnCall map[int]struct {
+		result1 *powerattackforecast.ReactionForecast
+	}
 	RepositoriesStub        func() *repositories.RepositoryCollection
 	repositoriesMutex       sync.RWMutex
 	repositoriesArgsForCall []struct {
@@ -60,6 +80,26 @@ type FakeCalculationInterface struct {
 	repositoriesReturnsOnCall map[int]struct {
 		result1 *repositories.RepositoryCollection
 	}
+	RiposteStub        func() *powerattackforecast.AttackForecast
+	riposteMutex       sync.RWMutex
+	riposteArgsForCall []struct {
+	}
+	riposteReturns struct {
+		result1 *powerattackforecast.AttackForecast
+	}
+	riposteReturnsOnCall map[int]struct {
+		result1 *powerattackforecast.AttackForecast
+	}
+	RiposteSetupStub        func() *powerusagescenario.Setup
+	riposteSetupMutex       sync.RWMutex
+	riposteSetupArgsForCall []struct {
+	}
+	riposteSetupReturns struct {
+		result1 *powerusagescenario.Setup
+	}
+	riposteSetupReturnsOnCall map[int]struct {
+		result1 *powerusagescenario.Setup
+	}
 	SetupStub        func() *powerusagescenario.Setup
 	setupMutex       sync.RWMutex
 	setupArgsForCall []struct {
@@ -233,6 +273,59 @@ func (fake *FakeCalculationInterface) CounterAttackSetupReturnsOnCall(i int, res
 	}{result1}
 }
 
+func (fake *FakeCalculationInterface) CounterAttackStrikesFirst() bool {
+	fake.counterAttackStrikesFirstMutex.Lock()
+	ret, specificReturn := fake.counterAttackStrikesFirstReturnsOnCall[len(fake.counterAttackStrikesFirstArgsForCall)]
+	fake.counterAttackStrikesFirstArgsForCall = append(fake.counterAttackStrikesFirstArgsForCall, struct {
+	}{})
+	stub := fake.CounterAttackStrikesFirstStub
+	fakeReturns := fake.counterAttackStrikesFirstReturns
+	fake.recordInvocation("CounterAttackStrikesFirst", []interface{}{})
+	fake.counterAttackStrikesFirstMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeCalculationInterface) CounterAttackStrikesFirstCallCount() int {
+	fake.counterAttackStrikesFirstMutex.RLock()
+	defer fake.counterAttackStrikesFirstMutex.RUnlock()
+	return len(fake.counterAttackStrikesFirstArgsForCall)
+}
+
+func (fake *FakeCalculationInterface) CounterAttackStrikesFirstCalls(stub func() bool) {
+	fake.counterAttackStrikesFirstMutex.Lock()
+	defer fake.counterAttackStrikesFirstMutex.Unlock()
+	fake.CounterAttackStrikesFirstStub = stub
+}
+
+func (fake *FakeCalculationInterface) CounterAttackStrikesFirstReturns(result1 bool) {
+	fake.counterAttackStrikesFirstMutex.Lock()
+	defer fake.counterAttackStrikesFirstMutex.Unlock()
+	fake.CounterAttackStrikesFirstStub = nil
+	fake.counterAttackStrikesFirstReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeCalculationInterface) CounterAttackStrikesFirstReturnsOnCall(i int, result1 bool) {
+	fake.counterAttackStrikesFirstMutex.Lock()
+	defer fake.counterAttackStrikesFirstMutex.Unlock()
+	fake.CounterAttackStrikesFirstStub = nil
+	if fake.counterAttackStrikesFirstReturnsOnCall == nil {
+		fake.counterAttackStrikesFirstReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.counterAttackStrikesFirstReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeCalculationInterface) HealingForecast() *powerattackforecast.HealingForecast {
 	fake.healingForecastMutex.Lock()
 	ret, specificReturn := fake.healingForecastReturnsOnCall[len(fake.healingForecastArgsForCall)]
@@ -286,6 +379,59 @@ func (fake *FakeCalculationInterface) HealingForecastReturnsOnCall(i int, result
 	}{result1}
 }
 
+func (fake *FakeCalculationInterface) Reaction() *powerattackforecast.ReactionForecast {
+	fake.reactionMutex.Lock()
+	ret, specificReturn := fake.reactionReturnsOnCall[len(fake.reactionArgsForCall)]
+	fake.reactionArgsForCall = append(fake.reactionArgsForCall, struct {
+	}{})
+	stub := fake.ReactionStub
+	fakeReturns := fake.reactionReturns
+	fake.recordInvocation("Reaction", []interface{}{})
+	fake.reactionMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeCalculationInterface) ReactionCallCount() int {
+	fake.reactionMutex.RLock()
+	defer fake.reactionMutex.RUnlock()
+	return len(fake.reactionArgsForCall)
+}
+
+func (fake *FakeCalculationInterface) ReactionCalls(stub func() *powerattackforecast.ReactionForecast) {
+	fake.reactionMutex.Lock()
+	defer fake.reactionMutex.Unlock()
+	fake.ReactionStub = stub
+}
+
+func (fake *FakeCalculationInterface) ReactionReturns(result1 *powerattackforecast.ReactionForecast) {
+	fake.reactionMutex.Lock()
+	defer fake.reactionMutex.Unlock()
+	fake.ReactionStub = nil
+	fake.reactionReturns = struct {
+		result1 *powerattackforecast.ReactionForecast
+	}{result1}
+}
+
+func (fake *FakeCalculationInterface) ReactionReturnsOnCall(i int, result1 *powerattackforecast.ReactionForecast) {
+	fake.reactionMutex.Lock()
+	defer fake.reactionMutex.Unlock()
+	fake.ReactionStub = nil
+	if fake.reactionReturnsOnCall == nil {
+		fake.reactionReturnsOnCall = make(map[int]struct {
+			result1 *powerattackforecast.ReactionForecast
+		})
+	}
+	fake.reactionReturnsOnCall[i] = struct {
+		result1 *powerattackforecast.ReactionForecast
+	}{result1}
+}
+
 func (fake *FakeCalculationInterface) Repositories() *repositories.RepositoryCollection {
 	fake.repositoriesMutex.Lock()
 	ret, specificReturn := fake.repositoriesReturnsOnCall[len(fake.repositoriesArgsForCall)]
@@ -339,6 +485,112 @@ func (fake *FakeCalculationInterface) RepositoriesReturnsOnCall(i int, result1 *
 	}{result1}
 }
 
+func (fake *FakeCalculationInterface) Riposte() *powerattackforecast.AttackForecast {
+	fake.riposteMutex.Lock()
+	ret, specificReturn := fake.riposteReturnsOnCall[len(fake.riposteArgsForCall)]
+	fake.riposteArgsForCall = append(fake.riposteArgsForCall, struct {
+	}{})
+	stub := fake.RiposteStub
+	fakeReturns := fake.riposteReturns
+	fake.recordInvocation("Riposte", []interface{}{})
+	fake.riposteMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeCalculationInterface) RiposteCallCount() int {
+	fake.riposteMutex.RLock()
+	defer fake.riposteMutex.RUnlock()
+	return len(fake.riposteArgsForCall)
+}
+
+func (fake *FakeCalculationInterface) RiposteCalls(stub func() *powerattackforecast.AttackForecast) {
+	fake.riposteMutex.Lock()
+	defer fake.riposteMutex.Unlock()
+	fake.RiposteStub = stub
+}
+
+func (fake *FakeCalculationInterface) RiposteReturns(result1 *powerattackforecast.AttackForecast) {
+	fake.riposteMutex.Lock()
+	defer fake.riposteMutex.Unlock()
+	fake.RiposteStub = nil
+	fake.riposteReturns = struct {
+		result1 *powerattackforecast.AttackForecast
+	}{result1}
+}
+
+func (fake *FakeCalculationInterface) RiposteReturnsOnCall(i int, result1 *powerattackforecast.AttackForecast) {
+	fake.riposteMutex.Lock()
+	defer fake.riposteMutex.Unlock()
+	fake.RiposteStub = nil
+	if fake.riposteReturnsOnCall == nil {
+		fake.riposteReturnsOnCall = make(map[int]struct {
+			result1 *powerattackforecast.AttackForecast
+		})
+	}
+	fake.riposteReturnsOnCall[i] = struct {
+		result1 *powerattackforecast.AttackForecast
+	}{result1}
+}
+
+func (fake *FakeCalculationInterface) RiposteSetup() *powerusagescenario.Setup {
+	fake.riposteSetupMutex.Lock()
+	ret, specificReturn := fake.riposteSetupReturnsOnCall[len(fake.riposteSetupArgsForCall)]
+	fake.riposteSetupArgsForCall = append(fake.riposteSetupArgsForCall, struct {
+	}{})
+	stub := fake.RiposteSetupStub
+	fakeReturns := fake.riposteSetupReturns
+	fake.recordInvocation("RiposteSetup", []interface{}{})
+	fake.riposteSetupMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeCalculationInterface) RiposteSetupCallCount() int {
+	fake.riposteSetupMutex.RLock()
+	defer fake.riposteSetupMutex.RUnlock()
+	return len(fake.riposteSetupArgsForCall)
+}
+
+func (fake *FakeCalculationInterface) RiposteSetupCalls(stub func() *powerusagescenario.Setup) {
+	fake.riposteSetupMutex.Lock()
+	defer fake.riposteSetupMutex.Unlock()
+	fake.RiposteSetupStub = stub
+}
+
+func (fake *FakeCalculationInterface) RiposteSetupReturns(result1 *powerusagescenario.Setup) {
+	fake.riposteSetupMutex.Lock()
+	defer fake.riposteSetupMutex.Unlock()
+	fake.RiposteSetupStub = nil
+	fake.riposteSetupReturns = struct {
+		result1 *powerusagescenario.Setup
+	}{result1}
+}
+
+func (fake *FakeCalculationInterface) RiposteSetupReturnsOnCall(i int, result1 *powerusagescenario.Setup) {
+	fake.riposteSetupMutex.Lock()
+	defer fake.riposteSetupMutex.Unlock()
+	fake.RiposteSetupStub = nil
+	if fake.riposteSetupReturnsOnCall == nil {
+		fake.riposteSetupReturnsOnCall = make(map[int]struct {
+			result1 *powerusagescenario.Setup
+		})
+	}
+	fake.riposteSetupReturnsOnCall[i] = struct {
+		result1 *powerusagescenario.Setup
+	}{result1}
+}
+
 func (fake *FakeCalculationInterface) Setup() *powerusagescenario.Setup {
 	fake.setupMutex.Lock()
 	ret, specificReturn := fake.setupReturnsOnCall[len(fake.setupArgsForCall)]
@@ -401,10 +653,18 @@ func (fake *FakeCalculationInterface) Invocations() map[string][][]interface{} {
 	defer fake.counterAttackMutex.RUnlock()
 	fake.counterAttackSetupMutex.RLock()
 	defer fake.counterAttackSetupMutex.RUnlock()
+	fake.counterAttackStrikesFirstMutex.RLock()
+	defer fake.counterAttackStrikesFirstMutex.RUnlock()
 	fake.healingForecastMutex.RLock()
 	defer fake.healingForecastMutex.RUnlock()
+	fake.reactionMutex.RLock()
+	defer fake.reactionMutex.RUnlock()
 	fake.repositoriesMutex.RLock()
 	defer fake.repositoriesMutex.RUnlock()
+	fake.riposteMutex.RLock()
+	defer fake.riposteMutex.RUnlock()
+	fake.riposteSetupMutex.RLock()
+	defer fake.riposteSetupMutex.RUnlock()
 	fake.setupMutex.RLock()
 	defer fake.setupMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -63,6 +63,9 @@ func (context *VersusContext) setDamageBreakdown(damageDealtToTarget int, attack
 	distribution.DamageAbsorbedByArmor = context.calculateDamageAbsorbedByArmor(attackerContext, defenderContext, damageDealtToTarget)
 	damageDealtToTarget -= distribution.DamageAbsorbedByArmor
 
+	context.setDamageResistedOrAmplified(distribution, defenderContext, damageDealtToTarget)
+	damageDealtToTarget += distribution.DamageAmplified - distribution.DamageResisted
+
 	distribution.RawDamageDealt = damageDealtToTarget
 	distribution.IsFatalToTarget = distribution.RawDamageDealt >= defenderContext.HitPoints()
 
@@ -77,6 +80,23 @@ func (context *VersusContext) calculateDamageAbsorbedByArmor(attackerContext Att
 	return defenderContext.ArmorResistance()
 }
 
+// setDamageResistedOrAmplified applies the target's resistance or weakness to the damage that got past armor.
+//   Resistances of 100% or more block all of the damage. Fractions of a point of damage are ignored.
+func (context *VersusContext) setDamageResistedOrAmplified(distribution *damagedistribution.DamageDistribution, defenderContext DefenderContext, damageDealtToTarget int) {
+	resistancePercent := defenderContext.DamageResistancePercent()
+	if resistancePercent >= 100 {
+		distribution.DamageResisted = damageDealtToTarget
+		return
+	}
+
+	if resistancePercent > 0 {
+		distribution.DamageResisted = damageDealtToTarget * resistancePercent / 100
+		return
+	}
+
+	distribution.DamageAmplified = damageDealtToTarget * -resistancePercent / 100
+}
+
 func (context *VersusContext) setBarrierBurntAndDamageAbsorbed(distribution *damagedistribution.DamageDistribution, attackerContext AttackerContext, defenderContext DefenderContext, damageDealtToTarget int) {
 	barrierAbsorbsAllDamageAndExtraBurn := damageDealtToTarget+attackerContext.ExtraBarrierBurn() <= defenderContext.BarrierResistance()
 	if barrierAbsorbsAllDamageAndExtraBurn {
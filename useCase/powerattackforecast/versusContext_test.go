@@ -1,6 +1,7 @@
 package powerattackforecast_test
 
 import (
+	"github.com/chadius/terosgamerules/entity/damagetype"
 	"github.com/chadius/terosgamerules/entity/power"
 	"github.com/chadius/terosgamerules/entity/powerinterface"
 	"github.com/chadius/terosgamerules/entity/powerrepository"
@@ -331,3 +332,84 @@ func (suite *VersusContextTestSuite) TestKnowsIfAttackIsFatalToTarget(checker *C
 	)
 	checker.Assert(suite.versusContextSpearOnBandit.NormalDamage().IsFatalToTarget, Equals, true)
 }
+
+type VersusContextDamageTypeSuite struct {
+	teros     squaddieinterface.Interface
+	golem     squaddieinterface.Interface
+	fireSword powerinterface.Interface
+	iceSword  powerinterface.Interface
+	zapSword  powerinterface.Interface
+	sword     powerinterface.Interface
+
+	repos *repositories.RepositoryCollection
+}
+
+var _ = Suite(&VersusContextDamageTypeSuite{})
+
+func (suite *VersusContextDamageTypeSuite) SetUpTest(checker *C) {
+	suite.teros = squaddie.NewSquaddieBuilder().Teros().Strength(0).Build()
+	suite.golem = squaddie.NewSquaddieBuilder().WithName("Golem").AsEnemy().Armor(1).
+		DamageResistance(damagetype.Fire, 50).
+		DamageResistance(damagetype.Ice, -50).
+		DamageResistance(damagetype.Lightning, 150).
+		Build()
+
+	suite.sword = power.NewPowerBuilder().WithName("sword").IsPhysical().TargetsFoe().DealsDamage(9).Build()
+	suite.fireSword = power.NewPowerBuilder().CloneOf(suite.sword).WithName("fire sword").DamageType(damagetype.Fire).Build()
+	suite.iceSword = power.NewPowerBuilder().CloneOf(suite.sword).WithName("ice sword").DamageType(damagetype.Ice).Build()
+	suite.zapSword = power.NewPowerBuilder().CloneOf(suite.sword).WithName("zap sword").DamageType(damagetype.Lightning).Build()
+
+	squaddieRepo := squaddie.NewSquaddieRepository()
+	squaddieRepo.AddSquaddies([]squaddieinterface.Interface{suite.teros, suite.golem})
+
+	powerRepo := powerrepository.NewPowerRepository()
+	powerRepo.AddSlicePowerSource([]powerinterface.Interface{suite.sword, suite.fireSword, suite.iceSword, suite.zapSword})
+
+	suite.repos = &repositories.RepositoryCollection{SquaddieRepo: squaddieRepo, PowerRepo: powerRepo}
+}
+
+func (suite *VersusContextDamageTypeSuite) calculateSwordOnGolem(sword powerinterface.Interface) *powerattackforecast.VersusContext {
+	setup := powerusagescenario.Setup{
+		UserID:          suite.teros.ID(),
+		PowerID:         sword.ID(),
+		Targets:         []string{suite.golem.ID()},
+		IsCounterAttack: false,
+	}
+
+	attackerContext := powerattackforecast.NewAttackerContext(&squaddiestats.CalculateSquaddieOffenseStats{})
+	attackerContext.Calculate(setup, suite.repos)
+
+	defenderContext := powerattackforecast.NewDefenderContext(suite.golem.ID(), &squaddiestats.CalculateSquaddieDefenseStats{})
+	defenderContext.Calculate(&setup, suite.repos)
+
+	versusContext := &powerattackforecast.VersusContext{}
+	versusContext.Calculate(*attackerContext, *defenderContext)
+	return versusContext
+}
+
+func (suite *VersusContextDamageTypeSuite) TestUntypedDamageIsNotResisted(checker *C) {
+	damage := suite.calculateSwordOnGolem(suite.sword).NormalDamage()
+	checker.Assert(damage.DamageAbsorbedByArmor, Equals, 1)
+	checker.Assert(damage.DamageResisted, Equals, 0)
+	checker.Assert(damage.DamageAmplified, Equals, 0)
+	checker.Assert(damage.RawDamageDealt, Equals, 8)
+}
+
+func (suite *VersusContextDamageTypeSuite) TestResistanceAppliesAfterArmor(checker *C) {
+	damage := suite.calculateSwordOnGolem(suite.fireSword).NormalDamage()
+	checker.Assert(damage.DamageAbsorbedByArmor, Equals, 1)
+	checker.Assert(damage.DamageResisted, Equals, 4)
+	checker.Assert(damage.RawDamageDealt, Equals, 4)
+}
+
+func (suite *VersusContextDamageTypeSuite) TestWeaknessAmplifiesDamage(checker *C) {
+	damage := suite.calculateSwordOnGolem(suite.iceSword).NormalDamage()
+	checker.Assert(damage.DamageAmplified, Equals, 4)
+	checker.Assert(damage.RawDamageDealt, Equals, 12)
+}
+
+func (suite *VersusContextDamageTypeSuite) TestResistanceCannotHealTheTarget(checker *C) {
+	damage := suite.calculateSwordOnGolem(suite.zapSword).NormalDamage()
+	checker.Assert(damage.DamageResisted, Equals, 8)
+	checker.Assert(damage.RawDamageDealt, Equals, 0)
+}
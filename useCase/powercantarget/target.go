@@ -1,11 +1,15 @@
 package powercantarget
 
 import (
+	"github.com/chadius/terosgamerules/entity/affiliation"
+	"github.com/chadius/terosgamerules/entity/faction"
+	"github.com/chadius/terosgamerules/entity/squaddieinterface"
 	"github.com/chadius/terosgamerules/usecase/repositories"
 )
 
 // ValidTargetStrategy describes the shape of classes that check for valid attacks.
 type ValidTargetStrategy interface {
+	IsValidAction(userID string, powerID string, targetIDs []string, repos *repositories.RepositoryCollection) (bool, InvalidTargetReason)
 	IsValidTarget(userID string, powerID string, targetID string, repos *repositories.RepositoryCollection) (bool, InvalidTargetReason)
 	CanTargetTargetAffiliationWithPower(userID string, powerID string, targetID string, repos *repositories.RepositoryCollection) bool
 }
@@ -13,12 +17,27 @@ type ValidTargetStrategy interface {
 // ValidTargetChecker applies business logic to figure out if the user squaddie can target another squaddie with a given power.
 type ValidTargetChecker struct{}
 
+// IsValidAction checks to see if the user can use the power on the targets as a whole, no matter who they are.
+//   Attack and healing powers need at least one target.
+//   returns a bool and a InvalidTargetReason.
+//   If the action is valid, the bool is true and the InvalidTargetReason is TargetIsValid.
+func (v *ValidTargetChecker) IsValidAction(userID string, powerID string, targetIDs []string, repos *repositories.RepositoryCollection) (bool, InvalidTargetReason) {
+	if userCanUsePower, reason := v.userCanUsePower(userID, powerID, repos); !userCanUsePower {
+		return false, reason
+	}
+
+	if !(v.powerHasEnoughTargets(powerID, targetIDs, repos)) {
+		return false, PowerNeedsATarget
+	}
+	return true, TargetIsValid
+}
+
 // IsValidTarget checks to see if the user can apply the power against the target.
 //   returns a bool and a InvalidTargetReason.
 //   If the action is valid, the bool is true and the InvalidTargetReason is TargetIsValid.
 func (v *ValidTargetChecker) IsValidTarget(userID string, powerID string, targetID string, repos *repositories.RepositoryCollection) (bool, InvalidTargetReason) {
-	if !(v.targetIsStillAlive(userID, repos)) {
-		return false, UserIsDead
+	if userCanUsePower, reason := v.userCanUsePower(userID, powerID, repos); !userCanUsePower {
+		return false, reason
 	}
 
 	if !(v.targetIsStillAlive(targetID, repos) || v.userCanTargetDead()) {
@@ -28,6 +47,42 @@ func (v *ValidTargetChecker) IsValidTarget(userID string, powerID string, target
 	if v.CanTargetTargetAffiliationWithPower(userID, powerID, targetID, repos) == false {
 		return false, PowerCannotTargetAffiliation
 	}
+
+	if !(v.targetIsWithinRange(userID, powerID, targetID, repos)) {
+		return false, TargetIsOutOfRange
+	}
+	return true, TargetIsValid
+}
+
+// userCanUsePower checks the user and the power, no matter who the targets are.
+func (v *ValidTargetChecker) userCanUsePower(userID string, powerID string, repos *repositories.RepositoryCollection) (bool, InvalidTargetReason) {
+	if repos.PowerRepo.GetPowerByID(powerID) == nil {
+		return false, PowerDoesNotExist
+	}
+
+	if !(v.targetIsStillAlive(userID, repos)) {
+		return false, UserIsDead
+	}
+
+	if !(v.userCanAffordPower(userID, powerID, repos)) {
+		return false, UserCannotAffordPower
+	}
+
+	if !(v.powerIsOffCooldown(userID, powerID, repos)) {
+		return false, PowerIsOnCooldown
+	}
+
+	if !(v.powerHasChargesLeft(userID, powerID, repos)) {
+		return false, PowerHasNoChargesLeft
+	}
+
+	if !(v.summonTemplateExists(powerID, repos)) {
+		return false, SummonTemplateNotFound
+	}
+
+	if !(v.userIsUnderSummonLimit(userID, powerID, repos)) {
+		return false, SummonLimitReached
+	}
 	return true, TargetIsValid
 }
 
@@ -42,24 +97,144 @@ func (v *ValidTargetChecker) CanTargetTargetAffiliationWithPower(userID string,
 		return true
 	}
 
-	areFriends := user.AffiliationLogic().IsFriendsWith(target.AffiliationLogic())
-	if powerUsed.CanPowerTargetFriend() && areFriends {
+	if powerUsed.CanPowerTargetFriend() && AreFriends(user, target, repos) {
+		return true
+	}
+
+	if powerUsed.CanPowerTargetFriend() && powerUsed.Cleanses() && isFriendUnderAffiliationOverride(user, target, repos) {
 		return true
 	}
 
-	areFoes := user.AffiliationLogic().IsFoesWith(target.AffiliationLogic())
-	if powerUsed.CanPowerTargetFoe() && areFoes {
+	if powerUsed.CanPowerTargetFoe() && AreFoes(user, target, repos) {
 		return true
 	}
 
 	return false
 }
 
+// AreFriends returns true if the squaddies treat each other as friends.
+//   The faction relationship matrix is consulted first, then the squaddies' affiliation logic.
+func AreFriends(user, target squaddieinterface.Interface, repos *repositories.RepositoryCollection) bool {
+	return areAffiliationsFriends(user.AffiliationLogic(), target.AffiliationLogic(), repos)
+}
+
+// isFriendUnderAffiliationOverride returns true if the target was charmed or confused away from the user's side.
+//   Cleansing powers can still reach them.
+func isFriendUnderAffiliationOverride(user, target squaddieinterface.Interface, repos *repositories.RepositoryCollection) bool {
+	if !target.HasAffiliationOverride() {
+		return false
+	}
+	return areAffiliationsFriends(user.AffiliationLogic(), target.BaseAffiliationLogic(), repos)
+}
+
+func areAffiliationsFriends(userAffiliation, targetAffiliation affiliation.Interface, repos *repositories.RepositoryCollection) bool {
+	if repos.FactionRepo != nil {
+		if relationship, relationshipFound := repos.FactionRepo.GetRelationship(userAffiliation.Name(), targetAffiliation.Name()); relationshipFound {
+			return relationship == faction.Friend
+		}
+	}
+	return userAffiliation.IsFriendsWith(targetAffiliation)
+}
+
+// AreFoes returns true if the squaddies treat each other as foes.
+//   The faction relationship matrix is consulted first, then the squaddies' affiliation logic.
+func AreFoes(user, target squaddieinterface.Interface, repos *repositories.RepositoryCollection) bool {
+	if relationship, relationshipFound := getFactionRelationship(user, target, repos); relationshipFound {
+		return relationship == faction.Foe
+	}
+	return user.AffiliationLogic().IsFoesWith(target.AffiliationLogic())
+}
+
+func getFactionRelationship(user, target squaddieinterface.Interface, repos *repositories.RepositoryCollection) (string, bool) {
+	if repos.FactionRepo == nil {
+		return "", false
+	}
+	return repos.FactionRepo.GetRelationship(user.AffiliationLogic().Name(), target.AffiliationLogic().Name())
+}
+
 // userCanTargetDead returns true if the target is dead and the power can target dead.
 func (v *ValidTargetChecker) userCanTargetDead() bool {
 	return false
 }
 
+// userCanAffordPower returns true if the user has enough mana to use the power.
+func (v *ValidTargetChecker) userCanAffordPower(userID string, powerID string, repos *repositories.RepositoryCollection) bool {
+	user := repos.SquaddieRepo.GetOriginalSquaddieByID(userID)
+	powerUsed := repos.PowerRepo.GetPowerByID(powerID)
+	return user.CanAffordManaCost(powerUsed.ManaCost())
+}
+
+// powerIsOffCooldown returns true if the user has waited long enough to use the power again.
+func (v *ValidTargetChecker) powerIsOffCooldown(userID string, powerID string, repos *repositories.RepositoryCollection) bool {
+	user := repos.SquaddieRepo.GetOriginalSquaddieByID(userID)
+	return user.RemainingPowerCooldown(powerID) <= 0
+}
+
+// powerHasChargesLeft returns true if the user can use the power again this battle.
+func (v *ValidTargetChecker) powerHasChargesLeft(userID string, powerID string, repos *repositories.RepositoryCollection) bool {
+	user := repos.SquaddieRepo.GetOriginalSquaddieByID(userID)
+	powerUsed := repos.PowerRepo.GetPowerByID(powerID)
+	if powerUsed.ChargesPerBattle() == 0 {
+		return true
+	}
+	return user.PowerChargesUsed(powerID) < powerUsed.ChargesPerBattle()
+}
+
+// powerHasEnoughTargets returns true if the power does not attack or heal, or there is a target to use it on.
+func (v *ValidTargetChecker) powerHasEnoughTargets(powerID string, targetIDs []string, repos *repositories.RepositoryCollection) bool {
+	powerUsed := repos.PowerRepo.GetPowerByID(powerID)
+	if !powerUsed.CanAttack() && !powerUsed.CanHeal() {
+		return true
+	}
+	return len(targetIDs) > 0
+}
+
+// summonTemplateExists returns true if the power does not summon, or the squaddie it summons exists.
+func (v *ValidTargetChecker) summonTemplateExists(powerID string, repos *repositories.RepositoryCollection) bool {
+	powerUsed := repos.PowerRepo.GetPowerByID(powerID)
+	if !powerUsed.CanSummon() {
+		return true
+	}
+	return repos.SquaddieRepo.GetOriginalSquaddieByID(powerUsed.SummonTemplateID()) != nil
+}
+
+// userIsUnderSummonLimit returns true if the user has room for another summon.
+func (v *ValidTargetChecker) userIsUnderSummonLimit(userID string, powerID string, repos *repositories.RepositoryCollection) bool {
+	powerUsed := repos.PowerRepo.GetPowerByID(powerID)
+	if !powerUsed.CanSummon() || powerUsed.SummonLimit() == 0 {
+		return true
+	}
+	return CountActiveSummons(userID, repos) < powerUsed.SummonLimit()
+}
+
+// CountActiveSummons returns the number of squaddies the summoner summoned that are still in the battle.
+func CountActiveSummons(summonerID string, repos *repositories.RepositoryCollection) int {
+	activeSummons := 0
+	for _, squaddieID := range repos.SquaddieRepo.GetAllSquaddieIDs() {
+		summon := repos.SquaddieRepo.GetOriginalSquaddieByID(squaddieID)
+		if summon.SummonerID() == summonerID && !summon.IsDead() {
+			activeSummons++
+		}
+	}
+	return activeSummons
+}
+
+// targetIsWithinRange returns true if the power can reach the target from where the user stands.
+func (v *ValidTargetChecker) targetIsWithinRange(userID string, powerID string, targetID string, repos *repositories.RepositoryCollection) bool {
+	powerUsed := repos.PowerRepo.GetPowerByID(powerID)
+	return IsWithinRange(userID, targetID, powerUsed.Range(), repos)
+}
+
+// IsWithinRange returns true if the squaddies stand at most distance steps apart.
+//   Squaddies that are not both on the battle grid are always within range.
+func IsWithinRange(squaddieID, otherSquaddieID string, distance int, repos *repositories.RepositoryCollection) bool {
+	if repos.Grid == nil {
+		return true
+	}
+	distanceBetween, bothOnGrid := repos.Grid.DistanceBetween(squaddieID, otherSquaddieID)
+	return !bothOnGrid || distanceBetween <= distance
+}
+
 // targetIsStillAlive returns true if the target is alive.
 func (v *ValidTargetChecker) targetIsStillAlive(targetID string, repos *repositories.RepositoryCollection) bool {
 	target := repos.SquaddieRepo.GetSquaddieByID(targetID)
@@ -75,4 +250,12 @@ const (
 	PowerCannotTargetAffiliation InvalidTargetReason = "PowerCannotTargetAffiliation"
 	TargetIsDead                 InvalidTargetReason = "TargetIsDead"
 	UserIsDead                   InvalidTargetReason = "UserIsDead"
+	PowerDoesNotExist            InvalidTargetReason = "PowerDoesNotExist"
+	UserCannotAffordPower        InvalidTargetReason = "UserCannotAffordPower"
+	PowerIsOnCooldown            InvalidTargetReason = "PowerIsOnCooldown"
+	PowerHasNoChargesLeft        InvalidTargetReason = "PowerHasNoChargesLeft"
+	SummonTemplateNotFound       InvalidTargetReason = "SummonTemplateNotFound"
+	SummonLimitReached           InvalidTargetReason = "SummonLimitReached"
+	PowerNeedsATarget            InvalidTargetReason = "PowerNeedsATarget"
+	TargetIsOutOfRange           InvalidTargetReason = "TargetIsOutOfRange"
 )
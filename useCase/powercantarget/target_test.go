@@ -1,6 +1,8 @@
 package powercantarget_test
 
 import (
+	"github.com/chadius/terosgamerules/entity/battlegrid"
+	"github.com/chadius/terosgamerules/entity/faction"
 	"github.com/chadius/terosgamerules/entity/power"
 	"github.com/chadius/terosgamerules/entity/powerinterface"
 	"github.com/chadius/terosgamerules/entity/powerreference"
@@ -261,3 +263,226 @@ func (suite *TargetingCheck) TestTargetGivesUserIsDeadReasonForFailure(checker *
 	checker.Assert(canTarget, Equals, false)
 	checker.Assert(reasonForInvalidTarget, Equals, powercantarget.UserIsDead)
 }
+
+func (suite *TargetingCheck) TestTargetGivesUserCannotAffordPowerReasonForFailure(checker *C) {
+	fireball := power.NewPowerBuilder().WithName("fireball").TargetsFoe().IsSpell().DealsDamage(3).ManaCost(2).Build()
+	suite.powerRepo.AddPower(fireball)
+	mage := squaddie.NewSquaddieBuilder().WithName("mage").AsPlayer().Mana(3).Build()
+	suite.squaddieRepo.AddSquaddie(mage)
+
+	canTarget, reasonForInvalidTarget := suite.targetStrategy.IsValidTarget(mage.ID(), fireball.ID(), suite.bandit.ID(), suite.repos)
+	checker.Assert(canTarget, Equals, true)
+	checker.Assert(reasonForInvalidTarget, Equals, powercantarget.TargetIsValid)
+
+	mage.ReduceMana(2)
+	canTarget, reasonForInvalidTarget = suite.targetStrategy.IsValidTarget(mage.ID(), fireball.ID(), suite.bandit.ID(), suite.repos)
+	checker.Assert(canTarget, Equals, false)
+	checker.Assert(reasonForInvalidTarget, Equals, powercantarget.UserCannotAffordPower)
+}
+
+func (suite *TargetingCheck) TestTargetGivesPowerIsOnCooldownReasonForFailure(checker *C) {
+	fireball := power.NewPowerBuilder().WithName("fireball").TargetsFoe().IsSpell().DealsDamage(3).Cooldown(2).Build()
+	suite.powerRepo.AddPower(fireball)
+
+	suite.teros.MarkPowerUsed(fireball.ID(), fireball.Cooldown())
+	canTarget, reasonForInvalidTarget := suite.targetStrategy.IsValidTarget(suite.teros.ID(), fireball.ID(), suite.bandit.ID(), suite.repos)
+	checker.Assert(canTarget, Equals, false)
+	checker.Assert(reasonForInvalidTarget, Equals, powercantarget.PowerIsOnCooldown)
+
+	suite.teros.ReducePowerCooldowns()
+	suite.teros.ReducePowerCooldowns()
+	canTarget, reasonForInvalidTarget = suite.targetStrategy.IsValidTarget(suite.teros.ID(), fireball.ID(), suite.bandit.ID(), suite.repos)
+	checker.Assert(canTarget, Equals, true)
+	checker.Assert(reasonForInvalidTarget, Equals, powercantarget.TargetIsValid)
+}
+
+func (suite *TargetingCheck) TestTargetGivesPowerHasNoChargesLeftReasonForFailure(checker *C) {
+	meteor := power.NewPowerBuilder().WithName("meteor").TargetsFoe().IsSpell().DealsDamage(9).ChargesPerBattle(1).Build()
+	suite.powerRepo.AddPower(meteor)
+
+	canTarget, reasonForInvalidTarget := suite.targetStrategy.IsValidTarget(suite.teros.ID(), meteor.ID(), suite.bandit.ID(), suite.repos)
+	checker.Assert(canTarget, Equals, true)
+	checker.Assert(reasonForInvalidTarget, Equals, powercantarget.TargetIsValid)
+
+	suite.teros.MarkPowerUsed(meteor.ID(), meteor.Cooldown())
+	canTarget, reasonForInvalidTarget = suite.targetStrategy.IsValidTarget(suite.teros.ID(), meteor.ID(), suite.bandit.ID(), suite.repos)
+	checker.Assert(canTarget, Equals, false)
+	checker.Assert(reasonForInvalidTarget, Equals, powercantarget.PowerHasNoChargesLeft)
+}
+
+func (suite *TargetingCheck) TestTargetGivesTargetIsOutOfRangeReasonForFailure(checker *C) {
+	bow := power.NewPowerBuilder().WithName("bow").TargetsFoe().DealsDamage(1).Range(2).Build()
+	suite.powerRepo.AddPower(bow)
+
+	suite.repos.Grid = battlegrid.NewGrid()
+	suite.repos.Grid.PlaceSquaddie(suite.teros.ID(), battlegrid.Coordinate{Row: 0, Column: 0})
+	suite.repos.Grid.PlaceSquaddie(suite.bandit.ID(), battlegrid.Coordinate{Row: 0, Column: 2})
+	suite.repos.Grid.PlaceSquaddie(suite.bandit2.ID(), battlegrid.Coordinate{Row: 3, Column: 0})
+
+	canTarget, reasonForInvalidTarget := suite.targetStrategy.IsValidTarget(suite.teros.ID(), bow.ID(), suite.bandit.ID(), suite.repos)
+	checker.Assert(canTarget, Equals, true)
+	checker.Assert(reasonForInvalidTarget, Equals, powercantarget.TargetIsValid)
+
+	canTarget, reasonForInvalidTarget = suite.targetStrategy.IsValidTarget(suite.teros.ID(), bow.ID(), suite.bandit2.ID(), suite.repos)
+	checker.Assert(canTarget, Equals, false)
+	checker.Assert(reasonForInvalidTarget, Equals, powercantarget.TargetIsOutOfRange)
+}
+
+func (suite *TargetingCheck) TestSquaddiesOffTheGridAreAlwaysInRange(checker *C) {
+	suite.repos.Grid = battlegrid.NewGrid()
+	suite.repos.Grid.PlaceSquaddie(suite.teros.ID(), battlegrid.Coordinate{Row: 0, Column: 0})
+
+	canTarget, reasonForInvalidTarget := suite.targetStrategy.IsValidTarget(suite.teros.ID(), suite.axe.ID(), suite.bandit.ID(), suite.repos)
+	checker.Assert(canTarget, Equals, true)
+	checker.Assert(reasonForInvalidTarget, Equals, powercantarget.TargetIsValid)
+}
+
+func (suite *TargetingCheck) TestTargetGivesSummonTemplateNotFoundReasonForFailure(checker *C) {
+	callGhost := power.NewPowerBuilder().WithName("call ghost").TargetsSelf().Summons("templateGhost", 0, 0).Build()
+	suite.powerRepo.AddPower(callGhost)
+
+	canTarget, reasonForInvalidTarget := suite.targetStrategy.IsValidTarget(suite.teros.ID(), callGhost.ID(), suite.teros.ID(), suite.repos)
+	checker.Assert(canTarget, Equals, false)
+	checker.Assert(reasonForInvalidTarget, Equals, powercantarget.SummonTemplateNotFound)
+}
+
+func (suite *TargetingCheck) TestTargetGivesSummonLimitReachedReasonForFailure(checker *C) {
+	callBandit := power.NewPowerBuilder().WithName("call bandit").TargetsSelf().Summons(suite.bandit2.ID(), 0, 1).Build()
+	suite.powerRepo.AddPower(callBandit)
+
+	canTarget, reasonForInvalidTarget := suite.targetStrategy.IsValidTarget(suite.teros.ID(), callBandit.ID(), suite.teros.ID(), suite.repos)
+	checker.Assert(canTarget, Equals, true)
+	checker.Assert(reasonForInvalidTarget, Equals, powercantarget.TargetIsValid)
+
+	summonedBandit, _ := suite.squaddieRepo.CloneSquaddieWithNewID(suite.bandit2, "summonedBandit")
+	summonedBandit.MarkAsSummon(suite.teros.ID(), 0)
+	suite.squaddieRepo.AddSquaddie(summonedBandit)
+	checker.Assert(powercantarget.CountActiveSummons(suite.teros.ID(), suite.repos), Equals, 1)
+
+	canTarget, reasonForInvalidTarget = suite.targetStrategy.IsValidTarget(suite.teros.ID(), callBandit.ID(), suite.teros.ID(), suite.repos)
+	checker.Assert(canTarget, Equals, false)
+	checker.Assert(reasonForInvalidTarget, Equals, powercantarget.SummonLimitReached)
+
+	summonedBandit.ReduceHitPoints(summonedBandit.CurrentHitPoints())
+	checker.Assert(powercantarget.CountActiveSummons(suite.teros.ID(), suite.repos), Equals, 0)
+	canTarget, _ = suite.targetStrategy.IsValidTarget(suite.teros.ID(), callBandit.ID(), suite.teros.ID(), suite.repos)
+	checker.Assert(canTarget, Equals, true)
+}
+
+func (suite *TargetingCheck) TestActionWithoutTargetsStillChecksTheUser(checker *C) {
+	callBandit := power.NewPowerBuilder().WithName("call bandit").TargetsSelf().Summons(suite.bandit2.ID(), 0, 1).Build()
+	suite.powerRepo.AddPower(callBandit)
+
+	isValid, reasonForInvalidAction := suite.targetStrategy.IsValidAction(suite.teros.ID(), callBandit.ID(), []string{}, suite.repos)
+	checker.Assert(isValid, Equals, true)
+	checker.Assert(reasonForInvalidAction, Equals, powercantarget.TargetIsValid)
+
+	summonedBandit, _ := suite.squaddieRepo.CloneSquaddieWithNewID(suite.bandit2, "summonedBandit")
+	summonedBandit.MarkAsSummon(suite.teros.ID(), 0)
+	suite.squaddieRepo.AddSquaddie(summonedBandit)
+	isValid, reasonForInvalidAction = suite.targetStrategy.IsValidAction(suite.teros.ID(), callBandit.ID(), []string{}, suite.repos)
+	checker.Assert(isValid, Equals, false)
+	checker.Assert(reasonForInvalidAction, Equals, powercantarget.SummonLimitReached)
+
+	suite.teros.ReduceHitPoints(suite.teros.MaxHitPoints())
+	isValid, reasonForInvalidAction = suite.targetStrategy.IsValidAction(suite.teros.ID(), callBandit.ID(), []string{}, suite.repos)
+	checker.Assert(isValid, Equals, false)
+	checker.Assert(reasonForInvalidAction, Equals, powercantarget.UserIsDead)
+}
+
+func (suite *TargetingCheck) TestAttackAndHealingPowersNeedATarget(checker *C) {
+	isValid, reasonForInvalidAction := suite.targetStrategy.IsValidAction(suite.teros.ID(), suite.axe.ID(), []string{}, suite.repos)
+	checker.Assert(isValid, Equals, false)
+	checker.Assert(reasonForInvalidAction, Equals, powercantarget.PowerNeedsATarget)
+
+	isValid, reasonForInvalidAction = suite.targetStrategy.IsValidAction(suite.lini.ID(), suite.healingStaff.ID(), []string{}, suite.repos)
+	checker.Assert(isValid, Equals, false)
+	checker.Assert(reasonForInvalidAction, Equals, powercantarget.PowerNeedsATarget)
+
+	isValid, reasonForInvalidAction = suite.targetStrategy.IsValidAction(suite.teros.ID(), suite.axe.ID(), []string{suite.bandit.ID()}, suite.repos)
+	checker.Assert(isValid, Equals, true)
+	checker.Assert(reasonForInvalidAction, Equals, powercantarget.TargetIsValid)
+}
+
+func (suite *TargetingCheck) TestActionWithoutTargetsStillChecksTheCostOfThePower(checker *C) {
+	summonStorm := power.NewPowerBuilder().WithName("summon storm").TargetsSelf().IsSpell().ManaCost(2).Cooldown(2).ChargesPerBattle(1).Build()
+	suite.powerRepo.AddPower(summonStorm)
+	mage := squaddie.NewSquaddieBuilder().WithName("mage").AsPlayer().Mana(3).Build()
+	suite.squaddieRepo.AddSquaddie(mage)
+
+	mage.ReduceMana(2)
+	isValid, reasonForInvalidAction := suite.targetStrategy.IsValidAction(mage.ID(), summonStorm.ID(), []string{}, suite.repos)
+	checker.Assert(isValid, Equals, false)
+	checker.Assert(reasonForInvalidAction, Equals, powercantarget.UserCannotAffordPower)
+
+	mage.GainMana(2)
+	mage.MarkPowerUsed(summonStorm.ID(), summonStorm.Cooldown())
+	isValid, reasonForInvalidAction = suite.targetStrategy.IsValidAction(mage.ID(), summonStorm.ID(), []string{}, suite.repos)
+	checker.Assert(isValid, Equals, false)
+	checker.Assert(reasonForInvalidAction, Equals, powercantarget.PowerIsOnCooldown)
+
+	mage.ReducePowerCooldowns()
+	mage.ReducePowerCooldowns()
+	isValid, reasonForInvalidAction = suite.targetStrategy.IsValidAction(mage.ID(), summonStorm.ID(), []string{}, suite.repos)
+	checker.Assert(isValid, Equals, false)
+	checker.Assert(reasonForInvalidAction, Equals, powercantarget.PowerHasNoChargesLeft)
+}
+
+func (suite *TargetingCheck) TestUnknownPowersAreInvalid(checker *C) {
+	isValid, reasonForInvalidAction := suite.targetStrategy.IsValidAction(suite.teros.ID(), "powerDoesNotExist", []string{}, suite.repos)
+	checker.Assert(isValid, Equals, false)
+	checker.Assert(reasonForInvalidAction, Equals, powercantarget.PowerDoesNotExist)
+
+	canTarget, reasonForInvalidTarget := suite.targetStrategy.IsValidTarget(suite.teros.ID(), "powerDoesNotExist", suite.bandit.ID(), suite.repos)
+	checker.Assert(canTarget, Equals, false)
+	checker.Assert(reasonForInvalidTarget, Equals, powercantarget.PowerDoesNotExist)
+}
+
+func (suite *TargetingCheck) TestFactionRelationshipsOverrideAffiliations(checker *C) {
+	suite.repos.FactionRepo = faction.NewRepository()
+	suite.repos.FactionRepo.SetRelationship("player", "enemy", faction.Friend)
+
+	checker.Assert(suite.targetStrategy.CanTargetTargetAffiliationWithPower(suite.teros.ID(), suite.axe.ID(), suite.bandit.ID(), suite.repos), Equals, false)
+	checker.Assert(suite.targetStrategy.CanTargetTargetAffiliationWithPower(suite.lini.ID(), suite.healingStaff.ID(), suite.bandit.ID(), suite.repos), Equals, true)
+	checker.Assert(suite.targetStrategy.CanTargetTargetAffiliationWithPower(suite.bandit.ID(), suite.healingStaff.ID(), suite.teros.ID(), suite.repos), Equals, true)
+}
+
+func (suite *TargetingCheck) TestNeutralFactionsCannotTargetEachOther(checker *C) {
+	suite.repos.FactionRepo = faction.NewRepository()
+	suite.repos.FactionRepo.SetRelationship("player", "enemy", faction.Neutral)
+
+	checker.Assert(suite.targetStrategy.CanTargetTargetAffiliationWithPower(suite.teros.ID(), suite.axe.ID(), suite.bandit.ID(), suite.repos), Equals, false)
+	checker.Assert(suite.targetStrategy.CanTargetTargetAffiliationWithPower(suite.lini.ID(), suite.healingStaff.ID(), suite.bandit.ID(), suite.repos), Equals, false)
+	checker.Assert(suite.targetStrategy.CanTargetTargetAffiliationWithPower(suite.teros.ID(), suite.axe.ID(), suite.bomb.ID(), suite.repos), Equals, true)
+}
+
+func (suite *TargetingCheck) TestFactionsWithoutRelationshipsUseAffiliations(checker *C) {
+	suite.repos.FactionRepo = faction.NewRepository()
+	suite.repos.FactionRepo.SetRelationship("player", "enemy", faction.Friend)
+
+	checker.Assert(powercantarget.AreFoes(suite.teros, suite.bomb, suite.repos), Equals, true)
+	checker.Assert(powercantarget.AreFriends(suite.teros, suite.lini, suite.repos), Equals, true)
+	checker.Assert(powercantarget.AreFriends(suite.teros, suite.citizen, suite.repos), Equals, true)
+}
+
+func (suite *TargetingCheck) TestSquaddiesInTheSameFactionAreFriends(checker *C) {
+	cultist := squaddie.NewSquaddieBuilder().WithName("cultist").InFaction("cult").Build()
+	cultLeader := squaddie.NewSquaddieBuilder().WithName("cultLeader").InFaction("cult").Build()
+	checker.Assert(powercantarget.AreFriends(cultist, cultLeader, suite.repos), Equals, true)
+	checker.Assert(powercantarget.AreFoes(cultist, suite.teros, suite.repos), Equals, true)
+
+	suite.repos.FactionRepo = faction.NewRepository()
+	suite.repos.FactionRepo.SetRelationship("cult", "player", faction.Friend)
+	checker.Assert(powercantarget.AreFriends(cultist, suite.teros, suite.repos), Equals, true)
+	checker.Assert(powercantarget.AreFoes(cultist, suite.teros, suite.repos), Equals, false)
+}
+
+func (suite *TargetingCheck) TestCleansingPowersCanReachCharmedFriends(checker *C) {
+	purify := power.NewPowerBuilder().WithName("Purify").TargetsFriend().Cleanses().Build()
+	suite.powerRepo.AddSlicePowerSource([]powerinterface.Interface{purify})
+	suite.teros.OverrideAffiliation(suite.bandit.AffiliationLogic(), 1)
+
+	checker.Assert(suite.targetStrategy.CanTargetTargetAffiliationWithPower(suite.lini.ID(), purify.ID(), suite.teros.ID(), suite.repos), Equals, true)
+	checker.Assert(suite.targetStrategy.CanTargetTargetAffiliationWithPower(suite.lini.ID(), suite.healingStaff.ID(), suite.teros.ID(), suite.repos), Equals, false)
+	checker.Assert(suite.targetStrategy.CanTargetTargetAffiliationWithPower(suite.bandit.ID(), purify.ID(), suite.lini.ID(), suite.repos), Equals, false)
+}
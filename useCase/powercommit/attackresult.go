@@ -3,6 +3,7 @@ package powercommit
 import "github.com/chadius/terosgamerules/entity/damagedistribution"
 
 // AttackResult shows what happens when the power was an attack.
+//   Each roll is recorded as a Strike. The other fields summarize all of the strikes.
 type AttackResult struct {
 	attackRoll           int
 	defendRoll           int
@@ -14,9 +15,21 @@ type AttackResult struct {
 	criticallyHitTarget  bool
 	damage               *damagedistribution.DamageDistribution
 	isCounterAttack      bool
+	strikes              []*Strike
+	felledTarget         bool
+
+	hitPointsStolen   int
+	barrierSiphoned   int
+	recoilDamageTaken int
+
+	turnsCharmed  int
+	turnsConfused int
+
+	damagePerTurn       int
+	damageOverTimeTurns int
 }
 
-// NewAttackResult generates a new object.
+// NewAttackResult generates a new object with a single strike.
 func NewAttackResult(
 	attackRoll int,
 	defendRoll int,
@@ -40,7 +53,58 @@ func NewAttackResult(
 		criticallyHitTarget:  criticallyHitTarget,
 		damage:               damage,
 		isCounterAttack:      isCounterAttack,
+		strikes: []*Strike{
+			NewStrike(attackRoll, defendRoll, attackerTotal, defenderTotal, hitTarget, criticallyHitTarget, damage),
+		},
+	}
+}
+
+// NewMultiStrikeAttackResult generates a new object from a list of strikes.
+//   The rolls and totals come from the first strike. The attack hit or critically hit if any strike did,
+//   and the damage is the sum of every strike's damage.
+func NewMultiStrikeAttackResult(
+	attackerToHitBonus int,
+	defenderToHitPenalty int,
+	strikes []*Strike,
+	isCounterAttack bool,
+) *AttackResult {
+	result := &AttackResult{
+		attackerToHitBonus:   attackerToHitBonus,
+		defenderToHitPenalty: defenderToHitPenalty,
+		damage:               &damagedistribution.DamageDistribution{},
+		isCounterAttack:      isCounterAttack,
+		strikes:              strikes,
+	}
+
+	if len(strikes) > 0 {
+		result.attackRoll = strikes[0].AttackRoll()
+		result.defendRoll = strikes[0].DefendRoll()
+		result.attackerTotal = strikes[0].AttackerTotal()
+		result.defenderTotal = strikes[0].DefenderTotal()
+	}
+
+	for _, strike := range strikes {
+		result.hitTarget = result.hitTarget || strike.HitTarget()
+		result.criticallyHitTarget = result.criticallyHitTarget || strike.CriticallyHitTarget()
+		addDamageDistribution(result.damage, strike.Damage())
+	}
+	return result
+}
+
+func addDamageDistribution(total, damage *damagedistribution.DamageDistribution) {
+	if damage == nil {
+		return
 	}
+	total.DamageAbsorbedByArmor += damage.DamageAbsorbedByArmor
+	total.DamageAbsorbedByBarrier += damage.DamageAbsorbedByBarrier
+	total.DamageResisted += damage.DamageResisted
+	total.DamageAmplified += damage.DamageAmplified
+	total.RawDamageDealt += damage.RawDamageDealt
+	total.ExtraBarrierBurnt += damage.ExtraBarrierBurnt
+	total.TotalRawBarrierBurnt += damage.TotalRawBarrierBurnt
+	total.IsFatalToTarget = total.IsFatalToTarget || damage.IsFatalToTarget
+	total.ActualBarrierBurn += damage.ActualBarrierBurn
+	total.ActualDamageTaken += damage.ActualDamageTaken
 }
 
 // AttackRoll is a getter.
@@ -93,6 +157,63 @@ func (a *AttackResult) IsCounterAttack() bool {
 	return a.isCounterAttack
 }
 
+// HitPointsStolen is a getter.
+func (a *AttackResult) HitPointsStolen() int {
+	return a.hitPointsStolen
+}
+
+// BarrierSiphoned is a getter.
+func (a *AttackResult) BarrierSiphoned() int {
+	return a.barrierSiphoned
+}
+
+// RecoilDamageTaken is a getter.
+func (a *AttackResult) RecoilDamageTaken() int {
+	return a.recoilDamageTaken
+}
+
+// TurnsCharmed is a getter.
+func (a *AttackResult) TurnsCharmed() int {
+	return a.turnsCharmed
+}
+
+// TurnsConfused is a getter.
+func (a *AttackResult) TurnsConfused() int {
+	return a.turnsConfused
+}
+
+// DamagePerTurn is a getter.
+func (a *AttackResult) DamagePerTurn() int {
+	return a.damagePerTurn
+}
+
+// DamageOverTimeTurns is a getter.
+func (a *AttackResult) DamageOverTimeTurns() int {
+	return a.damageOverTimeTurns
+}
+
+// Strikes is a getter.
+func (a *AttackResult) Strikes() []*Strike {
+	return a.strikes
+}
+
+// FelledTarget returns true if this attack knocked out the target.
+//   A target that had already fallen to an earlier attack does not count.
+func (a *AttackResult) FelledTarget() bool {
+	return a.felledTarget
+}
+
+// StrikesThatHit counts the number of strikes that hit the target.
+func (a *AttackResult) StrikesThatHit() int {
+	count := 0
+	for _, strike := range a.strikes {
+		if strike.HitTarget() {
+			count++
+		}
+	}
+	return count
+}
+
 // AttackResultBuilder saves instructions so you can create an AttackResult.
 type AttackResultBuilder struct {
 	attackRoll           int
@@ -105,6 +226,18 @@ type AttackResultBuilder struct {
 	criticallyHitTarget  bool
 	damage               *damagedistribution.DamageDistribution
 	isCounterAttack      bool
+	strikes              []*Strike
+	felledTarget         bool
+
+	hitPointsStolen   int
+	barrierSiphoned   int
+	recoilDamageTaken int
+
+	turnsCharmed  int
+	turnsConfused int
+
+	damagePerTurn       int
+	damageOverTimeTurns int
 }
 
 // NewAttackResultBuilder returns a new builder object.
@@ -120,6 +253,15 @@ func NewAttackResultBuilder() *AttackResultBuilder {
 		false,
 		&damagedistribution.DamageDistribution{},
 		false,
+		nil,
+		false,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
 	}
 }
 
@@ -184,8 +326,79 @@ func (ar *AttackResultBuilder) DefenderTotal(defenderTotal int) *AttackResultBui
 	return ar
 }
 
+// FelledTarget marks that the attack knocked out the target.
+func (ar *AttackResultBuilder) FelledTarget() *AttackResultBuilder {
+	ar.felledTarget = true
+	return ar
+}
+
+// HitPointsStolen sets the hit points the attacker regained.
+func (ar *AttackResultBuilder) HitPointsStolen(hitPointsStolen int) *AttackResultBuilder {
+	ar.hitPointsStolen = hitPointsStolen
+	return ar
+}
+
+// BarrierSiphoned sets the barrier the attacker took from the target.
+func (ar *AttackResultBuilder) BarrierSiphoned(barrierSiphoned int) *AttackResultBuilder {
+	ar.barrierSiphoned = barrierSiphoned
+	return ar
+}
+
+// RecoilDamageTaken sets the damage the attacker suffered.
+func (ar *AttackResultBuilder) RecoilDamageTaken(recoilDamageTaken int) *AttackResultBuilder {
+	ar.recoilDamageTaken = recoilDamageTaken
+	return ar
+}
+
+// Charmed sets the number of turns the target joins the attacker's side.
+func (ar *AttackResultBuilder) Charmed(turns int) *AttackResultBuilder {
+	ar.turnsCharmed = turns
+	return ar
+}
+
+// Confused sets the number of turns the target treats everyone as a foe.
+func (ar *AttackResultBuilder) Confused(turns int) *AttackResultBuilder {
+	ar.turnsConfused = turns
+	return ar
+}
+
+// DamagedOverTime sets the damage the target takes at the start of each of their turns, and for how many turns.
+func (ar *AttackResultBuilder) DamagedOverTime(damagePerTurn, turns int) *AttackResultBuilder {
+	ar.damagePerTurn = damagePerTurn
+	ar.damageOverTimeTurns = turns
+	return ar
+}
+
+// AddStrike adds another strike to the attack.
+//   Once a strike is added, the result summarizes the strikes instead of using the other fields.
+func (ar *AttackResultBuilder) AddStrike(strike *Strike) *AttackResultBuilder {
+	ar.strikes = append(ar.strikes, strike)
+	return ar
+}
+
 // Build constructs an AttackResult.
 func (ar *AttackResultBuilder) Build() *AttackResult {
+	attackResult := ar.buildStrikes()
+	attackResult.felledTarget = ar.felledTarget
+	attackResult.hitPointsStolen = ar.hitPointsStolen
+	attackResult.barrierSiphoned = ar.barrierSiphoned
+	attackResult.recoilDamageTaken = ar.recoilDamageTaken
+	attackResult.turnsCharmed = ar.turnsCharmed
+	attackResult.turnsConfused = ar.turnsConfused
+	attackResult.damagePerTurn = ar.damagePerTurn
+	attackResult.damageOverTimeTurns = ar.damageOverTimeTurns
+	return attackResult
+}
+
+func (ar *AttackResultBuilder) buildStrikes() *AttackResult {
+	if len(ar.strikes) > 0 {
+		return NewMultiStrikeAttackResult(
+			ar.attackerToHitBonus,
+			ar.defenderToHitPenalty,
+			ar.strikes,
+			ar.isCounterAttack,
+		)
+	}
 	return NewAttackResult(
 		ar.attackRoll,
 		ar.defendRoll,
@@ -2,7 +2,12 @@ package powercommit
 
 // HealResult shows the effects of recovery abilities.
 type HealResult struct {
-	hitPointsRestored int
+	hitPointsRestored      int
+	manaRestored           int
+	barrierRestored        int
+	effectsRemoved         int
+	hitPointsHealedPerTurn int
+	healOverTimeTurns      int
 }
 
 // HitPointsRestored is a getter.
@@ -10,15 +15,50 @@ func (h *HealResult) HitPointsRestored() int {
 	return h.hitPointsRestored
 }
 
+// ManaRestored is a getter.
+func (h *HealResult) ManaRestored() int {
+	return h.manaRestored
+}
+
+// BarrierRestored is a getter.
+func (h *HealResult) BarrierRestored() int {
+	return h.barrierRestored
+}
+
+// EffectsRemoved is a getter.
+func (h *HealResult) EffectsRemoved() int {
+	return h.effectsRemoved
+}
+
+// HitPointsHealedPerTurn is a getter.
+func (h *HealResult) HitPointsHealedPerTurn() int {
+	return h.hitPointsHealedPerTurn
+}
+
+// HealOverTimeTurns is a getter.
+func (h *HealResult) HealOverTimeTurns() int {
+	return h.healOverTimeTurns
+}
+
 // HealResultBuilder is used to build heal results.
 type HealResultBuilder struct {
-	hitPointsRestored int
+	hitPointsRestored      int
+	manaRestored           int
+	barrierRestored        int
+	effectsRemoved         int
+	hitPointsHealedPerTurn int
+	healOverTimeTurns      int
 }
 
 // NewHealResultBuilder creates a new HealResultBuilder object.
 func NewHealResultBuilder() *HealResultBuilder {
 	return &HealResultBuilder{
-		hitPointsRestored: 0,
+		hitPointsRestored:      0,
+		manaRestored:           0,
+		barrierRestored:        0,
+		effectsRemoved:         0,
+		hitPointsHealedPerTurn: 0,
+		healOverTimeTurns:      0,
 	}
 }
 
@@ -28,9 +68,39 @@ func (hr *HealResultBuilder) HitPointsRestored(hitPoints int) *HealResultBuilder
 	return hr
 }
 
+// ManaRestored sets the field
+func (hr *HealResultBuilder) ManaRestored(mana int) *HealResultBuilder {
+	hr.manaRestored = mana
+	return hr
+}
+
+// BarrierRestored sets the field
+func (hr *HealResultBuilder) BarrierRestored(barrier int) *HealResultBuilder {
+	hr.barrierRestored = barrier
+	return hr
+}
+
+// EffectsRemoved sets the field
+func (hr *HealResultBuilder) EffectsRemoved(effectsRemoved int) *HealResultBuilder {
+	hr.effectsRemoved = effectsRemoved
+	return hr
+}
+
+// HealsOverTime sets the healing the target will receive on later turns
+func (hr *HealResultBuilder) HealsOverTime(hitPointsPerTurn, turns int) *HealResultBuilder {
+	hr.hitPointsHealedPerTurn = hitPointsPerTurn
+	hr.healOverTimeTurns = turns
+	return hr
+}
+
 // Build returns a HealResult
 func (hr *HealResultBuilder) Build() *HealResult {
 	return &HealResult{
 		hr.hitPointsRestored,
+		hr.manaRestored,
+		hr.barrierRestored,
+		hr.effectsRemoved,
+		hr.hitPointsHealedPerTurn,
+		hr.healOverTimeTurns,
 	}
 }
@@ -10,10 +10,16 @@ import (
 )
 
 type FakeResultStrategy struct {
-	CommitStub        func()
+	CommitStub        func() error
 	commitMutex       sync.RWMutex
 	commitArgsForCall []struct {
 	}
+	commitReturns struct {
+		result1 error
+	}
+	commitReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DieRollerStub        func() utility.SixSideGenerator
 	dieRollerMutex       sync.RWMutex
 	dieRollerArgsForCall []struct {
@@ -44,20 +50,36 @@ type FakeResultStrategy struct {
 	resultPerTargetReturnsOnCall map[int]struct {
 		result1 []*powercommit.ResultPerTarget
 	}
+	SummonedSquaddieIDsStub        func() []string
+	summonedSquaddieIDsMutex       sync.RWMutex
+	summonedSquaddieIDsArgsForCall []struct {
+	}
+	summonedSquaddieIDsReturns struct {
+		result1 []string
+	}
+	summonedSquaddieIDsReturnsOnCall map[int]struct {
+		result1 []string
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeResultStrategy) Commit() {
+func (fake *FakeResultStrategy) Commit() error {
 	fake.commitMutex.Lock()
+	ret, specificReturn := fake.commitReturnsOnCall[len(fake.commitArgsForCall)]
 	fake.commitArgsForCall = append(fake.commitArgsForCall, struct {
 	}{})
 	stub := fake.CommitStub
+	fakeReturns := fake.commitReturns
 	fake.recordInvocation("Commit", []interface{}{})
 	fake.commitMutex.Unlock()
 	if stub != nil {
-		fake.CommitStub()
+		return stub()
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
 }
 
 func (fake *FakeResultStrategy) CommitCallCount() int {
@@ -66,12 +88,35 @@ func (fake *FakeResultStrategy) CommitCallCount() int {
 	return len(fake.commitArgsForCall)
 }
 
-func (fake *FakeResultStrategy) CommitCalls(stub func()) {
+func (fake *FakeResultStrategy) CommitCalls(stub func() error) {
 	fake.commitMutex.Lock()
 	defer fake.commitMutex.Unlock()
 	fake.CommitStub = stub
 }
 
+func (fake *FakeResultStrategy) CommitReturns(result1 error) {
+	fake.commitMutex.Lock()
+	defer fake.commitMutex.Unlock()
+	fake.CommitStub = nil
+	fake.commitReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeResultStrategy) CommitReturnsOnCall(i int, result1 error) {
+	fake.commitMutex.Lock()
+	defer fake.commitMutex.Unlock()
+	fake.CommitStub = nil
+	if fake.commitReturnsOnCall == nil {
+		fake.commitReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.commitReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeResultStrategy) DieRoller() utility.SixSideGenerator {
 	fake.dieRollerMutex.Lock()
 	ret, specificReturn := fake.dieRollerReturnsOnCall[len(fake.dieRollerArgsForCall)]
@@ -231,6 +276,59 @@ func (fake *FakeResultStrategy) ResultPerTargetReturnsOnCall(i int, result1 []*p
 	}{result1}
 }
 
+func (fake *FakeResultStrategy) SummonedSquaddieIDs() []string {
+	fake.summonedSquaddieIDsMutex.Lock()
+	ret, specificReturn := fake.summonedSquaddieIDsReturnsOnCall[len(fake.summonedSquaddieIDsArgsForCall)]
+	fake.summonedSquaddieIDsArgsForCall = append(fake.summonedSquaddieIDsArgsForCall, struct {
+	}{})
+	stub := fake.SummonedSquaddieIDsStub
+	fakeReturns := fake.summonedSquaddieIDsReturns
+	fake.recordInvocation("SummonedSquaddieIDs", []interface{}{})
+	fake.summonedSquaddieIDsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeResultStrategy) SummonedSquaddieIDsCallCount() int {
+	fake.summonedSquaddieIDsMutex.RLock()
+	defer fake.summonedSquaddieIDsMutex.RUnlock()
+	return len(fake.summonedSquaddieIDsArgsForCall)
+}
+
+func (fake *FakeResultStrategy) SummonedSquaddieIDsCalls(stub func() []string) {
+	fake.summonedSquaddieIDsMutex.Lock()
+	defer fake.summonedSquaddieIDsMutex.Unlock()
+	fake.SummonedSquaddieIDsStub = stub
+}
+
+func (fake *FakeResultStrategy) SummonedSquaddieIDsReturns(result1 []string) {
+	fake.summonedSquaddieIDsMutex.Lock()
+	defer fake.summonedSquaddieIDsMutex.Unlock()
+	fake.SummonedSquaddieIDsStub = nil
+	fake.summonedSquaddieIDsReturns = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeResultStrategy) SummonedSquaddieIDsReturnsOnCall(i int, result1 []string) {
+	fake.summonedSquaddieIDsMutex.Lock()
+	defer fake.summonedSquaddieIDsMutex.Unlock()
+	fake.SummonedSquaddieIDsStub = nil
+	if fake.summonedSquaddieIDsReturnsOnCall == nil {
+		fake.summonedSquaddieIDsReturnsOnCall = make(map[int]struct {
+			result1 []string
+		})
+	}
+	fake.summonedSquaddieIDsReturnsOnCall[i] = struct {
+		result1 []string
+	}{result1}
+}
+
 func (fake *FakeResultStrategy) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -242,6 +340,8 @@ func (fake *FakeResultStrategy) Invocations() map[string][][]interface{} {
 	defer fake.forecastMutex.RUnlock()
 	fake.resultPerTargetMutex.RLock()
 	defer fake.resultPerTargetMutex.RUnlock()
+	fake.summonedSquaddieIDsMutex.RLock()
+	defer fake.summonedSquaddieIDsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
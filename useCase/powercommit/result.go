@@ -3,9 +3,15 @@ package powercommit
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
 
 import (
+	"fmt"
+	"github.com/chadius/terosgamerules/entity/affiliation"
 	"github.com/chadius/terosgamerules/entity/damagedistribution"
+	"github.com/chadius/terosgamerules/entity/lingeringeffect"
+	"github.com/chadius/terosgamerules/entity/powerinterface"
 	"github.com/chadius/terosgamerules/entity/powerusagescenario"
+	"github.com/chadius/terosgamerules/entity/squaddieinterface"
 	"github.com/chadius/terosgamerules/usecase/powerattackforecast"
+	"github.com/chadius/terosgamerules/usecase/powercantarget"
 	"github.com/chadius/terosgamerules/usecase/powerequip"
 	"github.com/chadius/terosgamerules/usecase/repositories"
 	"github.com/chadius/terosgamerules/usecase/squaddiestats"
@@ -18,14 +24,17 @@ type ResultStrategy interface {
 	Forecast() *powerattackforecast.Forecast
 	DieRoller() utility.SixSideGenerator
 	ResultPerTarget() []*ResultPerTarget
-	Commit()
+	SummonedSquaddieIDs() []string
+	Commit() error
 }
 
 // Result applies the forecast given to determine what actually happened. Changes are committed.
 type Result struct {
-	forecast        *powerattackforecast.Forecast
-	dieRoller       utility.SixSideGenerator
-	resultPerTarget []*ResultPerTarget
+	forecast            *powerattackforecast.Forecast
+	dieRoller           utility.SixSideGenerator
+	resultPerTarget     []*ResultPerTarget
+	manaSpent           int
+	summonedSquaddieIDs []string
 }
 
 // NewResult returns a new Result object.
@@ -53,9 +62,36 @@ func (result *Result) ResultPerTarget() []*ResultPerTarget {
 	return result.resultPerTarget
 }
 
+// ManaSpent is a getter.
+func (result *Result) ManaSpent() int {
+	return result.manaSpent
+}
+
+// SummonedSquaddieIDs is a getter.
+func (result *Result) SummonedSquaddieIDs() []string {
+	return result.summonedSquaddieIDs
+}
+
 // Commit tries to use the power and records the effects.
-func (result *Result) Commit() {
+//   The user pays the power's mana cost once, no matter how many targets there are. Counterattacks are free.
+//   Using the power starts its cooldown and spends one of its charges.
+//   Powers that summon add the forecasted summon to the battle before affecting their targets.
+//   Counterattacks happen after every target was affected, unless they strike first. Then they happen before the attack,
+//   and the attack only happens if the user survives. Ripostes answer the counterattack.
+//   Raises an error if the summon cannot join the battle. The power is not used then.
+func (result *Result) Commit() error {
+	err := result.summonSquaddie()
+	if err != nil {
+		return err
+	}
+	result.spendManaCost()
+	result.markPowerUsed()
+
 	for _, calculation := range result.forecast.ForecastedResultPerTarget() {
+		if calculation.CounterAttackStrikesFirst() {
+			result.commitCounterAttack(calculation)
+		}
+
 		attackResultForTarget := result.getAttackResult(calculation)
 		if attackResultForTarget != nil {
 			result.resultPerTarget = append(result.resultPerTarget, attackResultForTarget)
@@ -67,17 +103,93 @@ func (result *Result) Commit() {
 		}
 	}
 	for _, calculation := range result.forecast.ForecastedResultPerTarget() {
-		if result.isCounterAttackPossible(calculation) {
-			counterAttackResultForTarget := result.calculateAttackResultForThisTarget(calculation.CounterAttackSetup(), calculation.CounterAttack(), result.forecast.Repositories())
-			result.resultPerTarget = append(result.resultPerTarget, counterAttackResultForTarget)
+		if !calculation.CounterAttackStrikesFirst() {
+			result.commitCounterAttack(calculation)
+		}
+	}
+	for _, calculation := range result.forecast.ForecastedResultPerTarget() {
+		if result.isRipostePossible(calculation) {
+			riposteResultForTarget := result.calculateAttackResultForThisTarget(calculation.RiposteSetup(), calculation.Riposte(), result.forecast.Repositories())
+			result.resultPerTarget = append(result.resultPerTarget, riposteResultForTarget)
+			result.markCounterAttackMade(calculation.RiposteSetup())
 		}
 	}
+	return nil
+}
+
+func (result *Result) commitCounterAttack(calculation powerattackforecast.CalculationInterface) {
+	if !result.isCounterAttackPossible(calculation) {
+		return
+	}
+	counterAttackResultForTarget := result.calculateAttackResultForThisTarget(calculation.CounterAttackSetup(), calculation.CounterAttack(), result.forecast.Repositories())
+	result.resultPerTarget = append(result.resultPerTarget, counterAttackResultForTarget)
+	result.markCounterAttackMade(calculation.CounterAttackSetup())
+}
+
+func (result *Result) markCounterAttackMade(setup *powerusagescenario.Setup) {
+	result.forecast.Repositories().SquaddieRepo.GetOriginalSquaddieByID(setup.UserID).MarkCounterAttackMade()
+}
+
+func (result *Result) spendManaCost() {
+	setup := result.forecast.Setup()
+	powerUsed := result.forecast.Repositories().PowerRepo.GetPowerByID(setup.PowerID)
+	user := result.forecast.Repositories().SquaddieRepo.GetOriginalSquaddieByID(setup.UserID)
+	result.manaSpent = user.ReduceMana(powerUsed.ManaCost())
+}
+
+func (result *Result) markPowerUsed() {
+	setup := result.forecast.Setup()
+	powerUsed := result.forecast.Repositories().PowerRepo.GetPowerByID(setup.PowerID)
+	user := result.forecast.Repositories().SquaddieRepo.GetOriginalSquaddieByID(setup.UserID)
+	user.MarkPowerUsed(powerUsed.ID(), powerUsed.Cooldown())
+}
+
+// summonSquaddie clones the template into the forecasted summon, ready for battle with full health and its innate powers.
+//   The summon fights for the side the user is acting for.
+//   Raises an error if the template is missing or the summon cannot join the battle.
+func (result *Result) summonSquaddie() error {
+	summon := result.forecast.Summon()
+	if summon == nil {
+		return nil
+	}
+
+	repos := result.forecast.Repositories()
+	user := repos.SquaddieRepo.GetOriginalSquaddieByID(result.forecast.Setup().UserID)
+	template := repos.SquaddieRepo.GetOriginalSquaddieByID(summon.TemplateID)
+	if template == nil {
+		newError := fmt.Errorf(`squaddie "%s" cannot summon unknown squaddie "%s"`, user.Name(), summon.TemplateID)
+		utility.Log(newError.Error(), 0, utility.Error)
+		return newError
+	}
+
+	newSquaddie, err := repos.SquaddieRepo.CloneSquaddieWithNewID(template, summon.SquaddieID)
+	if err != nil {
+		return err
+	}
+	newSquaddie.SetHPToMax()
+	newSquaddie.SetBarrierToMax()
+	newSquaddie.SetManaToMax()
+	newSquaddie.ChangeBaseAffiliation(user.AffiliationLogic())
+	newSquaddie.MarkAsSummon(user.ID(), summon.Turns)
+
+	checkEquip := powerequip.CheckRepositories{}
+	checkEquip.LoadAllOfSquaddieInnatePowers(newSquaddie, newSquaddie.GetCopyOfPowerReferences(), repos)
+	checkEquip.EquipDefaultPower(newSquaddie, repos)
+	_, err = repos.SquaddieRepo.AddSquaddie(newSquaddie)
+	if err != nil {
+		return err
+	}
+	result.summonedSquaddieIDs = append(result.summonedSquaddieIDs, newSquaddie.ID())
+	return nil
 }
 
 func (result *Result) getAttackResult(calculation powerattackforecast.CalculationInterface) *ResultPerTarget {
 	if calculation.Attack() == nil {
 		return nil
 	}
+	if calculation.CounterAttackStrikesFirst() && calculation.Repositories().SquaddieRepo.GetOriginalSquaddieByID(calculation.Setup().UserID).IsDead() {
+		return nil
+	}
 	return result.calculateAttackResultForThisTarget(calculation.Setup(), calculation.Attack(), result.forecast.Repositories())
 }
 
@@ -98,54 +210,175 @@ func (result *Result) isCounterAttackPossible(calculation powerattackforecast.Ca
 		return false
 	}
 
+	originalAttacker := calculation.Repositories().SquaddieRepo.GetOriginalSquaddieByID(calculation.Setup().UserID)
+	if !powercantarget.AreFoes(counterAttacker, originalAttacker, calculation.Repositories()) {
+		return false
+	}
+
+	if calculation.CounterAttackStrikesFirst() && originalAttacker.IsDead() {
+		return false
+	}
+
 	return true
 }
 
-func (result *Result) calculateAttackResultForThisTarget(setup *powerusagescenario.Setup, attack *powerattackforecast.AttackForecast, repositories *repositories.RepositoryCollection) *ResultPerTarget {
-	results := &ResultPerTarget{
-		userID:   setup.UserID,
-		targetID: setup.Targets[0],
-		powerID:  setup.PowerID,
-		attack: &AttackResult{
-			isCounterAttack: attack.AttackerContext.IsCounterAttack(),
-		},
+// isRipostePossible returns true if the counterattack happened and both squaddies are still standing.
+func (result *Result) isRipostePossible(calculation powerattackforecast.CalculationInterface) bool {
+	if calculation.Riposte() == nil || !result.counterAttackHappened(calculation) {
+		return false
+	}
+
+	riposter := calculation.Repositories().SquaddieRepo.GetOriginalSquaddieByID(calculation.RiposteSetup().UserID)
+	counterAttacker := calculation.Repositories().SquaddieRepo.GetOriginalSquaddieByID(calculation.RiposteSetup().Targets[0])
+	if riposter.IsDead() || counterAttacker.IsDead() {
+		return false
+	}
+
+	return powercantarget.AreFoes(riposter, counterAttacker, calculation.Repositories())
+}
+
+func (result *Result) counterAttackHappened(calculation powerattackforecast.CalculationInterface) bool {
+	for _, resultForTarget := range result.resultPerTarget {
+		if resultForTarget.Attack() != nil &&
+			resultForTarget.Attack().IsCounterAttack() &&
+			resultForTarget.UserID() == calculation.CounterAttackSetup().UserID &&
+			resultForTarget.TargetID() == calculation.Setup().UserID {
+			return true
+		}
 	}
+	return false
+}
 
+// calculateAttackResultForThisTarget rolls each strike separately and applies its damage before the next one.
+//   Later strikes are forecast again so they see the target's reduced barrier. Stops early if the target falls.
+func (result *Result) calculateAttackResultForThisTarget(setup *powerusagescenario.Setup, attack *powerattackforecast.AttackForecast, repositories *repositories.RepositoryCollection) *ResultPerTarget {
 	attackingSquaddie := repositories.SquaddieRepo.GetOriginalSquaddieByID(setup.UserID)
 	checkEquip := powerequip.CheckRepositories{}
 	checkEquip.SquaddieEquipPower(attackingSquaddie, setup.PowerID, repositories)
 
-	attackRoll, defendRoll := result.dieRoller.RollTwoDice()
-	results.attack.attackerToHitBonus = attack.VersusContext.ToHit().AttackerToHitBonus
-	results.attack.defenderToHitPenalty = attack.VersusContext.ToHit().DefenderToHitPenalty
+	targetID := setup.Targets[0]
+	targetSquaddie := repositories.SquaddieRepo.GetOriginalSquaddieByID(targetID)
+	targetWasAlive := targetSquaddie.IsDead() == false
 
-	results.attack.attackRoll = attackRoll
-	results.attack.defendRoll = defendRoll
+	strikes := []*Strike{}
+	strikeForecast := attack
+	for strikeIndex := 0; strikeIndex < attack.AttackerContext.HitsPerUse(); strikeIndex++ {
+		if strikeIndex > 0 {
+			if targetSquaddie.IsDead() {
+				break
+			}
+			strikeForecast = powerattackforecast.NewForecastBuilder().
+				Setup(setup).
+				Repositories(repositories).
+				Build().
+				CalculateAttackForecast(targetID)
+		}
+
+		strike := result.rollStrike(strikeForecast)
+		targetSquaddie.TakeDamageDistribution(strike.damage)
+		strikes = append(strikes, strike)
+	}
+
+	attackResult := NewMultiStrikeAttackResult(
+		attack.VersusContext.ToHit().AttackerToHitBonus,
+		attack.VersusContext.ToHit().DefenderToHitPenalty,
+		strikes,
+		attack.AttackerContext.IsCounterAttack(),
+	)
+	attackResult.felledTarget = targetWasAlive && targetSquaddie.IsDead()
+	powerUsed := repositories.PowerRepo.GetPowerByID(setup.PowerID)
+	applyAttackerEffects(attackResult, attackingSquaddie, targetSquaddie, powerUsed)
+	applyAffiliationEffects(attackResult, attackingSquaddie, targetSquaddie, powerUsed)
+	applyDamageOverTime(attackResult, targetSquaddie, powerUsed)
+
+	return &ResultPerTarget{
+		userID:   setup.UserID,
+		targetID: targetID,
+		powerID:  setup.PowerID,
+		attack:   attackResult,
+	}
+}
 
-	results.attack.attackerTotal = results.attack.attackRoll + results.attack.attackerToHitBonus
-	results.attack.defenderTotal = results.attack.defendRoll + results.attack.defenderToHitPenalty
+// applyAttackerEffects changes the attacker after a successful attack.
+//   The attacker steals hit points based on the damage dealt, siphons barrier from the target and then suffers recoil.
+func applyAttackerEffects(attackResult *AttackResult, attacker, target squaddieinterface.Interface, powerUsed powerinterface.Interface) {
+	if !attackResult.HitTarget() {
+		return
+	}
+
+	attackResult.hitPointsStolen = attacker.GainHitPoints(attackResult.Damage().ActualDamageTaken * powerUsed.LifeStealPercent() / 100)
 
-	results.attack.hitTarget = results.attack.attackerTotal >= results.attack.defenderTotal
-	results.attack.criticallyHitTarget = attack.AttackerContext.CanCritical() && results.attack.attackerTotal >= results.attack.defenderTotal+attack.AttackerContext.CriticalHitThreshold()
+	barrierSiphoned := powerUsed.BarrierSiphon()
+	if barrierSiphoned > target.CurrentBarrier() {
+		barrierSiphoned = target.CurrentBarrier()
+	}
+	target.ReduceBarrier(barrierSiphoned)
+	attacker.GainBarrier(barrierSiphoned)
+	attackResult.barrierSiphoned = barrierSiphoned
 
-	if !results.attack.hitTarget {
-		results.attack.damage = &damagedistribution.DamageDistribution{
+	hitPointsBeforeRecoil := attacker.CurrentHitPoints()
+	attacker.ReduceHitPoints(powerUsed.RecoilDamage())
+	attackResult.recoilDamageTaken = hitPointsBeforeRecoil - attacker.CurrentHitPoints()
+}
+
+// applyAffiliationEffects changes the target's affiliation after a successful attack.
+//   Charmed targets join the attacker's side. Confused targets treat everyone as a foe.
+//   If the power does both, the confusion wins.
+func applyAffiliationEffects(attackResult *AttackResult, attacker, target squaddieinterface.Interface, powerUsed powerinterface.Interface) {
+	if !attackResult.HitTarget() || target.IsDead() {
+		return
+	}
+
+	if powerUsed.CharmTurns() > 0 {
+		target.OverrideAffiliation(attacker.AffiliationLogic(), powerUsed.CharmTurns())
+		attackResult.turnsCharmed = powerUsed.CharmTurns()
+	}
+	if powerUsed.ConfuseTurns() > 0 {
+		target.OverrideAffiliation(&affiliation.Neutral{}, powerUsed.ConfuseTurns())
+		attackResult.turnsConfused = powerUsed.ConfuseTurns()
+	}
+}
+
+// applyDamageOverTime leaves a harmful lingering effect on the target after a successful attack.
+func applyDamageOverTime(attackResult *AttackResult, target squaddieinterface.Interface, powerUsed powerinterface.Interface) {
+	if !attackResult.HitTarget() || target.IsDead() || !powerUsed.DamagesOverTime() {
+		return
+	}
+
+	target.AddLingeringEffect(&lingeringeffect.LingeringEffect{
+		Name:             powerUsed.Name(),
+		SourcePowerID:    powerUsed.ID(),
+		HitPointsPerTurn: -powerUsed.DamagePerTurn(),
+		TurnsRemaining:   powerUsed.DamageOverTimeTurns(),
+	})
+	attackResult.damagePerTurn = powerUsed.DamagePerTurn()
+	attackResult.damageOverTimeTurns = powerUsed.DamageOverTimeTurns()
+}
+
+func (result *Result) rollStrike(attack *powerattackforecast.AttackForecast) *Strike {
+	attackRoll, defendRoll := result.dieRoller.RollTwoDice()
+	attackerTotal := attackRoll + attack.VersusContext.ToHit().AttackerToHitBonus
+	defenderTotal := defendRoll + attack.VersusContext.ToHit().DefenderToHitPenalty
+
+	hitTarget := attackerTotal >= defenderTotal
+	criticallyHitTarget := attack.AttackerContext.CanCritical() && attackerTotal >= defenderTotal+attack.AttackerContext.CriticalHitThreshold()
+
+	var damage *damagedistribution.DamageDistribution
+	if !hitTarget {
+		damage = &damagedistribution.DamageDistribution{
 			DamageAbsorbedByArmor:   0,
 			DamageAbsorbedByBarrier: 0,
 			RawDamageDealt:          0,
 			ExtraBarrierBurnt:       0,
 			TotalRawBarrierBurnt:    0,
 		}
-	} else if results.attack.criticallyHitTarget {
-		results.attack.damage = attack.VersusContext.CriticalHitDamage()
+	} else if criticallyHitTarget {
+		damage = attack.VersusContext.CriticalHitDamage()
 	} else {
-		results.attack.damage = attack.VersusContext.NormalDamage()
+		damage = attack.VersusContext.NormalDamage()
 	}
 
-	targetSquaddie := repositories.SquaddieRepo.GetOriginalSquaddieByID(results.targetID)
-	targetSquaddie.TakeDamageDistribution(results.attack.damage)
-
-	return results
+	return NewStrike(attackRoll, defendRoll, attackerTotal, defenderTotal, hitTarget, criticallyHitTarget, damage)
 }
 
 func (result *Result) calculateHealingResultForThisTarget(setup *powerusagescenario.Setup, forecast *powerattackforecast.HealingForecast, repositories *repositories.RepositoryCollection) *ResultPerTarget {
@@ -162,14 +395,34 @@ func (result *Result) calculateHealingResultForThisTarget(setup *powerusagescena
 	checkEquip := powerequip.CheckRepositories{}
 	checkEquip.SquaddieEquipPower(healingSquaddie, setup.PowerID, repositories)
 
-	offenseStrategy := squaddiestats.CalculateSquaddieOffenseStats{}
-
+	powerUsed := repositories.PowerRepo.GetPowerByID(setup.PowerID)
 	targetSquaddie := repositories.SquaddieRepo.GetOriginalSquaddieByID(resultForThisTarget.targetID)
-	maximumHealing, err := offenseStrategy.GetHitPointsHealedWithPower(setup.UserID, setup.PowerID, resultForThisTarget.targetID, repositories)
-	if err != nil {
-		return resultForThisTarget
+	if powerUsed.CanHeal() {
+		offenseStrategy := squaddiestats.CalculateSquaddieOffenseStats{}
+		maximumHealing, err := offenseStrategy.GetHitPointsHealedWithPower(setup.UserID, setup.PowerID, resultForThisTarget.targetID, repositories)
+		if err != nil {
+			return resultForThisTarget
+		}
+		resultForThisTarget.healing.hitPointsRestored = targetSquaddie.GainHitPoints(maximumHealing)
+	}
+	resultForThisTarget.healing.manaRestored = targetSquaddie.GainMana(forecast.RawManaRestored)
+	resultForThisTarget.healing.barrierRestored = targetSquaddie.GainBarrier(forecast.RawBarrierRestored)
+
+	if powerUsed.Cleanses() {
+		resultForThisTarget.healing.effectsRemoved = targetSquaddie.RemoveHarmfulLingeringEffects()
+		if targetSquaddie.RemoveAffiliationOverride() {
+			resultForThisTarget.healing.effectsRemoved++
+		}
+	}
+	if powerUsed.HealsOverTime() {
+		targetSquaddie.AddLingeringEffect(&lingeringeffect.LingeringEffect{
+			Name:             powerUsed.Name(),
+			SourcePowerID:    powerUsed.ID(),
+			HitPointsPerTurn: powerUsed.HitPointsHealedPerTurn(),
+			TurnsRemaining:   powerUsed.HealOverTimeTurns(),
+		})
+		resultForThisTarget.healing.hitPointsHealedPerTurn = powerUsed.HitPointsHealedPerTurn()
+		resultForThisTarget.healing.healOverTimeTurns = powerUsed.HealOverTimeTurns()
 	}
-	hitPointsRestored := targetSquaddie.GainHitPoints(maximumHealing)
-	resultForThisTarget.healing.hitPointsRestored = hitPointsRestored
 	return resultForThisTarget
 }
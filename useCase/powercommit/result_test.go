@@ -1,6 +1,9 @@
 package powercommit_test
 
 import (
+	"github.com/chadius/terosgamerules/entity/affiliation"
+	"github.com/chadius/terosgamerules/entity/damagedistribution"
+	"github.com/chadius/terosgamerules/entity/lingeringeffect"
 	"github.com/chadius/terosgamerules/entity/power"
 	"github.com/chadius/terosgamerules/entity/powerinterface"
 	"github.com/chadius/terosgamerules/entity/powerreference"
@@ -13,6 +16,7 @@ import (
 	"github.com/chadius/terosgamerules/usecase/powerequip"
 	"github.com/chadius/terosgamerules/usecase/repositories"
 	"github.com/chadius/terosgamerules/usecase/squaddiestats"
+	"github.com/chadius/terosgamerules/utility"
 	"github.com/chadius/terosgamerules/utility/testutility"
 	. "gopkg.in/check.v1"
 	"testing"
@@ -691,3 +695,682 @@ func (suite *ResultOnHealing) TestHealResultShowsForEachTarget(checker *C) {
 		2+suite.resultHealingStaffOnTerosAndVale.ResultPerTarget()[1].Healing().HitPointsRestored(),
 	)
 }
+
+type ResultOnMana struct {
+	lini  squaddieinterface.Interface
+	teros squaddieinterface.Interface
+	vale  squaddieinterface.Interface
+
+	manaSurge powerinterface.Interface
+
+	repos *repositories.RepositoryCollection
+}
+
+var _ = Suite(&ResultOnMana{})
+
+func (suite *ResultOnMana) SetUpTest(checker *C) {
+	suite.lini = squaddie.NewSquaddieBuilder().Lini().Mana(5).Build()
+	suite.teros = squaddie.NewSquaddieBuilder().Teros().Mana(4).Build()
+	suite.vale = squaddie.NewSquaddieBuilder().WithName("Vale").AsPlayer().Mana(4).Build()
+	suite.teros.ReduceMana(4)
+	suite.vale.ReduceMana(1)
+
+	suite.manaSurge = power.NewPowerBuilder().WithName("Mana Surge").TargetsFriend().IsSpell().ManaCost(3).RestoresMana(2).Cooldown(2).ChargesPerBattle(3).Build()
+
+	squaddieRepo := squaddie.NewSquaddieRepository()
+	squaddieRepo.AddSquaddies([]squaddieinterface.Interface{suite.lini, suite.teros, suite.vale})
+
+	powerRepo := powerrepository.NewPowerRepository()
+	powerRepo.AddSlicePowerSource([]powerinterface.Interface{suite.manaSurge})
+
+	suite.repos = &repositories.RepositoryCollection{PowerRepo: powerRepo, SquaddieRepo: squaddieRepo}
+}
+
+func (suite *ResultOnMana) commitManaSurge(targetIDs []string) *powercommit.Result {
+	forecast := powerattackforecast.NewForecastBuilder().
+		Setup(
+			&powerusagescenario.Setup{
+				UserID:          suite.lini.ID(),
+				PowerID:         suite.manaSurge.ID(),
+				Targets:         targetIDs,
+				IsCounterAttack: false,
+			},
+		).
+		Repositories(suite.repos).
+		OffenseStrategy(&squaddiestats.CalculateSquaddieOffenseStats{}).
+		Build()
+	forecast.CalculateForecast()
+
+	result := powercommit.NewResult(forecast, nil, nil)
+	result.Commit()
+	return result
+}
+
+func (suite *ResultOnMana) TestUserPaysTheManaCostOnce(checker *C) {
+	result := suite.commitManaSurge([]string{suite.teros.ID(), suite.vale.ID()})
+	checker.Assert(result.ManaSpent(), Equals, 3)
+	checker.Assert(suite.lini.CurrentMana(), Equals, 2)
+}
+
+func (suite *ResultOnMana) TestPowerRestoresTargetMana(checker *C) {
+	result := suite.commitManaSurge([]string{suite.teros.ID(), suite.vale.ID()})
+	checker.Assert(result.ResultPerTarget(), HasLen, 2)
+	checker.Assert(result.ResultPerTarget()[0].Healing().ManaRestored(), Equals, 2)
+	checker.Assert(result.ResultPerTarget()[0].Healing().HitPointsRestored(), Equals, 0)
+	checker.Assert(suite.teros.CurrentMana(), Equals, 2)
+
+	checker.Assert(result.ResultPerTarget()[1].Healing().ManaRestored(), Equals, 1)
+	checker.Assert(suite.vale.CurrentMana(), Equals, 4)
+}
+
+func (suite *ResultOnMana) TestUsingThePowerStartsCooldownAndSpendsACharge(checker *C) {
+	suite.commitManaSurge([]string{suite.teros.ID(), suite.vale.ID()})
+	checker.Assert(suite.lini.RemainingPowerCooldown(suite.manaSurge.ID()), Equals, 2)
+	checker.Assert(suite.lini.PowerChargesUsed(suite.manaSurge.ID()), Equals, 1)
+}
+
+type ResultOnMultiHitAttack struct {
+	teros  squaddieinterface.Interface
+	bandit squaddieinterface.Interface
+
+	daggerFlurry powerinterface.Interface
+
+	repos *repositories.RepositoryCollection
+}
+
+var _ = Suite(&ResultOnMultiHitAttack{})
+
+func (suite *ResultOnMultiHitAttack) SetUpTest(checker *C) {
+	suite.teros = squaddie.NewSquaddieBuilder().Teros().Build()
+	suite.bandit = squaddie.NewSquaddieBuilder().Bandit().HitPoints(10).Barrier(3).Build()
+	suite.bandit.SetBarrierToMax()
+
+	suite.daggerFlurry = power.NewPowerBuilder().WithName("Dagger Flurry").TargetsFoe().CanBeEquipped().DealsDamage(2).HitsPerUse(2).Build()
+
+	squaddieRepo := squaddie.NewSquaddieRepository()
+	squaddieRepo.AddSquaddies([]squaddieinterface.Interface{suite.teros, suite.bandit})
+
+	powerRepo := powerrepository.NewPowerRepository()
+	powerRepo.AddSlicePowerSource([]powerinterface.Interface{suite.daggerFlurry})
+
+	suite.repos = &repositories.RepositoryCollection{PowerRepo: powerRepo, SquaddieRepo: squaddieRepo}
+
+	checkEquip := powerequip.CheckRepositories{}
+	checkEquip.LoadAllOfSquaddieInnatePowers(
+		suite.teros,
+		[]*powerreference.Reference{
+			suite.daggerFlurry.GetReference(),
+		},
+		suite.repos,
+	)
+}
+
+func (suite *ResultOnMultiHitAttack) commitDaggerFlurry(dieRoller utility.SixSideGenerator) *powercommit.Result {
+	forecast := powerattackforecast.NewForecastBuilder().
+		Setup(
+			&powerusagescenario.Setup{
+				UserID:          suite.teros.ID(),
+				PowerID:         suite.daggerFlurry.ID(),
+				Targets:         []string{suite.bandit.ID()},
+				IsCounterAttack: false,
+			},
+		).
+		Repositories(suite.repos).
+		OffenseStrategy(&squaddiestats.CalculateSquaddieOffenseStats{}).
+		Build()
+	forecast.CalculateForecast()
+
+	result := powercommit.NewResult(forecast, dieRoller, nil)
+	result.Commit()
+	return result
+}
+
+func (suite *ResultOnMultiHitAttack) TestEachStrikeRollsSeparately(checker *C) {
+	result := suite.commitDaggerFlurry(&testutility.ReplayDiceRoller{
+		RollHistory: [][]int{
+			{999, -999},
+			{-999, 999},
+		},
+	})
+
+	attack := result.ResultPerTarget()[0].Attack()
+	checker.Assert(attack.Strikes(), HasLen, 2)
+	checker.Assert(attack.Strikes()[0].HitTarget(), Equals, true)
+	checker.Assert(attack.Strikes()[1].HitTarget(), Equals, false)
+	checker.Assert(attack.StrikesThatHit(), Equals, 1)
+	checker.Assert(attack.HitTarget(), Equals, true)
+	checker.Assert(attack.AttackRoll(), Equals, 999)
+}
+
+func (suite *ResultOnMultiHitAttack) TestBarrierBurnAppliesPerStrike(checker *C) {
+	result := suite.commitDaggerFlurry(testutility.AlwaysHitDieRoller{})
+
+	attack := result.ResultPerTarget()[0].Attack()
+	checker.Assert(attack.Strikes(), HasLen, 2)
+	checker.Assert(attack.Strikes()[0].Damage().DamageAbsorbedByBarrier, Equals, 2)
+	checker.Assert(attack.Strikes()[0].Damage().RawDamageDealt, Equals, 0)
+	checker.Assert(attack.Strikes()[1].Damage().DamageAbsorbedByBarrier, Equals, 1)
+	checker.Assert(attack.Strikes()[1].Damage().RawDamageDealt, Equals, 1)
+
+	checker.Assert(attack.Damage().DamageAbsorbedByBarrier, Equals, 3)
+	checker.Assert(attack.Damage().RawDamageDealt, Equals, 1)
+	checker.Assert(suite.bandit.CurrentBarrier(), Equals, 0)
+	checker.Assert(suite.bandit.CurrentHitPoints(), Equals, 9)
+	checker.Assert(attack.FelledTarget(), Equals, false)
+}
+
+func (suite *ResultOnMultiHitAttack) TestStopsStrikingWhenTargetFalls(checker *C) {
+	suite.bandit.TakeDamageDistribution(&damagedistribution.DamageDistribution{DamageAbsorbedByBarrier: 3, RawDamageDealt: 9})
+
+	result := suite.commitDaggerFlurry(testutility.AlwaysHitDieRoller{})
+
+	attack := result.ResultPerTarget()[0].Attack()
+	checker.Assert(attack.Strikes(), HasLen, 1)
+	checker.Assert(attack.Damage().IsFatalToTarget, Equals, true)
+	checker.Assert(suite.bandit.IsDead(), Equals, true)
+	checker.Assert(attack.FelledTarget(), Equals, true)
+}
+
+type ResultOnAttackerEffects struct {
+	teros          squaddieinterface.Interface
+	bandit         squaddieinterface.Interface
+	shieldedBandit squaddieinterface.Interface
+
+	vampireBite   powerinterface.Interface
+	barrierDrain  powerinterface.Interface
+	recklessSwing powerinterface.Interface
+	poisonDart    powerinterface.Interface
+
+	repos *repositories.RepositoryCollection
+}
+
+var _ = Suite(&ResultOnAttackerEffects{})
+
+func (suite *ResultOnAttackerEffects) SetUpTest(checker *C) {
+	suite.teros = squaddie.NewSquaddieBuilder().Teros().HitPoints(10).Barrier(3).Build()
+	suite.teros.ReduceHitPoints(5)
+	suite.bandit = squaddie.NewSquaddieBuilder().Bandit().HitPoints(10).Build()
+	suite.shieldedBandit = squaddie.NewSquaddieBuilder().Bandit().WithID("shieldedBandit").HitPoints(10).Barrier(3).Build()
+	suite.shieldedBandit.SetBarrierToMax()
+
+	suite.vampireBite = power.NewPowerBuilder().WithName("Vampire Bite").TargetsFoe().DealsDamage(4).LifeStealPercent(50).Build()
+	suite.barrierDrain = power.NewPowerBuilder().WithName("Barrier Drain").TargetsFoe().DealsDamage(1).BarrierSiphon(2).Build()
+	suite.recklessSwing = power.NewPowerBuilder().WithName("Reckless Swing").TargetsFoe().DealsDamage(4).RecoilDamage(2).Build()
+	suite.poisonDart = power.NewPowerBuilder().WithName("Poison Dart").TargetsFoe().DealsDamage(1).DamagesOverTime(2, 3).Build()
+
+	squaddieRepo := squaddie.NewSquaddieRepository()
+	squaddieRepo.AddSquaddies([]squaddieinterface.Interface{suite.teros, suite.bandit, suite.shieldedBandit})
+
+	powerRepo := powerrepository.NewPowerRepository()
+	powerRepo.AddSlicePowerSource([]powerinterface.Interface{suite.vampireBite, suite.barrierDrain, suite.recklessSwing, suite.poisonDart})
+
+	suite.repos = &repositories.RepositoryCollection{PowerRepo: powerRepo, SquaddieRepo: squaddieRepo}
+}
+
+func (suite *ResultOnAttackerEffects) commitAttack(powerID, targetID string, dieRoller utility.SixSideGenerator) *powercommit.Result {
+	forecast := powerattackforecast.NewForecastBuilder().
+		Setup(
+			&powerusagescenario.Setup{
+				UserID:          suite.teros.ID(),
+				PowerID:         powerID,
+				Targets:         []string{targetID},
+				IsCounterAttack: false,
+			},
+		).
+		Repositories(suite.repos).
+		OffenseStrategy(&squaddiestats.CalculateSquaddieOffenseStats{}).
+		Build()
+	forecast.CalculateForecast()
+
+	result := powercommit.NewResult(forecast, dieRoller, nil)
+	result.Commit()
+	return result
+}
+
+func (suite *ResultOnAttackerEffects) TestLifeStealHealsAttacker(checker *C) {
+	result := suite.commitAttack(suite.vampireBite.ID(), suite.bandit.ID(), testutility.AlwaysHitDieRoller{})
+
+	checker.Assert(result.ResultPerTarget()[0].Attack().HitPointsStolen(), Equals, 2)
+	checker.Assert(suite.teros.CurrentHitPoints(), Equals, 7)
+	checker.Assert(suite.bandit.CurrentHitPoints(), Equals, 6)
+}
+
+func (suite *ResultOnAttackerEffects) TestBarrierSiphonMovesBarrierToAttacker(checker *C) {
+	result := suite.commitAttack(suite.barrierDrain.ID(), suite.shieldedBandit.ID(), testutility.AlwaysHitDieRoller{})
+
+	checker.Assert(result.ResultPerTarget()[0].Attack().BarrierSiphoned(), Equals, 2)
+	checker.Assert(suite.shieldedBandit.CurrentBarrier(), Equals, 0)
+	checker.Assert(suite.teros.CurrentBarrier(), Equals, 2)
+}
+
+func (suite *ResultOnAttackerEffects) TestRecoilHurtsAttacker(checker *C) {
+	result := suite.commitAttack(suite.recklessSwing.ID(), suite.bandit.ID(), testutility.AlwaysHitDieRoller{})
+
+	checker.Assert(result.ResultPerTarget()[0].Attack().RecoilDamageTaken(), Equals, 2)
+	checker.Assert(suite.teros.CurrentHitPoints(), Equals, 3)
+}
+
+func (suite *ResultOnAttackerEffects) TestDamageOverTimeHurtsTargetAtTurnStart(checker *C) {
+	result := suite.commitAttack(suite.poisonDart.ID(), suite.bandit.ID(), testutility.AlwaysHitDieRoller{})
+
+	checker.Assert(result.ResultPerTarget()[0].Attack().DamagePerTurn(), Equals, 2)
+	checker.Assert(result.ResultPerTarget()[0].Attack().DamageOverTimeTurns(), Equals, 3)
+	checker.Assert(suite.bandit.LingeringEffects(), HasLen, 1)
+	checker.Assert(suite.bandit.LingeringEffects()[0].IsHarmful(), Equals, true)
+	checker.Assert(suite.bandit.CurrentHitPoints(), Equals, 9)
+
+	suite.bandit.ApplyLingeringEffects()
+	checker.Assert(suite.bandit.CurrentHitPoints(), Equals, 7)
+}
+
+func (suite *ResultOnAttackerEffects) TestMissesDoNotAffectAttacker(checker *C) {
+	result := suite.commitAttack(suite.recklessSwing.ID(), suite.bandit.ID(), testutility.AlwaysMissDieRoller{})
+
+	checker.Assert(result.ResultPerTarget()[0].Attack().RecoilDamageTaken(), Equals, 0)
+	checker.Assert(suite.teros.CurrentHitPoints(), Equals, 5)
+}
+
+type ResultOnSupportEffects struct {
+	lini  squaddieinterface.Interface
+	teros squaddieinterface.Interface
+
+	ward powerinterface.Interface
+
+	repos *repositories.RepositoryCollection
+}
+
+var _ = Suite(&ResultOnSupportEffects{})
+
+func (suite *ResultOnSupportEffects) SetUpTest(checker *C) {
+	suite.lini = squaddie.NewSquaddieBuilder().Lini().Build()
+	suite.teros = squaddie.NewSquaddieBuilder().Teros().HitPoints(10).Barrier(5).Build()
+	suite.teros.ReduceHitPoints(5)
+	suite.teros.AddLingeringEffect(&lingeringeffect.LingeringEffect{Name: "Poison", HitPointsPerTurn: -1, TurnsRemaining: 3})
+
+	suite.ward = power.NewPowerBuilder().WithName("Ward").TargetsFriend().IsSpell().
+		BarrierRestored(3).HealsOverTime(2, 2).Cleanses().Build()
+
+	squaddieRepo := squaddie.NewSquaddieRepository()
+	squaddieRepo.AddSquaddies([]squaddieinterface.Interface{suite.lini, suite.teros})
+
+	powerRepo := powerrepository.NewPowerRepository()
+	powerRepo.AddSlicePowerSource([]powerinterface.Interface{suite.ward})
+
+	suite.repos = &repositories.RepositoryCollection{PowerRepo: powerRepo, SquaddieRepo: squaddieRepo}
+}
+
+func (suite *ResultOnSupportEffects) commitWard() *powercommit.Result {
+	forecast := powerattackforecast.NewForecastBuilder().
+		Setup(
+			&powerusagescenario.Setup{
+				UserID:          suite.lini.ID(),
+				PowerID:         suite.ward.ID(),
+				Targets:         []string{suite.teros.ID()},
+				IsCounterAttack: false,
+			},
+		).
+		Repositories(suite.repos).
+		OffenseStrategy(&squaddiestats.CalculateSquaddieOffenseStats{}).
+		Build()
+	forecast.CalculateForecast()
+
+	result := powercommit.NewResult(forecast, nil, nil)
+	result.Commit()
+	return result
+}
+
+func (suite *ResultOnSupportEffects) TestRestoresBarrier(checker *C) {
+	result := suite.commitWard()
+	checker.Assert(result.ResultPerTarget(), HasLen, 1)
+	checker.Assert(result.ResultPerTarget()[0].Healing().BarrierRestored(), Equals, 3)
+	checker.Assert(result.ResultPerTarget()[0].Healing().HitPointsRestored(), Equals, 0)
+	checker.Assert(suite.teros.CurrentBarrier(), Equals, 3)
+}
+
+func (suite *ResultOnSupportEffects) TestCleansesHarmfulEffects(checker *C) {
+	result := suite.commitWard()
+	checker.Assert(result.ResultPerTarget()[0].Healing().EffectsRemoved(), Equals, 1)
+	for _, effect := range suite.teros.LingeringEffects() {
+		checker.Assert(effect.IsHarmful(), Equals, false)
+	}
+}
+
+func (suite *ResultOnSupportEffects) TestCleansesCharmsAndConfusion(checker *C) {
+	suite.teros.OverrideAffiliation(&affiliation.Neutral{}, 2)
+
+	result := suite.commitWard()
+	checker.Assert(result.ResultPerTarget()[0].Healing().EffectsRemoved(), Equals, 2)
+	checker.Assert(suite.teros.HasAffiliationOverride(), Equals, false)
+	checker.Assert(suite.teros.AffiliationLogic().Name(), Equals, "player")
+}
+
+func (suite *ResultOnSupportEffects) TestHealsOverTimeAtTurnStart(checker *C) {
+	result := suite.commitWard()
+	checker.Assert(result.ResultPerTarget()[0].Healing().HitPointsHealedPerTurn(), Equals, 2)
+	checker.Assert(result.ResultPerTarget()[0].Healing().HealOverTimeTurns(), Equals, 2)
+	checker.Assert(suite.teros.CurrentHitPoints(), Equals, 5)
+
+	suite.teros.ApplyLingeringEffects()
+	checker.Assert(suite.teros.CurrentHitPoints(), Equals, 7)
+}
+
+type ResultOnAffiliationEffects struct {
+	teros  squaddieinterface.Interface
+	bandit squaddieinterface.Interface
+
+	charmingSong powerinterface.Interface
+	maddeningHex powerinterface.Interface
+	axe          powerinterface.Interface
+
+	repos *repositories.RepositoryCollection
+}
+
+var _ = Suite(&ResultOnAffiliationEffects{})
+
+func (suite *ResultOnAffiliationEffects) SetUpTest(checker *C) {
+	suite.teros = squaddie.NewSquaddieBuilder().Teros().HitPoints(10).Build()
+	suite.bandit = squaddie.NewSquaddieBuilder().Bandit().HitPoints(10).Build()
+
+	suite.charmingSong = power.NewPowerBuilder().WithName("Charming Song").TargetsFoe().DealsDamage(1).Charms(2).Build()
+	suite.maddeningHex = power.NewPowerBuilder().WithName("Maddening Hex").TargetsFoe().DealsDamage(1).Confuses(1).Build()
+	suite.axe = power.NewPowerBuilder().Axe().CanCounterAttack().Build()
+
+	squaddieRepo := squaddie.NewSquaddieRepository()
+	squaddieRepo.AddSquaddies([]squaddieinterface.Interface{suite.teros, suite.bandit})
+
+	powerRepo := powerrepository.NewPowerRepository()
+	powerRepo.AddSlicePowerSource([]powerinterface.Interface{suite.charmingSong, suite.maddeningHex, suite.axe})
+
+	suite.repos = &repositories.RepositoryCollection{PowerRepo: powerRepo, SquaddieRepo: squaddieRepo}
+
+	checkEquip := powerequip.CheckRepositories{}
+	checkEquip.LoadAllOfSquaddieInnatePowers(suite.bandit, []*powerreference.Reference{suite.axe.GetReference()}, suite.repos)
+	checkEquip.SquaddieEquipPower(suite.bandit, suite.axe.ID(), suite.repos)
+}
+
+func (suite *ResultOnAffiliationEffects) commitAttack(powerID string, dieRoller utility.SixSideGenerator) *powercommit.Result {
+	forecast := powerattackforecast.NewForecastBuilder().
+		Setup(
+			&powerusagescenario.Setup{
+				UserID:          suite.teros.ID(),
+				PowerID:         powerID,
+				Targets:         []string{suite.bandit.ID()},
+				IsCounterAttack: false,
+			},
+		).
+		Repositories(suite.repos).
+		OffenseStrategy(&squaddiestats.CalculateSquaddieOffenseStats{}).
+		Build()
+	forecast.CalculateForecast()
+
+	result := powercommit.NewResult(forecast, dieRoller, nil)
+	result.Commit()
+	return result
+}
+
+func (suite *ResultOnAffiliationEffects) TestCharmMakesTargetJoinTheAttacker(checker *C) {
+	result := suite.commitAttack(suite.charmingSong.ID(), testutility.AlwaysHitDieRoller{})
+
+	checker.Assert(result.ResultPerTarget()[0].Attack().TurnsCharmed(), Equals, 2)
+	checker.Assert(suite.bandit.AffiliationLogic().Name(), Equals, "player")
+	checker.Assert(suite.bandit.AffiliationOverrideTurnsRemaining(), Equals, 2)
+}
+
+func (suite *ResultOnAffiliationEffects) TestCharmedTargetsDoNotCounterAttack(checker *C) {
+	result := suite.commitAttack(suite.charmingSong.ID(), testutility.AlwaysHitDieRoller{})
+
+	checker.Assert(result.ResultPerTarget(), HasLen, 1)
+	checker.Assert(suite.teros.CurrentHitPoints(), Equals, suite.teros.MaxHitPoints())
+}
+
+func (suite *ResultOnAffiliationEffects) TestConfusedTargetsTreatEveryoneAsFoes(checker *C) {
+	result := suite.commitAttack(suite.maddeningHex.ID(), testutility.AlwaysHitDieRoller{})
+
+	checker.Assert(result.ResultPerTarget()[0].Attack().TurnsConfused(), Equals, 1)
+	checker.Assert(suite.bandit.AffiliationLogic().Name(), Equals, "neutral")
+	checker.Assert(result.ResultPerTarget(), HasLen, 2)
+	checker.Assert(result.ResultPerTarget()[1].Attack().IsCounterAttack(), Equals, true)
+}
+
+func (suite *ResultOnAffiliationEffects) TestMissesDoNotChangeAffiliation(checker *C) {
+	result := suite.commitAttack(suite.charmingSong.ID(), testutility.AlwaysMissDieRoller{})
+
+	checker.Assert(result.ResultPerTarget()[0].Attack().TurnsCharmed(), Equals, 0)
+	checker.Assert(suite.bandit.HasAffiliationOverride(), Equals, false)
+	checker.Assert(result.ResultPerTarget(), HasLen, 2)
+}
+
+type ResultOnSummon struct {
+	teros squaddieinterface.Interface
+	wolf  squaddieinterface.Interface
+
+	callWolf powerinterface.Interface
+	bite     powerinterface.Interface
+
+	repos *repositories.RepositoryCollection
+}
+
+var _ = Suite(&ResultOnSummon{})
+
+func (suite *ResultOnSummon) SetUpTest(checker *C) {
+	suite.teros = squaddie.NewSquaddieBuilder().Teros().WithID("teros").Build()
+	suite.wolf = squaddie.NewSquaddieBuilder().WithName("Wolf").WithID("wolf").AsEnemy().HitPoints(6).Build()
+
+	suite.callWolf = power.NewPowerBuilder().WithName("Call Wolf").TargetsSelf().Summons("wolf", 3, 2).Build()
+	suite.bite = power.NewPowerBuilder().WithName("Bite").TargetsFoe().CanBeEquipped().DealsDamage(2).Build()
+
+	squaddieRepo := squaddie.NewSquaddieRepository()
+	squaddieRepo.AddSquaddies([]squaddieinterface.Interface{suite.teros, suite.wolf})
+
+	powerRepo := powerrepository.NewPowerRepository()
+	powerRepo.AddSlicePowerSource([]powerinterface.Interface{suite.callWolf, suite.bite})
+
+	suite.repos = &repositories.RepositoryCollection{PowerRepo: powerRepo, SquaddieRepo: squaddieRepo}
+
+	checkEquip := powerequip.CheckRepositories{}
+	checkEquip.LoadAllOfSquaddieInnatePowers(suite.wolf, []*powerreference.Reference{suite.bite.GetReference()}, suite.repos)
+}
+
+func (suite *ResultOnSummon) commitCallWolf() *powercommit.Result {
+	result, _ := suite.commitSummon(suite.callWolf)
+	return result
+}
+
+func (suite *ResultOnSummon) commitSummon(summonPower powerinterface.Interface) (*powercommit.Result, error) {
+	forecast := powerattackforecast.NewForecastBuilder().
+		Setup(
+			&powerusagescenario.Setup{
+				UserID:          suite.teros.ID(),
+				PowerID:         summonPower.ID(),
+				Targets:         []string{suite.teros.ID()},
+				IsCounterAttack: false,
+			},
+		).
+		Repositories(suite.repos).
+		OffenseStrategy(&squaddiestats.CalculateSquaddieOffenseStats{}).
+		Build()
+	forecast.CalculateForecast()
+
+	result := powercommit.NewResult(forecast, testutility.AlwaysHitDieRoller{}, nil)
+	err := result.Commit()
+	return result, err
+}
+
+func (suite *ResultOnSummon) TestSummonJoinsTheBattle(checker *C) {
+	result := suite.commitCallWolf()
+
+	checker.Assert(result.SummonedSquaddieIDs(), DeepEquals, []string{"teros_wolf_1"})
+	summonedWolf := suite.repos.SquaddieRepo.GetOriginalSquaddieByID("teros_wolf_1")
+	checker.Assert(summonedWolf, NotNil)
+	checker.Assert(summonedWolf.Name(), Equals, "Wolf")
+	checker.Assert(summonedWolf.CurrentHitPoints(), Equals, 6)
+	checker.Assert(summonedWolf.GetEquippedPowerID(), Equals, suite.bite.ID())
+}
+
+func (suite *ResultOnSummon) TestSummonFightsForTheUser(checker *C) {
+	suite.commitCallWolf()
+
+	summonedWolf := suite.repos.SquaddieRepo.GetOriginalSquaddieByID("teros_wolf_1")
+	checker.Assert(summonedWolf.AffiliationLogic().Name(), Equals, suite.teros.AffiliationLogic().Name())
+	checker.Assert(summonedWolf.SummonerID(), Equals, suite.teros.ID())
+	checker.Assert(summonedWolf.SummonTurnsRemaining(), Equals, 3)
+}
+
+func (suite *ResultOnSummon) TestEachSummonGetsANewID(checker *C) {
+	suite.commitCallWolf()
+	result := suite.commitCallWolf()
+
+	checker.Assert(result.SummonedSquaddieIDs(), DeepEquals, []string{"teros_wolf_2"})
+	checker.Assert(suite.wolf.IsSummon(), Equals, false)
+}
+
+func (suite *ResultOnSummon) TestMissingTemplatesRaiseAnErrorAndThePowerIsNotUsed(checker *C) {
+	callGhost := power.NewPowerBuilder().WithName("Call Ghost").TargetsSelf().Summons("ghost", 0, 0).Cooldown(2).Build()
+	suite.repos.PowerRepo.AddPower(callGhost)
+
+	result, err := suite.commitSummon(callGhost)
+	checker.Assert(err, ErrorMatches, `squaddie "Teros" cannot summon unknown squaddie "ghost"`)
+	checker.Assert(result.SummonedSquaddieIDs(), HasLen, 0)
+	checker.Assert(suite.teros.RemainingPowerCooldown(callGhost.ID()), Equals, 0)
+}
+
+type ResultOnCounterAttackVariants struct {
+	teros  squaddieinterface.Interface
+	bandit squaddieinterface.Interface
+
+	rapier powerinterface.Interface
+	pike   powerinterface.Interface
+
+	repos *repositories.RepositoryCollection
+}
+
+var _ = Suite(&ResultOnCounterAttackVariants{})
+
+func (suite *ResultOnCounterAttackVariants) SetUpTest(checker *C) {
+	suite.teros = squaddie.NewSquaddieBuilder().Teros().HitPoints(10).Build()
+	suite.bandit = squaddie.NewSquaddieBuilder().Bandit().HitPoints(10).Build()
+
+	suite.rapier = power.NewPowerBuilder().WithName("Rapier").TargetsFoe().CanBeEquipped().DealsDamage(1).Riposte().Build()
+	suite.pike = power.NewPowerBuilder().WithName("Pike").TargetsFoe().CanBeEquipped().DealsDamage(1).FirstStrike().CounterAttacksPerTurn(1).Build()
+
+	squaddieRepo := squaddie.NewSquaddieRepository()
+	squaddieRepo.AddSquaddies([]squaddieinterface.Interface{suite.teros, suite.bandit})
+
+	powerRepo := powerrepository.NewPowerRepository()
+	powerRepo.AddSlicePowerSource([]powerinterface.Interface{suite.rapier, suite.pike})
+
+	suite.repos = &repositories.RepositoryCollection{PowerRepo: powerRepo, SquaddieRepo: squaddieRepo}
+
+	checkEquip := powerequip.CheckRepositories{}
+	checkEquip.LoadAllOfSquaddieInnatePowers(suite.teros, []*powerreference.Reference{suite.rapier.GetReference()}, suite.repos)
+	checkEquip.LoadAllOfSquaddieInnatePowers(suite.bandit, []*powerreference.Reference{suite.pike.GetReference()}, suite.repos)
+	checkEquip.SquaddieEquipPower(suite.bandit, suite.pike.ID(), suite.repos)
+}
+
+func (suite *ResultOnCounterAttackVariants) commitRapierOnBandit() *powercommit.Result {
+	forecast := powerattackforecast.NewForecastBuilder().
+		Setup(
+			&powerusagescenario.Setup{
+				UserID:          suite.teros.ID(),
+				PowerID:         suite.rapier.ID(),
+				Targets:         []string{suite.bandit.ID()},
+				IsCounterAttack: false,
+			},
+		).
+		Repositories(suite.repos).
+		OffenseStrategy(&squaddiestats.CalculateSquaddieOffenseStats{}).
+		Build()
+	forecast.CalculateForecast()
+
+	result := powercommit.NewResult(forecast, testutility.AlwaysHitDieRoller{}, nil)
+	result.Commit()
+	return result
+}
+
+func (suite *ResultOnCounterAttackVariants) TestFirstStrikeThenAttackThenRiposte(checker *C) {
+	result := suite.commitRapierOnBandit()
+
+	checker.Assert(result.ResultPerTarget(), HasLen, 3)
+	checker.Assert(result.ResultPerTarget()[0].UserID(), Equals, suite.bandit.ID())
+	checker.Assert(result.ResultPerTarget()[0].Attack().IsCounterAttack(), Equals, true)
+	checker.Assert(result.ResultPerTarget()[1].UserID(), Equals, suite.teros.ID())
+	checker.Assert(result.ResultPerTarget()[1].Attack().IsCounterAttack(), Equals, false)
+	checker.Assert(result.ResultPerTarget()[2].UserID(), Equals, suite.teros.ID())
+	checker.Assert(result.ResultPerTarget()[2].Attack().IsCounterAttack(), Equals, true)
+}
+
+func (suite *ResultOnCounterAttackVariants) TestCounterAttacksCountTowardsTheLimit(checker *C) {
+	suite.commitRapierOnBandit()
+	checker.Assert(suite.bandit.CounterAttacksThisTurn(), Equals, 1)
+	checker.Assert(suite.teros.CounterAttacksThisTurn(), Equals, 1)
+
+	result := suite.commitRapierOnBandit()
+	checker.Assert(result.ResultPerTarget(), HasLen, 1)
+}
+
+func (suite *ResultOnCounterAttackVariants) TestAttackerFelledByFirstStrikeDoesNotAttack(checker *C) {
+	suite.teros.ReduceHitPoints(suite.teros.CurrentHitPoints() - 1)
+
+	result := suite.commitRapierOnBandit()
+
+	checker.Assert(suite.teros.IsDead(), Equals, true)
+	checker.Assert(result.ResultPerTarget(), HasLen, 1)
+	checker.Assert(suite.bandit.CurrentHitPoints(), Equals, suite.bandit.MaxHitPoints())
+}
+
+type ResultOnGuard struct {
+	teros  squaddieinterface.Interface
+	lini   squaddieinterface.Interface
+	bandit squaddieinterface.Interface
+
+	axe powerinterface.Interface
+
+	repos *repositories.RepositoryCollection
+}
+
+var _ = Suite(&ResultOnGuard{})
+
+func (suite *ResultOnGuard) SetUpTest(checker *C) {
+	suite.teros = squaddie.NewSquaddieBuilder().Teros().HitPoints(10).Build()
+	suite.lini = squaddie.NewSquaddieBuilder().Lini().HitPoints(10).Build()
+	suite.bandit = squaddie.NewSquaddieBuilder().Bandit().Build()
+
+	suite.axe = power.NewPowerBuilder().WithName("Axe").TargetsFoe().DealsDamage(3).Build()
+
+	squaddieRepo := squaddie.NewSquaddieRepository()
+	squaddieRepo.AddSquaddies([]squaddieinterface.Interface{suite.teros, suite.lini, suite.bandit})
+
+	powerRepo := powerrepository.NewPowerRepository()
+	powerRepo.AddSlicePowerSource([]powerinterface.Interface{suite.axe})
+
+	suite.repos = &repositories.RepositoryCollection{PowerRepo: powerRepo, SquaddieRepo: squaddieRepo}
+
+	checkEquip := powerequip.CheckRepositories{}
+	checkEquip.LoadAllOfSquaddieInnatePowers(suite.bandit, []*powerreference.Reference{suite.axe.GetReference()}, suite.repos)
+}
+
+func (suite *ResultOnGuard) TestGuardianIsHitInsteadOfTheTarget(checker *C) {
+	suite.teros.Guard(suite.lini.ID())
+
+	forecast := powerattackforecast.NewForecastBuilder().
+		Setup(
+			&powerusagescenario.Setup{
+				UserID:          suite.bandit.ID(),
+				PowerID:         suite.axe.ID(),
+				Targets:         []string{suite.lini.ID()},
+				IsCounterAttack: false,
+			},
+		).
+		Repositories(suite.repos).
+		OffenseStrategy(&squaddiestats.CalculateSquaddieOffenseStats{}).
+		Build()
+	forecast.CalculateForecast()
+
+	result := powercommit.NewResult(forecast, testutility.AlwaysHitDieRoller{}, nil)
+	result.Commit()
+
+	checker.Assert(result.ResultPerTarget(), HasLen, 1)
+	checker.Assert(result.ResultPerTarget()[0].TargetID(), Equals, suite.teros.ID())
+	checker.Assert(suite.teros.CurrentHitPoints(), Equals, 7)
+	checker.Assert(suite.lini.CurrentHitPoints(), Equals, 10)
+}
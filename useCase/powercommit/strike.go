@@ -0,0 +1,71 @@
+package powercommit
+
+import "github.com/chadius/terosgamerules/entity/damagedistribution"
+
+// Strike shows what happened during one roll of an attack.
+//   Powers that hit more than once per use make one Strike per hit.
+type Strike struct {
+	attackRoll          int
+	defendRoll          int
+	attackerTotal       int
+	defenderTotal       int
+	hitTarget           bool
+	criticallyHitTarget bool
+	damage              *damagedistribution.DamageDistribution
+}
+
+// NewStrike generates a new object.
+func NewStrike(
+	attackRoll int,
+	defendRoll int,
+	attackerTotal int,
+	defenderTotal int,
+	hitTarget bool,
+	criticallyHitTarget bool,
+	damage *damagedistribution.DamageDistribution,
+) *Strike {
+	return &Strike{
+		attackRoll:          attackRoll,
+		defendRoll:          defendRoll,
+		attackerTotal:       attackerTotal,
+		defenderTotal:       defenderTotal,
+		hitTarget:           hitTarget,
+		criticallyHitTarget: criticallyHitTarget,
+		damage:              damage,
+	}
+}
+
+// AttackRoll is a getter.
+func (s *Strike) AttackRoll() int {
+	return s.attackRoll
+}
+
+// DefendRoll is a getter.
+func (s *Strike) DefendRoll() int {
+	return s.defendRoll
+}
+
+// AttackerTotal is a getter.
+func (s *Strike) AttackerTotal() int {
+	return s.attackerTotal
+}
+
+// DefenderTotal is a getter.
+func (s *Strike) DefenderTotal() int {
+	return s.defenderTotal
+}
+
+// HitTarget is a getter.
+func (s *Strike) HitTarget() bool {
+	return s.hitTarget
+}
+
+// CriticallyHitTarget is a getter.
+func (s *Strike) CriticallyHitTarget() bool {
+	return s.criticallyHitTarget
+}
+
+// Damage is a getter.
+func (s *Strike) Damage() *damagedistribution.DamageDistribution {
+	return s.damage
+}
@@ -0,0 +1,87 @@
+package reaction
+
+import (
+	"fmt"
+	"github.com/chadius/terosgamerules/usecase/powercantarget"
+	"github.com/chadius/terosgamerules/usecase/repositories"
+	"github.com/chadius/terosgamerules/utility"
+)
+
+// Kinds of reactions a squaddie can make in response to another squaddie's action.
+const (
+	// Guard has the squaddie take the attacks aimed at an ally.
+	Guard = "guard"
+	// Overwatch has the squaddie attack the first foe that moves into the range of its power.
+	Overwatch = "overwatch"
+)
+
+// guardDistance is how far the guardian can stand from the squaddie it guards.
+const guardDistance = 1
+
+// StartGuarding makes the guardian take the attacks aimed at the guarded squaddie.
+//   Raises an error if either squaddie is missing or dead, if they are not friends,
+//   or if they stand on the battle grid but not next to each other.
+func StartGuarding(guardianID, guardedID string, repos *repositories.RepositoryCollection) error {
+	guardian := repos.SquaddieRepo.GetOriginalSquaddieByID(guardianID)
+	if guardian == nil {
+		newError := fmt.Errorf(`guardian "%s" does not exist`, guardianID)
+		utility.Log(newError.Error(), 0, utility.Error)
+		return newError
+	}
+
+	guarded := repos.SquaddieRepo.GetOriginalSquaddieByID(guardedID)
+	if guarded == nil {
+		newError := fmt.Errorf(`squaddie "%s" cannot guard "%s", it does not exist`, guardian.Name(), guardedID)
+		utility.Log(newError.Error(), 0, utility.Error)
+		return newError
+	}
+
+	if guardianID == guardedID {
+		newError := fmt.Errorf(`squaddie "%s" cannot guard itself`, guardian.Name())
+		utility.Log(newError.Error(), 0, utility.Error)
+		return newError
+	}
+
+	if guardian.IsDead() || guarded.IsDead() {
+		newError := fmt.Errorf(`squaddie "%s" cannot guard "%s", both must be alive`, guardian.Name(), guarded.Name())
+		utility.Log(newError.Error(), 0, utility.Error)
+		return newError
+	}
+
+	if !powercantarget.AreFriends(guardian, guarded, repos) {
+		newError := fmt.Errorf(`squaddie "%s" cannot guard "%s", they are not friends`, guardian.Name(), guarded.Name())
+		utility.Log(newError.Error(), 0, utility.Error)
+		return newError
+	}
+
+	if !powercantarget.IsWithinRange(guardianID, guardedID, guardDistance, repos) {
+		newError := fmt.Errorf(`squaddie "%s" cannot guard "%s", they must stand next to each other`, guardian.Name(), guarded.Name())
+		utility.Log(newError.Error(), 0, utility.Error)
+		return newError
+	}
+
+	guardian.Guard(guardedID)
+	return nil
+}
+
+// FindGuardian returns the ID of the squaddie that takes the attack aimed at the target,
+//   or an empty string if nobody guards the target.
+//   Guardians must be alive, next to the target, friends with the target and foes of the attacker.
+//   If several squaddies guard the target, the one whose ID sorts first reacts.
+func FindGuardian(attackerID, targetID string, repos *repositories.RepositoryCollection) string {
+	attacker := repos.SquaddieRepo.GetOriginalSquaddieByID(attackerID)
+	target := repos.SquaddieRepo.GetOriginalSquaddieByID(targetID)
+	for _, squaddieID := range repos.SquaddieRepo.GetAllSquaddieIDs() {
+		guardian := repos.SquaddieRepo.GetOriginalSquaddieByID(squaddieID)
+		if squaddieID == attackerID || guardian.GuardedSquaddieID() != targetID || guardian.IsDead() {
+			continue
+		}
+		if !powercantarget.IsWithinRange(squaddieID, targetID, guardDistance, repos) {
+			continue
+		}
+		if powercantarget.AreFriends(guardian, target, repos) && powercantarget.AreFoes(guardian, attacker, repos) {
+			return squaddieID
+		}
+	}
+	return ""
+}
@@ -0,0 +1,101 @@
+package reaction_test
+
+import (
+	"github.com/chadius/terosgamerules/entity/battlegrid"
+	"github.com/chadius/terosgamerules/entity/squaddie"
+	"github.com/chadius/terosgamerules/entity/squaddieinterface"
+	"github.com/chadius/terosgamerules/usecase/reaction"
+	"github.com/chadius/terosgamerules/usecase/repositories"
+	. "gopkg.in/check.v1"
+	"testing"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type GuardSuite struct {
+	teros  squaddieinterface.Interface
+	lini   squaddieinterface.Interface
+	bandit squaddieinterface.Interface
+
+	repos *repositories.RepositoryCollection
+}
+
+var _ = Suite(&GuardSuite{})
+
+func (suite *GuardSuite) SetUpTest(checker *C) {
+	suite.teros = squaddie.NewSquaddieBuilder().Teros().Build()
+	suite.lini = squaddie.NewSquaddieBuilder().Lini().Build()
+	suite.bandit = squaddie.NewSquaddieBuilder().Bandit().Build()
+
+	squaddieRepo := squaddie.NewSquaddieRepository()
+	squaddieRepo.AddSquaddies([]squaddieinterface.Interface{suite.teros, suite.lini, suite.bandit})
+
+	suite.repos = &repositories.RepositoryCollection{
+		SquaddieRepo: squaddieRepo,
+	}
+}
+
+func (suite *GuardSuite) TestSquaddieCanGuardAFriend(checker *C) {
+	err := reaction.StartGuarding(suite.teros.ID(), suite.lini.ID(), suite.repos)
+	checker.Assert(err, IsNil)
+	checker.Assert(suite.teros.GuardedSquaddieID(), Equals, suite.lini.ID())
+}
+
+func (suite *GuardSuite) TestSquaddieCannotGuardAFoe(checker *C) {
+	err := reaction.StartGuarding(suite.teros.ID(), suite.bandit.ID(), suite.repos)
+	checker.Assert(err, ErrorMatches, `squaddie "Teros" cannot guard "Bandit", they are not friends`)
+	checker.Assert(suite.teros.GuardedSquaddieID(), Equals, "")
+}
+
+func (suite *GuardSuite) TestSquaddieCannotGuardItself(checker *C) {
+	err := reaction.StartGuarding(suite.teros.ID(), suite.teros.ID(), suite.repos)
+	checker.Assert(err, ErrorMatches, `squaddie "Teros" cannot guard itself`)
+}
+
+func (suite *GuardSuite) TestDeadSquaddiesCannotGuard(checker *C) {
+	suite.teros.ReduceHitPoints(suite.teros.MaxHitPoints())
+
+	err := reaction.StartGuarding(suite.teros.ID(), suite.lini.ID(), suite.repos)
+	checker.Assert(err, ErrorMatches, `squaddie "Teros" cannot guard "Lini", both must be alive`)
+}
+
+func (suite *GuardSuite) TestGuardianTakesAttacksFromFoes(checker *C) {
+	reaction.StartGuarding(suite.teros.ID(), suite.lini.ID(), suite.repos)
+	checker.Assert(reaction.FindGuardian(suite.bandit.ID(), suite.lini.ID(), suite.repos), Equals, suite.teros.ID())
+	checker.Assert(reaction.FindGuardian(suite.bandit.ID(), suite.teros.ID(), suite.repos), Equals, "")
+}
+
+func (suite *GuardSuite) TestSquaddiesOnlyGuardFriends(checker *C) {
+	suite.bandit.Guard(suite.lini.ID())
+	checker.Assert(reaction.FindGuardian(suite.teros.ID(), suite.lini.ID(), suite.repos), Equals, "")
+}
+
+func (suite *GuardSuite) TestDeadGuardiansDoNotReact(checker *C) {
+	reaction.StartGuarding(suite.teros.ID(), suite.lini.ID(), suite.repos)
+	suite.teros.ReduceHitPoints(suite.teros.MaxHitPoints())
+	checker.Assert(reaction.FindGuardian(suite.bandit.ID(), suite.lini.ID(), suite.repos), Equals, "")
+}
+
+func (suite *GuardSuite) TestGuardiansMustStandNextToTheGuardedSquaddie(checker *C) {
+	suite.repos.Grid = battlegrid.NewGrid()
+	suite.repos.Grid.PlaceSquaddie(suite.teros.ID(), battlegrid.Coordinate{Row: 0, Column: 0})
+	suite.repos.Grid.PlaceSquaddie(suite.lini.ID(), battlegrid.Coordinate{Row: 0, Column: 2})
+
+	err := reaction.StartGuarding(suite.teros.ID(), suite.lini.ID(), suite.repos)
+	checker.Assert(err, ErrorMatches, `squaddie "Teros" cannot guard "Lini", they must stand next to each other`)
+
+	suite.repos.Grid.PlaceSquaddie(suite.lini.ID(), battlegrid.Coordinate{Row: 0, Column: 1})
+	err = reaction.StartGuarding(suite.teros.ID(), suite.lini.ID(), suite.repos)
+	checker.Assert(err, IsNil)
+	checker.Assert(reaction.FindGuardian(suite.bandit.ID(), suite.lini.ID(), suite.repos), Equals, suite.teros.ID())
+}
+
+func (suite *GuardSuite) TestGuardiansDoNotReactWhenTheGuardedSquaddieMovesAway(checker *C) {
+	suite.repos.Grid = battlegrid.NewGrid()
+	suite.repos.Grid.PlaceSquaddie(suite.teros.ID(), battlegrid.Coordinate{Row: 0, Column: 0})
+	suite.repos.Grid.PlaceSquaddie(suite.lini.ID(), battlegrid.Coordinate{Row: 1, Column: 0})
+	reaction.StartGuarding(suite.teros.ID(), suite.lini.ID(), suite.repos)
+
+	suite.repos.Grid.PlaceSquaddie(suite.lini.ID(), battlegrid.Coordinate{Row: 3, Column: 0})
+	checker.Assert(reaction.FindGuardian(suite.bandit.ID(), suite.lini.ID(), suite.repos), Equals, "")
+}
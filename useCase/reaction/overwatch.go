@@ -0,0 +1,74 @@
+package reaction
+
+import (
+	"fmt"
+	"github.com/chadius/terosgamerules/entity/battlegrid"
+	"github.com/chadius/terosgamerules/usecase/powercantarget"
+	"github.com/chadius/terosgamerules/usecase/repositories"
+	"github.com/chadius/terosgamerules/utility"
+)
+
+// StartOverwatch makes the squaddie attack with the power when a foe moves into its range.
+//   If powerID is empty, the squaddie uses its equipped power.
+//   Raises an error if the squaddie is missing or dead, or if it does not have the attacking power.
+func StartOverwatch(squaddieID, powerID string, repos *repositories.RepositoryCollection) error {
+	overwatcher := repos.SquaddieRepo.GetOriginalSquaddieByID(squaddieID)
+	if overwatcher == nil {
+		newError := fmt.Errorf(`squaddie "%s" does not exist`, squaddieID)
+		utility.Log(newError.Error(), 0, utility.Error)
+		return newError
+	}
+
+	if overwatcher.IsDead() {
+		newError := fmt.Errorf(`squaddie "%s" cannot go on overwatch, it is dead`, overwatcher.Name())
+		utility.Log(newError.Error(), 0, utility.Error)
+		return newError
+	}
+
+	if powerID == "" {
+		powerID = overwatcher.GetEquippedPowerID()
+	}
+	powerToUse := repos.PowerRepo.GetPowerByID(powerID)
+	if powerToUse == nil || !overwatcher.HasPowerWithID(powerID) || !powerToUse.CanAttack() {
+		newError := fmt.Errorf(`squaddie "%s" cannot go on overwatch with "%s", it needs an attacking power it has`, overwatcher.Name(), powerID)
+		utility.Log(newError.Error(), 0, utility.Error)
+		return newError
+	}
+
+	overwatcher.StartOverwatch(powerID)
+	return nil
+}
+
+// FindOverwatchers returns the IDs of the squaddies that react when the mover leaves the origin, sorted by ID.
+//   Overwatchers must be alive, foes of the mover and on the battle grid.
+//   The mover must have stepped into the range of their overwatch power from outside of it.
+func FindOverwatchers(moverID string, origin battlegrid.Coordinate, repos *repositories.RepositoryCollection) []string {
+	overwatcherIDs := []string{}
+	if repos.Grid == nil {
+		return overwatcherIDs
+	}
+	destination, moverOnGrid := repos.Grid.SquaddieCoordinate(moverID)
+	if !moverOnGrid {
+		return overwatcherIDs
+	}
+
+	mover := repos.SquaddieRepo.GetOriginalSquaddieByID(moverID)
+	for _, squaddieID := range repos.SquaddieRepo.GetAllSquaddieIDs() {
+		overwatcher := repos.SquaddieRepo.GetOriginalSquaddieByID(squaddieID)
+		if squaddieID == moverID || overwatcher.OverwatchPowerID() == "" || overwatcher.IsDead() {
+			continue
+		}
+		overwatchPower := repos.PowerRepo.GetPowerByID(overwatcher.OverwatchPowerID())
+		overwatcherCoordinate, overwatcherOnGrid := repos.Grid.SquaddieCoordinate(squaddieID)
+		if overwatchPower == nil || !overwatcherOnGrid || !powercantarget.AreFoes(overwatcher, mover, repos) {
+			continue
+		}
+
+		wasInRange := origin.DistanceTo(overwatcherCoordinate) <= overwatchPower.Range()
+		isInRange := destination.DistanceTo(overwatcherCoordinate) <= overwatchPower.Range()
+		if !wasInRange && isInRange {
+			overwatcherIDs = append(overwatcherIDs, squaddieID)
+		}
+	}
+	return overwatcherIDs
+}
@@ -0,0 +1,113 @@
+package reaction_test
+
+import (
+	"github.com/chadius/terosgamerules/entity/battlegrid"
+	"github.com/chadius/terosgamerules/entity/power"
+	"github.com/chadius/terosgamerules/entity/powerinterface"
+	"github.com/chadius/terosgamerules/entity/powerrepository"
+	"github.com/chadius/terosgamerules/entity/squaddie"
+	"github.com/chadius/terosgamerules/entity/squaddieinterface"
+	"github.com/chadius/terosgamerules/usecase/reaction"
+	"github.com/chadius/terosgamerules/usecase/repositories"
+	. "gopkg.in/check.v1"
+)
+
+type OverwatchSuite struct {
+	teros  squaddieinterface.Interface
+	lini   squaddieinterface.Interface
+	bandit squaddieinterface.Interface
+
+	bow    powerinterface.Interface
+	potion powerinterface.Interface
+
+	repos *repositories.RepositoryCollection
+}
+
+var _ = Suite(&OverwatchSuite{})
+
+func (suite *OverwatchSuite) SetUpTest(checker *C) {
+	suite.teros = squaddie.NewSquaddieBuilder().Teros().Build()
+	suite.lini = squaddie.NewSquaddieBuilder().Lini().Build()
+	suite.bandit = squaddie.NewSquaddieBuilder().Bandit().Build()
+
+	suite.bow = power.NewPowerBuilder().WithName("Bow").TargetsFoe().DealsDamage(1).Range(2).Build()
+	suite.potion = power.NewPowerBuilder().WithName("Potion").TargetsSelf().HitPointsHealed(1).Build()
+	suite.teros.AddPowerReference(suite.bow.GetReference())
+	suite.teros.AddPowerReference(suite.potion.GetReference())
+
+	squaddieRepo := squaddie.NewSquaddieRepository()
+	squaddieRepo.AddSquaddies([]squaddieinterface.Interface{suite.teros, suite.lini, suite.bandit})
+
+	powerRepo := powerrepository.NewPowerRepository()
+	powerRepo.AddSlicePowerSource([]powerinterface.Interface{suite.bow, suite.potion})
+
+	suite.repos = &repositories.RepositoryCollection{
+		SquaddieRepo: squaddieRepo,
+		PowerRepo:    powerRepo,
+		Grid:         battlegrid.NewGrid(),
+	}
+	suite.repos.Grid.PlaceSquaddie(suite.teros.ID(), battlegrid.Coordinate{Row: 0, Column: 0})
+	suite.repos.Grid.PlaceSquaddie(suite.bandit.ID(), battlegrid.Coordinate{Row: 0, Column: 5})
+}
+
+func (suite *OverwatchSuite) TestSquaddieCanOverwatchWithAnAttackingPower(checker *C) {
+	err := reaction.StartOverwatch(suite.teros.ID(), suite.bow.ID(), suite.repos)
+	checker.Assert(err, IsNil)
+	checker.Assert(suite.teros.OverwatchPowerID(), Equals, suite.bow.ID())
+}
+
+func (suite *OverwatchSuite) TestSquaddieOverwatchesWithItsEquippedPowerByDefault(checker *C) {
+	suite.teros.EquipPower(suite.bow.ID())
+
+	err := reaction.StartOverwatch(suite.teros.ID(), "", suite.repos)
+	checker.Assert(err, IsNil)
+	checker.Assert(suite.teros.OverwatchPowerID(), Equals, suite.bow.ID())
+}
+
+func (suite *OverwatchSuite) TestSquaddieCannotOverwatchWithoutAnAttackingPower(checker *C) {
+	err := reaction.StartOverwatch(suite.teros.ID(), suite.potion.ID(), suite.repos)
+	checker.Assert(err, ErrorMatches, `squaddie "Teros" cannot go on overwatch with "`+suite.potion.ID()+`", it needs an attacking power it has`)
+
+	err = reaction.StartOverwatch(suite.lini.ID(), suite.bow.ID(), suite.repos)
+	checker.Assert(err, ErrorMatches, `squaddie "Lini" cannot go on overwatch with "`+suite.bow.ID()+`", it needs an attacking power it has`)
+	checker.Assert(suite.lini.OverwatchPowerID(), Equals, "")
+}
+
+func (suite *OverwatchSuite) TestDeadSquaddiesCannotOverwatch(checker *C) {
+	suite.teros.ReduceHitPoints(suite.teros.MaxHitPoints())
+
+	err := reaction.StartOverwatch(suite.teros.ID(), suite.bow.ID(), suite.repos)
+	checker.Assert(err, ErrorMatches, `squaddie "Teros" cannot go on overwatch, it is dead`)
+}
+
+func (suite *OverwatchSuite) TestOverwatchReactsToFoesEnteringRange(checker *C) {
+	reaction.StartOverwatch(suite.teros.ID(), suite.bow.ID(), suite.repos)
+
+	suite.repos.Grid.PlaceSquaddie(suite.bandit.ID(), battlegrid.Coordinate{Row: 0, Column: 3})
+	checker.Assert(reaction.FindOverwatchers(suite.bandit.ID(), battlegrid.Coordinate{Row: 0, Column: 5}, suite.repos), HasLen, 0)
+
+	suite.repos.Grid.PlaceSquaddie(suite.bandit.ID(), battlegrid.Coordinate{Row: 0, Column: 2})
+	checker.Assert(reaction.FindOverwatchers(suite.bandit.ID(), battlegrid.Coordinate{Row: 0, Column: 3}, suite.repos), DeepEquals, []string{suite.teros.ID()})
+}
+
+func (suite *OverwatchSuite) TestOverwatchIgnoresFoesAlreadyInRange(checker *C) {
+	reaction.StartOverwatch(suite.teros.ID(), suite.bow.ID(), suite.repos)
+
+	suite.repos.Grid.PlaceSquaddie(suite.bandit.ID(), battlegrid.Coordinate{Row: 0, Column: 1})
+	checker.Assert(reaction.FindOverwatchers(suite.bandit.ID(), battlegrid.Coordinate{Row: 0, Column: 2}, suite.repos), HasLen, 0)
+}
+
+func (suite *OverwatchSuite) TestOverwatchIgnoresFriends(checker *C) {
+	reaction.StartOverwatch(suite.teros.ID(), suite.bow.ID(), suite.repos)
+
+	suite.repos.Grid.PlaceSquaddie(suite.lini.ID(), battlegrid.Coordinate{Row: 1, Column: 0})
+	checker.Assert(reaction.FindOverwatchers(suite.lini.ID(), battlegrid.Coordinate{Row: 5, Column: 0}, suite.repos), HasLen, 0)
+}
+
+func (suite *OverwatchSuite) TestDeadOverwatchersDoNotReact(checker *C) {
+	reaction.StartOverwatch(suite.teros.ID(), suite.bow.ID(), suite.repos)
+	suite.teros.ReduceHitPoints(suite.teros.MaxHitPoints())
+
+	suite.repos.Grid.PlaceSquaddie(suite.bandit.ID(), battlegrid.Coordinate{Row: 0, Column: 2})
+	checker.Assert(reaction.FindOverwatchers(suite.bandit.ID(), battlegrid.Coordinate{Row: 0, Column: 5}, suite.repos), HasLen, 0)
+}
@@ -1,6 +1,9 @@
 package repositories
 
 import (
+	"github.com/chadius/terosgamerules/entity/battlegrid"
+	"github.com/chadius/terosgamerules/entity/faction"
+	"github.com/chadius/terosgamerules/entity/item"
 	"github.com/chadius/terosgamerules/entity/levelupbenefit"
 	"github.com/chadius/terosgamerules/entity/powerrepository"
 	"github.com/chadius/terosgamerules/entity/squaddie"
@@ -8,9 +11,15 @@ import (
 )
 
 // RepositoryCollection holds all of the repositories used in the setup.
+//   Grid is nil if the battle does not track where squaddies stand.
 type RepositoryCollection struct {
-	SquaddieRepo *squaddie.Repository
-	PowerRepo    *powerrepository.Repository
-	LevelRepo    *levelupbenefit.Repository
-	ClassRepo    *squaddieclass.Repository
+	SquaddieRepo  *squaddie.Repository
+	PowerRepo     *powerrepository.Repository
+	LevelRepo     *levelupbenefit.Repository
+	ClassRepo     *squaddieclass.Repository
+	PromotionRepo *squaddieclass.PromotionRepository
+	ItemRepo      *item.Repository
+	TeamInventory *item.TeamInventory
+	FactionRepo   *faction.Repository
+	Grid          *battlegrid.Grid
 }
@@ -0,0 +1,333 @@
+package simulator
+
+import (
+	"fmt"
+	"github.com/chadius/terosgamerules/entity/squaddie"
+	"github.com/chadius/terosgamerules/usecase/ai"
+	"github.com/chadius/terosgamerules/usecase/battleturn"
+	"github.com/chadius/terosgamerules/usecase/powerattackforecast"
+	"github.com/chadius/terosgamerules/usecase/powercommit"
+	"github.com/chadius/terosgamerules/usecase/powerequip"
+	"github.com/chadius/terosgamerules/usecase/repositories"
+	"github.com/chadius/terosgamerules/usecase/squaddiestats"
+	"github.com/chadius/terosgamerules/utility"
+)
+
+// DefaultMaximumTurns ends a battle in a draw if no team has won by then.
+const DefaultMaximumTurns = 50
+
+// Team is a group of squaddies that act together.
+//   If Policy is nil, the Matchup's Policy chooses their actions.
+type Team struct {
+	Name        string
+	SquaddieIDs []string
+	Policy      ai.DecisionStrategy
+}
+
+// Matchup describes the battle to simulate.
+//   Repositories hold the squaddies in their starting state and the powers they use.
+//   Teams act in the given order every turn. Squaddies act once per turn and their turn ends after they act.
+//   Summoned squaddies join the team that summoned them and act right after they arrive, until they vanish.
+//   When a new turn starts, squaddies apply their lingering effects, regenerate mana and count down
+//   their power cooldowns, the same way a replayed battle does.
+//   Squaddies whose affiliation is overridden act with the team that shares their new affiliation,
+//   and the override counts down at the end of each of their turns.
+type Matchup struct {
+	Repositories *repositories.RepositoryCollection
+	Teams        []*Team
+	Policy       ai.DecisionStrategy
+	MaximumTurns int
+}
+
+// CheckForErrors makes sure the matchup can be simulated.
+func (m *Matchup) CheckForErrors() error {
+	if len(m.Teams) < 2 {
+		newError := fmt.Errorf("matchup needs at least 2 teams, found %d", len(m.Teams))
+		utility.Log(newError.Error(), 0, utility.Error)
+		return newError
+	}
+
+	for _, team := range m.Teams {
+		if team.Policy == nil && m.Policy == nil {
+			newError := fmt.Errorf(`team "%s" has no policy`, team.Name)
+			utility.Log(newError.Error(), 0, utility.Error)
+			return newError
+		}
+
+		for _, squaddieID := range team.SquaddieIDs {
+			if m.Repositories.SquaddieRepo.GetOriginalSquaddieByID(squaddieID) == nil {
+				newError := fmt.Errorf(`team "%s" has unknown squaddie "%s"`, team.Name, squaddieID)
+				utility.Log(newError.Error(), 0, utility.Error)
+				return newError
+			}
+		}
+	}
+	return nil
+}
+
+// RunResult describes what happened in one battle.
+//   WinningTeam is empty if the battle ended in a draw.
+//   Damage counts the hit points lost after barrier and armor absorbed their share.
+type RunResult struct {
+	Seed                  int64
+	WinningTeam           string
+	Turns                 int
+	DamageDealtByPowerID  map[string]int
+	AttacksByPowerID      map[string]int
+	CriticalHitsByPowerID map[string]int
+	SurvivingSquaddieIDs  []string
+}
+
+// PlayBattle plays the matchup to completion using dice seeded with the seed.
+//   The squaddies, faction relationships and team inventory in the matchup's repositories are not changed.
+//   Raises an error if the squaddies cannot be copied for the battle.
+func PlayBattle(matchup *Matchup, seed int64) (*RunResult, error) {
+	battleRepos, err := cloneRepositoriesForBattle(matchup)
+	if err != nil {
+		return nil, err
+	}
+	dieRoller := utility.NewSeededDieRoller(seed)
+	runResult := &RunResult{
+		Seed:                  seed,
+		DamageDealtByPowerID:  map[string]int{},
+		AttacksByPowerID:      map[string]int{},
+		CriticalHitsByPowerID: map[string]int{},
+		SurvivingSquaddieIDs:  []string{},
+	}
+
+	maximumTurns := matchup.MaximumTurns
+	if maximumTurns <= 0 {
+		maximumTurns = DefaultMaximumTurns
+	}
+
+	roster := newBattleRoster(matchup)
+	battleIsOver := false
+	for runResult.Turns < maximumTurns && battleIsOver == false {
+		runResult.Turns++
+		if runResult.Turns > 1 {
+			battleturn.StartNewTurn(roster.getAllSquaddieIDs(matchup), battleRepos)
+		}
+		for _, team := range matchup.Teams {
+			playTeamTurn(matchup, team, roster, battleRepos, dieRoller, runResult)
+			runResult.WinningTeam, battleIsOver = getBattleOutcome(matchup, roster, battleRepos)
+			if battleIsOver {
+				break
+			}
+		}
+	}
+
+	for _, team := range matchup.Teams {
+		for _, squaddieID := range team.SquaddieIDs {
+			if battleRepos.SquaddieRepo.GetOriginalSquaddieByID(squaddieID).IsDead() == false {
+				runResult.SurvivingSquaddieIDs = append(runResult.SurvivingSquaddieIDs, squaddieID)
+			}
+		}
+	}
+	return runResult, nil
+}
+
+// battleRoster lists the squaddies fighting for each team during one battle, including their summons.
+type battleRoster map[*Team][]string
+
+func newBattleRoster(matchup *Matchup) battleRoster {
+	roster := battleRoster{}
+	for _, team := range matchup.Teams {
+		roster[team] = append([]string{}, team.SquaddieIDs...)
+	}
+	return roster
+}
+
+// getAllSquaddieIDs returns every squaddie in the battle, in team order.
+func (roster battleRoster) getAllSquaddieIDs(matchup *Matchup) []string {
+	squaddieIDs := []string{}
+	for _, team := range matchup.Teams {
+		squaddieIDs = append(squaddieIDs, roster[team]...)
+	}
+	return squaddieIDs
+}
+
+func cloneRepositoriesForBattle(matchup *Matchup) (*repositories.RepositoryCollection, error) {
+	battleSquaddieRepo := squaddie.NewSquaddieRepository()
+	battleRepos := &repositories.RepositoryCollection{
+		SquaddieRepo:  battleSquaddieRepo,
+		PowerRepo:     matchup.Repositories.PowerRepo,
+		LevelRepo:     matchup.Repositories.LevelRepo,
+		ClassRepo:     matchup.Repositories.ClassRepo,
+		PromotionRepo: matchup.Repositories.PromotionRepo,
+		ItemRepo:      matchup.Repositories.ItemRepo,
+	}
+	if matchup.Repositories.FactionRepo != nil {
+		battleRepos.FactionRepo = matchup.Repositories.FactionRepo.Clone()
+	}
+	if matchup.Repositories.TeamInventory != nil {
+		battleRepos.TeamInventory = matchup.Repositories.TeamInventory.Clone()
+	}
+
+	checkEquip := powerequip.CheckRepositories{}
+	for _, team := range matchup.Teams {
+		for _, squaddieID := range team.SquaddieIDs {
+			original := matchup.Repositories.SquaddieRepo.GetOriginalSquaddieByID(squaddieID)
+			clone, err := matchup.Repositories.SquaddieRepo.CloneSquaddieWithNewID(original, squaddieID)
+			if err != nil {
+				return nil, err
+			}
+			battleSquaddieRepo.AddSquaddie(clone)
+
+			if original.GetEquippedPowerID() != "" {
+				checkEquip.SquaddieEquipPower(clone, original.GetEquippedPowerID(), battleRepos)
+			} else {
+				checkEquip.EquipDefaultPower(clone, battleRepos)
+			}
+		}
+	}
+
+	err := cloneSummonTemplatesForBattle(matchup, battleRepos)
+	if err != nil {
+		return nil, err
+	}
+	return battleRepos, nil
+}
+
+// cloneSummonTemplatesForBattle copies the templates the teams can summon.
+//   Templates start the battle felled, so they only take part through their summons.
+func cloneSummonTemplatesForBattle(matchup *Matchup, battleRepos *repositories.RepositoryCollection) error {
+	for _, team := range matchup.Teams {
+		for _, squaddieID := range team.SquaddieIDs {
+			summoner := battleRepos.SquaddieRepo.GetOriginalSquaddieByID(squaddieID)
+			for _, reference := range summoner.GetCopyOfPowerReferences() {
+				summonPower := battleRepos.PowerRepo.GetPowerByID(reference.PowerID)
+				if summonPower == nil || !summonPower.CanSummon() {
+					continue
+				}
+
+				templateID := summonPower.SummonTemplateID()
+				template := matchup.Repositories.SquaddieRepo.GetOriginalSquaddieByID(templateID)
+				if template == nil || battleRepos.SquaddieRepo.GetOriginalSquaddieByID(templateID) != nil {
+					continue
+				}
+
+				clone, err := matchup.Repositories.SquaddieRepo.CloneSquaddieWithNewID(template, templateID)
+				if err != nil {
+					return err
+				}
+				clone.ReduceHitPoints(clone.CurrentHitPoints())
+				battleRepos.SquaddieRepo.AddSquaddie(clone)
+			}
+		}
+	}
+	return nil
+}
+
+func playTeamTurn(matchup *Matchup, team *Team, roster battleRoster, battleRepos *repositories.RepositoryCollection, dieRoller utility.SixSideGenerator, runResult *RunResult) {
+	policy := team.Policy
+	if policy == nil {
+		policy = matchup.Policy
+	}
+
+	squaddieIDs := getSquaddieIDsControlledByTeam(matchup, team, roster, battleRepos)
+	for actorIndex := 0; actorIndex < len(squaddieIDs); actorIndex++ {
+		squaddieID := squaddieIDs[actorIndex]
+		squaddieToAct := battleRepos.SquaddieRepo.GetOriginalSquaddieByID(squaddieID)
+		if squaddieToAct.IsDead() || squaddieToAct.TurnEnded() {
+			continue
+		}
+
+		setup, err := policy.ChooseAction(squaddieID, battleRepos)
+		if err != nil {
+			battleturn.EndSquaddieTurn(squaddieToAct)
+			continue
+		}
+
+		forecast := powerattackforecast.NewForecastBuilder().
+			Setup(setup).
+			Repositories(battleRepos).
+			OffenseStrategy(&squaddiestats.CalculateSquaddieOffenseStats{}).
+			Build()
+		forecast.CalculateForecast()
+
+		result := powercommit.NewResult(forecast, dieRoller, nil)
+		err = result.Commit()
+		if err == nil {
+			recordResult(result, runResult)
+			roster[team] = append(roster[team], result.SummonedSquaddieIDs()...)
+			squaddieIDs = append(squaddieIDs, result.SummonedSquaddieIDs()...)
+		}
+		battleturn.EndSquaddieTurn(squaddieToAct)
+
+		if _, battleIsOver := getBattleOutcome(matchup, roster, battleRepos); battleIsOver {
+			return
+		}
+	}
+}
+
+// getSquaddieIDsControlledByTeam returns the squaddies that act during the team's turn.
+//   Squaddies with an overridden affiliation are controlled by the first team with a member who
+//   naturally shares that affiliation. If no team does, the squaddie stays with its own team.
+func getSquaddieIDsControlledByTeam(matchup *Matchup, team *Team, roster battleRoster, battleRepos *repositories.RepositoryCollection) []string {
+	squaddieIDs := []string{}
+	for _, otherTeam := range matchup.Teams {
+		for _, squaddieID := range roster[otherTeam] {
+			if getControllingTeam(matchup, otherTeam, squaddieID, roster, battleRepos) == team {
+				squaddieIDs = append(squaddieIDs, squaddieID)
+			}
+		}
+	}
+	return squaddieIDs
+}
+
+func getControllingTeam(matchup *Matchup, ownTeam *Team, squaddieID string, roster battleRoster, battleRepos *repositories.RepositoryCollection) *Team {
+	squaddieToControl := battleRepos.SquaddieRepo.GetOriginalSquaddieByID(squaddieID)
+	if !squaddieToControl.HasAffiliationOverride() {
+		return ownTeam
+	}
+
+	for _, team := range matchup.Teams {
+		for _, memberID := range roster[team] {
+			member := battleRepos.SquaddieRepo.GetOriginalSquaddieByID(memberID)
+			if member.HasAffiliationOverride() {
+				continue
+			}
+			if member.BaseAffiliationLogic().Name() == squaddieToControl.AffiliationLogic().Name() {
+				return team
+			}
+		}
+	}
+	return ownTeam
+}
+
+func recordResult(result *powercommit.Result, runResult *RunResult) {
+	for _, resultPerTarget := range result.ResultPerTarget() {
+		if resultPerTarget.Attack() == nil {
+			continue
+		}
+
+		powerID := resultPerTarget.PowerID()
+		runResult.AttacksByPowerID[powerID]++
+		if resultPerTarget.Attack().CriticallyHitTarget() {
+			runResult.CriticalHitsByPowerID[powerID]++
+		}
+		runResult.DamageDealtByPowerID[powerID] += resultPerTarget.Attack().Damage().RawDamageDealt
+	}
+}
+
+// getBattleOutcome returns true once at most one team controls living squaddies,
+//   along with the name of the surviving team. If every team fell, the winner is empty.
+func getBattleOutcome(matchup *Matchup, roster battleRoster, battleRepos *repositories.RepositoryCollection) (string, bool) {
+	teamsStillFighting := []string{}
+	for _, team := range matchup.Teams {
+		for _, squaddieID := range getSquaddieIDsControlledByTeam(matchup, team, roster, battleRepos) {
+			if battleRepos.SquaddieRepo.GetOriginalSquaddieByID(squaddieID).IsDead() == false {
+				teamsStillFighting = append(teamsStillFighting, team.Name)
+				break
+			}
+		}
+	}
+
+	switch len(teamsStillFighting) {
+	case 0:
+		return "", true
+	case 1:
+		return teamsStillFighting[0], true
+	}
+	return "", false
+}
@@ -0,0 +1,156 @@
+package simulator
+
+import (
+	"fmt"
+	"github.com/chadius/terosgamerules/utility"
+	"runtime"
+	"sync"
+)
+
+// Options controls how many battles to run.
+//   Battle i uses the seed Seed + i, so the same Options always produce the same Report.
+//   If Workers is 0 or less, one worker runs per CPU.
+type Options struct {
+	NumberOfRuns int
+	Seed         int64
+	Workers      int
+}
+
+// Report aggregates the results of many battles.
+type Report struct {
+	Runs                  []*RunResult
+	WinsByTeam            map[string]int
+	Draws                 int
+	TotalTurns            int
+	DamageDealtByPowerID  map[string]int
+	AttacksByPowerID      map[string]int
+	CriticalHitsByPowerID map[string]int
+	SurvivalsBySquaddieID map[string]int
+}
+
+// Run plays the matchup many times in parallel and aggregates the results.
+//   Raises an error if the matchup cannot be simulated or a battle cannot be played.
+func Run(matchup *Matchup, options *Options) (*Report, error) {
+	err := matchup.CheckForErrors()
+	if err != nil {
+		return nil, err
+	}
+
+	if options.NumberOfRuns < 1 {
+		newError := fmt.Errorf("simulator needs at least 1 run, found %d", options.NumberOfRuns)
+		utility.Log(newError.Error(), 0, utility.Error)
+		return nil, newError
+	}
+
+	workers := options.Workers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	runs := make([]*RunResult, options.NumberOfRuns)
+	runErrors := make([]error, options.NumberOfRuns)
+	runIndices := make(chan int)
+	var waitGroup sync.WaitGroup
+	for worker := 0; worker < workers; worker++ {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			for runIndex := range runIndices {
+				runs[runIndex], runErrors[runIndex] = PlayBattle(matchup, options.Seed+int64(runIndex))
+			}
+		}()
+	}
+
+	for runIndex := 0; runIndex < options.NumberOfRuns; runIndex++ {
+		runIndices <- runIndex
+	}
+	close(runIndices)
+	waitGroup.Wait()
+
+	for _, runError := range runErrors {
+		if runError != nil {
+			return nil, runError
+		}
+	}
+	return NewReport(runs), nil
+}
+
+// NewReport totals the results of the runs.
+func NewReport(runs []*RunResult) *Report {
+	report := &Report{
+		Runs:                  runs,
+		WinsByTeam:            map[string]int{},
+		DamageDealtByPowerID:  map[string]int{},
+		AttacksByPowerID:      map[string]int{},
+		CriticalHitsByPowerID: map[string]int{},
+		SurvivalsBySquaddieID: map[string]int{},
+	}
+
+	for _, run := range runs {
+		if run.WinningTeam == "" {
+			report.Draws++
+		} else {
+			report.WinsByTeam[run.WinningTeam]++
+		}
+		report.TotalTurns += run.Turns
+
+		for powerID, damage := range run.DamageDealtByPowerID {
+			report.DamageDealtByPowerID[powerID] += damage
+		}
+		for powerID, attacks := range run.AttacksByPowerID {
+			report.AttacksByPowerID[powerID] += attacks
+		}
+		for powerID, criticalHits := range run.CriticalHitsByPowerID {
+			report.CriticalHitsByPowerID[powerID] += criticalHits
+		}
+		for _, squaddieID := range run.SurvivingSquaddieIDs {
+			report.SurvivalsBySquaddieID[squaddieID]++
+		}
+	}
+	return report
+}
+
+// NumberOfRuns returns how many battles were played.
+func (r *Report) NumberOfRuns() int {
+	return len(r.Runs)
+}
+
+// WinRate returns the fraction of battles the team won.
+func (r *Report) WinRate(teamName string) float64 {
+	return r.getFractionOfRuns(r.WinsByTeam[teamName])
+}
+
+// DrawRate returns the fraction of battles nobody won.
+func (r *Report) DrawRate() float64 {
+	return r.getFractionOfRuns(r.Draws)
+}
+
+// AverageTurns returns the average length of a battle.
+func (r *Report) AverageTurns() float64 {
+	return r.getFractionOfRuns(r.TotalTurns)
+}
+
+// AverageDamagePerRun returns how much damage the power dealt in an average battle.
+func (r *Report) AverageDamagePerRun(powerID string) float64 {
+	return r.getFractionOfRuns(r.DamageDealtByPowerID[powerID])
+}
+
+// CriticalHitRate returns the fraction of the power's attacks that were critical hits.
+func (r *Report) CriticalHitRate(powerID string) float64 {
+	if r.AttacksByPowerID[powerID] == 0 {
+		return 0
+	}
+	return float64(r.CriticalHitsByPowerID[powerID]) / float64(r.AttacksByPowerID[powerID])
+}
+
+// SurvivalRate returns the fraction of battles the squaddie survived.
+func (r *Report) SurvivalRate(squaddieID string) float64 {
+	return r.getFractionOfRuns(r.SurvivalsBySquaddieID[squaddieID])
+}
+
+func (r *Report) getFractionOfRuns(count int) float64 {
+	if len(r.Runs) == 0 {
+		return 0
+	}
+	return float64(count) / float64(len(r.Runs))
+}
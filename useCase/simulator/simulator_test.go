@@ -0,0 +1,203 @@
+package simulator_test
+
+import (
+	"github.com/chadius/terosgamerules/entity/faction"
+	"github.com/chadius/terosgamerules/entity/item"
+	"github.com/chadius/terosgamerules/entity/power"
+	"github.com/chadius/terosgamerules/entity/powerinterface"
+	"github.com/chadius/terosgamerules/entity/powerreference"
+	"github.com/chadius/terosgamerules/entity/powerrepository"
+	"github.com/chadius/terosgamerules/entity/powerusagescenario"
+	"github.com/chadius/terosgamerules/entity/squaddie"
+	"github.com/chadius/terosgamerules/entity/squaddieinterface"
+	"github.com/chadius/terosgamerules/usecase/ai"
+	"github.com/chadius/terosgamerules/usecase/powercantarget"
+	"github.com/chadius/terosgamerules/usecase/powerequip"
+	"github.com/chadius/terosgamerules/usecase/repositories"
+	"github.com/chadius/terosgamerules/usecase/simulator"
+	. "gopkg.in/check.v1"
+	"testing"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type SimulatorSuite struct {
+	teros  squaddieinterface.Interface
+	bandit squaddieinterface.Interface
+	axe    powerinterface.Interface
+
+	repos   *repositories.RepositoryCollection
+	matchup *simulator.Matchup
+}
+
+var _ = Suite(&SimulatorSuite{})
+
+func (suite *SimulatorSuite) SetUpTest(checker *C) {
+	suite.teros = squaddie.NewSquaddieBuilder().Teros().HitPoints(5).Strength(1).Build()
+	suite.bandit = squaddie.NewSquaddieBuilder().Bandit().HitPoints(5).Strength(1).Build()
+	suite.axe = power.NewPowerBuilder().Axe().Build()
+
+	squaddieRepo := squaddie.NewSquaddieRepository()
+	squaddieRepo.AddSquaddies([]squaddieinterface.Interface{suite.teros, suite.bandit})
+
+	powerRepo := powerrepository.NewPowerRepository()
+	powerRepo.AddSlicePowerSource([]powerinterface.Interface{suite.axe})
+
+	suite.repos = &repositories.RepositoryCollection{
+		SquaddieRepo: squaddieRepo,
+		PowerRepo:    powerRepo,
+	}
+
+	checkEquip := powerequip.CheckRepositories{}
+	for _, fighter := range []squaddieinterface.Interface{suite.teros, suite.bandit} {
+		checkEquip.LoadAllOfSquaddieInnatePowers(fighter, []*powerreference.Reference{suite.axe.GetReference()}, suite.repos)
+		checkEquip.EquipDefaultPower(fighter, suite.repos)
+	}
+
+	suite.matchup = &simulator.Matchup{
+		Repositories: suite.repos,
+		Teams: []*simulator.Team{
+			{Name: "Players", SquaddieIDs: []string{suite.teros.ID()}},
+			{Name: "Bandits", SquaddieIDs: []string{suite.bandit.ID()}},
+		},
+		Policy: &ai.Greedy{},
+	}
+}
+
+func (suite *SimulatorSuite) TestStrongerTeamAlwaysWins(checker *C) {
+	suite.bandit.ReduceHitPoints(4)
+	report, err := simulator.Run(suite.matchup, &simulator.Options{NumberOfRuns: 20, Seed: 1, Workers: 4})
+	checker.Assert(err, IsNil)
+
+	checker.Assert(report.NumberOfRuns(), Equals, 20)
+	checker.Assert(report.WinRate("Players"), Equals, 1.0)
+	checker.Assert(report.WinRate("Bandits"), Equals, 0.0)
+	checker.Assert(report.SurvivalRate(suite.teros.ID()), Equals, 1.0)
+	checker.Assert(report.SurvivalRate(suite.bandit.ID()), Equals, 0.0)
+	checker.Assert(report.AverageDamagePerRun(suite.axe.ID()) > 0, Equals, true)
+}
+
+func (suite *SimulatorSuite) TestSameSeedGivesTheSameReport(checker *C) {
+	oneWorker, err := simulator.Run(suite.matchup, &simulator.Options{NumberOfRuns: 30, Seed: 7, Workers: 1})
+	checker.Assert(err, IsNil)
+	manyWorkers, err := simulator.Run(suite.matchup, &simulator.Options{NumberOfRuns: 30, Seed: 7, Workers: 8})
+	checker.Assert(err, IsNil)
+
+	checker.Assert(manyWorkers, DeepEquals, oneWorker)
+	checker.Assert(oneWorker.WinRate("Players")+oneWorker.WinRate("Bandits")+oneWorker.DrawRate(), Equals, 1.0)
+	checker.Assert(oneWorker.AverageTurns() >= 1, Equals, true)
+}
+
+func (suite *SimulatorSuite) TestEachRunUsesItsOwnSeed(checker *C) {
+	report, err := simulator.Run(suite.matchup, &simulator.Options{NumberOfRuns: 3, Seed: 100})
+	checker.Assert(err, IsNil)
+	checker.Assert(report.Runs[0].Seed, Equals, int64(100))
+	checker.Assert(report.Runs[2].Seed, Equals, int64(102))
+	battle, err := simulator.PlayBattle(suite.matchup, 101)
+	checker.Assert(err, IsNil)
+	checker.Assert(battle, DeepEquals, report.Runs[1])
+}
+
+func (suite *SimulatorSuite) TestBattlesDoNotChangeTheOriginalSquaddies(checker *C) {
+	_, err := simulator.Run(suite.matchup, &simulator.Options{NumberOfRuns: 5, Seed: 1})
+	checker.Assert(err, IsNil)
+	checker.Assert(suite.teros.CurrentHitPoints(), Equals, 5)
+	checker.Assert(suite.bandit.CurrentHitPoints(), Equals, 5)
+}
+
+func (suite *SimulatorSuite) TestBattlesDoNotShareFactionsOrTeamInventory(checker *C) {
+	suite.repos.FactionRepo = faction.NewRepository()
+	suite.repos.FactionRepo.SetRelationship("player", "enemy", faction.Foe)
+	suite.repos.TeamInventory = item.NewTeamInventory()
+	suite.repos.TeamInventory.AddItem("player", "itemPotion", 1)
+
+	_, err := simulator.Run(suite.matchup, &simulator.Options{NumberOfRuns: 5, Seed: 1})
+	checker.Assert(err, IsNil)
+	relationship, _ := suite.repos.FactionRepo.GetRelationship("enemy", "player")
+	checker.Assert(relationship, Equals, faction.Foe)
+	checker.Assert(suite.repos.TeamInventory.CountItem("player", "itemPotion"), Equals, 1)
+}
+
+func (suite *SimulatorSuite) TestPlayBattleRaisesAnErrorIfSquaddiesCannotBeCopied(checker *C) {
+	suite.matchup.Teams[1].SquaddieIDs = []string{"squaddieDoesNotExist"}
+	_, err := simulator.PlayBattle(suite.matchup, 1)
+	checker.Assert(err, ErrorMatches, "cannot clone a squaddie that does not exist")
+}
+
+func (suite *SimulatorSuite) TestRaisesAnErrorIfMatchupIsInvalid(checker *C) {
+	suite.matchup.Teams[1].SquaddieIDs = []string{"squaddieDoesNotExist"}
+	_, err := simulator.Run(suite.matchup, &simulator.Options{NumberOfRuns: 1})
+	checker.Assert(err, ErrorMatches, `team "Bandits" has unknown squaddie "squaddieDoesNotExist"`)
+
+	suite.matchup.Teams = suite.matchup.Teams[:1]
+	_, err = simulator.Run(suite.matchup, &simulator.Options{NumberOfRuns: 1})
+	checker.Assert(err, ErrorMatches, "matchup needs at least 2 teams, found 1")
+}
+
+func (suite *SimulatorSuite) TestRaisesAnErrorWithoutRuns(checker *C) {
+	_, err := simulator.Run(suite.matchup, &simulator.Options{NumberOfRuns: 0})
+	checker.Assert(err, ErrorMatches, "simulator needs at least 1 run, found 0")
+}
+
+func (suite *SimulatorSuite) TestCharmedSquaddiesFightForTheirNewSide(checker *C) {
+	charmedBandit := squaddie.NewSquaddieBuilder().Bandit().WithID("charmedBandit").HitPoints(5).Strength(1).Build()
+	suite.repos.SquaddieRepo.AddSquaddie(charmedBandit)
+	checkEquip := powerequip.CheckRepositories{}
+	checkEquip.LoadAllOfSquaddieInnatePowers(charmedBandit, []*powerreference.Reference{suite.axe.GetReference()}, suite.repos)
+	checkEquip.EquipDefaultPower(charmedBandit, suite.repos)
+	charmedBandit.OverrideAffiliation(suite.teros.AffiliationLogic(), simulator.DefaultMaximumTurns)
+
+	suite.teros.ReduceHitPoints(4)
+	suite.matchup.Teams[1].SquaddieIDs = []string{suite.bandit.ID(), charmedBandit.ID()}
+
+	report, err := simulator.Run(suite.matchup, &simulator.Options{NumberOfRuns: 10, Seed: 1})
+	checker.Assert(err, IsNil)
+	checker.Assert(report.DrawRate(), Equals, 0.0)
+	checker.Assert(report.WinRate("Players") > 0, Equals, true)
+	checker.Assert(report.SurvivalRate(suite.bandit.ID()) < 1.0, Equals, true)
+}
+
+// summonFirstPolicy summons whenever it can and otherwise acts greedily. It remembers who acted.
+type summonFirstPolicy struct {
+	summonPowerID string
+	actorIDs      []string
+}
+
+func (policy *summonFirstPolicy) ChooseAction(userID string, repos *repositories.RepositoryCollection) (*powerusagescenario.Setup, error) {
+	policy.actorIDs = append(policy.actorIDs, userID)
+	user := repos.SquaddieRepo.GetOriginalSquaddieByID(userID)
+	for _, reference := range user.GetCopyOfPowerReferences() {
+		if reference.PowerID != policy.summonPowerID {
+			continue
+		}
+		summonSetup := &powerusagescenario.Setup{UserID: userID, PowerID: policy.summonPowerID, Targets: []string{}}
+		if isValid, _ := (&powercantarget.ValidTargetChecker{}).IsValidAction(userID, policy.summonPowerID, summonSetup.Targets, repos); isValid {
+			return summonSetup, nil
+		}
+	}
+	return (&ai.Greedy{}).ChooseAction(userID, repos)
+}
+
+func (suite *SimulatorSuite) TestSummonsFightUntilTheyVanish(checker *C) {
+	wolf := squaddie.NewSquaddieBuilder().WithName("Wolf").WithID("wolf").AsPlayer().HitPoints(1).Build()
+	callWolf := power.NewPowerBuilder().WithName("Call Wolf").TargetsSelf().Summons(wolf.ID(), 1, 1).Build()
+	suite.repos.SquaddieRepo.AddSquaddie(wolf)
+	suite.repos.PowerRepo.AddPower(callWolf)
+	checkEquip := powerequip.CheckRepositories{}
+	checkEquip.LoadAllOfSquaddieInnatePowers(wolf, []*powerreference.Reference{suite.axe.GetReference()}, suite.repos)
+	checkEquip.LoadAllOfSquaddieInnatePowers(suite.teros, []*powerreference.Reference{suite.axe.GetReference(), callWolf.GetReference()}, suite.repos)
+
+	policy := &summonFirstPolicy{summonPowerID: callWolf.ID()}
+	suite.matchup.Teams[0].Policy = policy
+	suite.matchup.MaximumTurns = 3
+	suite.bandit.ImproveDefense(100, 0, 0, 0, 0)
+	suite.bandit.SetHPToMax()
+
+	_, err := simulator.PlayBattle(suite.matchup, 1)
+	checker.Assert(err, IsNil)
+	checker.Assert(policy.actorIDs, DeepEquals, []string{
+		suite.teros.ID(), suite.teros.ID() + "_wolf_1",
+		suite.teros.ID(), suite.teros.ID() + "_wolf_2",
+		suite.teros.ID(), suite.teros.ID() + "_wolf_3",
+	})
+}
@@ -10,6 +10,7 @@ type CalculateSquaddieDefenseStatsStrategy interface {
 	GetSquaddieArmorAgainstPower(squaddieID, powerID string, repos *repositories.RepositoryCollection) (int, error)
 	GetSquaddieBarrierAgainstPower(squaddieID, powerID string, repos *repositories.RepositoryCollection) (int, error)
 	GetSquaddieCurrentHitPoints(squaddieID, powerID string, repos *repositories.RepositoryCollection) (int, error)
+	GetSquaddieDamageResistanceAgainstPower(squaddieID, powerID string, repos *repositories.RepositoryCollection) (int, error)
 }
 
 // CalculateSquaddieDefenseStats determines how a squaddie can evade a given attack
@@ -54,3 +55,14 @@ func (c *CalculateSquaddieDefenseStats) GetSquaddieCurrentHitPoints(squaddieID,
 
 	return squaddie.CurrentHitPoints(), nil
 }
+
+// GetSquaddieDamageResistanceAgainstPower returns the percentage of the power's damage type the squaddie resists.
+//   Negative values mean the squaddie is weak to the damage type.
+func (c *CalculateSquaddieDefenseStats) GetSquaddieDamageResistanceAgainstPower(squaddieID, powerID string, repos *repositories.RepositoryCollection) (int, error) {
+	squaddie, powerToMeasure, err := getSquaddieAndAttackPower(squaddieID, powerID, repos)
+	if err != nil {
+		return 0, err
+	}
+
+	return squaddie.DamageResistancePercent(powerToMeasure.DamageType()), nil
+}
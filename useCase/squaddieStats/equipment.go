@@ -0,0 +1,72 @@
+package squaddiestats
+
+import (
+	"github.com/chadius/terosgamerules/entity/item"
+	"github.com/chadius/terosgamerules/entity/squaddieinterface"
+	"github.com/chadius/terosgamerules/usecase/repositories"
+)
+
+// equippedSquaddie adds the stat modifiers of every equipped item to the squaddie's stats.
+//   Power sources read stats through the squaddie, so wrapping it lets equipment affect every calculation.
+type equippedSquaddie struct {
+	squaddieinterface.Interface
+	modifiers item.StatModifiers
+}
+
+// Aim includes equipment.
+func (e *equippedSquaddie) Aim() int {
+	return e.Interface.Aim() + e.modifiers.Aim
+}
+
+// Strength includes equipment.
+func (e *equippedSquaddie) Strength() int {
+	return e.Interface.Strength() + e.modifiers.Strength
+}
+
+// Mind includes equipment.
+func (e *equippedSquaddie) Mind() int {
+	return e.Interface.Mind() + e.modifiers.Mind
+}
+
+// Dodge includes equipment.
+func (e *equippedSquaddie) Dodge() int {
+	return e.Interface.Dodge() + e.modifiers.Dodge
+}
+
+// Deflect includes equipment.
+func (e *equippedSquaddie) Deflect() int {
+	return e.Interface.Deflect() + e.modifiers.Deflect
+}
+
+// Armor includes equipment.
+func (e *equippedSquaddie) Armor() int {
+	return e.Interface.Armor() + e.modifiers.Armor
+}
+
+// GetEquipmentModifiers returns the total stat modifiers from every item the squaddie has equipped.
+//   Items missing from the item repository are ignored.
+func GetEquipmentModifiers(squaddie squaddieinterface.Interface, repos *repositories.RepositoryCollection) item.StatModifiers {
+	modifiers := item.StatModifiers{}
+	if repos.ItemRepo == nil {
+		return modifiers
+	}
+
+	for _, slot := range squaddie.GetEquippedItemSlots() {
+		equippedItem, err := repos.ItemRepo.GetItemByID(squaddie.GetEquippedItemID(slot))
+		if err != nil {
+			continue
+		}
+		modifiers = modifiers.Add(equippedItem.Modifiers())
+	}
+	return modifiers
+}
+
+func applyEquipment(squaddie squaddieinterface.Interface, repos *repositories.RepositoryCollection) squaddieinterface.Interface {
+	if len(squaddie.GetEquippedItemSlots()) == 0 {
+		return squaddie
+	}
+	return &equippedSquaddie{
+		Interface: squaddie,
+		modifiers: GetEquipmentModifiers(squaddie, repos),
+	}
+}
@@ -0,0 +1,91 @@
+package squaddiestats_test
+
+import (
+	"github.com/chadius/terosgamerules/entity/item"
+	"github.com/chadius/terosgamerules/entity/power"
+	"github.com/chadius/terosgamerules/entity/powerinterface"
+	"github.com/chadius/terosgamerules/entity/powerrepository"
+	"github.com/chadius/terosgamerules/entity/squaddie"
+	"github.com/chadius/terosgamerules/entity/squaddieinterface"
+	"github.com/chadius/terosgamerules/usecase/repositories"
+	"github.com/chadius/terosgamerules/usecase/squaddiestats"
+	. "gopkg.in/check.v1"
+)
+
+type squaddieEquipment struct {
+	teros squaddieinterface.Interface
+
+	spear powerinterface.Interface
+	blot  powerinterface.Interface
+
+	repos *repositories.RepositoryCollection
+
+	offenseStrategy squaddiestats.CalculateSquaddieOffenseStatsStrategy
+	defenseStrategy squaddiestats.CalculateSquaddieDefenseStatsStrategy
+}
+
+var _ = Suite(&squaddieEquipment{})
+
+func (suite *squaddieEquipment) SetUpTest(checker *C) {
+	suite.teros = squaddie.NewSquaddieBuilder().Teros().Aim(1).Strength(1).Mind(1).Dodge(1).Deflect(1).Armor(1).Build()
+	suite.teros.EquipItem(item.Armor, "itemPlate", nil)
+	suite.teros.EquipItem(item.Accessory, "itemRing", nil)
+
+	suite.spear = power.NewPowerBuilder().Spear().DealsDamage(0).ToHitBonus(0).Build()
+	suite.blot = power.NewPowerBuilder().Blot().DealsDamage(0).ToHitBonus(0).Build()
+
+	squaddieRepo := squaddie.NewSquaddieRepository()
+	squaddieRepo.AddSquaddie(suite.teros)
+
+	powerRepo := powerrepository.NewPowerRepository()
+	powerRepo.AddSlicePowerSource([]powerinterface.Interface{suite.spear, suite.blot})
+
+	itemRepo := item.NewRepository()
+	itemRepo.AddListOfItems([]*item.Item{
+		item.NewItem("itemPlate", "Plate Armor", item.Armor, item.StatModifiers{Armor: 3, Dodge: -1}, nil),
+		item.NewItem("itemRing", "Focus Ring", item.Accessory, item.StatModifiers{Aim: 1, Strength: 1, Mind: 2, Deflect: 2}, nil),
+	})
+
+	suite.repos = &repositories.RepositoryCollection{
+		SquaddieRepo: squaddieRepo,
+		PowerRepo:    powerRepo,
+		ItemRepo:     itemRepo,
+	}
+
+	suite.offenseStrategy = &squaddiestats.CalculateSquaddieOffenseStats{}
+	suite.defenseStrategy = &squaddiestats.CalculateSquaddieDefenseStats{}
+}
+
+func (suite *squaddieEquipment) TestEquipmentModifiersAreSummed(checker *C) {
+	modifiers := squaddiestats.GetEquipmentModifiers(suite.teros, suite.repos)
+	checker.Assert(modifiers, Equals, item.StatModifiers{Aim: 1, Strength: 1, Mind: 2, Dodge: -1, Deflect: 2, Armor: 3})
+}
+
+func (suite *squaddieEquipment) TestEquipmentImprovesOffense(checker *C) {
+	aim, _ := suite.offenseStrategy.GetSquaddieAimWithPower(suite.teros.ID(), suite.spear.ID(), suite.repos)
+	checker.Assert(aim, Equals, 2)
+
+	spearDamage, _ := suite.offenseStrategy.GetSquaddieRawDamageWithPower(suite.teros.ID(), suite.spear.ID(), suite.repos)
+	checker.Assert(spearDamage, Equals, 2)
+
+	blotDamage, _ := suite.offenseStrategy.GetSquaddieRawDamageWithPower(suite.teros.ID(), suite.blot.ID(), suite.repos)
+	checker.Assert(blotDamage, Equals, 3)
+}
+
+func (suite *squaddieEquipment) TestEquipmentChangesDefense(checker *C) {
+	armor, _ := suite.defenseStrategy.GetSquaddieArmorAgainstPower(suite.teros.ID(), suite.spear.ID(), suite.repos)
+	checker.Assert(armor, Equals, 4)
+
+	dodge, _ := suite.defenseStrategy.GetSquaddieToHitPenaltyAgainstPower(suite.teros.ID(), suite.spear.ID(), suite.repos)
+	checker.Assert(dodge, Equals, 0)
+
+	deflect, _ := suite.defenseStrategy.GetSquaddieToHitPenaltyAgainstPower(suite.teros.ID(), suite.blot.ID(), suite.repos)
+	checker.Assert(deflect, Equals, 3)
+}
+
+func (suite *squaddieEquipment) TestIgnoresEquipmentWithoutItemRepository(checker *C) {
+	suite.repos.ItemRepo = nil
+
+	armor, _ := suite.defenseStrategy.GetSquaddieArmorAgainstPower(suite.teros.ID(), suite.spear.ID(), suite.repos)
+	checker.Assert(armor, Equals, 1)
+}
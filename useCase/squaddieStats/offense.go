@@ -147,7 +147,7 @@ func getSquaddie(squaddieID string, repos *repositories.RepositoryCollection) (s
 		utility.Log(newError.Error(), 0, utility.Error)
 		return nil, newError
 	}
-	return squaddie, nil
+	return applyEquipment(squaddie, repos), nil
 }
 
 func getHealingPower(powerID string, repos *repositories.RepositoryCollection) (powerinterface.Interface, error) {
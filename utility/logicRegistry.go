@@ -0,0 +1,96 @@
+package utility
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// LogicFactory creates a new logic object.
+type LogicFactory func() interface{}
+
+// LogicRegistry creates logic objects based on the keyword they were registered under.
+//   Games built on this library can register their own logic at startup.
+type LogicRegistry struct {
+	logicType          string
+	factoriesByKeyword map[string]LogicFactory
+	lock               sync.RWMutex
+}
+
+// NewLogicRegistry returns an empty registry. logicType names the logic in error messages.
+func NewLogicRegistry(logicType string) *LogicRegistry {
+	return &LogicRegistry{
+		logicType:          logicType,
+		factoriesByKeyword: map[string]LogicFactory{},
+	}
+}
+
+// Register adds the factory under the given keyword.
+//   Raises an error if the keyword is empty or already registered.
+func (registry *LogicRegistry) Register(keyword string, factory LogicFactory) error {
+	registry.lock.Lock()
+	defer registry.lock.Unlock()
+
+	if keyword == "" {
+		newError := fmt.Errorf("%s keyword cannot be empty", registry.logicType)
+		Log(newError.Error(), 0, Error)
+		return newError
+	}
+
+	if _, exists := registry.factoriesByKeyword[keyword]; exists {
+		newError := fmt.Errorf(`%s keyword "%s" is already registered`, registry.logicType, keyword)
+		Log(newError.Error(), 0, Error)
+		return newError
+	}
+
+	registry.factoriesByKeyword[keyword] = factory
+	return nil
+}
+
+// RegisterKeywords adds the factory under each of the given keywords.
+//   Stops at the first keyword that cannot be registered and returns its error.
+func (registry *LogicRegistry) RegisterKeywords(keywords []string, factory LogicFactory) error {
+	for _, keyword := range keywords {
+		err := registry.Register(keyword, factory)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Unregister removes the keyword, so NewLogic no longer understands it.
+//   Unknown keywords are ignored.
+func (registry *LogicRegistry) Unregister(keyword string) {
+	registry.lock.Lock()
+	defer registry.lock.Unlock()
+	delete(registry.factoriesByKeyword, keyword)
+}
+
+// NewLogic uses the factory registered under the keyword to create a new logic object.
+//   Raises an error if the keyword is unknown.
+func (registry *LogicRegistry) NewLogic(keyword string) (interface{}, error) {
+	registry.lock.RLock()
+	factory, exists := registry.factoriesByKeyword[keyword]
+	registry.lock.RUnlock()
+
+	if !exists {
+		newError := fmt.Errorf(`unknown %s keyword "%s"`, registry.logicType, keyword)
+		Log(newError.Error(), 0, Error)
+		return nil, newError
+	}
+	return factory(), nil
+}
+
+// Keywords returns all registered keywords in alphabetical order.
+func (registry *LogicRegistry) Keywords() []string {
+	registry.lock.RLock()
+	defer registry.lock.RUnlock()
+
+	keywords := []string{}
+	for keyword := range registry.factoriesByKeyword {
+		keywords = append(keywords, keyword)
+	}
+	sort.Strings(keywords)
+	return keywords
+}
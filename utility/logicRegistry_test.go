@@ -0,0 +1,64 @@
+package utility_test
+
+import (
+	"github.com/chadius/terosgamerules/utility"
+	. "gopkg.in/check.v1"
+)
+
+type LogicRegistrySuite struct {
+	registry *utility.LogicRegistry
+}
+
+var _ = Suite(&LogicRegistrySuite{})
+
+func (suite *LogicRegistrySuite) SetUpTest(checker *C) {
+	suite.registry = utility.NewLogicRegistry("greeting logic")
+	suite.registry.Register("hello", func() interface{} { return "hello world" })
+}
+
+func (suite *LogicRegistrySuite) TestCreatesLogicByKeyword(checker *C) {
+	logic, err := suite.registry.NewLogic("hello")
+	checker.Assert(err, IsNil)
+	checker.Assert(logic, Equals, "hello world")
+}
+
+func (suite *LogicRegistrySuite) TestRaisesAnErrorForUnknownKeywords(checker *C) {
+	logic, err := suite.registry.NewLogic("kwyjibo")
+	checker.Assert(err, ErrorMatches, `unknown greeting logic keyword "kwyjibo"`)
+	checker.Assert(logic, IsNil)
+}
+
+func (suite *LogicRegistrySuite) TestCannotRegisterAKeywordTwice(checker *C) {
+	err := suite.registry.Register("hello", func() interface{} { return "goodbye" })
+	checker.Assert(err, ErrorMatches, `greeting logic keyword "hello" is already registered`)
+
+	logic, _ := suite.registry.NewLogic("hello")
+	checker.Assert(logic, Equals, "hello world")
+}
+
+func (suite *LogicRegistrySuite) TestCannotRegisterAnEmptyKeyword(checker *C) {
+	err := suite.registry.Register("", func() interface{} { return "silence" })
+	checker.Assert(err, ErrorMatches, "greeting logic keyword cannot be empty")
+}
+
+func (suite *LogicRegistrySuite) TestUnregisteredKeywordsAreUnknown(checker *C) {
+	suite.registry.Unregister("hello")
+	_, err := suite.registry.NewLogic("hello")
+	checker.Assert(err, ErrorMatches, `unknown greeting logic keyword "hello"`)
+
+	err = suite.registry.Register("hello", func() interface{} { return "hello again" })
+	checker.Assert(err, IsNil)
+}
+
+func (suite *LogicRegistrySuite) TestListsKeywordsInOrder(checker *C) {
+	suite.registry.Register("aloha", func() interface{} { return "aloha" })
+	checker.Assert(suite.registry.Keywords(), DeepEquals, []string{"aloha", "hello"})
+}
+
+func (suite *LogicRegistrySuite) TestRegistersManyKeywordsAtOnce(checker *C) {
+	err := suite.registry.RegisterKeywords([]string{"hi", "Hi"}, func() interface{} { return "hi there" })
+	checker.Assert(err, IsNil)
+
+	logic, _ := suite.registry.NewLogic("Hi")
+	checker.Assert(logic, Equals, "hi there")
+}
@@ -7,6 +7,25 @@ type SixSideGenerator interface {
 	RollTwoDice() (int, int)
 }
 
+// IntGenerator is an interface that generates numbers from 0 to maxInt, not including maxInt.
+//   *rand.Rand satisfies this interface.
+type IntGenerator interface {
+	Intn(maxInt int) int
+}
+
+// NewSeededIntGenerator returns an IntGenerator that always produces the same sequence for the same seed.
+func NewSeededIntGenerator(seed int64) IntGenerator {
+	return rand.New(rand.NewSource(seed))
+}
+
+// GlobalIntGenerator generates numbers with RandomInt.
+type GlobalIntGenerator struct{}
+
+// Intn returns a random integer from 0 to maxInt.
+func (g GlobalIntGenerator) Intn(maxInt int) int {
+	return RandomInt(maxInt)
+}
+
 // RandomInt returns a random integer from 0 to maxInt.
 func RandomInt(maxInt int) int {
 	return rand.Intn(maxInt)
@@ -18,5 +37,27 @@ type RandomDieRoller struct{}
 
 // RollTwoDice rolls two dice.
 func (r RandomDieRoller) RollTwoDice() (int, int) {
-	return 1 + RandomInt(5), 1 + RandomInt(5)
+	return rollTwoDice(GlobalIntGenerator{})
+}
+
+// SeededDieRoller rolls two six sided dice using its own IntGenerator,
+//   so its rolls do not depend on any other random numbers.
+type SeededDieRoller struct {
+	generator IntGenerator
+}
+
+// NewSeededDieRoller returns a SeededDieRoller that always rolls the same sequence for the same seed.
+func NewSeededDieRoller(seed int64) *SeededDieRoller {
+	return &SeededDieRoller{generator: NewSeededIntGenerator(seed)}
+}
+
+// RollTwoDice rolls two dice.
+func (r *SeededDieRoller) RollTwoDice() (int, int) {
+	return rollTwoDice(r.generator)
+}
+
+// rollTwoDice rolls two six sided dice using the given generator.
+//   Every die roller uses this so they all roll from 1 to 6.
+func rollTwoDice(generator IntGenerator) (int, int) {
+	return 1 + generator.Intn(6), 1 + generator.Intn(6)
 }
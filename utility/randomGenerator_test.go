@@ -0,0 +1,46 @@
+package utility_test
+
+import (
+	"github.com/chadius/terosgamerules/utility"
+	. "gopkg.in/check.v1"
+)
+
+type DieRollerSuite struct{}
+
+var _ = Suite(&DieRollerSuite{})
+
+func countFacesRolled(dieRoller utility.SixSideGenerator) map[int]int {
+	facesRolled := map[int]int{}
+	for roll := 0; roll < 600; roll++ {
+		firstDie, secondDie := dieRoller.RollTwoDice()
+		facesRolled[firstDie]++
+		facesRolled[secondDie]++
+	}
+	return facesRolled
+}
+
+func (suite *DieRollerSuite) TestRandomDiceRollEveryFaceFromOneToSix(checker *C) {
+	facesRolled := countFacesRolled(utility.RandomDieRoller{})
+	checker.Assert(facesRolled, HasLen, 6)
+	for face := 1; face <= 6; face++ {
+		checker.Assert(facesRolled[face] > 0, Equals, true, Commentf("face %d was never rolled", face))
+	}
+}
+
+func (suite *DieRollerSuite) TestSeededDiceRollEveryFaceFromOneToSix(checker *C) {
+	facesRolled := countFacesRolled(utility.NewSeededDieRoller(1))
+	checker.Assert(facesRolled, HasLen, 6)
+	for face := 1; face <= 6; face++ {
+		checker.Assert(facesRolled[face] > 0, Equals, true, Commentf("face %d was never rolled", face))
+	}
+}
+
+func (suite *DieRollerSuite) TestSeededDiceRollTheSameSequenceForTheSameSeed(checker *C) {
+	firstRoller := utility.NewSeededDieRoller(7)
+	secondRoller := utility.NewSeededDieRoller(7)
+	for roll := 0; roll < 10; roll++ {
+		firstA, firstB := firstRoller.RollTwoDice()
+		secondA, secondB := secondRoller.RollTwoDice()
+		checker.Assert([]int{firstA, firstB}, DeepEquals, []int{secondA, secondB})
+	}
+}
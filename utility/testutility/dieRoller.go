@@ -23,8 +23,8 @@ type ReplayDiceRoller struct {
 }
 
 // RollTwoDice consumes the 0th record in the history and returns it as a roll.
-func (r ReplayDiceRoller) RollTwoDice() (int, int) {
+func (r *ReplayDiceRoller) RollTwoDice() (int, int) {
 	currentRoll := r.RollHistory[0]
-	r.RollHistory = r.RollHistory[0:]
+	r.RollHistory = r.RollHistory[1:]
 	return currentRoll[0], currentRoll[1]
 }